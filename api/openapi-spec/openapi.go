@@ -0,0 +1,127 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapispec
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+
+	"emperror.dev/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// nolint: gochecknoglobals
+//go:embed cloudinfo.yaml
+var document []byte
+
+// nolint: gochecknoglobals
+//go:embed cloudinfo-v2.yaml
+var documentV2 []byte
+
+// nolint: gochecknoglobals
+//go:embed swagger-ui/*
+var swaggerUI embed.FS
+
+// Document returns the OpenAPI 3 document of the API in JSON: cloudinfo.yaml with the paths and the schemas of
+// cloudinfo-v2.yaml merged into it and the server urls prefixed by the base path.
+func Document(basePath string) ([]byte, error) {
+	doc, err := load(document)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to load the v1 document")
+	}
+
+	docV2, err := load(documentV2)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to load the v2 document")
+	}
+
+	prefixServers(doc["servers"], basePath)
+	prefixServers(docV2["servers"], basePath)
+
+	paths := field(doc, "paths")
+	for path, item := range field(docV2, "paths") {
+		// the v2 paths are served under the servers of the v2 document
+		if item, ok := item.(map[string]interface{}); ok {
+			item["servers"] = docV2["servers"]
+		}
+		paths[path] = item
+	}
+
+	schemas := field(field(doc, "components"), "schemas")
+	for name, schema := range field(field(docV2, "components"), "schemas") {
+		schemas[name] = schema
+	}
+
+	return json.MarshalIndent(doc, "", "  ")
+}
+
+// SwaggerUI returns a filesystem with the Swagger UI assets under the swagger-ui directory.
+func SwaggerUI() embed.FS {
+	return swaggerUI
+}
+
+// load parses a YAML document into values that can be marshaled into JSON
+func load(data []byte) (map[string]interface{}, error) {
+	var doc interface{}
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+
+	converted, ok := toJSONValue(doc).(map[string]interface{})
+	if !ok {
+		return nil, errors.New("the document is not an object")
+	}
+
+	return converted, nil
+}
+
+// toJSONValue converts the maps with interface{} keys decoded from YAML to maps with string keys, recursively
+func toJSONValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			m[fmt.Sprint(key)] = toJSONValue(item)
+		}
+		return m
+	case []interface{}:
+		for i, item := range v {
+			v[i] = toJSONValue(item)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// field returns the object under the key of an object, an empty object if there is no such object
+func field(object map[string]interface{}, key string) map[string]interface{} {
+	if value, ok := object[key].(map[string]interface{}); ok {
+		return value
+	}
+
+	return map[string]interface{}{}
+}
+
+// prefixServers prefixes the urls of a list of servers with the base path
+func prefixServers(servers interface{}, basePath string) {
+	list, _ := servers.([]interface{})
+	for _, server := range list {
+		if server, ok := server.(map[string]interface{}); ok {
+			server["url"] = fmt.Sprintf("%s%s", basePath, server["url"])
+		}
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapispec
+
+import (
+	"encoding/json"
+	"io/fs"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDocument(t *testing.T) {
+	tests := []struct {
+		name     string
+		basePath string
+		server   string
+		serverV2 string
+	}{
+		{
+			name:     "without a base path",
+			server:   "/api/v1",
+			serverV2: "/api",
+		},
+		{
+			name:     "the server urls are prefixed by the base path",
+			basePath: "/cloudinfo",
+			server:   "/cloudinfo/api/v1",
+			serverV2: "/cloudinfo/api",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			type server struct {
+				URL string `json:"url"`
+			}
+			var document struct {
+				OpenAPI string   `json:"openapi"`
+				Servers []server `json:"servers"`
+				Paths   map[string]struct {
+					Servers []server `json:"servers"`
+				} `json:"paths"`
+				Components struct {
+					Schemas map[string]interface{} `json:"schemas"`
+				} `json:"components"`
+			}
+			data, err := Document(test.basePath)
+			require.NoError(t, err)
+			require.NoError(t, json.Unmarshal(data, &document))
+
+			assert.Equal(t, "3.0.0", document.OpenAPI)
+			assert.Equal(t, []server{{URL: test.server}}, document.Servers)
+			assert.Contains(t, document.Paths, "/providers")
+			assert.Contains(t, document.Paths, "/events")
+			assert.Empty(t, document.Paths["/providers"].Servers)
+
+			v2 := document.Paths["/v2/providers/{provider}/services/{service}/regions/{region}/products"]
+			assert.Equal(t, []server{{URL: test.serverV2}}, v2.Servers)
+			assert.Contains(t, document.Components.Schemas, "ProductDetails")
+			assert.Contains(t, document.Components.Schemas, "ProductV2")
+		})
+	}
+}
+
+func TestSwaggerUI(t *testing.T) {
+	for _, asset := range []string{"swagger-ui/swagger-ui-bundle.js", "swagger-ui/swagger-ui.css"} {
+		info, err := fs.Stat(SwaggerUI(), asset)
+		require.NoError(t, err, asset)
+		assert.NotZero(t, info.Size(), asset)
+	}
+}
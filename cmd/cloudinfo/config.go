@@ -16,6 +16,7 @@ package main
 
 import (
 	"fmt"
+	"net"
 	"net/url"
 	"os"
 	"strings"
@@ -25,18 +26,39 @@ import (
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 
+	"github.com/banzaicloud/cloudinfo/internal/app/cloudinfo/api"
 	"github.com/banzaicloud/cloudinfo/internal/app/cloudinfo/cistore"
 	"github.com/banzaicloud/cloudinfo/internal/app/cloudinfo/loader"
 	"github.com/banzaicloud/cloudinfo/internal/app/cloudinfo/management"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/currency"
 	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/distribution"
 	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/alibaba"
 	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/amazon"
 	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/azure"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/civo"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/custom"
 	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/digitalocean"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/equinixmetal"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/exoscale"
 	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/google"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/hetzner"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/huawei"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/ibm"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/linode"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/openstack"
 	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/oracle"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/outscale"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/ovh"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/scaleway"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/tencent"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/upcloud"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/vultr"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/yandex"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/webhook"
 	"github.com/banzaicloud/cloudinfo/internal/platform/jaeger"
 	"github.com/banzaicloud/cloudinfo/internal/platform/log"
+	"github.com/banzaicloud/cloudinfo/pkg/plugin"
 )
 
 // Provider constants
@@ -53,6 +75,38 @@ const (
 	Azure = "azure"
 	// Digitalocean is the identifier of the Digitalocean provider
 	Digitalocean = "digitalocean"
+	// Linode is the identifier of the Linode provider
+	Linode = "linode"
+	// Hetzner is the identifier of the Hetzner Cloud provider
+	Hetzner = "hetzner"
+	// OVH is the identifier of the OVHcloud provider
+	OVH = "ovh"
+	// Scaleway is the identifier of the Scaleway provider
+	Scaleway = "scaleway"
+	// Vultr is the identifier of the Vultr provider
+	Vultr = "vultr"
+	// IBM is the identifier of the IBM Cloud provider
+	IBM = "ibm"
+	// Tencent is the identifier of the Tencent Cloud provider
+	Tencent = "tencent"
+	// Exoscale is the identifier of the Exoscale provider
+	Exoscale = "exoscale"
+	// EquinixMetal is the identifier of the Equinix Metal provider
+	EquinixMetal = "equinixmetal"
+	// OpenStack is the identifier of the OpenStack provider
+	OpenStack = "openstack"
+	// Custom is the identifier of the Custom catalog provider
+	Custom = "custom"
+	// Yandex is the identifier of the Yandex Cloud provider
+	Yandex = "yandex"
+	// Huawei is the identifier of the Huawei Cloud provider
+	Huawei = "huawei"
+	// Outscale is the identifier of the Outscale provider
+	Outscale = "outscale"
+	// UpCloud is the identifier of the UpCloud provider
+	UpCloud = "upcloud"
+	// Civo is the identifier of the Civo provider
+	Civo = "civo"
 	// Vsphere is the identifier of the Vsphere provider
 	Vsphere = "vsphere"
 )
@@ -118,6 +172,16 @@ type configuration struct {
 		Address string
 
 		BasePath string
+
+		api.Config `mapstructure:",squash"`
+	}
+
+	// gRPC API configuration
+	Grpc struct {
+		Enabled bool
+
+		// gRPC server address
+		Address string
 	}
 
 	// Scrape configuration
@@ -126,6 +190,26 @@ type configuration struct {
 
 		// Cloud info scrape interval
 		Interval time.Duration
+
+		// Block and object storage price scrape interval (zero disables scraping the storage prices)
+		StorageInterval time.Duration
+
+		// Network traffic, load balancer, NAT gateway and public IP price scrape interval
+		// (zero disables scraping the network prices)
+		NetworkInterval time.Duration
+
+		// Providers to be scraped first (in the given order) on startup
+		Priority []string
+
+		// Retain the raw provider responses of the products for debugging purposes
+		RawPayloads bool
+
+		// Workload fit of the instance types by instance type prefix, overriding the classification rules
+		Workloads map[string][]string
+
+		// YAML file of the lifecycle stages of the instance types by provider and instance type prefix,
+		// overriding the ones published by the providers
+		LifecycleFile string
 	}
 
 	// Provider configuration
@@ -166,12 +250,129 @@ type configuration struct {
 			digitalocean.Config `mapstructure:",squash"`
 		}
 
+		// Linode configuration
+		Linode struct {
+			Enabled       bool
+			linode.Config `mapstructure:",squash"`
+		}
+
+		// Hetzner Cloud configuration
+		Hetzner struct {
+			Enabled        bool
+			hetzner.Config `mapstructure:",squash"`
+		}
+
+		// OVHcloud configuration
+		OVH struct {
+			Enabled    bool
+			ovh.Config `mapstructure:",squash"`
+		}
+
+		// Scaleway configuration
+		Scaleway struct {
+			Enabled         bool
+			scaleway.Config `mapstructure:",squash"`
+		}
+
+		// Vultr configuration
+		Vultr struct {
+			Enabled      bool
+			vultr.Config `mapstructure:",squash"`
+		}
+
+		// IBM Cloud configuration
+		IBM struct {
+			Enabled    bool
+			ibm.Config `mapstructure:",squash"`
+		}
+
+		// Tencent Cloud configuration
+		Tencent struct {
+			Enabled        bool
+			tencent.Config `mapstructure:",squash"`
+		}
+
+		// Exoscale configuration
+		Exoscale struct {
+			Enabled         bool
+			exoscale.Config `mapstructure:",squash"`
+		}
+
+		// Equinix Metal configuration
+		EquinixMetal struct {
+			Enabled             bool
+			equinixmetal.Config `mapstructure:",squash"`
+		}
+
+		// OpenStack configuration
+		OpenStack struct {
+			Enabled          bool
+			openstack.Config `mapstructure:",squash"`
+		}
+
+		// Custom catalog configuration
+		Custom struct {
+			Enabled       bool
+			custom.Config `mapstructure:",squash"`
+		}
+
+		// Yandex Cloud configuration
+		Yandex struct {
+			Enabled       bool
+			yandex.Config `mapstructure:",squash"`
+		}
+
+		// Huawei Cloud configuration
+		Huawei struct {
+			Enabled       bool
+			huawei.Config `mapstructure:",squash"`
+		}
+
+		// Outscale configuration
+		Outscale struct {
+			Enabled         bool
+			outscale.Config `mapstructure:",squash"`
+		}
+
+		// UpCloud configuration
+		UpCloud struct {
+			Enabled        bool
+			upcloud.Config `mapstructure:",squash"`
+		}
+
+		// Civo configuration
+		Civo struct {
+			Enabled     bool
+			civo.Config `mapstructure:",squash"`
+		}
+
 		// VSphere configuration
 		VSphere struct {
 			Enabled bool
 		}
 	}
 
+	// Plugin configuration of the out-of-tree providers, keyed by provider name
+	Plugin map[string]struct {
+		plugin.Config `mapstructure:",squash"`
+
+		// Services offered by the provider
+		Services []string
+	}
+
+	// Region metadata configuration
+	Region struct {
+		// YAML file of the carbon intensity data of the regions by provider and region,
+		// overriding the ones published by the providers
+		CarbonFile string
+	}
+
+	// Currency conversion configuration
+	Currency currency.Config
+
+	// Webhooks notified of the product changes detected by the scraping
+	Webhook webhook.Config
+
 	Distribution distribution.Config
 
 	Management management.Config
@@ -185,10 +386,56 @@ type configuration struct {
 func (c configuration) Validate() error {
 	// TODO: write config validation
 
+	if err := c.App.Config.Validate(); err != nil {
+		return err
+	}
+
+	if c.Grpc.Enabled && c.Grpc.Address == "" {
+		return errors.New("gRPC address is required")
+	}
+
+	if c.Grpc.Enabled && sameListenAddress(c.Grpc.Address, c.App.Address) {
+		return errors.NewWithDetails("gRPC address must differ from the application address", "address", c.Grpc.Address)
+	}
+
+	if c.Grpc.Enabled && c.Management.Enabled && sameListenAddress(c.Grpc.Address, c.Management.Address) {
+		return errors.NewWithDetails("gRPC address must differ from the management address", "address", c.Grpc.Address)
+	}
+
 	if !c.Scrape.Enabled && !(c.Store.Redis.Enabled || c.Store.Cassandra.Enabled) {
 		return errors.New("storage is required when scraping is disabled")
 	}
 
+	if c.Scrape.StorageInterval < 0 {
+		return errors.New("scrape storage interval must not be negative")
+	}
+
+	if c.Scrape.NetworkInterval < 0 {
+		return errors.New("scrape network interval must not be negative")
+	}
+
+	if err := cloudinfo.ValidateWorkloadMapping(c.Scrape.Workloads); err != nil {
+		return errors.WrapIf(err, "invalid scrape workloads configuration")
+	}
+
+	if err := c.Currency.Validate(); err != nil {
+		return errors.WrapIf(err, "invalid currency configuration")
+	}
+
+	if err := c.Webhook.Validate(); err != nil {
+		return errors.WrapIf(err, "invalid webhook configuration")
+	}
+
+	for name, pluginConfig := range c.Plugin {
+		if pluginConfig.Path == "" {
+			return errors.NewWithDetails("plugin path is required", "plugin", name)
+		}
+
+		if len(pluginConfig.Services) == 0 {
+			return errors.NewWithDetails("plugin services are required", "plugin", name)
+		}
+	}
+
 	return nil
 }
 
@@ -212,6 +459,12 @@ func configure(v *viper.Viper, p *pflag.FlagSet) {
 	// Application constants
 	v.Set("appName", appName)
 
+	// User agent sent with the provider API calls
+	userAgent := appName
+	if version != "" {
+		userAgent = fmt.Sprintf("%s/%s", appName, version)
+	}
+
 	// Global configuration
 	v.SetDefault("environment", "production")
 	v.SetDefault("debug", false)
@@ -261,6 +514,27 @@ func configure(v *viper.Viper, p *pflag.FlagSet) {
 	_ = v.BindPFlag("app.address", p.Lookup("listen-address"))
 
 	v.SetDefault("app.basePath", "/")
+	v.SetDefault("app.dataAgeHeaders", true)
+	v.SetDefault("app.defaultSort", "type")
+	v.SetDefault("app.slowRequestThreshold", time.Second)
+	v.SetDefault("app.compression.enabled", true)
+	v.SetDefault("app.compression.level", 0)
+	v.SetDefault("app.compression.minSize", 1024)
+	v.SetDefault("app.compression.brotli", false)
+	v.SetDefault("app.compression.brotliQuality", 0)
+	v.SetDefault("app.cors.allowedOrigins", []string{"*"})
+	v.SetDefault("app.cors.allowedMethods", []string{"GET", "POST", "PUT", "PATCH", "DELETE", "HEAD"})
+	v.SetDefault("app.cors.allowedHeaders", []string{"Origin", "Content-Length", "Content-Type", "Banzai-Cloud-Pipeline-UUID"})
+	v.SetDefault("app.cors.exposedHeaders", []string{"ETag", "X-Data-Age", "X-Data-Scraped-At", "X-Next-Cursor", "X-Total-Count"})
+	v.SetDefault("app.cors.allowCredentials", false)
+	v.SetDefault("app.cors.maxAge", 12*time.Hour)
+
+	// gRPC API configuration
+	p.Bool("grpc-enabled", false, "the gRPC API is served if enabled")
+	_ = v.BindPFlag("grpc.enabled", p.Lookup("grpc-enabled"))
+
+	p.String("grpc-address", ":9091", "the address where the gRPC API is served")
+	_ = v.BindPFlag("grpc.address", p.Lookup("grpc-address"))
 
 	// Scrape configuration
 	p.Bool("scrape", true, "enable cloud info scraping")
@@ -269,6 +543,24 @@ func configure(v *viper.Viper, p *pflag.FlagSet) {
 	p.Duration("scrape-interval", 24*time.Hour, "duration (in go syntax) between renewing information")
 	_ = v.BindPFlag("scrape.interval", p.Lookup("scrape-interval"))
 
+	v.SetDefault("scrape.storageInterval", 24*time.Hour)
+	v.SetDefault("scrape.networkInterval", 24*time.Hour)
+
+	p.StringSlice("scrape-priority", nil, "providers to be scraped first (in the given order) on startup")
+	_ = v.BindPFlag("scrape.priority", p.Lookup("scrape-priority"))
+
+	v.SetDefault("scrape.rawPayloads", false)
+
+	// Currency configuration
+	v.SetDefault("currency.refreshInterval", 24*time.Hour)
+	v.SetDefault("currency.ecb.enabled", true)
+	v.SetDefault("currency.ecb.url", "")
+
+	// Webhook configuration
+	v.SetDefault("webhook.attempts", 3)
+	v.SetDefault("webhook.retryBackoff", 10*time.Second)
+	v.SetDefault("webhook.timeout", 10*time.Second)
+
 	// Amazon config
 	p.Bool("provider-amazon", false, "enable amazon provider")
 	_ = v.BindPFlag("provider.amazon.enabled", p.Lookup("provider-amazon"))
@@ -283,6 +575,8 @@ func configure(v *viper.Viper, p *pflag.FlagSet) {
 	_ = v.BindEnv("provider.amazon.sharedCredentialsFile")
 	_ = v.BindEnv("provider.amazon.profile", "AWS_PROFILE")
 	_ = v.BindEnv("provider.amazon.assumeRoleARN", "AWS_ASSUME_ROLE_ARN")
+	v.SetDefault("provider.amazon.userAgent", userAgent)
+	v.SetDefault("provider.amazon.savingsPlans", false)
 	v.SetDefault("provider.amazon.pricing.region", defaultAmazonRegion)
 	_ = v.BindEnv("provider.amazon.pricing.accessKey")
 	_ = v.BindEnv("provider.amazon.pricing.secretKey")
@@ -300,6 +594,7 @@ func configure(v *viper.Viper, p *pflag.FlagSet) {
 	_ = v.BindEnv("provider.google.credentials", "GOOGLE_CREDENTIALS")
 	_ = v.BindEnv("provider.google.credentialsFile", "GOOGLE_CREDENTIALS_FILE")
 	_ = v.BindEnv("provider.google.project", "GOOGLE_PROJECT")
+	v.SetDefault("provider.google.userAgent", userAgent)
 
 	// Alibaba config
 	p.Bool("provider-alibaba", false, "enable alibaba provider")
@@ -311,6 +606,7 @@ func configure(v *viper.Viper, p *pflag.FlagSet) {
 	_ = v.BindEnv("provider.alibaba.accessKey", "ALICLOUD_ACCESS_KEY")
 	_ = v.BindEnv("provider.alibaba.secretKey", "ALIBABA_ACCESS_KEY_SECRET")
 	_ = v.BindEnv("provider.alibaba.secretKey", "ALICLOUD_SECRET_KEY")
+	v.SetDefault("provider.alibaba.userAgent", userAgent)
 
 	// Oracle config
 	p.Bool("provider-oracle", false, "enable oracle provider")
@@ -324,6 +620,7 @@ func configure(v *viper.Viper, p *pflag.FlagSet) {
 	_ = v.BindEnv("provider.oracle.privateKeyPassphrase", "ORACLE_PRIVATE_KEY_PASSPHRASE")
 	_ = v.BindEnv("provider.oracle.configFilePath", "ORACLE_CONFIG_FILE_PATH")
 	_ = v.BindEnv("provider.oracle.profile", "ORACLE_PROFILE")
+	v.SetDefault("provider.oracle.userAgent", userAgent)
 
 	// Azure config
 	p.Bool("provider-azure", false, "enable azure provider")
@@ -333,12 +630,161 @@ func configure(v *viper.Viper, p *pflag.FlagSet) {
 	_ = v.BindEnv("provider.azure.clientId")
 	_ = v.BindEnv("provider.azure.clientSecret")
 	_ = v.BindEnv("provider.azure.tenantId")
+	_ = v.BindEnv("provider.azure.environment", "AZURE_ENVIRONMENT")
+	v.SetDefault("provider.azure.environment", "AzurePublicCloud")
+	v.SetDefault("provider.azure.userAgent", userAgent)
 
 	// DigitalOcean config
 	p.Bool("provider-digitalocean", false, "enable digitalocean provider")
 	_ = v.BindPFlag("provider.digitalocean.enabled", p.Lookup("provider-digitalocean"))
 
 	_ = v.BindEnv("provider.digitalocean.accessToken", "DIGITALOCEAN_ACCESS_TOKEN")
+	v.SetDefault("provider.digitalocean.userAgent", userAgent)
+
+	// Linode config
+	p.Bool("provider-linode", false, "enable linode provider")
+	_ = v.BindPFlag("provider.linode.enabled", p.Lookup("provider-linode"))
+
+	_ = v.BindEnv("provider.linode.accessToken", "LINODE_TOKEN")
+	v.SetDefault("provider.linode.userAgent", userAgent)
+
+	// Hetzner Cloud config
+	p.Bool("provider-hetzner", false, "enable hetzner provider")
+	_ = v.BindPFlag("provider.hetzner.enabled", p.Lookup("provider-hetzner"))
+
+	_ = v.BindEnv("provider.hetzner.token", "HCLOUD_TOKEN")
+	v.SetDefault("provider.hetzner.ipv4Surcharge", true)
+	v.SetDefault("provider.hetzner.userAgent", userAgent)
+
+	// OVHcloud config
+	p.Bool("provider-ovh", false, "enable ovh provider")
+	_ = v.BindPFlag("provider.ovh.enabled", p.Lookup("provider-ovh"))
+
+	_ = v.BindEnv("provider.ovh.endpoint", "OVH_ENDPOINT")
+	_ = v.BindEnv("provider.ovh.applicationKey", "OVH_APPLICATION_KEY")
+	_ = v.BindEnv("provider.ovh.applicationSecret", "OVH_APPLICATION_SECRET")
+	_ = v.BindEnv("provider.ovh.consumerKey", "OVH_CONSUMER_KEY")
+	_ = v.BindEnv("provider.ovh.projectId", "OVH_CLOUD_PROJECT_SERVICE")
+	v.SetDefault("provider.ovh.endpoint", "https://eu.api.ovh.com/1.0")
+	v.SetDefault("provider.ovh.subsidiary", "FR")
+	v.SetDefault("provider.ovh.userAgent", userAgent)
+
+	// Scaleway config
+	p.Bool("provider-scaleway", false, "enable scaleway provider")
+	_ = v.BindPFlag("provider.scaleway.enabled", p.Lookup("provider-scaleway"))
+
+	_ = v.BindEnv("provider.scaleway.secretKey", "SCW_SECRET_KEY")
+	v.SetDefault("provider.scaleway.zones", scaleway.DefaultZones)
+	v.SetDefault("provider.scaleway.userAgent", userAgent)
+
+	// Vultr config
+	p.Bool("provider-vultr", false, "enable vultr provider")
+	_ = v.BindPFlag("provider.vultr.enabled", p.Lookup("provider-vultr"))
+
+	_ = v.BindEnv("provider.vultr.apiKey", "VULTR_API_KEY")
+	v.SetDefault("provider.vultr.userAgent", userAgent)
+
+	// IBM Cloud config
+	p.Bool("provider-ibm", false, "enable ibm provider")
+	_ = v.BindPFlag("provider.ibm.enabled", p.Lookup("provider-ibm"))
+
+	_ = v.BindEnv("provider.ibm.apiKey", "IBMCLOUD_API_KEY")
+	_ = v.BindEnv("provider.ibm.region", "IBMCLOUD_REGION")
+	v.SetDefault("provider.ibm.region", "us-south")
+	v.SetDefault("provider.ibm.catalogEntry", "is.instance")
+	v.SetDefault("provider.ibm.country", "USA")
+	v.SetDefault("provider.ibm.userAgent", userAgent)
+
+	// Tencent Cloud config
+	p.Bool("provider-tencent", false, "enable tencent provider")
+	_ = v.BindPFlag("provider.tencent.enabled", p.Lookup("provider-tencent"))
+
+	_ = v.BindEnv("provider.tencent.secretID", "TENCENTCLOUD_SECRET_ID")
+	_ = v.BindEnv("provider.tencent.secretKey", "TENCENTCLOUD_SECRET_KEY")
+	_ = v.BindEnv("provider.tencent.region", "TENCENTCLOUD_REGION")
+	v.SetDefault("provider.tencent.region", "ap-guangzhou")
+	v.SetDefault("provider.tencent.language", "en-US")
+	v.SetDefault("provider.tencent.userAgent", userAgent)
+
+	// Exoscale config
+	p.Bool("provider-exoscale", false, "enable exoscale provider")
+	_ = v.BindPFlag("provider.exoscale.enabled", p.Lookup("provider-exoscale"))
+
+	_ = v.BindEnv("provider.exoscale.apiKey", "EXOSCALE_API_KEY")
+	_ = v.BindEnv("provider.exoscale.apiSecret", "EXOSCALE_API_SECRET")
+	v.SetDefault("provider.exoscale.currency", "USD")
+	v.SetDefault("provider.exoscale.userAgent", userAgent)
+
+	// Equinix Metal config
+	p.Bool("provider-equinixmetal", false, "enable equinixmetal provider")
+	_ = v.BindPFlag("provider.equinixmetal.enabled", p.Lookup("provider-equinixmetal"))
+
+	_ = v.BindEnv("provider.equinixmetal.authToken", "METAL_AUTH_TOKEN")
+	v.SetDefault("provider.equinixmetal.userAgent", userAgent)
+
+	// OpenStack config
+	p.Bool("provider-openstack", false, "enable openstack provider")
+	_ = v.BindPFlag("provider.openstack.enabled", p.Lookup("provider-openstack"))
+
+	_ = v.BindEnv("provider.openstack.authURL", "OS_AUTH_URL")
+	_ = v.BindEnv("provider.openstack.username", "OS_USERNAME")
+	_ = v.BindEnv("provider.openstack.password", "OS_PASSWORD")
+	_ = v.BindEnv("provider.openstack.userDomainName", "OS_USER_DOMAIN_NAME")
+	_ = v.BindEnv("provider.openstack.projectName", "OS_PROJECT_NAME")
+	_ = v.BindEnv("provider.openstack.projectDomainName", "OS_PROJECT_DOMAIN_NAME")
+	_ = v.BindEnv("provider.openstack.applicationCredentialID", "OS_APPLICATION_CREDENTIAL_ID")
+	_ = v.BindEnv("provider.openstack.applicationCredentialSecret", "OS_APPLICATION_CREDENTIAL_SECRET")
+	_ = v.BindEnv("provider.openstack.interface", "OS_INTERFACE")
+	v.SetDefault("provider.openstack.userDomainName", "Default")
+	v.SetDefault("provider.openstack.projectDomainName", "Default")
+	v.SetDefault("provider.openstack.interface", "public")
+	v.SetDefault("provider.openstack.userAgent", userAgent)
+
+	// Custom catalog config
+	p.Bool("provider-custom", false, "enable custom provider")
+	_ = v.BindPFlag("provider.custom.enabled", p.Lookup("provider-custom"))
+
+	// Yandex Cloud config
+	p.Bool("provider-yandex", false, "enable yandex provider")
+	_ = v.BindPFlag("provider.yandex.enabled", p.Lookup("provider-yandex"))
+
+	_ = v.BindEnv("provider.yandex.oauthToken", "YC_TOKEN")
+	v.SetDefault("provider.yandex.currency", "RUB")
+	v.SetDefault("provider.yandex.userAgent", userAgent)
+
+	// Huawei Cloud config
+	p.Bool("provider-huawei", false, "enable huawei provider")
+	_ = v.BindPFlag("provider.huawei.enabled", p.Lookup("provider-huawei"))
+
+	_ = v.BindEnv("provider.huawei.accessKey", "HUAWEICLOUD_SDK_AK")
+	_ = v.BindEnv("provider.huawei.secretKey", "HUAWEICLOUD_SDK_SK")
+	v.SetDefault("provider.huawei.bssEndpoint", "https://bss-intl.myhuaweicloud.com")
+	v.SetDefault("provider.huawei.userAgent", userAgent)
+
+	// Outscale config
+	p.Bool("provider-outscale", false, "enable outscale provider")
+	_ = v.BindPFlag("provider.outscale.enabled", p.Lookup("provider-outscale"))
+
+	_ = v.BindEnv("provider.outscale.accessKey", "OSC_ACCESS_KEY")
+	_ = v.BindEnv("provider.outscale.secretKey", "OSC_SECRET_KEY")
+	_ = v.BindEnv("provider.outscale.region", "OSC_REGION")
+	v.SetDefault("provider.outscale.region", "eu-west-2")
+	v.SetDefault("provider.outscale.userAgent", userAgent)
+
+	// UpCloud config
+	p.Bool("provider-upcloud", false, "enable upcloud provider")
+	_ = v.BindPFlag("provider.upcloud.enabled", p.Lookup("provider-upcloud"))
+
+	_ = v.BindEnv("provider.upcloud.username", "UPCLOUD_USERNAME")
+	_ = v.BindEnv("provider.upcloud.password", "UPCLOUD_PASSWORD")
+	v.SetDefault("provider.upcloud.userAgent", userAgent)
+
+	// Civo config
+	p.Bool("provider-civo", false, "enable civo provider")
+	_ = v.BindPFlag("provider.civo.enabled", p.Lookup("provider-civo"))
+
+	_ = v.BindEnv("provider.civo.apiKey", "CIVO_TOKEN")
+	v.SetDefault("provider.civo.userAgent", userAgent)
 
 	// Distribution
 	v.SetDefault("distribution.pke.amazon.enabled", true)
@@ -347,11 +793,13 @@ func configure(v *viper.Viper, p *pflag.FlagSet) {
 	// Management
 	v.SetDefault("management.enabled", true)
 	v.SetDefault("management.address", ":8001")
+	v.SetDefault("management.exportDir", "")
 
 	// ServiceLoader
 	v.SetDefault("serviceloader.serviceConfigLocation", "./configs")
 	v.SetDefault("serviceloader.serviceConfigName", "services")
 	v.SetDefault("serviceloader.format", "yaml")
+	v.SetDefault("serviceloader.complianceConfigName", "compliance")
 
 	// CloudInfoStore
 	// Redis product store
@@ -370,3 +818,26 @@ func configure(v *viper.Viper, p *pflag.FlagSet) {
 	v.SetDefault("store.gocache.expiration", 0)
 	v.SetDefault("store.gocache.cleanupInterval", 0)
 }
+
+// sameListenAddress tells whether two listen addresses collide: they have the same port and the same host,
+// or either of them listens on all the interfaces
+func sameListenAddress(a, b string) bool {
+	aHost, aPort, err := net.SplitHostPort(a)
+	if err != nil {
+		return a == b
+	}
+	bHost, bPort, err := net.SplitHostPort(b)
+	if err != nil {
+		return a == b
+	}
+
+	if aPort != bPort {
+		return false
+	}
+
+	anyHost := func(host string) bool {
+		return host == "" || net.ParseIP(host).IsUnspecified()
+	}
+
+	return aHost == bHost || anyHost(aHost) || anyHost(bHost)
+}
@@ -27,9 +27,18 @@
 package main
 
 import (
+	"context"
 	"fmt"
+	"io"
+	"net"
+	"net/http"
 	"net/url"
 	"os"
+	"os/signal"
+	"sort"
+	"sync"
+	"syscall"
+	"time"
 
 	"emperror.dev/emperror"
 	"emperror.dev/errors"
@@ -38,6 +47,7 @@ import (
 	_ "github.com/sagikazarmark/viperx/remote/bankvaults"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
+	"google.golang.org/grpc"
 
 	"github.com/banzaicloud/cloudinfo/internal/app/cloudinfo/api"
 	"github.com/banzaicloud/cloudinfo/internal/app/cloudinfo/cistore"
@@ -48,16 +58,37 @@ import (
 	"github.com/banzaicloud/cloudinfo/internal/cloudinfo"
 	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/cloudinfoadapter"
 	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/cloudinfodriver"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/currency"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/export"
 	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/metrics"
 	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/alibaba"
 	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/amazon"
 	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/azure"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/civo"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/custom"
 	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/digitalocean"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/equinixmetal"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/exoscale"
 	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/google"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/hetzner"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/huawei"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/ibm"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/linode"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/openstack"
 	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/oracle"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/outscale"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/ovh"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/scaleway"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/tencent"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/upcloud"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/vultr"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/yandex"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/webhook"
 	"github.com/banzaicloud/cloudinfo/internal/platform/buildinfo"
 	"github.com/banzaicloud/cloudinfo/internal/platform/errorhandler"
 	"github.com/banzaicloud/cloudinfo/internal/platform/log"
+	"github.com/banzaicloud/cloudinfo/pkg/plugin"
 )
 
 // Provisioned by ldflags
@@ -75,6 +106,8 @@ func main() {
 	p.String("config", "", "Configuration file")
 	p.Bool("version", false, "Show version information")
 	p.Bool("dump-config", false, "Dump configuration to the console (and exit)")
+	p.String("export-parquet", "", "Export the product catalog as Parquet files to a local directory, s3://bucket/prefix or gs://bucket/prefix (and exit)")
+	p.StringSlice("export-providers", nil, "Providers to export with --export-parquet (all of them if empty)")
 
 	_ = p.Parse(os.Args[1:])
 
@@ -176,6 +209,7 @@ func main() {
 
 	infoers, providers, err := loadInfoers(config, cloudInfoLogger)
 	emperror.Panic(err)
+	defer closeInfoers(infoers)
 
 	reporter := metrics.NewDefaultMetricsReporter()
 
@@ -183,14 +217,44 @@ func main() {
 
 	serviceManager := loader.NewDefaultServiceManager(config.ServiceLoader, cloudInfoStore, cloudInfoLogger, eventBus)
 	serviceManager.ConfigureServices(providers, config.Distribution)
+	configurePluginServices(config, cloudInfoStore)
 
 	serviceManager.LoadServiceInformation(providers)
 
 	prodInfo, err := cloudinfo.NewCloudInfo(providers, cloudInfoStore, cloudInfoLogger)
 	emperror.Panic(err)
 
+	exporter := export.NewExporter(prodInfo, cloudInfoLogger)
+	if destination, _ := p.GetString("export-parquet"); destination != "" {
+		exportProviders, _ := p.GetStringSlice("export-providers")
+
+		files, err := exporter.Export(context.Background(), destination, exportProviders)
+		emperror.Panic(err)
+
+		for _, file := range files {
+			fmt.Println(file)
+		}
+
+		os.Exit(0)
+	}
+
 	if config.Scrape.Enabled {
-		scrapingDriver := cloudinfo.NewScrapingDriver(config.Scrape.Interval, infoers, cloudInfoStore, eventBus, reporter, tracer, errorHandler, cloudInfoLogger)
+		lifecycles, err := cloudinfo.LoadLifecycleOverrides(config.Scrape.LifecycleFile)
+		emperror.Panic(err)
+
+		scrapingDriverConfig := cloudinfo.ScrapingDriverConfig{
+			RenewalInterval: config.Scrape.Interval,
+			StorageInterval: config.Scrape.StorageInterval,
+			NetworkInterval: config.Scrape.NetworkInterval,
+			Priority:        config.Scrape.Priority,
+			Workloads:       config.Scrape.Workloads,
+			Lifecycles:      lifecycles,
+		}
+		scrapingDriver := cloudinfo.NewScrapingDriver(scrapingDriverConfig, infoers, cloudInfoStore, eventBus, reporter, tracer, errorHandler, cloudInfoLogger)
+
+		if len(config.Webhook.Endpoints) > 0 {
+			webhook.NewDispatcher(config.Webhook, cloudInfoLogger).Subscribe(eventBus)
+		}
 
 		err = scrapingDriver.StartScraping()
 		emperror.Panic(err)
@@ -198,31 +262,93 @@ func main() {
 		// start the management service
 		// TODO: management requires scraping at the moment. Let's remove that dependency.
 		if config.Management.Enabled {
-			go management.StartManagementEngine(config.Management, cloudInfoStore, *scrapingDriver, cloudInfoLogger)
+			go management.StartManagementEngine(config.Management, cloudInfoStore, scrapingDriver, exporter, cloudInfoLogger)
 		}
 	}
 
-	err = api.ConfigureValidator(providers, prodInfo, cloudInfoLogger)
+	err = api.ConfigureValidator(providers, prodInfo, eventBus, cloudInfoLogger)
 	emperror.Panic(err)
 
 	cloudinfoLogger := cloudinfoadapter.NewLogger(logger)
 	providerService := cloudinfo.NewProviderService(prodInfo)
 	serviceService := cloudinfo.NewServiceService(prodInfo)
 	regionService := cloudinfo.NewRegionService(prodInfo)
+	imageService := cloudinfo.NewImageService(prodInfo)
+	versionService := cloudinfo.NewVersionService(prodInfo)
+	spotPriceService := cloudinfo.NewSpotPriceService(prodInfo)
+	searchService := cloudinfo.NewSearchService(prodInfo)
 	instanceTypeService := cloudinfo.NewInstanceTypeService(prodInfo)
 	endpoints := cloudinfodriver.MakeEndpoints(instanceTypeService)
 	providerEndpoints := cloudinfodriver.MakeProviderEndpoints(providerService, cloudinfoLogger)
 	serviceEndpoints := cloudinfodriver.MakeServiceEndpoints(serviceService, cloudinfoLogger)
 	regionEndpoints := cloudinfodriver.MakeRegionEndpoints(regionService, cloudinfoLogger)
+	imageEndpoints := cloudinfodriver.MakeImageEndpoints(imageService, cloudinfoLogger)
+	versionEndpoints := cloudinfodriver.MakeVersionEndpoints(versionService, cloudinfoLogger)
+	spotPriceEndpoints := cloudinfodriver.MakeSpotPriceEndpoints(spotPriceService, cloudinfoLogger)
+	searchEndpoints := cloudinfodriver.MakeSearchEndpoints(searchService, cloudinfoLogger)
 	graphqlHandler := cloudinfodriver.MakeGraphQLHandler(
 		endpoints,
 		providerEndpoints,
 		serviceEndpoints,
 		regionEndpoints,
+		imageEndpoints,
+		versionEndpoints,
+		spotPriceEndpoints,
+		searchEndpoints,
+		eventBus,
 		errorHandler,
 	)
 
-	routeHandler := api.NewRouteHandler(prodInfo, buildInfo, graphqlHandler, cloudInfoLogger)
+	// the servers are shut down gracefully on the termination signals
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	var shutdown sync.WaitGroup
+
+	if config.Grpc.Enabled {
+		grpcServer := cloudinfodriver.MakeGRPCServer(
+			endpoints,
+			providerEndpoints,
+			serviceEndpoints,
+			regionEndpoints,
+			errorHandler,
+		)
+
+		listener, err := net.Listen("tcp", config.Grpc.Address)
+		emperror.Panic(errors.WrapIfWithDetails(err, "failed to listen on the gRPC address", "address", config.Grpc.Address))
+
+		logger.Info("gRPC API enabled", map[string]interface{}{"address": config.Grpc.Address})
+
+		go func() {
+			emperror.Panic(errors.Wrap(grpcServer.Serve(listener), "failed to serve the gRPC API"))
+		}()
+
+		shutdown.Add(1)
+		go func() {
+			defer shutdown.Done()
+
+			<-ctx.Done()
+			stopGRPCServer(grpcServer, config.ShutdownTimeout)
+			logger.Info("gRPC API stopped")
+		}()
+	}
+
+	routeHandler := api.NewRouteHandler(config.App.Config, prodInfo, buildInfo, graphqlHandler, eventBus, cloudInfoLogger)
+	routeHandler.AddReadinessCheck("store", cloudInfoStore)
+	routeHandler.AddReadinessCheck("data", api.DataReadinessCheck(prodInfo))
+
+	carbonOverrides, err := cloudinfo.LoadRegionCarbonOverrides(config.Region.CarbonFile)
+	emperror.Panic(err)
+	routeHandler.SetRegionCarbonData(cloudinfo.NewRegionCarbonData(carbonOverrides))
+
+	regionCompliance, err := loader.LoadRegionCompliance(config.ServiceLoader)
+	emperror.Panic(err)
+	routeHandler.SetRegionCompliance(regionCompliance)
+
+	currencyConverter := currency.NewConverter(cloudInfoLogger, config.Currency.Sources()...)
+	err = cloudinfo.NewPeriodicExecutor(config.Currency.RefreshInterval, cloudInfoLogger).Execute(context.Background(), currencyConverter.Refresh)
+	emperror.Panic(errors.WrapIf(err, "failed to refresh exchange rates"))
+	routeHandler.EnableCurrencyConversion(currencyConverter)
 
 	// new default gin engine (recovery, logger middleware)
 	router := gin.Default()
@@ -236,8 +362,44 @@ func main() {
 
 	routeHandler.ConfigureRoutes(router, config.App.BasePath)
 
-	err = router.Run(config.App.Address)
-	emperror.Panic(errors.Wrap(err, "failed to run router"))
+	server := &http.Server{Addr: config.App.Address, Handler: router}
+
+	shutdown.Add(1)
+	go func() {
+		defer shutdown.Done()
+
+		<-ctx.Done()
+		logger.Info("shutting down")
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), config.ShutdownTimeout)
+		defer cancel()
+
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			logger.Error("failed to shut down the server gracefully", map[string]interface{}{"error": err.Error()})
+		}
+	}()
+
+	logger.Info("listening", map[string]interface{}{"address": config.App.Address})
+	if err := server.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+		emperror.Panic(errors.Wrap(err, "failed to run router"))
+	}
+
+	shutdown.Wait()
+}
+
+// stopGRPCServer stops the gRPC server gracefully, the calls still pending after the timeout are cancelled
+func stopGRPCServer(server *grpc.Server, timeout time.Duration) {
+	stopped := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(timeout):
+		server.Stop()
+	}
 }
 
 func loadInfoers(config configuration, logger cloudinfo.Logger) (map[string]cloudinfo.CloudInfoer, []string, error) {
@@ -249,7 +411,10 @@ func loadInfoers(config configuration, logger cloudinfo.Logger) (map[string]clou
 		providers = append(providers, Amazon)
 		logger := logger.WithFields(map[string]interface{}{"provider": Amazon})
 
-		infoer, err := amazon.NewAmazonInfoer(config.Provider.Amazon.Config, logger)
+		providerConfig := config.Provider.Amazon.Config
+		providerConfig.RawPayloads = config.Scrape.RawPayloads
+
+		infoer, err := amazon.NewAmazonInfoer(providerConfig, logger)
 		if err != nil {
 			return nil, nil, errors.WithDetails(err, "provider", Amazon)
 		}
@@ -263,7 +428,10 @@ func loadInfoers(config configuration, logger cloudinfo.Logger) (map[string]clou
 		providers = append(providers, Google)
 		logger := logger.WithFields(map[string]interface{}{"provider": Google})
 
-		infoer, err := google.NewGoogleInfoer(config.Provider.Google.Config, logger)
+		providerConfig := config.Provider.Google.Config
+		providerConfig.RawPayloads = config.Scrape.RawPayloads
+
+		infoer, err := google.NewGoogleInfoer(providerConfig, logger)
 		if err != nil {
 			return nil, nil, emperror.With(err, "provider", Google)
 		}
@@ -305,7 +473,10 @@ func loadInfoers(config configuration, logger cloudinfo.Logger) (map[string]clou
 		providers = append(providers, Azure)
 		logger := logger.WithFields(map[string]interface{}{"provider": Azure})
 
-		infoer, err := azure.NewAzureInfoer(config.Provider.Azure.Config, logger)
+		providerConfig := config.Provider.Azure.Config
+		providerConfig.RawPayloads = config.Scrape.RawPayloads
+
+		infoer, err := azure.NewAzureInfoer(providerConfig, logger)
 		if err != nil {
 			return nil, nil, emperror.With(err, "provider", Azure)
 		}
@@ -329,11 +500,283 @@ func loadInfoers(config configuration, logger cloudinfo.Logger) (map[string]clou
 		logger.Info("configured cloud info provider")
 	}
 
+	if config.Provider.Linode.Enabled {
+		providers = append(providers, Linode)
+		logger := logger.WithFields(map[string]interface{}{"provider": Linode})
+
+		infoer, err := linode.NewLinodeInfoer(config.Provider.Linode.Config, logger)
+		if err != nil {
+			return nil, nil, emperror.With(err, "provider", Linode)
+		}
+
+		infoers[Linode] = infoer
+
+		logger.Info("configured cloud info provider")
+	}
+
+	if config.Provider.Hetzner.Enabled {
+		providers = append(providers, Hetzner)
+		logger := logger.WithFields(map[string]interface{}{"provider": Hetzner})
+
+		infoer, err := hetzner.NewHetznerInfoer(config.Provider.Hetzner.Config, logger)
+		if err != nil {
+			return nil, nil, emperror.With(err, "provider", Hetzner)
+		}
+
+		infoers[Hetzner] = infoer
+
+		logger.Info("configured cloud info provider")
+	}
+
+	if config.Provider.OVH.Enabled {
+		providers = append(providers, OVH)
+		logger := logger.WithFields(map[string]interface{}{"provider": OVH})
+
+		infoer, err := ovh.NewOVHInfoer(config.Provider.OVH.Config, logger)
+		if err != nil {
+			return nil, nil, emperror.With(err, "provider", OVH)
+		}
+
+		infoers[OVH] = infoer
+
+		logger.Info("configured cloud info provider")
+	}
+
+	if config.Provider.Scaleway.Enabled {
+		providers = append(providers, Scaleway)
+		logger := logger.WithFields(map[string]interface{}{"provider": Scaleway})
+
+		infoer, err := scaleway.NewScalewayInfoer(config.Provider.Scaleway.Config, logger)
+		if err != nil {
+			return nil, nil, emperror.With(err, "provider", Scaleway)
+		}
+
+		infoers[Scaleway] = infoer
+
+		logger.Info("configured cloud info provider")
+	}
+
+	if config.Provider.Vultr.Enabled {
+		providers = append(providers, Vultr)
+		logger := logger.WithFields(map[string]interface{}{"provider": Vultr})
+
+		infoer, err := vultr.NewVultrInfoer(config.Provider.Vultr.Config, logger)
+		if err != nil {
+			return nil, nil, emperror.With(err, "provider", Vultr)
+		}
+
+		infoers[Vultr] = infoer
+
+		logger.Info("configured cloud info provider")
+	}
+
+	if config.Provider.IBM.Enabled {
+		providers = append(providers, IBM)
+		logger := logger.WithFields(map[string]interface{}{"provider": IBM})
+
+		infoer, err := ibm.NewIBMInfoer(config.Provider.IBM.Config, logger)
+		if err != nil {
+			return nil, nil, emperror.With(err, "provider", IBM)
+		}
+
+		infoers[IBM] = infoer
+
+		logger.Info("configured cloud info provider")
+	}
+
+	if config.Provider.Tencent.Enabled {
+		providers = append(providers, Tencent)
+		logger := logger.WithFields(map[string]interface{}{"provider": Tencent})
+
+		infoer, err := tencent.NewTencentInfoer(config.Provider.Tencent.Config, logger)
+		if err != nil {
+			return nil, nil, emperror.With(err, "provider", Tencent)
+		}
+
+		infoers[Tencent] = infoer
+
+		logger.Info("configured cloud info provider")
+	}
+
+	if config.Provider.Exoscale.Enabled {
+		providers = append(providers, Exoscale)
+		logger := logger.WithFields(map[string]interface{}{"provider": Exoscale})
+
+		infoer, err := exoscale.NewExoscaleInfoer(config.Provider.Exoscale.Config, logger)
+		if err != nil {
+			return nil, nil, emperror.With(err, "provider", Exoscale)
+		}
+
+		infoers[Exoscale] = infoer
+
+		logger.Info("configured cloud info provider")
+	}
+
+	if config.Provider.EquinixMetal.Enabled {
+		providers = append(providers, EquinixMetal)
+		logger := logger.WithFields(map[string]interface{}{"provider": EquinixMetal})
+
+		infoer, err := equinixmetal.NewEquinixMetalInfoer(config.Provider.EquinixMetal.Config, logger)
+		if err != nil {
+			return nil, nil, emperror.With(err, "provider", EquinixMetal)
+		}
+
+		infoers[EquinixMetal] = infoer
+
+		logger.Info("configured cloud info provider")
+	}
+
+	if config.Provider.OpenStack.Enabled {
+		providers = append(providers, OpenStack)
+		logger := logger.WithFields(map[string]interface{}{"provider": OpenStack})
+
+		infoer, err := openstack.NewOpenStackInfoer(config.Provider.OpenStack.Config, logger)
+		if err != nil {
+			return nil, nil, emperror.With(err, "provider", OpenStack)
+		}
+
+		infoers[OpenStack] = infoer
+
+		logger.Info("configured cloud info provider")
+	}
+
+	if config.Provider.Custom.Enabled {
+		providers = append(providers, Custom)
+		logger := logger.WithFields(map[string]interface{}{"provider": Custom})
+
+		infoer, err := custom.NewCustomInfoer(config.Provider.Custom.Config, logger)
+		if err != nil {
+			return nil, nil, emperror.With(err, "provider", Custom)
+		}
+
+		infoers[Custom] = infoer
+
+		logger.Info("configured cloud info provider")
+	}
+
+	if config.Provider.Yandex.Enabled {
+		providers = append(providers, Yandex)
+		logger := logger.WithFields(map[string]interface{}{"provider": Yandex})
+
+		infoer, err := yandex.NewYandexInfoer(config.Provider.Yandex.Config, logger)
+		if err != nil {
+			return nil, nil, emperror.With(err, "provider", Yandex)
+		}
+
+		infoers[Yandex] = infoer
+
+		logger.Info("configured cloud info provider")
+	}
+
+	if config.Provider.Huawei.Enabled {
+		providers = append(providers, Huawei)
+		logger := logger.WithFields(map[string]interface{}{"provider": Huawei})
+
+		infoer, err := huawei.NewHuaweiInfoer(config.Provider.Huawei.Config, logger)
+		if err != nil {
+			return nil, nil, emperror.With(err, "provider", Huawei)
+		}
+
+		infoers[Huawei] = infoer
+
+		logger.Info("configured cloud info provider")
+	}
+
+	if config.Provider.Outscale.Enabled {
+		providers = append(providers, Outscale)
+		logger := logger.WithFields(map[string]interface{}{"provider": Outscale})
+
+		infoer, err := outscale.NewOutscaleInfoer(config.Provider.Outscale.Config, logger)
+		if err != nil {
+			return nil, nil, emperror.With(err, "provider", Outscale)
+		}
+
+		infoers[Outscale] = infoer
+
+		logger.Info("configured cloud info provider")
+	}
+
+	if config.Provider.UpCloud.Enabled {
+		providers = append(providers, UpCloud)
+		logger := logger.WithFields(map[string]interface{}{"provider": UpCloud})
+
+		infoer, err := upcloud.NewUpCloudInfoer(config.Provider.UpCloud.Config, logger)
+		if err != nil {
+			return nil, nil, emperror.With(err, "provider", UpCloud)
+		}
+
+		infoers[UpCloud] = infoer
+
+		logger.Info("configured cloud info provider")
+	}
+
+	if config.Provider.Civo.Enabled {
+		providers = append(providers, Civo)
+		logger := logger.WithFields(map[string]interface{}{"provider": Civo})
+
+		infoer, err := civo.NewCivoInfoer(config.Provider.Civo.Config, logger)
+		if err != nil {
+			return nil, nil, emperror.With(err, "provider", Civo)
+		}
+
+		infoers[Civo] = infoer
+
+		logger.Info("configured cloud info provider")
+	}
+
 	if config.Provider.VSphere.Enabled {
 		providers = append(providers, Vsphere)
 		logger := logger.WithFields(map[string]interface{}{"provider": Vsphere})
 		logger.Info("configured cloud info provider")
 	}
 
+	plugins := make([]string, 0, len(config.Plugin))
+	for name := range config.Plugin {
+		plugins = append(plugins, name)
+	}
+	sort.Strings(plugins)
+
+	for _, name := range plugins {
+		if cloudinfo.Contains(providers, name) {
+			closeInfoers(infoers)
+			return nil, nil, errors.NewWithDetails("plugin conflicts with a built-in provider", "provider", name)
+		}
+
+		providers = append(providers, name)
+		logger := logger.WithFields(map[string]interface{}{"provider": name})
+
+		infoer, err := plugin.Start(name, config.Plugin[name].Config, logger)
+		if err != nil {
+			closeInfoers(infoers)
+			return nil, nil, errors.WithDetails(err, "provider", name)
+		}
+
+		infoers[name] = infoer
+
+		logger.Info("configured cloud info provider plugin")
+	}
+
 	return infoers, providers, nil
 }
+
+// configurePluginServices stores the services of the plugin providers, those are configured with the plugins
+// instead of the service descriptors
+func configurePluginServices(config configuration, store cloudinfo.CloudInfoStore) {
+	for name, pluginConfig := range config.Plugin {
+		services := make([]types.Service, 0, len(pluginConfig.Services))
+		for _, service := range pluginConfig.Services {
+			services = append(services, types.Service{Service: service})
+		}
+
+		store.StoreServices(name, services)
+	}
+}
+
+// closeInfoers stops the plugin processes behind the infoers
+func closeInfoers(infoers map[string]cloudinfo.CloudInfoer) {
+	for _, infoer := range infoers {
+		if closer, ok := infoer.(io.Closer); ok {
+			_ = closer.Close()
+		}
+	}
+}
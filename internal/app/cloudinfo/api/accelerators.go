@@ -0,0 +1,89 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"sort"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// acceleratorKey identifies an accelerator model of a provider
+type acceleratorKey struct {
+	acceleratorType string
+	vendor          string
+	model           string
+}
+
+// aggregateAccelerators collects the accelerator models of the products with the numbers they are attached in,
+// the zones and the instance types offering them, ordered by type, vendor and model
+func aggregateAccelerators(products []types.ProductDetails) AcceleratorsResponse {
+	accelerators := make(map[acceleratorKey]*AcceleratorInfo)
+	for _, product := range products {
+		for _, accelerator := range product.Accelerators {
+			key := acceleratorKey{acceleratorType: accelerator.Type, vendor: accelerator.Vendor, model: accelerator.Model}
+			info, ok := accelerators[key]
+			if !ok {
+				info = &AcceleratorInfo{Type: accelerator.Type, Vendor: accelerator.Vendor, Model: accelerator.Model}
+				accelerators[key] = info
+			}
+			if accelerator.Count > 0 {
+				info.Memory = accelerator.Memory / float64(accelerator.Count)
+				info.Counts = appendUniqueInt(info.Counts, accelerator.Count)
+			}
+			for _, zone := range product.Zones {
+				info.Zones = appendUniqueString(info.Zones, zone)
+			}
+			info.InstanceTypes = appendUniqueString(info.InstanceTypes, product.Type)
+		}
+	}
+
+	response := make(AcceleratorsResponse, 0, len(accelerators))
+	for _, info := range accelerators {
+		sort.Ints(info.Counts)
+		sort.Strings(info.Zones)
+		sort.Strings(info.InstanceTypes)
+		response = append(response, *info)
+	}
+	sort.Slice(response, func(i, j int) bool {
+		if response[i].Type != response[j].Type {
+			return response[i].Type < response[j].Type
+		}
+		if response[i].Vendor != response[j].Vendor {
+			return response[i].Vendor < response[j].Vendor
+		}
+		return response[i].Model < response[j].Model
+	})
+
+	return response
+}
+
+func appendUniqueInt(values []int, value int) []int {
+	for _, v := range values {
+		if v == value {
+			return values
+		}
+	}
+	return append(values, value)
+}
+
+func appendUniqueString(values []string, value string) []string {
+	for _, v := range values {
+		if v == value {
+			return values
+		}
+	}
+	return append(values, value)
+}
@@ -0,0 +1,60 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestAggregateAccelerators(t *testing.T) {
+	inferentia := func(count int) types.Accelerator {
+		return types.Accelerator{Type: types.AcceleratorInferentia, Vendor: "aws", Model: "Inferentia2", Count: count, Memory: float64(count) * 32}
+	}
+	products := []types.ProductDetails{
+		{VMInfo: types.VMInfo{Type: "m5.large", Zones: []string{"us-east-1a"}}},
+		{VMInfo: types.VMInfo{Type: "inf2.xlarge", Zones: []string{"us-east-1b", "us-east-1a"},
+			Accelerators: []types.Accelerator{inferentia(1)}}},
+		{VMInfo: types.VMInfo{Type: "inf2.48xlarge", Zones: []string{"us-east-1c"},
+			Accelerators: []types.Accelerator{inferentia(12)}}},
+		{VMInfo: types.VMInfo{Type: "f1.2xlarge", Zones: []string{"us-east-1a"},
+			Accelerators: []types.Accelerator{{Type: types.AcceleratorFPGA, Vendor: "xilinx", Model: "VU9P", Count: 1, Memory: 64}}}},
+	}
+
+	assert.Equal(t, AcceleratorsResponse{
+		{
+			Type:          types.AcceleratorFPGA,
+			Vendor:        "xilinx",
+			Model:         "VU9P",
+			Memory:        64,
+			Counts:        []int{1},
+			Zones:         []string{"us-east-1a"},
+			InstanceTypes: []string{"f1.2xlarge"},
+		},
+		{
+			Type:          types.AcceleratorInferentia,
+			Vendor:        "aws",
+			Model:         "Inferentia2",
+			Memory:        32,
+			Counts:        []int{1, 12},
+			Zones:         []string{"us-east-1a", "us-east-1b", "us-east-1c"},
+			InstanceTypes: []string{"inf2.48xlarge", "inf2.xlarge"},
+		},
+	}, aggregateAccelerators(products))
+	assert.Empty(t, aggregateAccelerators(products[:1]))
+}
@@ -0,0 +1,122 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"strconv"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// attributeBounds holds the bounds of the numeric attributes of the products requested in the query parameters
+type attributeBounds struct {
+	minCpu          *float64
+	maxCpu          *float64
+	minMemory       *float64
+	maxMemory       *float64
+	gpus            *float64
+	maxPricePerHour *float64
+}
+
+// parseAttributeBounds parses the attribute bounds of the query parameters, the empty parameters set no bounds
+func parseAttributeBounds(queryParams GetProductDetailsQueryParams) (attributeBounds, error) {
+	var (
+		bounds attributeBounds
+		err    error
+	)
+
+	params := []struct {
+		name  string
+		value string
+		bound **float64
+	}{
+		{name: "minCpu", value: queryParams.MinCpu, bound: &bounds.minCpu},
+		{name: "maxCpu", value: queryParams.MaxCpu, bound: &bounds.maxCpu},
+		{name: "minMemory", value: queryParams.MinMemory, bound: &bounds.minMemory},
+		{name: "maxMemory", value: queryParams.MaxMemory, bound: &bounds.maxMemory},
+		{name: "gpu", value: queryParams.Gpu, bound: &bounds.gpus},
+		{name: "maxPricePerHour", value: queryParams.MaxPricePerHour, bound: &bounds.maxPricePerHour},
+	}
+	for _, param := range params {
+		if *param.bound, err = parseBound(param.name, param.value); err != nil {
+			return attributeBounds{}, err
+		}
+	}
+
+	if bounds.minCpu != nil && bounds.maxCpu != nil && *bounds.minCpu > *bounds.maxCpu {
+		return attributeBounds{}, errors.NewWithDetails("minCpu must not be greater than maxCpu",
+			"minCpu", *bounds.minCpu, "maxCpu", *bounds.maxCpu)
+	}
+
+	if bounds.minMemory != nil && bounds.maxMemory != nil && *bounds.minMemory > *bounds.maxMemory {
+		return attributeBounds{}, errors.NewWithDetails("minMemory must not be greater than maxMemory",
+			"minMemory", *bounds.minMemory, "maxMemory", *bounds.maxMemory)
+	}
+
+	return bounds, nil
+}
+
+// parseBound parses a non-negative bound, nil if the value is empty
+func parseBound(name, value string) (*float64, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	bound, err := strconv.ParseFloat(value, 64)
+	if err != nil || bound < 0 {
+		return nil, errors.NewWithDetails("invalid "+name+" query parameter", name, value)
+	}
+
+	return &bound, nil
+}
+
+// empty returns true if no bounds are set
+func (b attributeBounds) empty() bool {
+	return b.minCpu == nil && b.maxCpu == nil && b.minMemory == nil && b.maxMemory == nil && b.gpus == nil &&
+		b.maxPricePerHour == nil
+}
+
+// matches returns true if the attributes of the product are within the bounds
+func (b attributeBounds) matches(product types.ProductDetails) bool {
+	switch {
+	case b.minCpu != nil && product.Cpus < *b.minCpu,
+		b.maxCpu != nil && product.Cpus > *b.maxCpu,
+		b.minMemory != nil && product.Mem < *b.minMemory,
+		b.maxMemory != nil && product.Mem > *b.maxMemory,
+		b.gpus != nil && product.Gpus != *b.gpus,
+		b.maxPricePerHour != nil && product.OnDemandPrice > *b.maxPricePerHour:
+		return false
+	}
+
+	return true
+}
+
+// filterAttributes keeps the products with attributes within the bounds
+func filterAttributes(products []types.ProductDetails, bounds attributeBounds) []types.ProductDetails {
+	if bounds.empty() {
+		return products
+	}
+
+	filtered := make([]types.ProductDetails, 0, len(products))
+	for _, product := range products {
+		if bounds.matches(product) {
+			filtered = append(filtered, product)
+		}
+	}
+
+	return filtered
+}
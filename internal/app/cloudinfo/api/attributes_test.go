@@ -0,0 +1,97 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestFilterAttributes(t *testing.T) {
+	products := []types.ProductDetails{
+		{VMInfo: types.VMInfo{Type: "m5.large", Cpus: 2, Mem: 8, OnDemandPrice: 0.096}},
+		{VMInfo: types.VMInfo{Type: "m5.2xlarge", Cpus: 8, Mem: 32, OnDemandPrice: 0.384}},
+		{VMInfo: types.VMInfo{Type: "p3.2xlarge", Cpus: 8, Mem: 61, Gpus: 1, OnDemandPrice: 3.06}},
+		{VMInfo: types.VMInfo{Type: "m5.8xlarge", Cpus: 32, Mem: 128, OnDemandPrice: 1.536}},
+	}
+
+	tests := []struct {
+		name        string
+		queryParams GetProductDetailsQueryParams
+		expected    []types.ProductDetails
+	}{
+		{
+			name:        "no bounds",
+			queryParams: GetProductDetailsQueryParams{},
+			expected:    products,
+		},
+		{
+			name:        "cpu range",
+			queryParams: GetProductDetailsQueryParams{MinCpu: "4", MaxCpu: "16"},
+			expected:    products[1:3],
+		},
+		{
+			name:        "min memory and max price",
+			queryParams: GetProductDetailsQueryParams{MinMemory: "8", MaxPricePerHour: "0.5"},
+			expected:    products[:2],
+		},
+		{
+			name:        "gpus",
+			queryParams: GetProductDetailsQueryParams{Gpu: "1"},
+			expected:    products[2:3],
+		},
+		{
+			name:        "no gpus",
+			queryParams: GetProductDetailsQueryParams{Gpu: "0", MaxMemory: "64"},
+			expected:    products[:2],
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			bounds, err := parseAttributeBounds(test.queryParams)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, filterAttributes(products, bounds))
+		})
+	}
+}
+
+func TestParseAttributeBounds_Invalid(t *testing.T) {
+	tests := []struct {
+		name        string
+		queryParams GetProductDetailsQueryParams
+	}{
+		{
+			name:        "not a number",
+			queryParams: GetProductDetailsQueryParams{MinCpu: "four"},
+		},
+		{
+			name:        "negative",
+			queryParams: GetProductDetailsQueryParams{MaxPricePerHour: "-1"},
+		},
+		{
+			name:        "min greater than max",
+			queryParams: GetProductDetailsQueryParams{MinMemory: "64", MaxMemory: "32"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := parseAttributeBounds(test.queryParams)
+			assert.Error(t, err)
+		})
+	}
+}
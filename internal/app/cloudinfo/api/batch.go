@@ -0,0 +1,56 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"emperror.dev/errors"
+)
+
+// maxBatchRegions the maximum number of regions of a batch products request
+const maxBatchRegions = 50
+
+// validateBatchProductsRequest checks that the batch products request holds at least one and at most maxBatchRegions
+// distinct regions
+func validateBatchProductsRequest(request BatchProductsRequest) error {
+	if len(request.Regions) == 0 {
+		return errors.New("at least one region must be requested")
+	}
+
+	if len(request.Regions) > maxBatchRegions {
+		return errors.NewWithDetails("too many regions requested", "regions", len(request.Regions), "max", maxBatchRegions)
+	}
+
+	requested := make(map[BatchProductsRegion]bool, len(request.Regions))
+	for _, region := range request.Regions {
+		if requested[region] {
+			return errors.NewWithDetails("region requested more than once",
+				"provider", region.Provider, "service", region.Service, "region", region.Region)
+		}
+		requested[region] = true
+	}
+
+	return nil
+}
+
+// pathParams returns the path parameters of the products endpoint of the region, to validate the region the same way
+func (r BatchProductsRegion) pathParams() GetRegionPathParams {
+	return GetRegionPathParams{
+		GetServicesPathParams: GetServicesPathParams{
+			GetProviderPathParams: GetProviderPathParams{Provider: r.Provider},
+			Service:               r.Service,
+		},
+		Region: r.Region,
+	}
+}
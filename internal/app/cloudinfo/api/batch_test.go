@@ -0,0 +1,75 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateBatchProductsRequest(t *testing.T) {
+	tooMany := make([]BatchProductsRegion, 0, maxBatchRegions+1)
+	for i := 0; i <= maxBatchRegions; i++ {
+		tooMany = append(tooMany, BatchProductsRegion{Provider: "amazon", Service: "compute", Region: fmt.Sprintf("region-%d", i)})
+	}
+
+	tests := []struct {
+		name    string
+		regions []BatchProductsRegion
+		check   func(err error)
+	}{
+		{
+			name: "distinct regions are accepted",
+			regions: []BatchProductsRegion{
+				{Provider: "amazon", Service: "compute", Region: "eu-west-1"},
+				{Provider: "amazon", Service: "compute", Region: "us-east-1"},
+				{Provider: "google", Service: "compute", Region: "eu-west-1"},
+			},
+			check: func(err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "at least one region is required",
+			check: func(err error) {
+				assert.EqualError(t, err, "at least one region must be requested")
+			},
+		},
+		{
+			name:    "the number of regions is limited",
+			regions: tooMany,
+			check: func(err error) {
+				assert.EqualError(t, err, "too many regions requested")
+			},
+		},
+		{
+			name: "a region is requested once",
+			regions: []BatchProductsRegion{
+				{Provider: "amazon", Service: "compute", Region: "eu-west-1"},
+				{Provider: "amazon", Service: "compute", Region: "eu-west-1"},
+			},
+			check: func(err error) {
+				assert.EqualError(t, err, "region requested more than once")
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.check(validateBatchProductsRequest(BatchProductsRequest{Regions: test.regions}))
+		})
+	}
+}
@@ -56,6 +56,8 @@ func (erc *errClassifier) Classify(inErr interface{}) (interface{}, error) {
 	case *url.Error:
 		// the cloud info service is not available
 		problem = erc.classifyUrlError(e, errors.GetDetails(err))
+	case unknownInstanceTypeError:
+		problem = problems.NewNotFoundProblem(e.Error(), e.suggestions)
 	default:
 		// unclassified error
 		problem = erc.classifyGenericError(cause, errors.GetDetails(err))
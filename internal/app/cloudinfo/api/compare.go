@@ -0,0 +1,133 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"sort"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/currency"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// comparisonService the service compared when none is requested
+const comparisonService = "compute"
+
+// regionFilter restricts the regions visited across the providers, the empty fields match all of them
+type regionFilter struct {
+	providers []string
+	continent string
+	regions   []string
+}
+
+// visitRegionProducts calls visit with the products of every region of the service matching the filter across the
+// providers; the providers not offering the service and the regions not yet scraped are skipped. The prices are
+// converted to USD (if the currency conversion is enabled) to be comparable across the providers.
+func (r *RouteHandler) visitRegionProducts(service string, filter regionFilter, visit func(provider, region string, products []types.ProductDetails)) error {
+	providers, err := r.prod.GetProviders()
+	if err != nil {
+		return errors.WrapIf(err, "failed to retrieve providers")
+	}
+
+	for _, provider := range providers {
+		if len(filter.providers) > 0 && !cloudinfo.Contains(filter.providers, provider.Provider) {
+			continue
+		}
+
+		regions, err := r.prod.GetRegions(provider.Provider, service)
+		if err != nil {
+			continue
+		}
+
+		for id, name := range regions {
+			if filter.continent != "" && !cloudinfo.MatchContinent(r.region(provider.Provider, id, name).Continent, filter.continent) {
+				continue
+			}
+			if len(filter.regions) > 0 && !cloudinfo.Contains(filter.regions, id) {
+				continue
+			}
+
+			products, err := r.prod.GetProductDetails(provider.Provider, service, id)
+			if err != nil {
+				continue
+			}
+
+			if r.currencyConverter != nil {
+				if products, err = convertCurrency(products, r.currencyConverter, currency.USD); err != nil {
+					continue
+				}
+			}
+
+			visit(provider.Provider, id, products)
+		}
+	}
+
+	return nil
+}
+
+// parseComparisonSize parses the number of vCPUs and the memory to match, both of them are required
+func parseComparisonSize(queryParams GetComparisonQueryParams) (float64, float64, error) {
+	cpu, err := parseBound("cpu", queryParams.Cpu)
+	if err != nil {
+		return 0, 0, err
+	}
+	memory, err := parseBound("memory", queryParams.Memory)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if cpu == nil || *cpu == 0 || memory == nil || *memory == 0 {
+		return 0, 0, errors.New("the cpu and memory query parameters are required")
+	}
+
+	return *cpu, *memory, nil
+}
+
+// closestProduct selects the priced product having at least the given vCPUs and memory with the least excess of them
+// (relative to the requested size), the cheaper one in case of a tie
+func closestProduct(products []types.ProductDetails, cpu, memory float64) (types.ProductDetails, bool) {
+	var (
+		closest       types.ProductDetails
+		closestExcess float64
+		found         bool
+	)
+
+	for _, product := range products {
+		if product.OnDemandPrice <= 0 || product.Cpus < cpu || product.Mem < memory {
+			continue
+		}
+
+		excess := (product.Cpus-cpu)/cpu + (product.Mem-memory)/memory
+		if !found || excess < closestExcess ||
+			(excess == closestExcess && product.OnDemandPrice < closest.OnDemandPrice) {
+			closest, closestExcess, found = product, excess, true
+		}
+	}
+
+	return closest, found
+}
+
+// sortMatches sorts the matches of the providers by their price, the cheapest first
+func sortMatches(matches ComparisonResponse) {
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].OnDemandPrice != matches[j].OnDemandPrice {
+			return matches[i].OnDemandPrice < matches[j].OnDemandPrice
+		}
+
+		return matches[i].Provider < matches[j].Provider
+	})
+}
@@ -0,0 +1,123 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestParseComparisonSize(t *testing.T) {
+	tests := []struct {
+		name        string
+		queryParams GetComparisonQueryParams
+		check       func(cpu, memory float64, err error)
+	}{
+		{
+			name:        "the size is parsed",
+			queryParams: GetComparisonQueryParams{Cpu: "8", Memory: "32"},
+			check: func(cpu, memory float64, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, 8.0, cpu)
+				assert.Equal(t, 32.0, memory)
+			},
+		},
+		{
+			name:        "the memory is required",
+			queryParams: GetComparisonQueryParams{Cpu: "8"},
+			check: func(cpu, memory float64, err error) {
+				assert.EqualError(t, err, "the cpu and memory query parameters are required")
+			},
+		},
+		{
+			name:        "the cpu is validated",
+			queryParams: GetComparisonQueryParams{Cpu: "eight", Memory: "32"},
+			check: func(cpu, memory float64, err error) {
+				assert.EqualError(t, err, "invalid cpu query parameter")
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.check(parseComparisonSize(test.queryParams))
+		})
+	}
+}
+
+func TestClosestProduct(t *testing.T) {
+	product := func(name string, cpus, mem, price float64) types.ProductDetails {
+		return types.ProductDetails{VMInfo: types.VMInfo{Type: name, Cpus: cpus, Mem: mem, OnDemandPrice: price}}
+	}
+
+	tests := []struct {
+		name     string
+		products []types.ProductDetails
+		check    func(product types.ProductDetails, found bool)
+	}{
+		{
+			name: "the exact match is the closest",
+			products: []types.ProductDetails{
+				product("large", 16, 64, 0.8),
+				product("exact", 8, 32, 0.4),
+				product("small", 4, 16, 0.2),
+			},
+			check: func(product types.ProductDetails, found bool) {
+				assert.True(t, found)
+				assert.Equal(t, "exact", product.Type)
+			},
+		},
+		{
+			name: "the product with the least excess is the closest",
+			products: []types.ProductDetails{
+				product("compute", 16, 32, 0.5),
+				product("memory", 8, 64, 0.6),
+				product("general", 8, 48, 0.7),
+			},
+			check: func(product types.ProductDetails, found bool) {
+				assert.True(t, found)
+				assert.Equal(t, "general", product.Type)
+			},
+		},
+		{
+			name: "the cheaper product wins a tie",
+			products: []types.ProductDetails{
+				product("intel", 8, 32, 0.4),
+				product("amd", 8, 32, 0.35),
+				product("unpriced", 8, 32, 0),
+			},
+			check: func(product types.ProductDetails, found bool) {
+				assert.True(t, found)
+				assert.Equal(t, "amd", product.Type)
+			},
+		},
+		{
+			name: "no product is large enough",
+			products: []types.ProductDetails{
+				product("small", 4, 16, 0.2),
+			},
+			check: func(product types.ProductDetails, found bool) {
+				assert.False(t, found)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.check(closestProduct(test.products, 8, 32))
+		})
+	}
+}
@@ -0,0 +1,274 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/andybalholm/brotli"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// encodingGzip the gzip content coding
+	encodingGzip = "gzip"
+	// encodingBrotli the brotli content coding
+	encodingBrotli = "br"
+
+	// defaultCompressionMinSize the size of the smallest responses compressed unless configured otherwise
+	defaultCompressionMinSize = 1024
+)
+
+// CompressionConfig holds the configuration of the compression of the responses.
+type CompressionConfig struct {
+	// Enabled enables compressing the responses with the encodings accepted by the clients
+	Enabled bool
+
+	// Level is the gzip compression level (1-9), zero uses the default level
+	Level int
+
+	// MinSize is the size of the smallest compressed response in bytes, the smaller ones are sent as they are
+	MinSize int
+
+	// Brotli enables the brotli encoding, preferred over gzip by the clients accepting both
+	Brotli bool
+
+	// BrotliQuality is the brotli compression quality (1-11), zero uses the default quality
+	BrotliQuality int
+}
+
+// encoder compresses a response
+type encoder interface {
+	io.WriteCloser
+	Flush() error
+	Reset(w io.Writer)
+}
+
+// compressionMiddleware compresses the responses with the encoding negotiated from the Accept-Encoding header of the request
+// the event streams, the websockets and the responses smaller than the minimum size are sent uncompressed
+func (r *RouteHandler) compressionMiddleware() gin.HandlerFunc {
+	cfg := r.config.Compression
+
+	level := gzip.DefaultCompression
+	if cfg.Level != 0 {
+		level = cfg.Level
+	}
+	quality := brotli.DefaultCompression
+	if cfg.BrotliQuality != 0 {
+		quality = cfg.BrotliQuality
+	}
+	minSize := defaultCompressionMinSize
+	if cfg.MinSize != 0 {
+		minSize = cfg.MinSize
+	}
+
+	pools := map[string]*sync.Pool{
+		encodingGzip: {New: func() interface{} {
+			w, _ := gzip.NewWriterLevel(io.Discard, level)
+			return w
+		}},
+		encodingBrotli: {New: func() interface{} {
+			return brotli.NewWriterLevel(io.Discard, quality)
+		}},
+	}
+
+	return func(c *gin.Context) {
+		if c.Request.Method == http.MethodHead || c.IsWebsocket() {
+			c.Next()
+			return
+		}
+
+		c.Writer.Header().Add("Vary", "Accept-Encoding")
+
+		encoding := negotiateEncoding(c.GetHeader("Accept-Encoding"), cfg.Brotli)
+		if encoding == "" {
+			c.Next()
+			return
+		}
+
+		cw := &compressWriter{ResponseWriter: c.Writer, encoding: encoding, pool: pools[encoding], minSize: minSize}
+		c.Writer = cw
+		defer func() {
+			cw.close()
+			c.Writer = cw.ResponseWriter
+		}()
+
+		c.Next()
+	}
+}
+
+// negotiateEncoding returns the preferred content coding accepted by the client (br or gzip), empty if none of them is accepted
+func negotiateEncoding(acceptEncoding string, brotliEnabled bool) string {
+	var best string
+	var bestQ float64
+	for _, item := range strings.Split(acceptEncoding, ",") {
+		parts := strings.Split(strings.TrimSpace(item), ";")
+		coding := strings.ToLower(strings.TrimSpace(parts[0]))
+
+		q := 1.0
+		for _, param := range parts[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				if v, err := strconv.ParseFloat(param[2:], 64); err == nil {
+					q = v
+				}
+			}
+		}
+
+		var candidates []string
+		switch coding {
+		case encodingBrotli:
+			if brotliEnabled {
+				candidates = []string{encodingBrotli}
+			}
+		case encodingGzip, "x-gzip":
+			candidates = []string{encodingGzip}
+		case "*":
+			if brotliEnabled {
+				candidates = append(candidates, encodingBrotli)
+			}
+			candidates = append(candidates, encodingGzip)
+		}
+
+		for _, candidate := range candidates {
+			// on equal preference brotli wins, it compresses better
+			if q > bestQ || (q == bestQ && q > 0 && candidate == encodingBrotli) {
+				best, bestQ = candidate, q
+			}
+		}
+	}
+
+	return best
+}
+
+// compressWriter compresses the response once its size reaches the minimum, it's sent as it is otherwise
+type compressWriter struct {
+	gin.ResponseWriter
+
+	encoding string
+	pool     *sync.Pool
+	minSize  int
+
+	// buf holds the beginning of the response until the compression is decided on
+	buf []byte
+	// enc is the encoder of the compressed response, nil if not (yet) compressed
+	enc encoder
+	// plain signals the response is sent uncompressed
+	plain bool
+}
+
+func (w *compressWriter) Write(data []byte) (int, error) {
+	switch {
+	case w.plain:
+		return w.ResponseWriter.Write(data)
+	case w.enc != nil:
+		return w.enc.Write(data)
+	}
+
+	w.buf = append(w.buf, data...)
+	if len(w.buf) >= w.minSize {
+		if err := w.start(); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(data), nil
+}
+
+func (w *compressWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+// Flush starts sending the response, compressed if it can be, so the streamed responses are not held back
+func (w *compressWriter) Flush() {
+	if !w.plain && w.enc == nil {
+		if err := w.start(); err != nil {
+			return
+		}
+	}
+
+	if w.enc != nil {
+		_ = w.enc.Flush()
+	}
+	w.ResponseWriter.Flush()
+}
+
+// start decides on compressing the response by its headers and writes the buffered beginning of it
+func (w *compressWriter) start() error {
+	if !w.compressible() {
+		w.plain = true
+		return w.writeBuffer(w.ResponseWriter)
+	}
+
+	header := w.Header()
+	header.Set("Content-Encoding", w.encoding)
+	header.Del("Content-Length")
+	// the compressed representation is not byte for byte the same as the tagged one
+	if etag := header.Get(etagHeader); etag != "" && !strings.HasPrefix(etag, "W/") {
+		header.Set(etagHeader, "W/"+etag)
+	}
+
+	w.enc = w.pool.Get().(encoder)
+	w.enc.Reset(w.ResponseWriter)
+
+	return w.writeBuffer(w.enc)
+}
+
+// compressible tells whether the response can be compressed
+func (w *compressWriter) compressible() bool {
+	header := w.Header()
+	if header.Get("Content-Encoding") != "" {
+		return false
+	}
+
+	status := w.Status()
+	if status < http.StatusOK || status == http.StatusNoContent || status == http.StatusNotModified {
+		return false
+	}
+
+	return !strings.HasPrefix(header.Get("Content-Type"), eventStreamContentType)
+}
+
+func (w *compressWriter) writeBuffer(dst io.Writer) error {
+	if len(w.buf) == 0 {
+		return nil
+	}
+
+	_, err := dst.Write(w.buf)
+	w.buf = nil
+
+	return err
+}
+
+// close sends the rest of the response: the buffered small ones as they are, the end of the compressed ones
+func (w *compressWriter) close() {
+	if w.enc != nil {
+		_ = w.enc.Close()
+		w.enc.Reset(io.Discard)
+		w.pool.Put(w.enc)
+		w.enc = nil
+		return
+	}
+
+	if !w.plain {
+		w.plain = true
+		_ = w.writeBuffer(w.ResponseWriter)
+	}
+}
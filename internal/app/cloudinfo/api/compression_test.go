@@ -0,0 +1,201 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/andybalholm/brotli"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/cloudinfoadapter"
+)
+
+func TestNegotiateEncoding(t *testing.T) {
+	tests := []struct {
+		name           string
+		acceptEncoding string
+		brotli         bool
+		encoding       string
+	}{
+		{name: "no accept encoding", acceptEncoding: "", encoding: ""},
+		{name: "gzip", acceptEncoding: "gzip, deflate", encoding: encodingGzip},
+		{name: "brotli disabled", acceptEncoding: "br", encoding: ""},
+		{name: "brotli disabled falls back to gzip", acceptEncoding: "gzip, deflate, br", encoding: encodingGzip},
+		{name: "brotli preferred", acceptEncoding: "gzip, deflate, br", brotli: true, encoding: encodingBrotli},
+		{name: "quality values", acceptEncoding: "br;q=0.5, gzip;q=0.8", brotli: true, encoding: encodingGzip},
+		{name: "refused", acceptEncoding: "gzip;q=0", encoding: ""},
+		{name: "any", acceptEncoding: "*", encoding: encodingGzip},
+		{name: "identity only", acceptEncoding: "identity", encoding: ""},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.encoding, negotiateEncoding(test.acceptEncoding, test.brotli))
+		})
+	}
+}
+
+func TestRouteHandler_compressionMiddleware(t *testing.T) {
+	large := strings.Repeat(`{"type":"m5.large","onDemandPrice":0.107}`, 100)
+
+	tests := []struct {
+		name           string
+		config         CompressionConfig
+		acceptEncoding string
+		handler        gin.HandlerFunc
+		check          func(t *testing.T, w *httptest.ResponseRecorder)
+	}{
+		{
+			name:           "gzip",
+			acceptEncoding: "gzip",
+			handler: func(c *gin.Context) {
+				c.Header(etagHeader, `"0123"`)
+				c.Data(http.StatusOK, gin.MIMEJSON, []byte(large))
+			},
+			check: func(t *testing.T, w *httptest.ResponseRecorder) {
+				assert.Equal(t, encodingGzip, w.Header().Get("Content-Encoding"))
+				assert.Equal(t, "Accept-Encoding", w.Header().Get("Vary"))
+				assert.Equal(t, `W/"0123"`, w.Header().Get(etagHeader))
+				assert.Less(t, w.Body.Len(), len(large)/10)
+
+				r, err := gzip.NewReader(w.Body)
+				require.NoError(t, err)
+				body, err := io.ReadAll(r)
+				require.NoError(t, err)
+				assert.Equal(t, large, string(body))
+			},
+		},
+		{
+			name:           "brotli",
+			config:         CompressionConfig{Brotli: true},
+			acceptEncoding: "gzip, br",
+			handler: func(c *gin.Context) {
+				c.Data(http.StatusOK, gin.MIMEJSON, []byte(large))
+			},
+			check: func(t *testing.T, w *httptest.ResponseRecorder) {
+				assert.Equal(t, encodingBrotli, w.Header().Get("Content-Encoding"))
+
+				body, err := io.ReadAll(brotli.NewReader(w.Body))
+				require.NoError(t, err)
+				assert.Equal(t, large, string(body))
+			},
+		},
+		{
+			name: "not accepted",
+			handler: func(c *gin.Context) {
+				c.Data(http.StatusOK, gin.MIMEJSON, []byte(large))
+			},
+			check: func(t *testing.T, w *httptest.ResponseRecorder) {
+				assert.Empty(t, w.Header().Get("Content-Encoding"))
+				assert.Equal(t, large, w.Body.String())
+			},
+		},
+		{
+			name:           "small response",
+			acceptEncoding: "gzip",
+			handler: func(c *gin.Context) {
+				c.JSON(http.StatusOK, "ok")
+			},
+			check: func(t *testing.T, w *httptest.ResponseRecorder) {
+				assert.Empty(t, w.Header().Get("Content-Encoding"))
+				assert.Equal(t, `"ok"`, w.Body.String())
+			},
+		},
+		{
+			name:           "small response written in pieces",
+			config:         CompressionConfig{MinSize: 10},
+			acceptEncoding: "gzip",
+			handler: func(c *gin.Context) {
+				c.Header("Content-Type", mimeNDJSON)
+				_, _ = c.Writer.WriteString("{}\n")
+				_, _ = c.Writer.WriteString("{}\n")
+			},
+			check: func(t *testing.T, w *httptest.ResponseRecorder) {
+				assert.Empty(t, w.Header().Get("Content-Encoding"))
+				assert.Equal(t, "{}\n{}\n", w.Body.String())
+			},
+		},
+		{
+			name:           "flushed stream",
+			acceptEncoding: "gzip",
+			handler: func(c *gin.Context) {
+				c.Header("Content-Type", mimeNDJSON)
+				_, _ = c.Writer.WriteString("{}\n")
+				c.Writer.Flush()
+				_, _ = c.Writer.WriteString("{}\n")
+			},
+			check: func(t *testing.T, w *httptest.ResponseRecorder) {
+				assert.Equal(t, encodingGzip, w.Header().Get("Content-Encoding"))
+
+				r, err := gzip.NewReader(w.Body)
+				require.NoError(t, err)
+				body, err := io.ReadAll(r)
+				require.NoError(t, err)
+				assert.Equal(t, "{}\n{}\n", string(body))
+			},
+		},
+		{
+			name:           "event stream",
+			acceptEncoding: "gzip",
+			handler: func(c *gin.Context) {
+				c.Header("Content-Type", eventStreamContentType)
+				_, _ = c.Writer.WriteString(large)
+			},
+			check: func(t *testing.T, w *httptest.ResponseRecorder) {
+				assert.Empty(t, w.Header().Get("Content-Encoding"))
+				assert.Equal(t, large, w.Body.String())
+			},
+		},
+		{
+			name:           "not modified",
+			acceptEncoding: "gzip",
+			handler: func(c *gin.Context) {
+				c.AbortWithStatus(http.StatusNotModified)
+			},
+			check: func(t *testing.T, w *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusNotModified, w.Code)
+				assert.Empty(t, w.Header().Get("Content-Encoding"))
+				assert.Zero(t, w.Body.Len())
+			},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := &RouteHandler{config: Config{Compression: test.config}, log: cloudinfoadapter.NewNoopLogger()}
+
+			router := gin.New()
+			router.Use(r.compressionMiddleware())
+			router.GET("/products", test.handler)
+
+			req := httptest.NewRequest(http.MethodGet, "/products", nil)
+			if test.acceptEncoding != "" {
+				req.Header.Set("Accept-Encoding", test.acceptEncoding)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			test.check(t, w)
+		})
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"time"
+
+	"emperror.dev/errors"
+)
+
+// Config holds the configuration of the REST API.
+type Config struct {
+	// DataAgeHeaders enables the data freshness headers on API responses
+	DataAgeHeaders bool
+
+	// DefaultSort is the sort order of the listings when the request doesn't specify one,
+	// a field name optionally prefixed with "-" for descending order (eg.: "-onDemandPrice").
+	// Listings not having the field are sorted by their identifier.
+	DefaultSort string
+
+	// SlowRequestThreshold is the duration above which requests are logged, zero disables the logging
+	SlowRequestThreshold time.Duration
+
+	// Compression configures the compression of the responses
+	Compression CompressionConfig
+
+	// CORS is the cross-origin resource sharing policy of the API
+	CORS CORSConfig
+}
+
+// Validate checks that the configuration is valid.
+func (c Config) Validate() error {
+	if c.DefaultSort != "" {
+		if field, _ := parseSort(c.DefaultSort); productComparators[field] == nil {
+			return errors.NewWithDetails("unsupported default sort field", "field", field)
+		}
+	}
+
+	if c.SlowRequestThreshold < 0 {
+		return errors.New("slow request threshold must not be negative")
+	}
+
+	if c.Compression.Level < 0 || c.Compression.Level > 9 {
+		return errors.NewWithDetails("gzip compression level must be between 1 and 9", "level", c.Compression.Level)
+	}
+
+	if c.Compression.BrotliQuality < 0 || c.Compression.BrotliQuality > 11 {
+		return errors.NewWithDetails("brotli compression quality must be between 1 and 11", "quality", c.Compression.BrotliQuality)
+	}
+
+	if c.Compression.MinSize < 0 {
+		return errors.New("compression minimum size must not be negative")
+	}
+
+	if err := c.CORS.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"net/http"
+	"sort"
+
+	"emperror.dev/errors"
+	"github.com/gin-gonic/gin"
+
+	"github.com/banzaicloud/cloudinfo/internal/platform/log"
+)
+
+// swagger:route GET /continents/regions continents getContinentRegions
+//
+// Provides the regions of all the providers grouped by continent, for presenting a geographic region picker.
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+//     Security:
+//
+//     Responses:
+//       200: ContinentRegionsResponse
+func (r *RouteHandler) getContinentRegions() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger := log.WithFieldsForHandlers(c, r.log, nil)
+
+		logger.Info("getting the regions grouped by continent")
+
+		providers, err := r.prod.GetProviders()
+		if err != nil {
+			r.errorResponder.Respond(c, errors.WrapIf(err, "failed to retrieve providers"))
+			return
+		}
+
+		continents := make(map[string][]ProviderRegion)
+		for _, provider := range providers {
+			// the services of a provider mostly share the regions
+			seen := make(map[string]bool)
+			for _, service := range provider.Services {
+				locations, err := r.prod.GetContinentsData(provider.Provider, service.Service)
+				if err != nil {
+					logger.Debug("skipping service without regions", map[string]interface{}{
+						"provider": provider.Provider, "service": service.Service})
+					continue
+				}
+
+				for continent, regions := range locations {
+					for _, region := range regions {
+						if seen[region.ID] {
+							continue
+						}
+						seen[region.ID] = true
+
+						continents[continent] = append(continents[continent], ProviderRegion{
+							Provider: provider.Provider,
+							Region:   r.region(provider.Provider, region.ID, region.Name),
+						})
+					}
+				}
+			}
+		}
+
+		c.JSON(http.StatusOK, newContinentRegionsResponse(r.prod.GetContinents(), continents))
+	}
+}
+
+// newContinentRegionsResponse lists the continents in the order of the known ones, followed by the rest by name
+func newContinentRegionsResponse(known []string, continents map[string][]ProviderRegion) ContinentRegionsResponse {
+	order := make(map[string]int, len(known))
+	for i, continent := range known {
+		order[continent] = i
+	}
+
+	response := make(ContinentRegionsResponse, 0, len(continents))
+	for continent, regions := range continents {
+		sort.Slice(regions, func(i, j int) bool {
+			if regions[i].Provider != regions[j].Provider {
+				return regions[i].Provider < regions[j].Provider
+			}
+			return regions[i].ID < regions[j].ID
+		})
+
+		response = append(response, ContinentRegions{
+			Name:    continent,
+			Regions: regions,
+		})
+	}
+
+	sort.Slice(response, func(i, j int) bool {
+		oi, iKnown := order[response[i].Name]
+		oj, jKnown := order[response[j].Name]
+		if iKnown != jKnown {
+			return iKnown
+		}
+		if iKnown {
+			return oi < oj
+		}
+		return response[i].Name < response[j].Name
+	})
+
+	return response
+}
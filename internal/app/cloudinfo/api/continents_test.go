@@ -0,0 +1,53 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestNewContinentRegionsResponse(t *testing.T) {
+	continents := map[string][]ProviderRegion{
+		"unknown": {
+			{Provider: "vultr", Region: types.Region{ID: "xyz"}},
+		},
+		types.ContinentEurope: {
+			{Provider: "google", Region: types.Region{ID: "europe-west1"}},
+			{Provider: "amazon", Region: types.Region{ID: "eu-west-1"}},
+			{Provider: "amazon", Region: types.Region{ID: "eu-central-1"}},
+		},
+		types.ContinentAsia: {
+			{Provider: "amazon", Region: types.Region{ID: "ap-south-1"}},
+		},
+	}
+
+	response := newContinentRegionsResponse([]string{types.ContinentAsia, types.ContinentEurope}, continents)
+
+	var names []string
+	for _, continent := range response {
+		names = append(names, continent.Name)
+	}
+	assert.Equal(t, []string{types.ContinentAsia, types.ContinentEurope, "unknown"}, names)
+
+	var regions []string
+	for _, region := range response[1].Regions {
+		regions = append(regions, region.Provider+"/"+region.ID)
+	}
+	assert.Equal(t, []string{"amazon/eu-central-1", "amazon/eu-west-1", "google/europe-west1"}, regions)
+}
@@ -0,0 +1,89 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/gin-contrib/cors"
+)
+
+// CORSConfig holds the cross-origin resource sharing policy of the API, letting the browser based frontends call it directly.
+type CORSConfig struct {
+	// AllowedOrigins are the origins allowed to call the API: "*" allows any origin, the others may contain
+	// wildcards (eg.: https://*.example.com); empty disables the cross-origin requests
+	AllowedOrigins []string
+
+	// AllowedMethods are the methods the cross-origin requests may use
+	AllowedMethods []string
+
+	// AllowedHeaders are the non simple headers the cross-origin requests may send
+	AllowedHeaders []string
+
+	// ExposedHeaders are the response headers exposed to the cross-origin callers (eg.: X-Total-Count)
+	ExposedHeaders []string
+
+	// AllowCredentials allows the cross-origin requests to include cookies and HTTP authentication,
+	// only permitted with explicitly listed origins
+	AllowCredentials bool
+
+	// MaxAge is the time the browsers may cache the results of the preflight requests for
+	MaxAge time.Duration
+}
+
+// Enabled tells whether any cross-origin request is allowed.
+func (c CORSConfig) Enabled() bool {
+	return len(c.AllowedOrigins) > 0
+}
+
+// Validate checks that the CORS policy is valid.
+func (c CORSConfig) Validate() error {
+	if !c.Enabled() {
+		return nil
+	}
+
+	if c.MaxAge < 0 {
+		return errors.New("cors max age must not be negative")
+	}
+
+	if c.AllowCredentials {
+		for _, origin := range c.AllowedOrigins {
+			if origin == "*" {
+				return errors.New("cors credentials can not be allowed for any origin")
+			}
+		}
+	}
+
+	config := c.corsConfig()
+	if err := config.Validate(); err != nil {
+		return errors.WrapIf(err, "invalid cors policy")
+	}
+
+	return nil
+}
+
+// corsConfig returns the configuration of the CORS middleware implementing the policy
+func (c CORSConfig) corsConfig() cors.Config {
+	return cors.Config{
+		AllowOrigins:     c.AllowedOrigins,
+		AllowMethods:     c.AllowedMethods,
+		AllowHeaders:     c.AllowedHeaders,
+		ExposeHeaders:    c.ExposedHeaders,
+		AllowCredentials: c.AllowCredentials,
+		MaxAge:           c.MaxAge,
+		AllowWildcard:    true,
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCORSConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		config CORSConfig
+		valid  bool
+	}{
+		{
+			name:  "disabled",
+			valid: true,
+		},
+		{
+			name:   "any origin",
+			config: CORSConfig{AllowedOrigins: []string{"*"}, MaxAge: time.Hour},
+			valid:  true,
+		},
+		{
+			name:   "listed origins with credentials",
+			config: CORSConfig{AllowedOrigins: []string{"https://app.example.com", "https://*.example.org"}, AllowCredentials: true},
+			valid:  true,
+		},
+		{
+			name:   "any origin with credentials",
+			config: CORSConfig{AllowedOrigins: []string{"*"}, AllowCredentials: true},
+		},
+		{
+			name:   "origin without scheme",
+			config: CORSConfig{AllowedOrigins: []string{"app.example.com"}},
+		},
+		{
+			name:   "negative max age",
+			config: CORSConfig{AllowedOrigins: []string{"*"}, MaxAge: -time.Second},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.config.Validate()
+			if test.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestCORSConfig_corsConfig(t *testing.T) {
+	config := CORSConfig{
+		AllowedOrigins: []string{"https://*.example.com"},
+		AllowedMethods: []string{http.MethodGet},
+		AllowedHeaders: []string{"Content-Type"},
+		ExposedHeaders: []string{"X-Total-Count"},
+		MaxAge:         time.Hour,
+	}
+
+	tests := []struct {
+		name   string
+		method string
+		origin string
+		check  func(t *testing.T, w *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "preflight of an allowed origin",
+			method: http.MethodOptions,
+			origin: "https://app.example.com",
+			check: func(t *testing.T, w *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusNoContent, w.Code)
+				assert.Equal(t, "https://app.example.com", w.Header().Get("Access-Control-Allow-Origin"))
+				assert.Equal(t, "GET", w.Header().Get("Access-Control-Allow-Methods"))
+				assert.Equal(t, "3600", w.Header().Get("Access-Control-Max-Age"))
+			},
+		},
+		{
+			name:   "request of an allowed origin",
+			method: http.MethodGet,
+			origin: "https://app.example.com",
+			check: func(t *testing.T, w *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, w.Code)
+				assert.Equal(t, "https://app.example.com", w.Header().Get("Access-Control-Allow-Origin"))
+				assert.Equal(t, "X-Total-Count", w.Header().Get("Access-Control-Expose-Headers"))
+			},
+		},
+		{
+			name:   "request of another origin",
+			method: http.MethodGet,
+			origin: "https://example.org",
+			check: func(t *testing.T, w *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusForbidden, w.Code)
+				assert.Empty(t, w.Header().Get("Access-Control-Allow-Origin"))
+			},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			router := gin.New()
+			router.Use(cors.New(config.corsConfig()))
+			router.GET("/providers", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+			req := httptest.NewRequest(test.method, "/providers", nil)
+			req.Header.Set("Origin", test.origin)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			test.check(t, w)
+		})
+	}
+}
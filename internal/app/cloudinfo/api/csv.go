@@ -0,0 +1,160 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/gin-gonic/gin"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+var (
+	// productCSVColumns the columns of the products rendered as CSV unless the fields are selected
+	productCSVColumns = []string{"type", "category", "cpusPerVm", "memPerVm", "gpusPerVm", "onDemandPrice", "ntwPerfCategory",
+		"architecture", "zones", "currency"}
+	// regionCSVColumns the columns of the regions rendered as CSV
+	regionCSVColumns = []string{"id", "name", "displayName", "continent", "country", "latitude", "longitude", "compliance"}
+	// zoneCSVColumns the columns of the zones rendered as CSV
+	zoneCSVColumns = []string{"name", "id", "instanceTypes"}
+	// spotPriceCSVColumns the columns of the spot price history rendered as CSV
+	spotPriceCSVColumns = []string{"timestamp", "zone", "price"}
+	// onDemandPriceCSVColumns the columns of the on demand price history rendered as CSV
+	onDemandPriceCSVColumns = []string{"timestamp", "onDemandPrice", "currency"}
+)
+
+// spotPriceRow is a spot price of the history flattened to a CSV row
+type spotPriceRow struct {
+	Timestamp time.Time `json:"timestamp"`
+	Zone      string    `json:"zone"`
+	Price     float64   `json:"price"`
+}
+
+// spotPriceRows flattens the spot price history to a row per record and zone
+func spotPriceRows(history []types.SpotPriceRecord) []spotPriceRow {
+	rows := make([]spotPriceRow, 0, len(history))
+	for _, record := range history {
+		for _, price := range record.SpotPrice {
+			rows = append(rows, spotPriceRow{Timestamp: record.Timestamp, Zone: price.Zone, Price: price.Price})
+		}
+	}
+
+	return rows
+}
+
+// renderCSV renders the records (a slice of anything marshaled to JSON objects) as CSV with the given columns
+// the strings, numbers and booleans are written as they are, the lists of scalars joined by semicolons
+// and any other value as JSON; the columns missing from a record are left empty
+func renderCSV(columns []string, records interface{}) ([]byte, error) {
+	raw, err := json.Marshal(records)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to marshal records")
+	}
+
+	var objects []map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &objects); err != nil {
+		return nil, errors.WrapIf(err, "failed to unmarshal records")
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(columns); err != nil {
+		return nil, errors.WrapIf(err, "failed to write CSV header")
+	}
+
+	row := make([]string, len(columns))
+	for _, object := range objects {
+		for i, column := range columns {
+			row[i] = csvCell(object[column])
+		}
+		if err := w.Write(row); err != nil {
+			return nil, errors.WrapIf(err, "failed to write CSV row")
+		}
+	}
+	w.Flush()
+
+	return buf.Bytes(), errors.WrapIf(w.Error(), "failed to write CSV")
+}
+
+// csvCell returns the CSV representation of a JSON value
+func csvCell(value json.RawMessage) string {
+	if len(value) == 0 {
+		return ""
+	}
+
+	d := json.NewDecoder(bytes.NewReader(value))
+	d.UseNumber()
+
+	var v interface{}
+	if err := d.Decode(&v); err != nil {
+		return string(value)
+	}
+
+	if cell, ok := csvScalar(v); ok {
+		return cell
+	}
+
+	if list, ok := v.([]interface{}); ok {
+		cells := make([]string, 0, len(list))
+		for _, item := range list {
+			cell, ok := csvScalar(item)
+			if !ok {
+				return string(value)
+			}
+			cells = append(cells, cell)
+		}
+
+		return strings.Join(cells, ";")
+	}
+
+	return string(value)
+}
+
+// csvScalar returns the CSV representation of a JSON scalar, false for lists and objects
+func csvScalar(v interface{}) (string, bool) {
+	switch v := v.(type) {
+	case nil:
+		return "", true
+	case string:
+		return v, true
+	case json.Number:
+		return v.String(), true
+	case bool:
+		if v {
+			return "true", true
+		}
+		return "false", true
+	default:
+		return "", false
+	}
+}
+
+// respondCSV writes the records as a CSV response
+func (r *RouteHandler) respondCSV(c *gin.Context, columns []string, records interface{}) {
+	data, err := renderCSV(columns, records)
+	if err != nil {
+		r.errorResponder.Respond(c, err)
+		return
+	}
+
+	c.Data(http.StatusOK, mimeCSV+"; charset=utf-8", data)
+}
@@ -0,0 +1,65 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestRenderCSV(t *testing.T) {
+	products := []types.ProductDetails{
+		{
+			VMInfo: types.VMInfo{
+				Type:          "m5.large",
+				Category:      types.CategoryGeneral,
+				Cpus:          2,
+				Mem:           8,
+				OnDemandPrice: 0.107,
+				Zones:         []string{"eu-west-1a", "eu-west-1b"},
+				SpotPrice:     []types.ZonePrice{{Zone: "eu-west-1a", Price: 0.04}},
+			},
+		},
+		{
+			VMInfo: types.VMInfo{
+				Type:     "p3.2xlarge, large",
+				Category: types.CategoryGpu,
+				Gpus:     1,
+			},
+		},
+	}
+
+	data, err := renderCSV([]string{"type", "category", "cpusPerVm", "gpusPerVm", "onDemandPrice", "zones", "spotPrice", "burst", "unknown"}, products)
+	require.NoError(t, err)
+	assert.Equal(t, "type,category,cpusPerVm,gpusPerVm,onDemandPrice,zones,spotPrice,burst,unknown\n"+
+		`m5.large,General purpose,2,0,0.107,eu-west-1a;eu-west-1b,"[{""zone"":""eu-west-1a"",""price"":0.04}]",false,`+"\n"+
+		`"p3.2xlarge, large",GPU instance,0,1,0,,,false,`+"\n", string(data))
+}
+
+func TestSpotPriceRows(t *testing.T) {
+	timestamp := time.Date(2021, 5, 1, 12, 0, 0, 0, time.UTC)
+	history := []types.SpotPriceRecord{
+		{Timestamp: timestamp, SpotPrice: []types.ZonePrice{{Zone: "eu-west-1a", Price: 0.03}, {Zone: "eu-west-1b", Price: 0.04}}},
+	}
+
+	data, err := renderCSV(spotPriceCSVColumns, spotPriceRows(history))
+	require.NoError(t, err)
+	assert.Equal(t, "timestamp,zone,price\n2021-05-01T12:00:00Z,eu-west-1a,0.03\n2021-05-01T12:00:00Z,eu-west-1b,0.04\n", string(data))
+}
@@ -0,0 +1,101 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"math"
+	"strings"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/currency"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// convertCurrency converts the prices of the products to the given currency; the slices and maps of the prices are
+// copied, as they are shared with the store
+func convertCurrency(products []types.ProductDetails, converter *currency.Converter, to string) ([]types.ProductDetails, error) {
+	converted := make([]types.ProductDetails, 0, len(products))
+	for _, product := range products {
+		rate, err := converter.Rate(product.Currency, to)
+		if err != nil {
+			return nil, err
+		}
+
+		product.OnDemandPrice = convertPrice(product.OnDemandPrice, rate)
+		product.MonthlyPrice = convertPrice(product.MonthlyPrice, rate)
+		product.EffectiveMonthlyPrice = convertPrice(product.EffectiveMonthlyPrice, rate)
+
+		if product.SpotPrice != nil {
+			spotPrices := make([]types.ZonePrice, len(product.SpotPrice))
+			for i, price := range product.SpotPrice {
+				spotPrices[i] = types.ZonePrice{Zone: price.Zone, Price: convertPrice(price.Price, rate)}
+			}
+			product.SpotPrice = spotPrices
+		}
+
+		if product.ReservedPrices != nil {
+			reservedPrices := make([]types.ReservedPrice, len(product.ReservedPrices))
+			for i, price := range product.ReservedPrices {
+				price.UpfrontPrice = convertPrice(price.UpfrontPrice, rate)
+				price.MonthlyPrice = convertPrice(price.MonthlyPrice, rate)
+				price.EffectiveHourlyPrice = convertPrice(price.EffectiveHourlyPrice, rate)
+				reservedPrices[i] = price
+			}
+			product.ReservedPrices = reservedPrices
+		}
+
+		if product.SavingsPlans != nil {
+			savingsPlans := make([]types.SavingsPlanPrice, len(product.SavingsPlans))
+			for i, price := range product.SavingsPlans {
+				price.Rate = convertPrice(price.Rate, rate)
+				savingsPlans[i] = price
+			}
+			product.SavingsPlans = savingsPlans
+		}
+
+		if product.OsPrices != nil {
+			osPrices := make(map[string]float64, len(product.OsPrices))
+			for os, price := range product.OsPrices {
+				osPrices[os] = convertPrice(price, rate)
+			}
+			product.OsPrices = osPrices
+		}
+
+		if product.CpuCredits != nil {
+			cpuCredits := *product.CpuCredits
+			cpuCredits.UnlimitedSurcharge = convertPrice(cpuCredits.UnlimitedSurcharge, rate)
+			product.CpuCredits = &cpuCredits
+		}
+
+		product.Currency = currencyCode(to)
+		converted = append(converted, product)
+	}
+
+	return converted, nil
+}
+
+// convertPrice converts a price with the exchange rate, rounded to a millionth to spare the floating point noise
+func convertPrice(price, rate float64) float64 {
+	return math.Round(price*rate*1e6) / 1e6
+}
+
+// currencyCode returns the currency code of the prices, empty for USD
+func currencyCode(c string) string {
+	c = strings.ToUpper(c)
+	if c == currency.USD {
+		return ""
+	}
+
+	return c
+}
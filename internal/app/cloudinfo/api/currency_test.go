@@ -0,0 +1,65 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"logur.dev/logur"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/cloudinfoadapter"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/currency"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestConvertCurrency(t *testing.T) {
+	converter := currency.NewConverter(cloudinfoadapter.NewLogger(&logur.TestLogger{}), currency.FixedSource{"EUR": 0.8, "CNY": 6.4})
+	converter.Refresh(context.Background())
+
+	products := []types.ProductDetails{
+		{VMInfo: types.VMInfo{
+			Type:           "m5.large",
+			OnDemandPrice:  0.1,
+			SpotPrice:      []types.ZonePrice{{Zone: "eu-west-1a", Price: 0.05}},
+			ReservedPrices: []types.ReservedPrice{{Term: "1yr", UpfrontPrice: 500, EffectiveHourlyPrice: 0.06}},
+			OsPrices:       map[string]float64{types.OsLinux: 0.1},
+		}},
+		{VMInfo: types.VMInfo{Type: "ecs.g6.large", OnDemandPrice: 3.2, Currency: "CNY"}},
+	}
+
+	converted, err := convertCurrency(products, converter, "eur")
+	assert.NoError(t, err)
+	assert.Equal(t, []types.ProductDetails{
+		{VMInfo: types.VMInfo{
+			Type:           "m5.large",
+			OnDemandPrice:  0.08,
+			SpotPrice:      []types.ZonePrice{{Zone: "eu-west-1a", Price: 0.04}},
+			ReservedPrices: []types.ReservedPrice{{Term: "1yr", UpfrontPrice: 400, EffectiveHourlyPrice: 0.048}},
+			OsPrices:       map[string]float64{types.OsLinux: 0.08},
+			Currency:       "EUR",
+		}},
+		{VMInfo: types.VMInfo{Type: "ecs.g6.large", OnDemandPrice: 0.4, Currency: "EUR"}},
+	}, converted)
+	assert.Equal(t, 0.05, products[0].SpotPrice[0].Price, "the prices of the store should be left intact")
+
+	converted, err = convertCurrency(products[1:], converter, "USD")
+	assert.NoError(t, err)
+	assert.Equal(t, "", converted[0].Currency, "the USD prices should have no currency")
+
+	_, err = convertCurrency(products, converter, "XYZ")
+	assert.Error(t, err)
+}
@@ -0,0 +1,47 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"math"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// annotatePricePremiums annotates the products with their on demand price premium (%) over the cheapest product
+// of the same category in the result set; products without category or price have no baseline
+func annotatePricePremiums(products []types.ProductDetails) {
+	cheapest := make(map[string]float64)
+	for _, product := range products {
+		if product.Category == "" || product.OnDemandPrice <= 0 {
+			continue
+		}
+
+		if price, ok := cheapest[product.Category]; !ok || product.OnDemandPrice < price {
+			cheapest[product.Category] = product.OnDemandPrice
+		}
+	}
+
+	for i, product := range products {
+		derived := &types.DerivedDetails{}
+
+		if baseline, ok := cheapest[product.Category]; ok && product.OnDemandPrice > 0 {
+			premium := math.Round((product.OnDemandPrice-baseline)/baseline*10000) / 100
+			derived.PricePremium = &premium
+		}
+
+		products[i].Derived = derived
+	}
+}
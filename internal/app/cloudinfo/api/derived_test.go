@@ -0,0 +1,52 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestAnnotatePricePremiums(t *testing.T) {
+	products := []types.ProductDetails{
+		{VMInfo: types.VMInfo{Type: "m5.large", Category: types.CategoryGeneral, OnDemandPrice: 0.096}},
+		{VMInfo: types.VMInfo{Type: "m5.xlarge", Category: types.CategoryGeneral, OnDemandPrice: 0.192}},
+		{VMInfo: types.VMInfo{Type: "c5.large", Category: types.CategoryCompute, OnDemandPrice: 0.085}},
+		{VMInfo: types.VMInfo{Type: "unknown", OnDemandPrice: 0.1}},
+	}
+
+	annotatePricePremiums(products)
+
+	premiums := make(map[string]*float64, len(products))
+	for _, product := range products {
+		if assert.NotNil(t, product.Derived, "the derived values should be set") {
+			premiums[product.Type] = product.Derived.PricePremium
+		}
+	}
+
+	if assert.NotNil(t, premiums["m5.large"]) {
+		assert.Equal(t, 0.0, *premiums["m5.large"], "the cheapest product should have no premium")
+	}
+	if assert.NotNil(t, premiums["m5.xlarge"]) {
+		assert.Equal(t, 100.0, *premiums["m5.xlarge"])
+	}
+	if assert.NotNil(t, premiums["c5.large"]) {
+		assert.Equal(t, 0.0, *premiums["c5.large"], "the products should be compared within their category")
+	}
+	assert.Nil(t, premiums["unknown"], "the product without category should have no baseline")
+}
@@ -0,0 +1,65 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"net/http"
+
+	"emperror.dev/emperror"
+	"emperror.dev/errors"
+	"github.com/gin-gonic/gin"
+
+	openapispec "github.com/banzaicloud/cloudinfo/api/openapi-spec"
+)
+
+// swaggerUIAssetsPath the path the embedded Swagger UI assets are served under, relative to the API
+const swaggerUIAssetsPath = "/api/v1/docs/assets"
+
+// swaggerUIPage the Swagger UI page rendering the OpenAPI document next to it, with the assets served by the application
+const swaggerUIPage = `<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8">
+  <title>Cloudinfo API</title>
+  <link rel="stylesheet" href="docs/assets/swagger-ui.css">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="docs/assets/swagger-ui-bundle.js"></script>
+  <script>
+    window.ui = SwaggerUIBundle({url: "openapi.json", dom_id: "#swagger-ui"});
+  </script>
+</body>
+</html>
+`
+
+// getOpenAPI serves the OpenAPI document of the API generated from the embedded specification
+func getOpenAPI(basePath string) gin.HandlerFunc {
+	document, err := openapispec.Document(basePath)
+	emperror.Panic(errors.WrapIf(err, "generate the OpenAPI document"))
+
+	return func(c *gin.Context) {
+		c.Data(http.StatusOK, "application/json; charset=utf-8", document)
+	}
+}
+
+// getSwaggerUI serves the Swagger UI of the API
+func getSwaggerUI() gin.HandlerFunc {
+	page := []byte(swaggerUIPage)
+
+	return func(c *gin.Context) {
+		c.Data(http.StatusOK, "text/html; charset=utf-8", page)
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"regexp"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	openapispec "github.com/banzaicloud/cloudinfo/api/openapi-spec"
+)
+
+func TestGetSwaggerUI(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+
+	getSwaggerUI()(c)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.NotContains(t, w.Body.String(), "https://", "the page loads its assets from the application only")
+
+	assets := regexp.MustCompile(`(?:href|src)="docs/assets/([^"]+)"`).FindAllStringSubmatch(w.Body.String(), -1)
+	require.Len(t, assets, 2)
+	for _, asset := range assets {
+		_, err := fs.Stat(openapispec.SwaggerUI(), path.Join("swagger-ui", asset[1]))
+		assert.NoError(t, err, "the %s asset is embedded", asset[1])
+	}
+}
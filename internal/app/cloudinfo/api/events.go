@@ -0,0 +1,228 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"io"
+	"sync"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/gin-gonic/gin"
+	"github.com/mitchellh/mapstructure"
+
+	"github.com/banzaicloud/cloudinfo/internal/app/cloudinfo/messaging"
+	"github.com/banzaicloud/cloudinfo/internal/platform/log"
+)
+
+// eventBuffer is the number of events buffered for a client, events are dropped for slow clients
+const eventBuffer = 16
+
+// names of the streamed events
+const (
+	refreshEventName         = "refresh"
+	scrapeCompletedEventName = "scrape-completed"
+	priceChangedEventName    = "price-changed"
+)
+
+// RefreshEvent is streamed to the clients when the data of a region has been refreshed
+type RefreshEvent struct {
+	Provider string    `json:"provider"`
+	Service  string    `json:"service"`
+	Region   string    `json:"region"`
+	Time     time.Time `json:"time"`
+}
+
+// ScrapeCompletedEvent is streamed to the clients when the scraping of a provider has been completed
+type ScrapeCompletedEvent struct {
+	Provider string    `json:"provider"`
+	Time     time.Time `json:"time"`
+}
+
+// PriceChangedEvent is streamed to the clients when the on demand prices of products of a region have changed
+type PriceChangedEvent struct {
+	Provider string    `json:"provider"`
+	Service  string    `json:"service"`
+	Region   string    `json:"region"`
+	Types    []string  `json:"types"`
+	Time     time.Time `json:"time"`
+}
+
+// streamEvent is an event to be streamed to the clients
+type streamEvent struct {
+	name     string
+	provider string
+	data     interface{}
+}
+
+// GetEventsQueryParams is a placeholder for the events query parameters
+// swagger:parameters getEvents
+type GetEventsQueryParams struct {
+	// the provider to stream the events of, all the providers if empty
+	// in:query
+	Provider string `json:"provider"`
+}
+
+// eventBroker fans out the events received from the event bus to the connected clients
+type eventBroker struct {
+	clients map[chan streamEvent]string
+	mu      sync.RWMutex
+}
+
+// newEventBroker creates an event broker subscribed to the refresh, scraping complete and products changed events
+// of the event bus
+func newEventBroker(eventBus messaging.EventBus) *eventBroker {
+	broker := &eventBroker{
+		clients: make(map[chan streamEvent]string),
+	}
+
+	if eventBus != nil {
+		eventBus.SubscribeRegionRefreshed(broker.publishRefresh)
+		eventBus.SubscribeAnyScrapingComplete(broker.publishScrapeCompleted)
+		eventBus.SubscribeProductsChanged(broker.publishPriceChanged)
+	}
+
+	return broker
+}
+
+// publishRefresh sends a refresh event of the region to the clients
+func (b *eventBroker) publishRefresh(provider, service, region string) {
+	b.publish(streamEvent{
+		name:     refreshEventName,
+		provider: provider,
+		data: RefreshEvent{
+			Provider: provider,
+			Service:  service,
+			Region:   region,
+			Time:     time.Now(),
+		},
+	})
+}
+
+// publishScrapeCompleted sends a scrape completed event of the provider to the clients
+func (b *eventBroker) publishScrapeCompleted(provider string) {
+	b.publish(streamEvent{
+		name:     scrapeCompletedEventName,
+		provider: provider,
+		data: ScrapeCompletedEvent{
+			Provider: provider,
+			Time:     time.Now(),
+		},
+	})
+}
+
+// publishPriceChanged sends a price changed event of the region to the clients if products were repriced
+func (b *eventBroker) publishPriceChanged(provider, service, region string, change messaging.ProductsChange) {
+	if len(change.Repriced) == 0 {
+		return
+	}
+
+	b.publish(streamEvent{
+		name:     priceChangedEventName,
+		provider: provider,
+		data: PriceChangedEvent{
+			Provider: provider,
+			Service:  service,
+			Region:   region,
+			Types:    change.Repriced,
+			Time:     time.Now(),
+		},
+	})
+}
+
+// publish sends the event to the clients interested in its provider
+func (b *eventBroker) publish(event streamEvent) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for client, filter := range b.clients {
+		if filter != "" && filter != event.provider {
+			continue
+		}
+
+		select {
+		case client <- event:
+		default:
+			// the client doesn't keep up, drop the event
+		}
+	}
+}
+
+// subscribe registers a new client; an empty provider means all the providers
+func (b *eventBroker) subscribe(provider string) chan streamEvent {
+	client := make(chan streamEvent, eventBuffer)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.clients[client] = provider
+
+	return client
+}
+
+// unsubscribe removes the client and releases its resources
+func (b *eventBroker) unsubscribe(client chan streamEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delete(b.clients, client)
+	close(client)
+}
+
+// swagger:route GET /events events getEvents
+//
+// Streams server-sent events whenever the data of a provider region has been refreshed (refresh), the scraping of a
+// provider has been completed (scrape-completed) or the on demand prices of products have changed (price-changed).
+//
+//     Produces:
+//     - text/event-stream
+//
+//     Schemes: http
+//
+//     Security:
+//
+//     Responses:
+//       200:
+func (r *RouteHandler) getEvents() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		queryParams := GetEventsQueryParams{}
+		if err := mapstructure.Decode(getQueryAsMap(c), &queryParams); err != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
+			return
+		}
+
+		logger := log.WithFieldsForHandlers(c, r.log, map[string]interface{}{"provider": queryParams.Provider})
+		logger.Info("streaming events")
+
+		client := r.events.subscribe(queryParams.Provider)
+		defer r.events.unsubscribe(client)
+
+		c.Header("Content-Type", eventStreamContentType)
+		c.Header("Cache-Control", "no-cache")
+		c.Header("Connection", "keep-alive")
+
+		c.Stream(func(w io.Writer) bool {
+			select {
+			case event := <-client:
+				c.SSEvent(event.name, event.data)
+				return true
+			case <-c.Request.Context().Done():
+				return false
+			}
+		})
+
+		logger.Debug("client disconnected from the events")
+	}
+}
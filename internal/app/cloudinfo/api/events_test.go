@@ -0,0 +1,126 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/app/cloudinfo/messaging"
+)
+
+func TestEventBroker_PublishRefresh(t *testing.T) {
+	tests := []struct {
+		name     string
+		filter   string
+		provider string
+		check    func(t *testing.T, client chan streamEvent)
+	}{
+		{
+			name:     "event is sent to the clients without provider filter",
+			filter:   "",
+			provider: "amazon",
+			check: func(t *testing.T, client chan streamEvent) {
+				assert.Len(t, client, 1, "the event should be received")
+				event := <-client
+				assert.Equal(t, refreshEventName, event.name)
+				refresh := event.data.(RefreshEvent)
+				assert.Equal(t, "amazon", refresh.Provider)
+				assert.Equal(t, "compute", refresh.Service)
+				assert.Equal(t, "eu-west-1", refresh.Region)
+			},
+		},
+		{
+			name:     "event is sent to the clients filtering for the provider",
+			filter:   "amazon",
+			provider: "amazon",
+			check: func(t *testing.T, client chan streamEvent) {
+				assert.Len(t, client, 1, "the event should be received")
+			},
+		},
+		{
+			name:     "event is not sent to the clients filtering for other providers",
+			filter:   "google",
+			provider: "amazon",
+			check: func(t *testing.T, client chan streamEvent) {
+				assert.Len(t, client, 0, "the event should not be received")
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			broker := newEventBroker(nil)
+			client := broker.subscribe(test.filter)
+
+			broker.publishRefresh(test.provider, "compute", "eu-west-1")
+
+			test.check(t, client)
+
+			broker.unsubscribe(client)
+			assert.Empty(t, broker.clients, "the client should be removed")
+		})
+	}
+}
+
+func TestEventBroker_PublishScrapeCompleted(t *testing.T) {
+	broker := newEventBroker(nil)
+	client := broker.subscribe("")
+	defer broker.unsubscribe(client)
+
+	broker.publishScrapeCompleted("amazon")
+
+	assert.Len(t, client, 1, "the event should be received")
+	event := <-client
+	assert.Equal(t, scrapeCompletedEventName, event.name)
+	assert.Equal(t, "amazon", event.data.(ScrapeCompletedEvent).Provider)
+}
+
+func TestEventBroker_PublishPriceChanged(t *testing.T) {
+	tests := []struct {
+		name   string
+		change messaging.ProductsChange
+		check  func(t *testing.T, client chan streamEvent)
+	}{
+		{
+			name:   "event is sent for repriced products",
+			change: messaging.ProductsChange{Added: []string{"m6i.large"}, Repriced: []string{"m5.large", "m5.xlarge"}},
+			check: func(t *testing.T, client chan streamEvent) {
+				assert.Len(t, client, 1, "the event should be received")
+				event := <-client
+				assert.Equal(t, priceChangedEventName, event.name)
+				assert.Equal(t, []string{"m5.large", "m5.xlarge"}, event.data.(PriceChangedEvent).Types)
+			},
+		},
+		{
+			name:   "event is not sent without repriced products",
+			change: messaging.ProductsChange{Added: []string{"m6i.large"}},
+			check: func(t *testing.T, client chan streamEvent) {
+				assert.Len(t, client, 0, "the event should not be received")
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			broker := newEventBroker(nil)
+			client := broker.subscribe("amazon")
+			defer broker.unsubscribe(client)
+
+			broker.publishPriceChanged("amazon", "compute", "eu-west-1", test.change)
+
+			test.check(t, client)
+		})
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// sparseProductDetailsResponse is the product details response with the requested fields of the products only
+type sparseProductDetailsResponse struct {
+	Products     []map[string]json.RawMessage `json:"products"`
+	ScrapingTime string                       `json:"scrapingTime"`
+	Total        int                          `json:"total"`
+	NextCursor   string                       `json:"nextCursor,omitempty"`
+}
+
+// productFieldNames holds the JSON names of the fields of the products
+var productFieldNames = jsonFieldNames(reflect.TypeOf(types.ProductDetails{}))
+
+// jsonFieldNames returns the JSON names of the fields of a struct type, including the fields of the embedded structs
+func jsonFieldNames(t reflect.Type) map[string]bool {
+	names := make(map[string]bool)
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			for name := range jsonFieldNames(field.Type) {
+				names[name] = true
+			}
+			continue
+		}
+
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "-" || field.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		names[name] = true
+	}
+
+	return names
+}
+
+// parseProductFields parses the comma separated list of the product fields to keep in the response
+func parseProductFields(expr string) ([]string, error) {
+	var fields []string
+	for _, field := range strings.Split(expr, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+
+		if !productFieldNames[field] {
+			return nil, errors.NewWithDetails("invalid fields query parameter", "field", field)
+		}
+		fields = append(fields, field)
+	}
+
+	if len(fields) == 0 {
+		return nil, errors.NewWithDetails("invalid fields query parameter", "fields", expr)
+	}
+
+	return fields, nil
+}
+
+// selectProductFields returns the JSON objects of the products with the given fields only
+// the fields left out of the JSON of a product (eg. empty optional fields) are left out of its object as well
+func selectProductFields(products []types.ProductDetails, fields []string) ([]map[string]json.RawMessage, error) {
+	selected := make([]map[string]json.RawMessage, 0, len(products))
+	for _, product := range products {
+		object, err := selectFields(product, fields)
+		if err != nil {
+			return nil, err
+		}
+		selected = append(selected, object)
+	}
+
+	return selected, nil
+}
+
+// selectFields returns the JSON object of the product with the given fields only
+func selectFields(product types.ProductDetails, fields []string) (map[string]json.RawMessage, error) {
+	raw, err := json.Marshal(product)
+	if err != nil {
+		return nil, errors.WrapIfWithDetails(err, "failed to marshal product", "type", product.Type)
+	}
+
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &all); err != nil {
+		return nil, errors.WrapIfWithDetails(err, "failed to unmarshal product", "type", product.Type)
+	}
+
+	object := make(map[string]json.RawMessage, len(fields))
+	for _, field := range fields {
+		if value, ok := all[field]; ok {
+			object[field] = value
+		}
+	}
+
+	return object, nil
+}
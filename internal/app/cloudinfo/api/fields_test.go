@@ -0,0 +1,83 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestParseProductFields(t *testing.T) {
+	tests := []struct {
+		name     string
+		expr     string
+		expected []string
+		err      bool
+	}{
+		{
+			name:     "fields of the embedded struct",
+			expr:     "type, onDemandPrice,cpusPerVm",
+			expected: []string{"type", "onDemandPrice", "cpusPerVm"},
+		},
+		{
+			name:     "fields of the outer struct",
+			expr:     "type,derived",
+			expected: []string{"type", "derived"},
+		},
+		{
+			name: "unknown field",
+			expr: "type,price",
+			err:  true,
+		},
+		{
+			name: "no fields",
+			expr: ",",
+			err:  true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fields, err := parseProductFields(test.expr)
+			if test.err {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, fields)
+		})
+	}
+}
+
+func TestSelectProductFields(t *testing.T) {
+	products := []types.ProductDetails{
+		{VMInfo: types.VMInfo{Type: "m5.large", OnDemandPrice: 0.096, Cpus: 2, Mem: 8, GpuModel: "none"}},
+		{VMInfo: types.VMInfo{Type: "t3.large", OnDemandPrice: 0.0832, Cpus: 2, Mem: 8}},
+	}
+
+	selected, err := selectProductFields(products, []string{"type", "onDemandPrice", "gpuModel"})
+	assert.NoError(t, err)
+
+	raw, err := json.Marshal(selected)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `[
+		{"type": "m5.large", "onDemandPrice": 0.096, "gpuModel": "none"},
+		{"type": "t3.large", "onDemandPrice": 0.0832}
+	]`, string(raw), "the omitted empty fields should be left out")
+}
@@ -0,0 +1,85 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"emperror.dev/errors"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// formatJSON the listing is a JSON document
+	formatJSON = "json"
+	// formatCSV the listing is a CSV table, see renderCSV
+	formatCSV = "csv"
+	// formatNDJSON the listing is streamed as newline delimited JSON, a record per line
+	formatNDJSON = "ndjson"
+
+	// mimeCSV the media type of the CSV responses
+	mimeCSV = "text/csv"
+	// mimeNDJSON the media type of the newline delimited JSON responses
+	mimeNDJSON = "application/x-ndjson"
+
+	// ndjsonFlushInterval the number of lines written between flushing the NDJSON responses to the client
+	ndjsonFlushInterval = 100
+)
+
+// negotiateFormat returns the format of the listing: the one requested explicitly with the format query parameter
+// (json, csv or ndjson) or negotiated with the Accept header of the request, JSON unless text/csv or application/x-ndjson is preferred
+func negotiateFormat(c *gin.Context, format string) (string, error) {
+	switch format {
+	case formatJSON, formatCSV, formatNDJSON:
+		return format, nil
+	case "":
+		switch c.NegotiateFormat(gin.MIMEJSON, mimeCSV, mimeNDJSON) {
+		case mimeCSV:
+			return formatCSV, nil
+		case mimeNDJSON:
+			return formatNDJSON, nil
+		default:
+			return formatJSON, nil
+		}
+	default:
+		return "", errors.NewWithDetails("invalid format query parameter", "format", format)
+	}
+}
+
+// respondNDJSON streams the records of a listing as newline delimited JSON, the i-th record returned by the record func
+// the records are encoded one by one and flushed regularly, so the whole listing is never marshaled at once;
+// as the status is sent with the first line, a failure midway is logged and cuts the response short
+func (r *RouteHandler) respondNDJSON(c *gin.Context, n int, record func(i int) (interface{}, error)) {
+	c.Header("Content-Type", mimeNDJSON+"; charset=utf-8")
+	c.Status(http.StatusOK)
+
+	enc := json.NewEncoder(c.Writer)
+	for i := 0; i < n; i++ {
+		v, err := record(i)
+		if err == nil {
+			err = enc.Encode(v)
+		}
+		if err != nil {
+			r.log.Error("failed to stream response", map[string]interface{}{"line": i, "error": err.Error()})
+			return
+		}
+
+		if (i+1)%ndjsonFlushInterval == 0 {
+			c.Writer.Flush()
+		}
+	}
+	c.Writer.Flush()
+}
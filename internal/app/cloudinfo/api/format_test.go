@@ -0,0 +1,173 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"emperror.dev/errors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/cloudinfoadapter"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestNegotiateFormat(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		accept string
+		check  func(t *testing.T, format string, err error)
+	}{
+		{
+			name: "json by default",
+			check: func(t *testing.T, format string, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, formatJSON, format)
+			},
+		},
+		{
+			name:   "csv accept header",
+			accept: "text/csv",
+			check: func(t *testing.T, format string, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, formatCSV, format)
+			},
+		},
+		{
+			name:   "ndjson accept header",
+			accept: "application/x-ndjson",
+			check: func(t *testing.T, format string, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, formatNDJSON, format)
+			},
+		},
+		{
+			name:   "any media type",
+			accept: "text/html,*/*;q=0.8",
+			check: func(t *testing.T, format string, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, formatJSON, format)
+			},
+		},
+		{
+			name:   "format overrides the accept header",
+			format: "json",
+			accept: "text/csv",
+			check: func(t *testing.T, format string, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, formatJSON, format)
+			},
+		},
+		{
+			name:   "csv format",
+			format: "csv",
+			check: func(t *testing.T, format string, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, formatCSV, format)
+			},
+		},
+		{
+			name:   "ndjson format",
+			format: "ndjson",
+			check: func(t *testing.T, format string, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, formatNDJSON, format)
+			},
+		},
+		{
+			name:   "invalid format",
+			format: "xml",
+			check: func(t *testing.T, format string, err error) {
+				assert.Error(t, err)
+			},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+			if test.accept != "" {
+				c.Request.Header.Set("Accept", test.accept)
+			}
+
+			format, err := negotiateFormat(c, test.format)
+
+			test.check(t, format, err)
+		})
+	}
+}
+
+func TestRouteHandler_RespondNDJSON(t *testing.T) {
+	products := []types.ProductDetails{
+		{VMInfo: types.VMInfo{Type: "m5.large", Cpus: 2, Mem: 8}},
+		{VMInfo: types.VMInfo{Type: "m5.xlarge", Cpus: 4, Mem: 16}},
+		{VMInfo: types.VMInfo{Type: "m5.2xlarge", Cpus: 8, Mem: 32}},
+	}
+
+	tests := []struct {
+		name   string
+		record func(i int) (interface{}, error)
+		check  func(t *testing.T, w *httptest.ResponseRecorder)
+	}{
+		{
+			name: "a line per record",
+			record: func(i int) (interface{}, error) {
+				return selectFields(products[i], []string{"type", "cpusPerVm"})
+			},
+			check: func(t *testing.T, w *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, w.Code)
+				assert.Equal(t, "application/x-ndjson; charset=utf-8", w.Header().Get("Content-Type"))
+				assert.Equal(t, `{"cpusPerVm":2,"type":"m5.large"}
+{"cpusPerVm":4,"type":"m5.xlarge"}
+{"cpusPerVm":8,"type":"m5.2xlarge"}
+`, w.Body.String())
+			},
+		},
+		{
+			name: "failure midway",
+			record: func(i int) (interface{}, error) {
+				if i == 1 {
+					return nil, errors.New("failed to marshal product")
+				}
+				return map[string]string{"type": products[i].Type}, nil
+			},
+			check: func(t *testing.T, w *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, w.Code)
+				assert.Equal(t, "{\"type\":\"m5.large\"}\n", w.Body.String())
+			},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+
+			r := &RouteHandler{log: cloudinfoadapter.NewNoopLogger()}
+			r.respondNDJSON(c, len(products), test.record)
+
+			test.check(t, w)
+		})
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"net/http"
+	"sort"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/gin-gonic/gin"
+	"github.com/mitchellh/mapstructure"
+
+	"github.com/banzaicloud/cloudinfo/internal/platform/log"
+)
+
+// swagger:route GET /status status getFreshness
+//
+// Reports the freshness of the data per provider, service and region: the time of the last successful scrape,
+// the age of the data and the last failed scrape, so the stale data can be detected.
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+//     Security:
+//
+//     Responses:
+//       200: FreshnessResponse
+func (r *RouteHandler) getFreshness() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		queryParams := GetFreshnessQueryParams{}
+		if err := mapstructure.Decode(getQueryAsMap(c), &queryParams); err != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
+			return
+		}
+
+		var maxAge time.Duration
+		if queryParams.MaxAge != "" {
+			var err error
+			if maxAge, err = time.ParseDuration(queryParams.MaxAge); err != nil || maxAge <= 0 {
+				r.errorResponder.Respond(c, errors.WithDetails(errors.NewWithDetails("invalid maxAge query parameter",
+					"maxAge", queryParams.MaxAge), "validation"))
+				return
+			}
+		}
+
+		logger := log.WithFieldsForHandlers(c, r.log, map[string]interface{}{"provider": queryParams.Provider})
+		logger.Info("getting data freshness")
+
+		var providers []string
+		if queryParams.Provider != "" {
+			if _, err := r.prod.GetProvider(queryParams.Provider); err != nil {
+				r.errorResponder.Respond(c, errors.WrapIfWithDetails(err, "failed to retrieve provider",
+					"provider", queryParams.Provider))
+				return
+			}
+			providers = []string{queryParams.Provider}
+		} else {
+			all, err := r.prod.GetProviders()
+			if err != nil {
+				r.errorResponder.Respond(c, errors.WrapIf(err, "failed to retrieve providers"))
+				return
+			}
+			for _, provider := range all {
+				providers = append(providers, provider.Provider)
+			}
+			sort.Strings(providers)
+		}
+
+		now := time.Now()
+		response := FreshnessResponse{Providers: make([]ProviderFreshness, 0, len(providers))}
+		for _, provider := range providers {
+			freshness, err := r.providerFreshness(provider, now, maxAge)
+			if err != nil {
+				r.errorResponder.Respond(c, err)
+				return
+			}
+			response.Providers = append(response.Providers, freshness)
+		}
+
+		logger.Debug("successfully retrieved data freshness")
+		c.JSON(http.StatusOK, response)
+	}
+}
+
+// providerFreshness collects the freshness of the data of the regions of the (not static) services of a provider
+func (r *RouteHandler) providerFreshness(provider string, now time.Time, maxAge time.Duration) (ProviderFreshness, error) {
+	freshness := ProviderFreshness{Provider: provider, Services: []ServiceFreshness{}}
+	if status, err := r.prod.GetStatus(provider); err == nil {
+		if scrapedAt, ok := parseStatus(status); ok {
+			freshness.ScrapedAt = &scrapedAt
+		}
+	}
+
+	services, err := r.prod.GetServices(provider)
+	if err != nil {
+		return ProviderFreshness{}, errors.WrapIfWithDetails(err, "failed to retrieve services", "provider", provider)
+	}
+	sort.Slice(services, func(i, j int) bool {
+		return services[i].ServiceName() < services[j].ServiceName()
+	})
+
+	for _, service := range services {
+		if service.IsStatic {
+			// the static services are not scraped
+			continue
+		}
+
+		// the regions of a service not scraped yet are unknown
+		regions, _ := r.prod.GetRegions(provider, service.ServiceName())
+		ids := make([]string, 0, len(regions))
+		for id := range regions {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+
+		serviceFreshness := ServiceFreshness{Service: service.ServiceName(), Regions: make([]RegionFreshness, 0, len(ids))}
+		for _, id := range ids {
+			serviceFreshness.Regions = append(serviceFreshness.Regions, r.regionFreshness(provider, service.ServiceName(), id, now, maxAge))
+		}
+		freshness.Services = append(freshness.Services, serviceFreshness)
+	}
+
+	return freshness, nil
+}
+
+// regionFreshness looks up the last successful and failed scrapes of a region
+func (r *RouteHandler) regionFreshness(provider, service, region string, now time.Time, maxAge time.Duration) RegionFreshness {
+	freshness := RegionFreshness{Region: region, Stale: true}
+	if status, err := r.prod.GetRegionStatus(provider, service, region); err == nil {
+		if scrapedAt, ok := parseStatus(status); ok {
+			age := int64(now.Sub(scrapedAt).Seconds())
+			freshness.ScrapedAt = &scrapedAt
+			freshness.DataAge = &age
+			freshness.Stale = maxAge > 0 && now.Sub(scrapedAt) > maxAge
+		}
+	}
+
+	if scrapeError, err := r.prod.GetRegionError(provider, service, region); err == nil {
+		freshness.LastError = &scrapeError
+		if freshness.ScrapedAt != nil && scrapeError.Time.After(*freshness.ScrapedAt) {
+			freshness.Stale = true
+		}
+	}
+
+	return freshness
+}
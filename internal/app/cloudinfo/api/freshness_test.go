@@ -0,0 +1,97 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/cloudinfoadapter"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestRouteHandler_regionFreshness(t *testing.T) {
+	now := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	scrapedAt := now.Add(-time.Hour)
+
+	tests := []struct {
+		name   string
+		ci     *statusCloudInfo
+		maxAge time.Duration
+		check  func(t *testing.T, freshness RegionFreshness)
+	}{
+		{
+			name: "never scraped region",
+			ci:   &statusCloudInfo{},
+			check: func(t *testing.T, freshness RegionFreshness) {
+				assert.Nil(t, freshness.ScrapedAt)
+				assert.Nil(t, freshness.DataAge)
+				assert.Nil(t, freshness.LastError)
+				assert.True(t, freshness.Stale, "the region never scraped should be stale")
+			},
+		},
+		{
+			name: "scraped region",
+			ci:   &statusCloudInfo{regionStatus: map[string]time.Time{"amazon/compute/eu-west-1": scrapedAt}},
+			check: func(t *testing.T, freshness RegionFreshness) {
+				assert.Equal(t, scrapedAt, freshness.ScrapedAt.UTC())
+				assert.Equal(t, int64(3600), *freshness.DataAge)
+				assert.False(t, freshness.Stale)
+			},
+		},
+		{
+			name:   "region older than the maximum age",
+			ci:     &statusCloudInfo{regionStatus: map[string]time.Time{"amazon/compute/eu-west-1": scrapedAt}},
+			maxAge: 30 * time.Minute,
+			check: func(t *testing.T, freshness RegionFreshness) {
+				assert.True(t, freshness.Stale, "the data older than the maximum age should be stale")
+			},
+		},
+		{
+			name: "region failed to be scraped since",
+			ci: &statusCloudInfo{
+				regionStatus: map[string]time.Time{"amazon/compute/eu-west-1": scrapedAt},
+				regionError:  map[string]types.ScrapeError{"amazon/compute/eu-west-1": {Time: now.Add(-time.Minute), Message: "throttled"}},
+			},
+			check: func(t *testing.T, freshness RegionFreshness) {
+				assert.Equal(t, "throttled", freshness.LastError.Message)
+				assert.True(t, freshness.Stale, "the region failed to be scraped after its last successful scrape should be stale")
+			},
+		},
+		{
+			name: "region scraped after its last failure",
+			ci: &statusCloudInfo{
+				regionStatus: map[string]time.Time{"amazon/compute/eu-west-1": scrapedAt},
+				regionError:  map[string]types.ScrapeError{"amazon/compute/eu-west-1": {Time: scrapedAt.Add(-time.Hour), Message: "throttled"}},
+			},
+			check: func(t *testing.T, freshness RegionFreshness) {
+				assert.NotNil(t, freshness.LastError, "the last error should be reported")
+				assert.False(t, freshness.Stale)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := &RouteHandler{prod: test.ci, log: cloudinfoadapter.NewNoopLogger()}
+
+			freshness := r.regionFreshness("amazon", "compute", "eu-west-1", now, test.maxAge)
+
+			assert.Equal(t, "eu-west-1", freshness.Region)
+			test.check(t, freshness)
+		})
+	}
+}
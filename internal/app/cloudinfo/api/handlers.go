@@ -16,13 +16,16 @@ package api
 
 import (
 	"net/http"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"emperror.dev/errors"
 	"github.com/gin-gonic/gin"
 	"github.com/mitchellh/mapstructure"
 
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo"
 	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
 	"github.com/banzaicloud/cloudinfo/internal/platform/log"
 )
@@ -42,6 +45,12 @@ import (
 //       200: ProvidersResponse
 func (r *RouteHandler) getProviders() gin.HandlerFunc {
 	return func(c *gin.Context) {
+		queryParams := GetListingQueryParams{}
+		if err := mapstructure.Decode(getQueryAsMap(c), &queryParams); err != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
+			return
+		}
+
 		logger := log.WithFieldsForHandlers(c, r.log, nil)
 
 		logger.Info("getting providers")
@@ -55,6 +64,11 @@ func (r *RouteHandler) getProviders() gin.HandlerFunc {
 			return
 		}
 
+		if err := r.sortProviders(providers, queryParams.Sort); err != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
+			return
+		}
+
 		logger.Debug("successfully retrieved providers")
 		c.JSON(http.StatusOK, ProvidersResponse{Providers: providers})
 	}
@@ -121,6 +135,12 @@ func (r *RouteHandler) getServices() gin.HandlerFunc {
 			return
 		}
 
+		queryParams := GetListingQueryParams{}
+		if err := mapstructure.Decode(getQueryAsMap(c), &queryParams); err != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
+			return
+		}
+
 		logger := log.WithFieldsForHandlers(c, r.log, map[string]interface{}{"provider": pathParams.Provider})
 		logger.Info("getting services")
 
@@ -136,6 +156,13 @@ func (r *RouteHandler) getServices() gin.HandlerFunc {
 			return
 		}
 
+		// the cached listing is not sorted in place
+		services = append([]types.Service(nil), services...)
+		if err := r.sortServices(services, queryParams.Sort); err != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
+			return
+		}
+
 		logger.Debug("successfully retrieved services")
 		c.JSON(http.StatusOK, NewServicesResponse(services))
 	}
@@ -223,6 +250,12 @@ func (r *RouteHandler) getContinentsData() gin.HandlerFunc {
 
 		logger.Info("getting continents data")
 
+		queryParams := GetListingQueryParams{}
+		if err := mapstructure.Decode(getQueryAsMap(c), &queryParams); err != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
+			return
+		}
+
 		locations, err := r.prod.GetContinentsData(pathParams.Provider, pathParams.Service)
 		if err != nil {
 			r.errorResponder.Respond(c, errors.WrapIfWithDetails(err, "failed to retrieve continents data for provider",
@@ -232,11 +265,23 @@ func (r *RouteHandler) getContinentsData() gin.HandlerFunc {
 
 		var response ContinentsDataResponse
 		for continent, regions := range locations {
+			regions = append([]types.Region(nil), regions...)
+			for i := range regions {
+				regions[i] = r.region(pathParams.Provider, regions[i].ID, regions[i].Name)
+			}
+			if err := r.sortRegions(regions, queryParams.Sort); err != nil {
+				r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
+				return
+			}
+
 			response = append(response, Continent{
 				Name:    continent,
 				Regions: regions,
 			})
 		}
+		sort.Slice(response, func(i, j int) bool {
+			return response[i].Name < response[j].Name
+		})
 
 		logger.Debug("successfully retrieved continents data")
 		c.JSON(http.StatusOK, response)
@@ -249,6 +294,8 @@ func (r *RouteHandler) getContinentsData() gin.HandlerFunc {
 //
 //     Produces:
 //     - application/json
+//     - text/csv
+//     - application/x-ndjson
 //
 //     Schemes: http
 //
@@ -274,6 +321,18 @@ func (r *RouteHandler) getRegions() gin.HandlerFunc {
 
 		logger.Info("getting regions")
 
+		queryParams := GetRegionsQueryParams{}
+		if err := mapstructure.Decode(getQueryAsMap(c), &queryParams); err != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
+			return
+		}
+
+		format, err := negotiateFormat(c, queryParams.Format)
+		if err != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
+			return
+		}
+
 		regions, err := r.prod.GetRegions(pathParams.Provider, pathParams.Service)
 		if err != nil {
 			r.errorResponder.Respond(c, errors.WrapIfWithDetails(err, "failed to retrieve regions",
@@ -282,13 +341,33 @@ func (r *RouteHandler) getRegions() gin.HandlerFunc {
 		}
 		var response RegionsResponse
 		for id, name := range regions {
-			response = append(response, types.Region{
-				ID:   id,
-				Name: name,
-			})
+			region := r.region(pathParams.Provider, id, name)
+			if queryParams.Continent != "" && !cloudinfo.MatchContinent(region.Continent, queryParams.Continent) {
+				continue
+			}
+			if queryParams.Country != "" && !strings.EqualFold(region.Country, queryParams.Country) {
+				continue
+			}
+			if queryParams.Compliance != "" && !r.regionCompliance.Covers(pathParams.Provider, id, strings.Split(queryParams.Compliance, ",")) {
+				continue
+			}
+
+			response = append(response, region)
+		}
+		if err := r.sortRegions(response, queryParams.Sort); err != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
+			return
 		}
 
 		logger.Debug("successfully retrieved regions")
+		switch format {
+		case formatCSV:
+			r.respondCSV(c, regionCSVColumns, response)
+			return
+		case formatNDJSON:
+			r.respondNDJSON(c, len(response), func(i int) (interface{}, error) { return response[i], nil })
+			return
+		}
 		c.JSON(http.StatusOK, response)
 	}
 }
@@ -337,24 +416,52 @@ func (r *RouteHandler) getRegion() gin.HandlerFunc {
 		}
 
 		logger.Debug("successfully retrieved region details")
-		c.JSON(http.StatusOK, GetRegionResp{pathParams.Region, regions[pathParams.Region], zones})
+		region := r.region(pathParams.Provider, pathParams.Region, regions[pathParams.Region])
+		c.JSON(http.StatusOK, GetRegionResp{
+			Id:          region.ID,
+			Name:        region.Name,
+			DisplayName: region.DisplayName,
+			Continent:   region.Continent,
+			Country:     region.Country,
+			Latitude:    region.Latitude,
+			Longitude:   region.Longitude,
+			Zones:       zones,
+			Carbon:      region.Carbon,
+			Compliance:  region.Compliance,
+		})
 	}
 }
 
-// swagger:route GET /providers/{provider}/services/{service}/regions/{region}/products products getProducts
+// region assembles a region of the provider with its geographic metadata, carbon intensity and compliance scopes
+func (r *RouteHandler) region(provider, id, name string) types.Region {
+	region := types.Region{
+		ID:         id,
+		Name:       name,
+		Carbon:     r.regionCarbon.Carbon(provider, id),
+		Compliance: r.regionCompliance.Scopes(provider, id),
+	}
+	cloudinfo.SetRegionGeo(provider, &region)
+
+	return region
+}
+
+// swagger:route GET /providers/{provider}/services/{service}/regions/{region}/zones region getZones
 //
-// Provides a list of available machine types on a given provider in a specific region.
+// Provides the availability zones of a specific region with their provider native ids and the instance types
+// offered in them, if known.
 //
 //     Produces:
 //     - application/json
+//     - text/csv
+//     - application/x-ndjson
 //
 //     Schemes: http
 //
 //     Security:
 //
 //     Responses:
-//       200: ProductDetailsResponse
-func (r *RouteHandler) getProducts() gin.HandlerFunc {
+//       200: ZonesResponse
+func (r *RouteHandler) getZones() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		pathParams := GetRegionPathParams{}
 		if err := mapstructure.Decode(getPathParamMap(c), &pathParams); err != nil {
@@ -369,48 +476,84 @@ func (r *RouteHandler) getProducts() gin.HandlerFunc {
 
 		logger := log.WithFieldsForHandlers(c, r.log,
 			map[string]interface{}{"provider": pathParams.Provider, "service": pathParams.Service, "region": pathParams.Region})
-		logger.Info("getting product details")
+		queryParams := GetFormatQueryParams{}
+		if err := mapstructure.Decode(getQueryAsMap(c), &queryParams); err != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
+			return
+		}
 
-		scrapingTime, err := r.prod.GetStatus(pathParams.Provider)
+		format, err := negotiateFormat(c, queryParams.Format)
 		if err != nil {
-			r.errorResponder.Respond(c, errors.WrapIfWithDetails(err, "failed to retrieve status",
-				"provider", pathParams.Provider))
+			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
 			return
 		}
-		details, err := r.prod.GetProductDetails(pathParams.Provider, pathParams.Service, pathParams.Region)
+
+		logger.Info("getting zones")
+
+		if zones, err := r.prod.GetZoneInfo(pathParams.Provider, pathParams.Region); err == nil {
+			logger.Debug("successfully retrieved zones")
+			switch format {
+			case formatCSV:
+				r.respondCSV(c, zoneCSVColumns, zones)
+				return
+			case formatNDJSON:
+				r.respondNDJSON(c, len(zones), func(i int) (interface{}, error) { return zones[i], nil })
+				return
+			}
+			c.JSON(http.StatusOK, ZonesResponse(zones))
+			return
+		}
+
+		// the providers not describing their zones only know the zone names
+		names, err := r.prod.GetZones(pathParams.Provider, pathParams.Service, pathParams.Region)
 		if err != nil {
-			r.errorResponder.Respond(c, errors.WrapIfWithDetails(err,
-				"failed to retrieve product details",
+			r.errorResponder.Respond(c, errors.WrapIfWithDetails(err, "failed to retrieve zones",
 				"provider", pathParams.Provider, "service", pathParams.Service, "region", pathParams.Region))
 			return
 		}
 
-		logger.Debug("successfully retrieved product details")
-		c.JSON(http.StatusOK, ProductDetailsResponse{details, scrapingTime})
+		zones := make(ZonesResponse, 0, len(names))
+		for _, name := range names {
+			zones = append(zones, types.ZoneInfo{Name: name})
+		}
+
+		logger.Debug("successfully retrieved zones")
+		switch format {
+		case formatCSV:
+			r.respondCSV(c, zoneCSVColumns, zones)
+			return
+		case formatNDJSON:
+			r.respondNDJSON(c, len(zones), func(i int) (interface{}, error) { return zones[i], nil })
+			return
+		}
+		c.JSON(http.StatusOK, zones)
 	}
 }
 
-// swagger:route GET /providers/{provider}/services/{service}/regions/{region}/images images getImages
+// swagger:route GET /providers/{provider}/services/{service}/regions/{region}/products products getProducts
 //
-// Provides a list of available images on a given provider in a specific region for a service.
+// Provides a list of available machine types on a given provider in a specific region.
 //
 //     Produces:
 //     - application/json
+//     - text/csv
+//     - application/x-ndjson
+//     - application/x-protobuf
 //
 //     Schemes: http
 //
 //     Security:
 //
 //     Responses:
-//       200: ImagesResponse
-func (r *RouteHandler) getImages() gin.HandlerFunc {
+//       200: ProductDetailsResponse
+func (r *RouteHandler) getProducts() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		pathParams := GetRegionPathParams{}
 		if err := mapstructure.Decode(getPathParamMap(c), &pathParams); err != nil {
 			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
 			return
 		}
-		queryParams := GetImagesQueryParams{}
+		queryParams := GetProductDetailsQueryParams{}
 		if err := mapstructure.Decode(getQueryAsMap(c), &queryParams); err != nil {
 			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
 			return
@@ -421,80 +564,132 @@ func (r *RouteHandler) getImages() gin.HandlerFunc {
 			return
 		}
 
-		logger := log.WithFieldsForHandlers(c, r.log, map[string]interface{}{"provider": pathParams.Provider,
-			"service": pathParams.Service, "region": pathParams.Region})
-		logger.Info("getting image details")
+		// the zone scoped route serves the products of the zone with the spot price of the zone
+		if zone := c.Param("zone"); zone != "" {
+			queryParams.Zone = zone
+			queryParams.zoneSpotPrices = true
+		}
 
-		images, err := r.prod.GetServiceImages(pathParams.Provider, pathParams.Service, pathParams.Region)
+		format, err := negotiateProtobufFormat(c, queryParams.Format)
 		if err != nil {
-			r.errorResponder.Respond(c, errors.WrapIfWithDetails(err, "failed to retrieve service images details",
-				"provider", pathParams.Provider, "service", pathParams.Service, "region", pathParams.Region))
+			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
 			return
 		}
 
-		zeroQuery := GetImagesQueryParams{}
-		if queryParams != zeroQuery {
-			filteredImages := make([]types.Image, 0, len(images))
-			for _, image := range images {
-				if queryParams.Version != "" && queryParams.Version != image.Version {
-					continue
-				}
-				if queryParams.Gpu == "true" && !image.GpuAvailable {
-					continue
-				}
-				if queryParams.Gpu == "false" && image.GpuAvailable {
-					continue
-				}
-				// todo possibly add filtering by all tags (generic)
-				if queryParams.Os != "" && queryParams.Os != image.Tags["os-type"] {
-					continue
-				}
-				if queryParams.Cr != "" && queryParams.Cr != image.Tags["cr"] {
-					continue
-				}
-				if queryParams.PkeVersion != "" && queryParams.PkeVersion != image.Tags["pke-version"] {
-					continue
+		logger := log.WithFieldsForHandlers(c, r.log,
+			map[string]interface{}{"provider": pathParams.Provider, "service": pathParams.Service, "region": pathParams.Region})
+		logger.Info("getting product details")
+
+		scrapingTime, err := r.prod.GetStatus(pathParams.Provider)
+		if err != nil {
+			r.errorResponder.Respond(c, errors.WrapIfWithDetails(err, "failed to retrieve status",
+				"provider", pathParams.Provider))
+			return
+		}
+		details, page, err := r.queryProducts(pathParams, queryParams)
+		if err != nil {
+			r.errorResponder.Respond(c, err)
+			return
+		}
+
+		var fields []string
+		if queryParams.Fields != "" {
+			fields, err = parseProductFields(queryParams.Fields)
+			if err != nil {
+				r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
+				return
+			}
+		}
+
+		if format == formatProtobuf {
+			// the protobuf messages have a fixed schema, the fields can't be selected
+			logger.Debug("successfully retrieved product details")
+			c.ProtoBuf(http.StatusOK, protoProductDetailsResponse(ProductDetailsResponse{
+				Products:     details[page.Start:page.End],
+				ScrapingTime: scrapingTime,
+				Total:        len(details),
+				NextCursor:   page.NextCursor,
+			}))
+			return
+		}
+
+		if format != formatJSON {
+			// the CSV and NDJSON listings have no room for the paging details, they are returned in headers
+			c.Header("X-Total-Count", strconv.Itoa(len(details)))
+			if page.NextCursor != "" {
+				c.Header("X-Next-Cursor", page.NextCursor)
+			}
+			logger.Debug("successfully retrieved product details")
+
+			products := details[page.Start:page.End]
+			if format == formatCSV {
+				columns := productCSVColumns
+				if fields != nil {
+					columns = fields
 				}
-				filteredImages = append(filteredImages, image)
+
+				r.respondCSV(c, columns, products)
+				return
 			}
 
-			latestOnly, _ := strconv.ParseBool(queryParams.LatestOnly)
-			if latestOnly && len(filteredImages) > 0 {
-				var latestImage = types.Image{}
-				for _, filteredImage := range filteredImages {
-					if filteredImage.CreationDate.After(latestImage.CreationDate) {
-						latestImage = filteredImage
-					}
+			r.respondNDJSON(c, len(products), func(i int) (interface{}, error) {
+				if fields != nil {
+					return selectFields(products[i], fields)
 				}
 
-				// override the filtered slice
-				filteredImages = []types.Image{latestImage}
+				return products[i], nil
+			})
+			return
+		}
+
+		if fields != nil {
+			products, err := selectProductFields(details[page.Start:page.End], fields)
+			if err != nil {
+				r.errorResponder.Respond(c, err)
+				return
 			}
-			c.JSON(http.StatusOK, filteredImages)
+
+			logger.Debug("successfully retrieved product details")
+			c.JSON(http.StatusOK, sparseProductDetailsResponse{
+				Products:     products,
+				ScrapingTime: scrapingTime,
+				Total:        len(details),
+				NextCursor:   page.NextCursor,
+			})
 			return
 		}
 
-		logger.Debug("successfully retrieved image details")
-		c.JSON(http.StatusOK, images)
+		logger.Debug("successfully retrieved product details")
+		c.JSON(http.StatusOK, ProductDetailsResponse{
+			Products:     details[page.Start:page.End],
+			ScrapingTime: scrapingTime,
+			Total:        len(details),
+			NextCursor:   page.NextCursor,
+		})
 	}
 }
 
-// swagger:route GET /providers/{provider}/services/{service}/regions/{region}/versions versions getVersions
+// swagger:route GET /providers/{provider}/services/{service}/regions/{region}/zones/{zone}/products products getZoneProducts
 //
-// Provides a list of available versions on a given provider in a specific region for a service.
+// Provides the machine types offered in an availability zone of a region, with the spot price of the zone only.
 //
 //     Produces:
 //     - application/json
+//     - text/csv
+//     - application/x-ndjson
+//     - application/x-protobuf
 //
 //     Schemes: http
 //
 //     Security:
 //
 //     Responses:
-//       200: VersionsResponse
-func (r *RouteHandler) getVersions() gin.HandlerFunc {
+//       200: ProductDetailsResponse
+func (r *RouteHandler) getZoneProducts() gin.HandlerFunc {
+	products := r.getProducts()
+
 	return func(c *gin.Context) {
-		pathParams := GetRegionPathParams{}
+		pathParams := GetZonePathParams{}
 		if err := mapstructure.Decode(getPathParamMap(c), &pathParams); err != nil {
 			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
 			return
@@ -505,19 +700,1550 @@ func (r *RouteHandler) getVersions() gin.HandlerFunc {
 			return
 		}
 
-		logger := log.WithFieldsForHandlers(c, r.log,
-			map[string]interface{}{"provider": pathParams.Provider, "service": pathParams.Service, "region": pathParams.Region})
-		logger.Info("getting version details")
+		// the zones are not known for every provider, only the known ones are checked
+		zones, err := r.prod.GetZones(pathParams.Provider, pathParams.Service, pathParams.Region)
+		if err == nil && len(zones) > 0 && !cloudinfo.Contains(zones, pathParams.Zone) {
+			r.errorResponder.Respond(c, errors.WithDetails(errors.NewWithDetails("unknown zone",
+				"provider", pathParams.Provider, "region", pathParams.Region, "zone", pathParams.Zone), "validation"))
+			return
+		}
 
-		versions, err := r.prod.GetVersions(pathParams.Provider, pathParams.Service, pathParams.Region)
+		products(c)
+	}
+}
+
+// zoneSpotPrices returns the spot prices of the zone
+func zoneSpotPrices(spotPrices []types.ZonePrice, zone string) []types.ZonePrice {
+	zonePrices := make([]types.ZonePrice, 0, 1)
+	for _, spotPrice := range spotPrices {
+		if spotPrice.Zone == zone {
+			zonePrices = append(zonePrices, spotPrice)
+		}
+	}
+
+	return zonePrices
+}
+
+// queryProducts returns the products of the region matching the filters of the query, converted, sorted and paged as requested
+func (r *RouteHandler) queryProducts(pathParams GetRegionPathParams, queryParams GetProductDetailsQueryParams) ([]types.ProductDetails, cloudinfo.Page, error) {
+	details, err := r.prod.GetProductDetails(pathParams.Provider, pathParams.Service, pathParams.Region)
+	if err != nil {
+		return nil, cloudinfo.Page{}, errors.WrapIfWithDetails(err,
+			"failed to retrieve product details",
+			"provider", pathParams.Provider, "service", pathParams.Service, "region", pathParams.Region)
+	}
+
+	if queryParams.Burstable != "" {
+		burstable, err := strconv.ParseBool(queryParams.Burstable)
 		if err != nil {
-			r.errorResponder.Respond(c, errors.WrapIfWithDetails(err, "failed to retrieve versions",
-				"service", pathParams.Provider, "service", pathParams.Service, "region", pathParams.Region))
-			return
+			return nil, cloudinfo.Page{}, errors.WithDetails(errors.WrapIf(err, "invalid burstable query parameter"), "validation")
 		}
 
-		logger.Debug("successfully retrieved version details")
-		c.JSON(http.StatusOK, versions)
+		filteredDetails := make([]types.ProductDetails, 0, len(details))
+		for _, detail := range details {
+			if detail.Burst == burstable {
+				filteredDetails = append(filteredDetails, detail)
+			}
+		}
+		details = filteredDetails
+	}
+
+	if queryParams.BareMetal != "" {
+		bareMetal, err := strconv.ParseBool(queryParams.BareMetal)
+		if err != nil {
+			return nil, cloudinfo.Page{}, errors.WithDetails(errors.WrapIf(err, "invalid bareMetal query parameter"), "validation")
+		}
+
+		filteredDetails := make([]types.ProductDetails, 0, len(details))
+		for _, detail := range details {
+			if detail.BareMetal == bareMetal {
+				filteredDetails = append(filteredDetails, detail)
+			}
+		}
+		details = filteredDetails
+	}
+
+	if queryParams.AcceleratedNetworking != "" {
+		acceleratedNetworking, err := strconv.ParseBool(queryParams.AcceleratedNetworking)
+		if err != nil {
+			return nil, cloudinfo.Page{}, errors.WithDetails(errors.WrapIf(err, "invalid acceleratedNetworking query parameter"), "validation")
+		}
+
+		filteredDetails := make([]types.ProductDetails, 0, len(details))
+		for _, detail := range details {
+			if detail.AcceleratedNetworking == acceleratedNetworking {
+				filteredDetails = append(filteredDetails, detail)
+			}
+		}
+		details = filteredDetails
+	}
+
+	if queryParams.IPv6 != "" {
+		ipv6, err := strconv.ParseBool(queryParams.IPv6)
+		if err != nil {
+			return nil, cloudinfo.Page{}, errors.WithDetails(errors.WrapIf(err, "invalid ipv6 query parameter"), "validation")
+		}
+
+		filteredDetails := make([]types.ProductDetails, 0, len(details))
+		for _, detail := range details {
+			if detail.IPv6 == ipv6 {
+				filteredDetails = append(filteredDetails, detail)
+			}
+		}
+		details = filteredDetails
+	}
+
+	if queryParams.MinBandwidth != "" {
+		minBandwidth, err := strconv.ParseFloat(queryParams.MinBandwidth, 64)
+		if err != nil || minBandwidth < 0 {
+			return nil, cloudinfo.Page{}, errors.WithDetails(errors.NewWithDetails("invalid minBandwidth query parameter",
+				"minBandwidth", queryParams.MinBandwidth), "validation")
+		}
+
+		filteredDetails := make([]types.ProductDetails, 0, len(details))
+		for _, detail := range details {
+			if detail.NtwPerfGbps > 0 && detail.NtwPerfGbps >= minBandwidth {
+				filteredDetails = append(filteredDetails, detail)
+			}
+		}
+		details = filteredDetails
+	}
+
+	if queryParams.FreeTier != "" {
+		freeTier, err := strconv.ParseBool(queryParams.FreeTier)
+		if err != nil {
+			return nil, cloudinfo.Page{}, errors.WithDetails(errors.WrapIf(err, "invalid freeTier query parameter"), "validation")
+		}
+
+		filteredDetails := make([]types.ProductDetails, 0, len(details))
+		for _, detail := range details {
+			if detail.FreeTier == freeTier {
+				filteredDetails = append(filteredDetails, detail)
+			}
+		}
+		details = filteredDetails
+	}
+
+	if queryParams.PlacementGroup != "" {
+		if !cloudinfo.IsPlacementStrategy(queryParams.PlacementGroup) {
+			return nil, cloudinfo.Page{}, errors.WithDetails(errors.NewWithDetails("invalid placementGroup query parameter",
+				"placementGroup", queryParams.PlacementGroup), "validation")
+		}
+
+		filteredDetails := make([]types.ProductDetails, 0, len(details))
+		for _, detail := range details {
+			if detail.PlacementGroup == queryParams.PlacementGroup ||
+				cloudinfo.Contains(detail.PlacementStrategies, queryParams.PlacementGroup) {
+				filteredDetails = append(filteredDetails, detail)
+			}
+		}
+		details = filteredDetails
+	}
+
+	if queryParams.Workload != "" {
+		if !cloudinfo.IsWorkload(queryParams.Workload) {
+			return nil, cloudinfo.Page{}, errors.WithDetails(errors.NewWithDetails("invalid workload query parameter",
+				"workload", queryParams.Workload), "validation")
+		}
+
+		filteredDetails := make([]types.ProductDetails, 0, len(details))
+		for _, detail := range details {
+			if cloudinfo.Contains(detail.Workloads, queryParams.Workload) {
+				filteredDetails = append(filteredDetails, detail)
+			}
+		}
+		details = filteredDetails
+	}
+
+	if queryParams.Partition != "" {
+		filteredDetails := make([]types.ProductDetails, 0, len(details))
+		for _, detail := range details {
+			if detail.Partition == queryParams.Partition {
+				filteredDetails = append(filteredDetails, detail)
+			}
+		}
+		details = filteredDetails
+	}
+
+	if queryParams.LocalDisk != "" {
+		details, err = filterLocalDisks(details, queryParams.LocalDisk)
+		if err != nil {
+			return nil, cloudinfo.Page{}, errors.WithDetails(err, "validation")
+		}
+	}
+
+	if queryParams.Architecture != "" {
+		if !cloudinfo.IsArchitecture(queryParams.Architecture) {
+			return nil, cloudinfo.Page{}, errors.WithDetails(errors.NewWithDetails("invalid architecture query parameter",
+				"architecture", queryParams.Architecture), "validation")
+		}
+
+		filteredDetails := make([]types.ProductDetails, 0, len(details))
+		for _, detail := range details {
+			if detail.Architecture == queryParams.Architecture {
+				filteredDetails = append(filteredDetails, detail)
+			}
+		}
+		details = filteredDetails
+	}
+
+	if queryParams.Zone != "" {
+		filteredDetails := make([]types.ProductDetails, 0, len(details))
+		for _, detail := range details {
+			if len(detail.Zones) == 0 || cloudinfo.Contains(detail.Zones, queryParams.Zone) {
+				if queryParams.zoneSpotPrices {
+					detail.SpotPrice = zoneSpotPrices(detail.SpotPrice, queryParams.Zone)
+				}
+				filteredDetails = append(filteredDetails, detail)
+			}
+		}
+		details = filteredDetails
+	}
+
+	if queryParams.GpuVendor != "" {
+		filteredDetails := make([]types.ProductDetails, 0, len(details))
+		for _, detail := range details {
+			if strings.EqualFold(detail.GpuVendor, queryParams.GpuVendor) {
+				filteredDetails = append(filteredDetails, detail)
+			}
+		}
+		details = filteredDetails
+	}
+
+	if queryParams.GpuModel != "" {
+		filteredDetails := make([]types.ProductDetails, 0, len(details))
+		for _, detail := range details {
+			if strings.EqualFold(detail.GpuModel, queryParams.GpuModel) {
+				filteredDetails = append(filteredDetails, detail)
+			}
+		}
+		details = filteredDetails
+	}
+
+	if queryParams.ReservedTerm != "" || queryParams.PaymentOption != "" {
+		details = filterReservedPrices(details, queryParams.ReservedTerm, queryParams.PaymentOption)
+	}
+
+	if queryParams.Os != "" {
+		details, err = filterOsPrices(details, queryParams.Os)
+		if err != nil {
+			return nil, cloudinfo.Page{}, errors.WithDetails(err, "validation")
+		}
+	}
+
+	if queryParams.Currency != "" {
+		if r.currencyConverter == nil {
+			return nil, cloudinfo.Page{}, errors.WithDetails(errors.New("currency conversion is not enabled"), "validation")
+		}
+
+		details, err = convertCurrency(details, r.currencyConverter, queryParams.Currency)
+		if err != nil {
+			return nil, cloudinfo.Page{}, errors.WithDetails(errors.WrapIf(err, "invalid currency query parameter"), "validation")
+		}
+	}
+
+	bounds, err := parseAttributeBounds(queryParams)
+	if err != nil {
+		return nil, cloudinfo.Page{}, errors.WithDetails(err, "validation")
+	}
+	details = filterAttributes(details, bounds)
+
+	debug := false
+	if queryParams.Debug != "" {
+		debug, err = strconv.ParseBool(queryParams.Debug)
+		if err != nil {
+			return nil, cloudinfo.Page{}, errors.WithDetails(errors.WrapIf(err, "invalid debug query parameter"), "validation")
+		}
+	}
+
+	if !debug {
+		for i := range details {
+			details[i].RawPayload = nil
+		}
+	}
+
+	if queryParams.IncludeDerived != "" {
+		derived, err := strconv.ParseBool(queryParams.IncludeDerived)
+		if err != nil {
+			return nil, cloudinfo.Page{}, errors.WithDetails(errors.WrapIf(err, "invalid includeDerived query parameter"), "validation")
+		}
+
+		if derived {
+			annotatePricePremiums(details)
+		}
+	}
+
+	if err := r.sortProducts(details, queryParams.Sort, queryParams.Order); err != nil {
+		return nil, cloudinfo.Page{}, errors.WithDetails(err, "validation")
+	}
+
+	var limit int
+	if queryParams.Limit != "" {
+		if limit, err = strconv.Atoi(queryParams.Limit); err != nil || limit < 1 {
+			return nil, cloudinfo.Page{}, errors.WithDetails(errors.NewWithDetails("limit must be a positive integer",
+				"limit", queryParams.Limit), "validation")
+		}
+	}
+
+	page, err := cloudinfo.Paginate(len(details), limit, queryParams.Cursor)
+	if err != nil {
+		return nil, cloudinfo.Page{}, errors.WithDetails(err, "validation")
+	}
+
+	return details, page, nil
+}
+
+// swagger:route GET /providers/{provider}/services/{service}/regions/{region}/products/{type} products getProduct
+//
+// Provides the details of a single machine type in a given region; the closest machine types are suggested if it's unknown.
+//
+//     Produces:
+//     - application/json
+//     - application/x-protobuf
+//
+//     Schemes: http
+//
+//     Security:
+//
+//     Responses:
+//       200: ProductResponse
+func (r *RouteHandler) getProduct() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		pathParams := GetProductPathParams{}
+		if err := mapstructure.Decode(getPathParamMap(c), &pathParams); err != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
+			return
+		}
+
+		if ve := ValidatePathData(pathParams); ve != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(ve, "validation"))
+			return
+		}
+
+		queryParams := GetProductQueryParams{}
+		if err := mapstructure.Decode(getQueryAsMap(c), &queryParams); err != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
+			return
+		}
+
+		logger := log.WithFieldsForHandlers(c, r.log, map[string]interface{}{"provider": pathParams.Provider,
+			"service": pathParams.Service, "region": pathParams.Region, "type": pathParams.Type})
+		logger.Info("getting product")
+
+		scrapingTime, err := r.prod.GetStatus(pathParams.Provider)
+		if err != nil {
+			r.errorResponder.Respond(c, errors.WrapIfWithDetails(err, "failed to retrieve status",
+				"provider", pathParams.Provider))
+			return
+		}
+
+		product, err := r.lookupProduct(pathParams, queryParams.Currency)
+		if err != nil {
+			r.errorResponder.Respond(c, err)
+			return
+		}
+
+		logger.Debug("successfully retrieved product")
+		if wantsProtobuf(c) {
+			c.ProtoBuf(http.StatusOK, protoProductResponse(ProductResponse{
+				Product:      product,
+				ScrapingTime: scrapingTime,
+			}))
+			return
+		}
+		c.JSON(http.StatusOK, ProductResponse{
+			Product:      product,
+			ScrapingTime: scrapingTime,
+		})
+	}
+}
+
+// lookupProduct returns the instance type of the region with its entries in the zones merged, with its prices converted
+// to the currency if not empty; the closest instance types are suggested if it's unknown
+func (r *RouteHandler) lookupProduct(pathParams GetProductPathParams, currency string) (types.ProductDetails, error) {
+	details, err := r.prod.GetProductDetails(pathParams.Provider, pathParams.Service, pathParams.Region)
+	if err != nil {
+		return types.ProductDetails{}, errors.WrapIfWithDetails(err, "failed to retrieve product details",
+			"provider", pathParams.Provider, "service", pathParams.Service, "region", pathParams.Region)
+	}
+
+	product, ok := findProduct(details, pathParams.Type)
+	if !ok {
+		return types.ProductDetails{}, errors.WithStack(unknownInstanceTypeError{
+			instanceType: pathParams.Type,
+			suggestions:  suggestInstanceTypes(details, pathParams.Type),
+		})
+	}
+	product.RawPayload = nil
+
+	if currency != "" {
+		if r.currencyConverter == nil {
+			return types.ProductDetails{}, errors.WithDetails(errors.New("currency conversion is not enabled"), "validation")
+		}
+
+		converted, err := convertCurrency([]types.ProductDetails{product}, r.currencyConverter, currency)
+		if err != nil {
+			return types.ProductDetails{}, errors.WithDetails(errors.WrapIf(err, "invalid currency query parameter"), "validation")
+		}
+		product = converted[0]
+	}
+
+	return product, nil
+}
+
+// swagger:route POST /products/batch products getBatchProducts
+//
+// Provides the available machine types of several regions (of any provider and service) in one request.
+//
+//     Consumes:
+//     - application/json
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+//     Security:
+//
+//     Responses:
+//       200: BatchProductsResponse
+func (r *RouteHandler) getBatchProducts() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		request := BatchProductsRequest{}
+		if err := c.ShouldBindJSON(&request); err != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(errors.WrapIf(err, "invalid batch products request"), "validation"))
+			return
+		}
+
+		if err := validateBatchProductsRequest(request); err != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
+			return
+		}
+
+		for _, region := range request.Regions {
+			if ve := ValidatePathData(region.pathParams()); ve != nil {
+				r.errorResponder.Respond(c, errors.WithDetails(ve, "validation"))
+				return
+			}
+		}
+
+		logger := log.WithFieldsForHandlers(c, r.log, map[string]interface{}{"regions": len(request.Regions)})
+		logger.Info("getting product details of a batch of regions")
+
+		response := BatchProductsResponse{
+			Results: make([]BatchProductsResult, 0, len(request.Regions)),
+		}
+		for _, region := range request.Regions {
+			result := BatchProductsResult{
+				Provider: region.Provider,
+				Service:  region.Service,
+				Region:   region.Region,
+			}
+
+			scrapingTime, err := r.prod.GetStatus(region.Provider)
+			if err != nil {
+				logger.Warn("failed to retrieve status", map[string]interface{}{"provider": region.Provider, "error": err.Error()})
+				result.Error = "failed to retrieve status"
+				response.Results = append(response.Results, result)
+				continue
+			}
+
+			details, err := r.prod.GetProductDetails(region.Provider, region.Service, region.Region)
+			if err != nil {
+				logger.Warn("failed to retrieve product details", map[string]interface{}{
+					"provider": region.Provider,
+					"service":  region.Service,
+					"region":   region.Region,
+					"error":    err.Error(),
+				})
+				result.Error = "failed to retrieve product details"
+				response.Results = append(response.Results, result)
+				continue
+			}
+
+			for i := range details {
+				details[i].RawPayload = nil
+			}
+
+			result.Products = details
+			result.ScrapingTime = scrapingTime
+			response.Results = append(response.Results, result)
+		}
+
+		logger.Debug("successfully retrieved product details of a batch of regions")
+		c.JSON(http.StatusOK, response)
+	}
+}
+
+// swagger:route GET /compare products getComparison
+//
+// Provides the closest matching instance type and its price of every provider in the requested regions.
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+//     Security:
+//
+//     Responses:
+//       200: ComparisonResponse
+func (r *RouteHandler) getComparison() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		queryParams := GetComparisonQueryParams{}
+		if err := mapstructure.Decode(getQueryAsMap(c), &queryParams); err != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
+			return
+		}
+
+		cpu, memory, err := parseComparisonSize(queryParams)
+		if err != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
+			return
+		}
+
+		service := queryParams.Service
+		if service == "" {
+			service = comparisonService
+		}
+
+		var regionIDs []string
+		if queryParams.Regions != "" {
+			regionIDs = strings.Split(queryParams.Regions, ",")
+		}
+
+		logger := log.WithFieldsForHandlers(c, r.log, map[string]interface{}{"service": service})
+		logger.Info("comparing the providers")
+
+		matches := make(map[string]ProviderMatch)
+		err = r.visitRegionProducts(service, regionFilter{continent: queryParams.Continent, regions: regionIDs},
+			func(provider, region string, products []types.ProductDetails) {
+				product, ok := closestProduct(products, cpu, memory)
+				if !ok {
+					return
+				}
+
+				match, found := matches[provider]
+				if !found || product.OnDemandPrice < match.OnDemandPrice ||
+					(product.OnDemandPrice == match.OnDemandPrice && region < match.Region) {
+					matches[provider] = ProviderMatch{
+						Provider:      provider,
+						Region:        region,
+						Type:          product.Type,
+						Cpus:          product.Cpus,
+						Mem:           product.Mem,
+						OnDemandPrice: product.OnDemandPrice,
+					}
+				}
+			})
+		if err != nil {
+			r.errorResponder.Respond(c, err)
+			return
+		}
+
+		response := make(ComparisonResponse, 0, len(matches))
+		for _, match := range matches {
+			response = append(response, match)
+		}
+		sortMatches(response)
+
+		logger.Debug("successfully compared the providers")
+		c.JSON(http.StatusOK, response)
+	}
+}
+
+// swagger:route GET /recommendations products getRecommendations
+//
+// Provides the cheapest instance types satisfying the vCPU, memory and GPU constraints across the providers and regions.
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+//     Security:
+//
+//     Responses:
+//       200: RecommendationsResponse
+func (r *RouteHandler) getRecommendations() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		queryParams := GetRecommendationsQueryParams{}
+		if err := mapstructure.Decode(getQueryAsMap(c), &queryParams); err != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
+			return
+		}
+
+		constraints, limit, err := parseRecommendationConstraints(queryParams)
+		if err != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
+			return
+		}
+
+		service := queryParams.Service
+		if service == "" {
+			service = comparisonService
+		}
+
+		filter := regionFilter{continent: queryParams.Continent}
+		if queryParams.Providers != "" {
+			filter.providers = strings.Split(queryParams.Providers, ",")
+		}
+		if queryParams.Regions != "" {
+			filter.regions = strings.Split(queryParams.Regions, ",")
+		}
+
+		logger := log.WithFieldsForHandlers(c, r.log, map[string]interface{}{"service": service})
+		logger.Info("recommending instance types")
+
+		var recommendations []Recommendation
+		err = r.visitRegionProducts(service, filter, func(provider, region string, products []types.ProductDetails) {
+			recommendations = append(recommendations, recommend(provider, region, products, constraints)...)
+		})
+		if err != nil {
+			r.errorResponder.Respond(c, err)
+			return
+		}
+
+		response := RecommendationsResponse(rankRecommendations(recommendations, limit))
+		if response == nil {
+			response = RecommendationsResponse{}
+		}
+
+		logger.Debug("successfully recommended instance types")
+		c.JSON(http.StatusOK, response)
+	}
+}
+
+// swagger:route GET /search products getSearch
+//
+// Searches the instance type names, families and attribute values across the providers and regions.
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+//     Security:
+//
+//     Responses:
+//       200: SearchResponse
+func (r *RouteHandler) getSearch() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		queryParams := GetSearchQueryParams{}
+		if err := mapstructure.Decode(getQueryAsMap(c), &queryParams); err != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
+			return
+		}
+
+		var limit *int
+		if queryParams.Limit != "" {
+			l, err := strconv.Atoi(queryParams.Limit)
+			if err != nil {
+				r.errorResponder.Respond(c, errors.WithDetails(errors.WrapIf(err, "invalid limit query parameter"), "validation"))
+				return
+			}
+			limit = &l
+		}
+
+		logger := log.WithFieldsForHandlers(c, r.log, map[string]interface{}{"query": queryParams.Q})
+		logger.Info("searching instance types")
+
+		hits, err := cloudinfo.NewSearchService(r.prod).Search(c.Request.Context(), queryParams.Q, &queryParams.Service, limit)
+		if err != nil {
+			if errors.As(err, &cloudinfo.SearchValidationError{}) {
+				err = errors.WithDetails(err, "validation")
+			}
+			r.errorResponder.Respond(c, err)
+			return
+		}
+
+		// the links are relative to the API root the search was served under
+		apiRoot := strings.TrimSuffix(c.Request.URL.Path, "/search")
+		response := make(SearchResponse, 0, len(hits))
+		for _, hit := range hits {
+			response = append(response, SearchHit{
+				Kind:         hit.Kind,
+				Provider:     hit.Provider,
+				Service:      hit.Service,
+				Region:       hit.Region,
+				InstanceType: hit.InstanceType,
+				Field:        hit.Field,
+				Value:        hit.Value,
+				Link:         apiRoot + hit.Link,
+			})
+		}
+
+		logger.Debug("successfully searched instance types")
+		c.JSON(http.StatusOK, response)
+	}
+}
+
+// swagger:route GET /providers/{provider}/services/{service}/regions/{region}/accelerators region getAccelerators
+//
+// Provides the non-GPU accelerators (TPU, Inferentia, Trainium, FPGA) offered in a specific region with the zones
+// and the instance types offering them.
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+//     Security:
+//
+//     Responses:
+//       200: AcceleratorsResponse
+func (r *RouteHandler) getAccelerators() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		pathParams := GetRegionPathParams{}
+		if err := mapstructure.Decode(getPathParamMap(c), &pathParams); err != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
+			return
+		}
+
+		if ve := ValidatePathData(pathParams); ve != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(ve, "validation"))
+			return
+		}
+
+		logger := log.WithFieldsForHandlers(c, r.log,
+			map[string]interface{}{"provider": pathParams.Provider, "service": pathParams.Service, "region": pathParams.Region})
+		logger.Info("getting accelerators")
+
+		details, err := r.prod.GetProductDetails(pathParams.Provider, pathParams.Service, pathParams.Region)
+		if err != nil {
+			r.errorResponder.Respond(c, errors.WrapIfWithDetails(err,
+				"failed to retrieve product details",
+				"provider", pathParams.Provider, "service", pathParams.Service, "region", pathParams.Region))
+			return
+		}
+
+		logger.Debug("successfully retrieved accelerators")
+		c.JSON(http.StatusOK, aggregateAccelerators(details))
+	}
+}
+
+// swagger:route GET /providers/{provider}/services/{service}/regions/{region}/images images getImages
+//
+// Provides a list of available images on a given provider in a specific region for a service.
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+//     Security:
+//
+//     Responses:
+//       200: ImagesResponse
+func (r *RouteHandler) getImages() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		pathParams := GetRegionPathParams{}
+		if err := mapstructure.Decode(getPathParamMap(c), &pathParams); err != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
+			return
+		}
+		queryParams := GetImagesQueryParams{}
+		if err := mapstructure.Decode(getQueryAsMap(c), &queryParams); err != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
+			return
+		}
+
+		if ve := ValidatePathData(pathParams); ve != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(ve, "validation"))
+			return
+		}
+
+		logger := log.WithFieldsForHandlers(c, r.log, map[string]interface{}{"provider": pathParams.Provider,
+			"service": pathParams.Service, "region": pathParams.Region})
+		logger.Info("getting image details")
+
+		images, err := r.prod.GetServiceImages(pathParams.Provider, pathParams.Service, pathParams.Region)
+		if err != nil {
+			r.errorResponder.Respond(c, errors.WrapIfWithDetails(err, "failed to retrieve service images details",
+				"provider", pathParams.Provider, "service", pathParams.Service, "region", pathParams.Region))
+			return
+		}
+
+		// the cached listing is not sorted in place
+		images = append([]types.Image(nil), images...)
+		if err := r.sortImages(images, queryParams.Sort); err != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
+			return
+		}
+
+		zeroQuery := GetImagesQueryParams{Sort: queryParams.Sort}
+		if queryParams != zeroQuery {
+			filteredImages := make([]types.Image, 0, len(images))
+			for _, image := range images {
+				if queryParams.Version != "" && queryParams.Version != image.Version {
+					continue
+				}
+				if queryParams.Gpu == "true" && !image.GpuAvailable {
+					continue
+				}
+				if queryParams.Gpu == "false" && image.GpuAvailable {
+					continue
+				}
+				// todo possibly add filtering by all tags (generic)
+				if queryParams.Os != "" && queryParams.Os != image.Tags["os-type"] {
+					continue
+				}
+				if queryParams.Cr != "" && queryParams.Cr != image.Tags["cr"] {
+					continue
+				}
+				if queryParams.PkeVersion != "" && queryParams.PkeVersion != image.Tags["pke-version"] {
+					continue
+				}
+				filteredImages = append(filteredImages, image)
+			}
+
+			latestOnly, _ := strconv.ParseBool(queryParams.LatestOnly)
+			if latestOnly && len(filteredImages) > 0 {
+				var latestImage = types.Image{}
+				for _, filteredImage := range filteredImages {
+					if filteredImage.CreationDate.After(latestImage.CreationDate) {
+						latestImage = filteredImage
+					}
+				}
+
+				// override the filtered slice
+				filteredImages = []types.Image{latestImage}
+			}
+			c.JSON(http.StatusOK, filteredImages)
+			return
+		}
+
+		logger.Debug("successfully retrieved image details")
+		c.JSON(http.StatusOK, images)
+	}
+}
+
+// swagger:route GET /providers/{provider}/services/{service}/regions/{region}/versions versions getVersions
+//
+// Provides a list of available versions on a given provider in a specific region for a service.
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+//     Security:
+//
+//     Responses:
+//       200: VersionsResponse
+func (r *RouteHandler) getVersions() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		pathParams := GetRegionPathParams{}
+		if err := mapstructure.Decode(getPathParamMap(c), &pathParams); err != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
+			return
+		}
+
+		if ve := ValidatePathData(pathParams); ve != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(ve, "validation"))
+			return
+		}
+
+		logger := log.WithFieldsForHandlers(c, r.log,
+			map[string]interface{}{"provider": pathParams.Provider, "service": pathParams.Service, "region": pathParams.Region})
+		logger.Info("getting version details")
+
+		queryParams := GetListingQueryParams{}
+		if err := mapstructure.Decode(getQueryAsMap(c), &queryParams); err != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
+			return
+		}
+
+		versions, err := r.prod.GetVersions(pathParams.Provider, pathParams.Service, pathParams.Region)
+		if err != nil {
+			r.errorResponder.Respond(c, errors.WrapIfWithDetails(err, "failed to retrieve versions",
+				"service", pathParams.Provider, "service", pathParams.Service, "region", pathParams.Region))
+			return
+		}
+
+		// the cached listing is not sorted in place
+		versions = append([]types.LocationVersion(nil), versions...)
+		if err := r.sortVersions(versions, queryParams.Sort); err != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
+			return
+		}
+
+		logger.Debug("successfully retrieved version details")
+		c.JSON(http.StatusOK, versions)
+	}
+}
+
+// swagger:route GET /providers/{provider}/services/{service}/regions/{region}/products/{type}/spot-history products getSpotPriceHistory
+//
+// Provides the spot price history of a machine type on a given provider in a specific region.
+//
+//     Produces:
+//     - application/json
+//     - text/csv
+//     - application/x-ndjson
+//     - application/x-protobuf
+//
+//     Schemes: http
+//
+//     Security:
+//
+//     Responses:
+//       200: SpotPriceHistoryResponse
+func (r *RouteHandler) getSpotPriceHistory() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		pathParams := GetProductPathParams{}
+		if err := mapstructure.Decode(getPathParamMap(c), &pathParams); err != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
+			return
+		}
+
+		if ve := ValidatePathData(pathParams); ve != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(ve, "validation"))
+			return
+		}
+
+		queryParams := GetSpotPriceHistoryQueryParams{}
+		if err := mapstructure.Decode(getQueryAsMap(c), &queryParams); err != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
+			return
+		}
+
+		from, to, err := parseTimeRange(queryParams.From, queryParams.To)
+		if err != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
+			return
+		}
+
+		format, err := negotiateProtobufFormat(c, queryParams.Format)
+		if err != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
+			return
+		}
+
+		logger := log.WithFieldsForHandlers(c, r.log, map[string]interface{}{"provider": pathParams.Provider,
+			"service": pathParams.Service, "region": pathParams.Region, "type": pathParams.Type})
+		logger.Info("getting spot price history")
+
+		history, err := r.prod.GetSpotPriceHistory(pathParams.Provider, pathParams.Region, pathParams.Type, from, to)
+		if err != nil {
+			r.errorResponder.Respond(c, errors.WrapIfWithDetails(err, "failed to retrieve spot price history",
+				"provider", pathParams.Provider, "region", pathParams.Region, "type", pathParams.Type))
+			return
+		}
+
+		logger.Debug("successfully retrieved spot price history")
+		switch format {
+		case formatCSV:
+			r.respondCSV(c, spotPriceCSVColumns, spotPriceRows(history))
+			return
+		case formatNDJSON:
+			r.respondNDJSON(c, len(history), func(i int) (interface{}, error) { return history[i], nil })
+			return
+		case formatProtobuf:
+			c.ProtoBuf(http.StatusOK, protoSpotPriceHistoryResponse(history))
+			return
+		}
+		c.JSON(http.StatusOK, SpotPriceHistoryResponse(history))
+	}
+}
+
+// swagger:route GET /providers/{provider}/services/{service}/regions/{region}/products/{type}/price-history products getOnDemandPriceHistory
+//
+// Provides the on demand price history of a machine type on a given provider in a specific region.
+//
+//     Produces:
+//     - application/json
+//     - text/csv
+//     - application/x-ndjson
+//
+//     Schemes: http
+//
+//     Security:
+//
+//     Responses:
+//       200: OnDemandPriceHistoryResponse
+func (r *RouteHandler) getOnDemandPriceHistory() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		pathParams := GetProductPathParams{}
+		if err := mapstructure.Decode(getPathParamMap(c), &pathParams); err != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
+			return
+		}
+
+		if ve := ValidatePathData(pathParams); ve != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(ve, "validation"))
+			return
+		}
+
+		queryParams := GetSpotPriceHistoryQueryParams{}
+		if err := mapstructure.Decode(getQueryAsMap(c), &queryParams); err != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
+			return
+		}
+
+		from, to, err := parseTimeRange(queryParams.From, queryParams.To)
+		if err != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
+			return
+		}
+
+		format, err := negotiateFormat(c, queryParams.Format)
+		if err != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
+			return
+		}
+
+		logger := log.WithFieldsForHandlers(c, r.log, map[string]interface{}{"provider": pathParams.Provider,
+			"service": pathParams.Service, "region": pathParams.Region, "type": pathParams.Type})
+		logger.Info("getting on demand price history")
+
+		history, err := r.prod.GetOnDemandPriceHistory(pathParams.Provider, pathParams.Region, pathParams.Type, from, to)
+		if err != nil {
+			r.errorResponder.Respond(c, errors.WrapIfWithDetails(err, "failed to retrieve on demand price history",
+				"provider", pathParams.Provider, "region", pathParams.Region, "type", pathParams.Type))
+			return
+		}
+
+		logger.Debug("successfully retrieved on demand price history")
+		switch format {
+		case formatCSV:
+			r.respondCSV(c, onDemandPriceCSVColumns, history)
+			return
+		case formatNDJSON:
+			r.respondNDJSON(c, len(history), func(i int) (interface{}, error) { return history[i], nil })
+			return
+		}
+		c.JSON(http.StatusOK, OnDemandPriceHistoryResponse(history))
+	}
+}
+
+// swagger:route GET /providers/{provider}/services/{service}/regions/{region}/products/{type}/savings-plans products getSavingsPlanRates
+//
+// Provides the savings plans rates and the effective rate of a machine type for a given commitment.
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+//     Security:
+//
+//     Responses:
+//       200: SavingsPlanRatesResponse
+func (r *RouteHandler) getSavingsPlanRates() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		pathParams := GetProductPathParams{}
+		if err := mapstructure.Decode(getPathParamMap(c), &pathParams); err != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
+			return
+		}
+
+		if ve := ValidatePathData(pathParams); ve != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(ve, "validation"))
+			return
+		}
+
+		queryParams := GetSavingsPlanRatesQueryParams{}
+		if err := mapstructure.Decode(getQueryAsMap(c), &queryParams); err != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
+			return
+		}
+
+		logger := log.WithFieldsForHandlers(c, r.log, map[string]interface{}{"provider": pathParams.Provider,
+			"service": pathParams.Service, "region": pathParams.Region, "type": pathParams.Type})
+		logger.Info("getting savings plan rates")
+
+		details, err := r.prod.GetProductDetails(pathParams.Provider, pathParams.Service, pathParams.Region)
+		if err != nil {
+			r.errorResponder.Respond(c, errors.WrapIfWithDetails(err, "failed to retrieve product details",
+				"provider", pathParams.Provider, "service", pathParams.Service, "region", pathParams.Region))
+			return
+		}
+
+		for _, detail := range details {
+			if detail.Type != pathParams.Type {
+				continue
+			}
+
+			rates, err := savingsPlanRates(detail, queryParams.Term, queryParams.PaymentOption, queryParams.PlanType)
+			if err != nil {
+				r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
+				return
+			}
+
+			logger.Debug("successfully retrieved savings plan rates")
+			c.JSON(http.StatusOK, rates)
+			return
+		}
+
+		r.errorResponder.Respond(c, errors.WithDetails(errors.NewWithDetails("unknown instance type",
+			"provider", pathParams.Provider, "region", pathParams.Region, "type", pathParams.Type), "validation"))
+	}
+}
+
+// swagger:route GET /providers/{provider}/regions/{region}/storage storage getStoragePrices
+//
+// Provides the block storage prices on a given provider in a specific region.
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+//     Security:
+//
+//     Responses:
+//       200: StoragePricesResponse
+func (r *RouteHandler) getStoragePrices() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		pathParams := GetStoragePathParams{}
+		if err := mapstructure.Decode(getPathParamMap(c), &pathParams); err != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
+			return
+		}
+
+		if ve := ValidatePathData(pathParams); ve != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(ve, "validation"))
+			return
+		}
+
+		logger := log.WithFieldsForHandlers(c, r.log, map[string]interface{}{"provider": pathParams.Provider,
+			"region": pathParams.Region})
+		logger.Info("getting storage prices")
+
+		prices, err := r.prod.GetStoragePrices(pathParams.Provider, pathParams.Region)
+		if err != nil {
+			r.errorResponder.Respond(c, errors.WrapIfWithDetails(err, "failed to retrieve storage prices",
+				"provider", pathParams.Provider, "region", pathParams.Region))
+			return
+		}
+
+		logger.Debug("successfully retrieved storage prices")
+		c.JSON(http.StatusOK, StoragePricesResponse(prices))
+	}
+}
+
+// swagger:route GET /providers/{provider}/regions/{region}/storage/object storage getObjectStoragePrices
+//
+// Provides the object storage prices on a given provider in a specific region.
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+//     Security:
+//
+//     Responses:
+//       200: ObjectStoragePricesResponse
+func (r *RouteHandler) getObjectStoragePrices() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		pathParams := GetStoragePathParams{}
+		if err := mapstructure.Decode(getPathParamMap(c), &pathParams); err != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
+			return
+		}
+
+		if ve := ValidatePathData(pathParams); ve != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(ve, "validation"))
+			return
+		}
+
+		logger := log.WithFieldsForHandlers(c, r.log, map[string]interface{}{"provider": pathParams.Provider,
+			"region": pathParams.Region})
+		logger.Info("getting object storage prices")
+
+		prices, err := r.prod.GetObjectStoragePrices(pathParams.Provider, pathParams.Region)
+		if err != nil {
+			r.errorResponder.Respond(c, errors.WrapIfWithDetails(err, "failed to retrieve object storage prices",
+				"provider", pathParams.Provider, "region", pathParams.Region))
+			return
+		}
+
+		logger.Debug("successfully retrieved object storage prices")
+		c.JSON(http.StatusOK, ObjectStoragePricesResponse(prices))
+	}
+}
+
+// swagger:route GET /providers/{provider}/regions/{region}/network network getNetworkPrices
+//
+// Provides the internet egress, inter-zone and inter-region traffic prices on a given provider in a specific region.
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+//     Security:
+//
+//     Responses:
+//       200: NetworkPricesResponse
+func (r *RouteHandler) getNetworkPrices() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		pathParams := GetStoragePathParams{}
+		if err := mapstructure.Decode(getPathParamMap(c), &pathParams); err != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
+			return
+		}
+
+		if ve := ValidatePathData(pathParams); ve != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(ve, "validation"))
+			return
+		}
+
+		logger := log.WithFieldsForHandlers(c, r.log, map[string]interface{}{"provider": pathParams.Provider,
+			"region": pathParams.Region})
+		logger.Info("getting network prices")
+
+		prices, err := r.prod.GetNetworkPrices(pathParams.Provider, pathParams.Region)
+		if err != nil {
+			r.errorResponder.Respond(c, errors.WrapIfWithDetails(err, "failed to retrieve network prices",
+				"provider", pathParams.Provider, "region", pathParams.Region))
+			return
+		}
+
+		logger.Debug("successfully retrieved network prices")
+		c.JSON(http.StatusOK, NetworkPricesResponse(prices))
+	}
+}
+
+// swagger:route GET /providers/{provider}/regions/{region}/loadbalancer network getLoadBalancerPrices
+//
+// Provides the managed load balancer prices on a given provider in a specific region.
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+//     Security:
+//
+//     Responses:
+//       200: LoadBalancerPricesResponse
+func (r *RouteHandler) getLoadBalancerPrices() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		pathParams := GetStoragePathParams{}
+		if err := mapstructure.Decode(getPathParamMap(c), &pathParams); err != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
+			return
+		}
+
+		if ve := ValidatePathData(pathParams); ve != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(ve, "validation"))
+			return
+		}
+
+		logger := log.WithFieldsForHandlers(c, r.log, map[string]interface{}{"provider": pathParams.Provider,
+			"region": pathParams.Region})
+		logger.Info("getting load balancer prices")
+
+		prices, err := r.prod.GetLoadBalancerPrices(pathParams.Provider, pathParams.Region)
+		if err != nil {
+			r.errorResponder.Respond(c, errors.WrapIfWithDetails(err, "failed to retrieve load balancer prices",
+				"provider", pathParams.Provider, "region", pathParams.Region))
+			return
+		}
+
+		logger.Debug("successfully retrieved load balancer prices")
+		c.JSON(http.StatusOK, LoadBalancerPricesResponse(prices))
+	}
+}
+
+// swagger:route GET /providers/{provider}/regions/{region}/nat-gateway network getNatGatewayPrices
+//
+// Provides the NAT gateway prices on a given provider in a specific region.
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+//     Security:
+//
+//     Responses:
+//       200: NatGatewayPricesResponse
+func (r *RouteHandler) getNatGatewayPrices() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		pathParams := GetStoragePathParams{}
+		if err := mapstructure.Decode(getPathParamMap(c), &pathParams); err != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
+			return
+		}
+
+		if ve := ValidatePathData(pathParams); ve != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(ve, "validation"))
+			return
+		}
+
+		logger := log.WithFieldsForHandlers(c, r.log, map[string]interface{}{"provider": pathParams.Provider,
+			"region": pathParams.Region})
+		logger.Info("getting NAT gateway prices")
+
+		prices, err := r.prod.GetNatGatewayPrices(pathParams.Provider, pathParams.Region)
+		if err != nil {
+			r.errorResponder.Respond(c, errors.WrapIfWithDetails(err, "failed to retrieve NAT gateway prices",
+				"provider", pathParams.Provider, "region", pathParams.Region))
+			return
+		}
+
+		logger.Debug("successfully retrieved NAT gateway prices")
+		c.JSON(http.StatusOK, NatGatewayPricesResponse(prices))
+	}
+}
+
+// swagger:route GET /providers/{provider}/regions/{region}/public-ip network getPublicIpPrices
+//
+// Provides the public IP address prices on a given provider in a specific region.
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+//     Security:
+//
+//     Responses:
+//       200: PublicIpPricesResponse
+func (r *RouteHandler) getPublicIpPrices() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		pathParams := GetStoragePathParams{}
+		if err := mapstructure.Decode(getPathParamMap(c), &pathParams); err != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
+			return
+		}
+
+		if ve := ValidatePathData(pathParams); ve != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(ve, "validation"))
+			return
+		}
+
+		logger := log.WithFieldsForHandlers(c, r.log, map[string]interface{}{"provider": pathParams.Provider,
+			"region": pathParams.Region})
+		logger.Info("getting public IP prices")
+
+		prices, err := r.prod.GetPublicIpPrices(pathParams.Provider, pathParams.Region)
+		if err != nil {
+			r.errorResponder.Respond(c, errors.WrapIfWithDetails(err, "failed to retrieve public IP prices",
+				"provider", pathParams.Provider, "region", pathParams.Region))
+			return
+		}
+
+		logger.Debug("successfully retrieved public IP prices")
+		c.JSON(http.StatusOK, PublicIpPricesResponse(prices))
+	}
+}
+
+// swagger:route GET /providers/{provider}/regions/{region}/databases databases getDatabases
+//
+// Provides the managed database instance classes and their prices on a given provider in a specific region.
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+//     Security:
+//
+//     Responses:
+//       200: DatabasesResponse
+func (r *RouteHandler) getDatabases() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		pathParams := GetStoragePathParams{}
+		if err := mapstructure.Decode(getPathParamMap(c), &pathParams); err != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
+			return
+		}
+
+		if ve := ValidatePathData(pathParams); ve != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(ve, "validation"))
+			return
+		}
+
+		queryParams := GetDatabasesQueryParams{}
+		if err := mapstructure.Decode(getQueryAsMap(c), &queryParams); err != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
+			return
+		}
+
+		logger := log.WithFieldsForHandlers(c, r.log, map[string]interface{}{"provider": pathParams.Provider,
+			"region": pathParams.Region})
+		logger.Info("getting managed databases")
+
+		databases, err := r.prod.GetDatabases(pathParams.Provider, pathParams.Region)
+		if err != nil {
+			r.errorResponder.Respond(c, errors.WrapIfWithDetails(err, "failed to retrieve managed databases",
+				"provider", pathParams.Provider, "region", pathParams.Region))
+			return
+		}
+
+		filtered := make([]types.DatabaseInfo, 0, len(databases))
+		for _, database := range databases {
+			if queryParams.Engine != "" && database.Engine != queryParams.Engine {
+				continue
+			}
+			if queryParams.Deployment != "" && database.Deployment != queryParams.Deployment {
+				continue
+			}
+			filtered = append(filtered, database)
+		}
+
+		logger.Debug("successfully retrieved managed databases")
+		c.JSON(http.StatusOK, DatabasesResponse(filtered))
+	}
+}
+
+// swagger:route GET /providers/{provider}/regions/{region}/dedicated-hosts dedicated-hosts getDedicatedHosts
+//
+// Provides the dedicated host types and their prices on a given provider in a specific region.
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+//     Security:
+//
+//     Responses:
+//       200: DedicatedHostsResponse
+func (r *RouteHandler) getDedicatedHosts() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		pathParams := GetStoragePathParams{}
+		if err := mapstructure.Decode(getPathParamMap(c), &pathParams); err != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
+			return
+		}
+
+		if ve := ValidatePathData(pathParams); ve != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(ve, "validation"))
+			return
+		}
+
+		logger := log.WithFieldsForHandlers(c, r.log, map[string]interface{}{"provider": pathParams.Provider,
+			"region": pathParams.Region})
+		logger.Info("getting dedicated hosts")
+
+		hosts, err := r.prod.GetDedicatedHosts(pathParams.Provider, pathParams.Region)
+		if err != nil {
+			r.errorResponder.Respond(c, errors.WrapIfWithDetails(err, "failed to retrieve dedicated hosts",
+				"provider", pathParams.Provider, "region", pathParams.Region))
+			return
+		}
+
+		logger.Debug("successfully retrieved dedicated hosts")
+		c.JSON(http.StatusOK, DedicatedHostsResponse(hosts))
+	}
+}
+
+// swagger:route GET /providers/{provider}/services/{service}/regions/{region}/serverless serverless getServerlessPrices
+//
+// Provides the per vCPU-second and per GB-second prices of the serverless containers (Fargate, Cloud Run,
+// Container Instances) in a region of the serverless service.
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+//     Security:
+//
+//     Responses:
+//       200: ServerlessPricesResponse
+func (r *RouteHandler) getServerlessPrices() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		pathParams := GetRegionPathParams{}
+		if err := mapstructure.Decode(getPathParamMap(c), &pathParams); err != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
+			return
+		}
+
+		if ve := ValidatePathData(pathParams); ve != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(ve, "validation"))
+			return
+		}
+
+		if pathParams.Service != cloudinfo.ServerlessService {
+			r.errorResponder.Respond(c, errors.WithDetails(errors.NewWithDetails("serverless prices are served by the serverless service",
+				"provider", pathParams.Provider, "service", pathParams.Service), "validation"))
+			return
+		}
+
+		logger := log.WithFieldsForHandlers(c, r.log, map[string]interface{}{"provider": pathParams.Provider,
+			"region": pathParams.Region})
+		logger.Info("getting serverless container prices")
+
+		prices, err := r.prod.GetServerlessPrices(pathParams.Provider, pathParams.Region)
+		if err != nil {
+			r.errorResponder.Respond(c, errors.WrapIfWithDetails(err, "failed to retrieve serverless container prices",
+				"provider", pathParams.Provider, "region", pathParams.Region))
+			return
+		}
+
+		logger.Debug("successfully retrieved serverless container prices")
+		c.JSON(http.StatusOK, ServerlessPricesResponse(prices))
+	}
+}
+
+// parseTimeRange parses the RFC 3339 bounds of a time range, an empty bound leaves the range open
+func parseTimeRange(fromParam, toParam string) (from, to time.Time, err error) {
+	if fromParam != "" {
+		if from, err = time.Parse(time.RFC3339, fromParam); err != nil {
+			return from, to, errors.WrapIfWithDetails(err, "invalid from parameter", "from", fromParam)
+		}
+	}
+
+	if toParam != "" {
+		if to, err = time.Parse(time.RFC3339, toParam); err != nil {
+			return from, to, errors.WrapIfWithDetails(err, "invalid to parameter", "to", toParam)
+		}
+	}
+
+	if !from.IsZero() && !to.IsZero() && to.Before(from) {
+		return from, to, errors.NewWithDetails("the end of the time range precedes its start", "from", fromParam, "to", toParam)
+	}
+
+	return from, to, nil
+}
+
+// swagger:route GET /changes products getChanges
+//
+// Returns the products changed after the given time per provider, service and region
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+//     Security:
+//
+//     Responses:
+//       200: ProductChangesResponse
+func (r *RouteHandler) getChanges() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		queryParams := GetChangesQueryParams{}
+		if err := mapstructure.Decode(getQueryAsMap(c), &queryParams); err != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
+			return
+		}
+
+		since, err := time.Parse(time.RFC3339, queryParams.Since)
+		if err != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(errors.WrapIfWithDetails(err, "invalid since query parameter",
+				"since", queryParams.Since), "validation"))
+			return
+		}
+
+		logger := log.WithFieldsForHandlers(c, r.log, map[string]interface{}{"provider": queryParams.Provider, "since": since})
+		logger.Info("getting product changes")
+
+		changes, err := r.prod.GetProductChanges(queryParams.Provider, since)
+		if err != nil {
+			r.errorResponder.Respond(c, errors.WrapIfWithDetails(err, "failed to retrieve product changes",
+				"provider", queryParams.Provider))
+			return
+		}
+
+		logger.Debug("successfully retrieved product changes")
+		c.JSON(http.StatusOK, ProductChangesResponse(changes))
 	}
 }
 
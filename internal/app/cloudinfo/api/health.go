@@ -0,0 +1,140 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/gin-gonic/gin"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// readinessTimeout is the time limit of a single readiness check
+const readinessTimeout = 2 * time.Second
+
+// HealthChecker checks the availability of a component the API depends on
+type HealthChecker interface {
+	// Ping returns an error if the component is not available
+	Ping(ctx context.Context) error
+}
+
+// HealthCheckerFunc adapts a function to the HealthChecker interface
+type HealthCheckerFunc func(ctx context.Context) error
+
+// Ping calls the underlying function
+func (fn HealthCheckerFunc) Ping(ctx context.Context) error {
+	return fn(ctx)
+}
+
+// dataReadiness checks whether the store holds data for all of the enabled providers
+type dataReadiness struct {
+	cloudInfo types.CloudInfo
+	// ready latches once every provider had its data populated
+	ready int32
+}
+
+// DataReadinessCheck returns a check that passes once the initial scrape or the store warm-up populated the data
+// of all the enabled providers, so that no traffic hits an empty cache after a restart; the regions failing to be
+// scraped don't keep the instance unready as long as the provider has at least one region committed
+func DataReadinessCheck(ci types.CloudInfo) HealthChecker {
+	return &dataReadiness{cloudInfo: ci}
+}
+
+// Ping returns an error while any of the enabled providers has no data yet
+func (d *dataReadiness) Ping(_ context.Context) error {
+	if atomic.LoadInt32(&d.ready) == 1 {
+		return nil
+	}
+
+	providers, err := d.cloudInfo.GetProviders()
+	if err != nil {
+		return errors.WrapIf(err, "failed to retrieve providers")
+	}
+
+	for _, provider := range providers {
+		if !d.hasData(provider) {
+			return errors.Errorf("no data for provider %s yet", provider.Provider)
+		}
+	}
+
+	atomic.StoreInt32(&d.ready, 1)
+
+	return nil
+}
+
+// hasData tells whether the provider completed a scrape cycle, its data was loaded, or any of its regions was committed
+func (d *dataReadiness) hasData(provider types.Provider) bool {
+	if _, err := d.cloudInfo.GetStatus(provider.Provider); err == nil {
+		return true
+	}
+
+	for _, service := range provider.Services {
+		regions, err := d.cloudInfo.GetRegions(provider.Provider, service.Service)
+		if err != nil {
+			continue
+		}
+
+		for region := range regions {
+			if _, err := d.cloudInfo.GetRegionStatus(provider.Provider, service.Service, region); err == nil {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// ReadinessResponse describes the readiness of the application
+// swagger:model ReadinessResponse
+type ReadinessResponse struct {
+	Status string `json:"status"`
+	// Failed holds the failure reason per component that is not ready
+	Failed map[string]string `json:"failed,omitempty"`
+}
+
+// AddReadinessCheck registers a component to be checked by the readiness probe
+func (r *RouteHandler) AddReadinessCheck(name string, checker HealthChecker) {
+	r.readinessChecks[name] = checker
+}
+
+// liveness responds with 200 as long as the application is able to serve requests
+func (r *RouteHandler) liveness(c *gin.Context) {
+	c.JSON(http.StatusOK, ReadinessResponse{Status: "ok"})
+}
+
+// readiness responds with 503 if any of the registered components is not available
+func (r *RouteHandler) readiness(c *gin.Context) {
+	failed := make(map[string]string)
+	for name, checker := range r.readinessChecks {
+		ctx, cancel := context.WithTimeout(c.Request.Context(), readinessTimeout)
+		if err := checker.Ping(ctx); err != nil {
+			failed[name] = err.Error()
+		}
+		cancel()
+	}
+
+	if len(failed) > 0 {
+		r.log.Warn("not ready", map[string]interface{}{"failed": failed})
+		c.JSON(http.StatusServiceUnavailable, ReadinessResponse{Status: "unavailable", Failed: failed})
+		return
+	}
+
+	c.JSON(http.StatusOK, ReadinessResponse{Status: "ready"})
+}
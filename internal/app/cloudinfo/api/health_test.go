@@ -0,0 +1,172 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"emperror.dev/errors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/cloudinfoadapter"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestRouteHandler_readiness(t *testing.T) {
+	available := HealthCheckerFunc(func(ctx context.Context) error { return nil })
+	unavailable := HealthCheckerFunc(func(ctx context.Context) error { return errors.New("connection refused") })
+
+	tests := []struct {
+		name     string
+		checks   map[string]HealthChecker
+		status   int
+		response ReadinessResponse
+	}{
+		{
+			name:     "ready without checks",
+			checks:   map[string]HealthChecker{},
+			status:   http.StatusOK,
+			response: ReadinessResponse{Status: "ready"},
+		},
+		{
+			name:     "ready when all the components are available",
+			checks:   map[string]HealthChecker{"store": available, "scraping": available},
+			status:   http.StatusOK,
+			response: ReadinessResponse{Status: "ready"},
+		},
+		{
+			name:   "unavailable when a component is not available",
+			checks: map[string]HealthChecker{"store": unavailable, "scraping": available},
+			status: http.StatusServiceUnavailable,
+			response: ReadinessResponse{
+				Status: "unavailable",
+				Failed: map[string]string{"store": "connection refused"},
+			},
+		},
+		{
+			name: "the checks have a deadline",
+			checks: map[string]HealthChecker{"store": HealthCheckerFunc(func(ctx context.Context) error {
+				if _, ok := ctx.Deadline(); !ok {
+					return errors.New("no deadline")
+				}
+				return nil
+			})},
+			status:   http.StatusOK,
+			response: ReadinessResponse{Status: "ready"},
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := &RouteHandler{readinessChecks: test.checks, log: cloudinfoadapter.NewNoopLogger()}
+
+			router := gin.New()
+			router.GET("/readyz", r.readiness)
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+			assert.Equal(t, test.status, w.Code)
+
+			var response ReadinessResponse
+			if assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response)) {
+				assert.Equal(t, test.response, response)
+			}
+		})
+	}
+}
+
+func TestRouteHandler_liveness(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := &RouteHandler{log: cloudinfoadapter.NewNoopLogger()}
+
+	router := gin.New()
+	router.GET("/healthz", r.liveness)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"status":"ok"}`, w.Body.String())
+}
+
+// readinessCloudInfo implements the CloudInfo interface for mocking the scraped providers and regions
+type readinessCloudInfo struct {
+	providers    []types.Provider
+	status       map[string]bool
+	regions      map[string]map[string]string
+	regionStatus map[string]bool
+	// implement the interface
+	types.CloudInfo
+}
+
+func (r *readinessCloudInfo) GetProviders() ([]types.Provider, error) {
+	return r.providers, nil
+}
+
+func (r *readinessCloudInfo) GetStatus(provider string) (string, error) {
+	if !r.status[provider] {
+		return "", errors.New("status not yet cached")
+	}
+	return "1622541600000", nil
+}
+
+func (r *readinessCloudInfo) GetRegions(provider, service string) (map[string]string, error) {
+	regions, ok := r.regions[provider+"/"+service]
+	if !ok {
+		return nil, errors.New("regions not yet cached")
+	}
+	return regions, nil
+}
+
+func (r *readinessCloudInfo) GetRegionStatus(provider, service, region string) (string, error) {
+	if !r.regionStatus[provider+"/"+service+"/"+region] {
+		return "", errors.New("region status not yet cached")
+	}
+	return "1622541600000", nil
+}
+
+func TestDataReadinessCheck(t *testing.T) {
+	compute := []types.Service{{Service: "compute"}}
+	ci := &readinessCloudInfo{
+		providers: []types.Provider{{Provider: "amazon", Services: compute}, {Provider: "google", Services: compute}},
+		status:    map[string]bool{"amazon": true},
+		regions: map[string]map[string]string{
+			"google/compute": {"europe-west1": "Belgium", "me-central2": "Dammam"},
+		},
+		regionStatus: map[string]bool{},
+	}
+	check := DataReadinessCheck(ci)
+
+	err := check.Ping(context.Background())
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "google")
+	}
+
+	// the scrape cycle of the provider fails on a region, the committed ones are served
+	ci.regionStatus["google/compute/europe-west1"] = true
+	assert.NoError(t, check.Ping(context.Background()))
+
+	// the readiness latches, evicted data does not take the instance out of rotation
+	ci.status["amazon"] = false
+	ci.regionStatus["google/compute/europe-west1"] = false
+	assert.NoError(t, check.Ping(context.Background()))
+}
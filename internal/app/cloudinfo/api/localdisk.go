@@ -0,0 +1,48 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"strings"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// localDiskAny matches the products with any kind of local disks
+const localDiskAny = "any"
+
+// filterLocalDisks keeps the products with local disks of the given type (nvme, ssd, hdd or any)
+func filterLocalDisks(products []types.ProductDetails, diskType string) ([]types.ProductDetails, error) {
+	diskType = strings.ToLower(diskType)
+	switch diskType {
+	case localDiskAny, types.LocalDiskNVMe, types.LocalDiskSSD, types.LocalDiskHDD:
+	default:
+		return nil, errors.NewWithDetails("invalid localDisk query parameter", "localDisk", diskType)
+	}
+
+	filtered := make([]types.ProductDetails, 0, len(products))
+	for _, product := range products {
+		for _, disk := range product.LocalDisks {
+			if diskType == localDiskAny || disk.Type == diskType {
+				filtered = append(filtered, product)
+				break
+			}
+		}
+	}
+
+	return filtered, nil
+}
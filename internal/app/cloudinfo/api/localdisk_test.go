@@ -0,0 +1,42 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestFilterLocalDisks(t *testing.T) {
+	products := []types.ProductDetails{
+		{VMInfo: types.VMInfo{Type: "i3.large", LocalDisks: []types.LocalDisk{{Count: 1, Size: 475, Type: types.LocalDiskNVMe}}}},
+		{VMInfo: types.VMInfo{Type: "d2.xlarge", LocalDisks: []types.LocalDisk{{Count: 3, Size: 2000, Type: types.LocalDiskHDD}}}},
+		{VMInfo: types.VMInfo{Type: "m5.large"}},
+	}
+
+	filtered, err := filterLocalDisks(products, "NVMe")
+	assert.NoError(t, err)
+	assert.Equal(t, products[:1], filtered)
+
+	filtered, err = filterLocalDisks(products, "any")
+	assert.NoError(t, err)
+	assert.Equal(t, products[:2], filtered, "the products without local disks should be left out")
+
+	_, err = filterLocalDisks(products, "tape")
+	assert.Error(t, err)
+}
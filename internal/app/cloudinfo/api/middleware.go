@@ -0,0 +1,206 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/banzaicloud/cloudinfo/internal/platform/log"
+)
+
+const (
+	// dataAgeHeader holds the number of seconds elapsed since the served data was last scraped
+	dataAgeHeader = "X-Data-Age"
+
+	// dataScrapedAtHeader holds the time the served data was last scraped
+	dataScrapedAtHeader = "X-Data-Scraped-At"
+
+	// neverScraped is the header value used when the served data has not been scraped yet
+	neverScraped = "never"
+
+	// eventStreamContentType is the content type of the long-lived server-sent event streams
+	eventStreamContentType = "text/event-stream"
+
+	// etagHeader holds the entity tag of the served representation
+	etagHeader = "ETag"
+
+	// ifNoneMatchHeader holds the entity tags of the representations the client already has
+	ifNoneMatchHeader = "If-None-Match"
+)
+
+// dataAgeMiddleware decorates responses with the age of the data stored for the requested provider (and region)
+func (r *RouteHandler) dataAgeMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		scrapedAt, ok := r.scrapedAt(c.Param("provider"), c.Param("service"), c.Param("region"))
+		if !ok {
+			c.Header(dataAgeHeader, neverScraped)
+			c.Header(dataScrapedAtHeader, neverScraped)
+			c.Next()
+			return
+		}
+
+		c.Header(dataAgeHeader, strconv.Itoa(int(time.Since(scrapedAt).Seconds())))
+		c.Header(dataScrapedAtHeader, scrapedAt.UTC().Format(time.RFC3339))
+		c.Next()
+	}
+}
+
+// etagMiddleware tags the responses of the region scoped read endpoints with an entity tag derived from the content hash
+// of the region stored at scrape time, the hash of its prices refreshed in between, the exchange rates of the converted
+// prices and the request; the conditional requests matching the tag are answered with 304 Not Modified, so the polling
+// clients don't download the unchanged products again
+func (r *RouteHandler) etagMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.Method != http.MethodGet && c.Request.Method != http.MethodHead {
+			c.Next()
+			return
+		}
+
+		provider, service, region := c.Param("provider"), c.Param("service"), c.Param("region")
+		if service == "" || region == "" {
+			c.Next()
+			return
+		}
+
+		hash, err := r.prod.GetRegionHash(provider, service, region)
+		if err != nil {
+			c.Next()
+			return
+		}
+
+		// the converted prices change with the exchange rates too
+		if c.Query("currency") != "" && r.currencyConverter != nil {
+			hash += "/" + r.currencyConverter.Fingerprint()
+		}
+
+		etag := entityTag(hash, c.Request)
+		c.Header(etagHeader, etag)
+		if etagMatches(c.GetHeader(ifNoneMatchHeader), etag) {
+			c.AbortWithStatus(http.StatusNotModified)
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// entityTag returns the entity tag of the representation of the region content served for the request,
+// the query and the negotiated media type select different representations of the same content
+func entityTag(hash string, req *http.Request) string {
+	sum := sha256.Sum256([]byte(hash + "\n" + req.URL.RequestURI() + "\n" + req.Header.Get("Accept")))
+
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// etagMatches tells whether the If-None-Match header value matches the entity tag, using the weak comparison
+func etagMatches(ifNoneMatch, etag string) bool {
+	if strings.TrimSpace(ifNoneMatch) == "*" {
+		return true
+	}
+
+	for _, tag := range strings.Split(ifNoneMatch, ",") {
+		if strings.TrimPrefix(strings.TrimSpace(tag), "W/") == etag {
+			return true
+		}
+	}
+
+	return false
+}
+
+// slowRequestMiddleware logs the requests served slower than the configured threshold
+// event streams and websockets are skipped, as they are served until the client disconnects
+func (r *RouteHandler) slowRequestMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		duration := time.Since(start)
+		if duration < r.config.SlowRequestThreshold {
+			return
+		}
+
+		if strings.HasPrefix(c.Writer.Header().Get("Content-Type"), eventStreamContentType) || c.IsWebsocket() {
+			return
+		}
+
+		params := make(map[string]string, len(c.Params))
+		for _, param := range c.Params {
+			params[param.Key] = param.Value
+		}
+
+		log.WithFieldsForHandlers(c, r.log, map[string]interface{}{
+			"path":     c.Request.URL.Path,
+			"params":   params,
+			"query":    c.Request.URL.RawQuery,
+			"status":   c.Writer.Status(),
+			"duration": duration.String(),
+		}).Warn("slow request")
+	}
+}
+
+// scrapedAt looks up the time of the last successful scrape
+// if no provider is given the oldest scrape time of all providers is returned
+func (r *RouteHandler) scrapedAt(provider, service, region string) (time.Time, bool) {
+	if provider == "" {
+		providers, err := r.prod.GetProviders()
+		if err != nil || len(providers) == 0 {
+			return time.Time{}, false
+		}
+
+		var oldest time.Time
+		for _, p := range providers {
+			t, ok := r.scrapedAt(p.Provider, "", "")
+			if !ok {
+				return time.Time{}, false
+			}
+
+			if oldest.IsZero() || t.Before(oldest) {
+				oldest = t
+			}
+		}
+
+		return oldest, true
+	}
+
+	if service != "" && region != "" {
+		if status, err := r.prod.GetRegionStatus(provider, service, region); err == nil {
+			return parseStatus(status)
+		}
+	}
+
+	status, err := r.prod.GetStatus(provider)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return parseStatus(status)
+}
+
+// parseStatus parses a status value (unix time in milliseconds) stored by the scraping manager
+func parseStatus(status string) (time.Time, bool) {
+	millis, err := strconv.ParseInt(status, 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return time.Unix(0, millis*int64(time.Millisecond)), true
+}
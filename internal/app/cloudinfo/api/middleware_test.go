@@ -0,0 +1,312 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"logur.dev/logur"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/cloudinfoadapter"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/currency"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// statusCloudInfo implements the CloudInfo interface for mocking the stored scrape times
+type statusCloudInfo struct {
+	status       map[string]time.Time
+	regionStatus map[string]time.Time
+	regionHash   map[string]string
+	regionError  map[string]types.ScrapeError
+	// implement the interface
+	types.CloudInfo
+}
+
+func (s *statusCloudInfo) GetProviders() ([]types.Provider, error) {
+	var providers []types.Provider
+	for provider := range s.status {
+		providers = append(providers, types.Provider{Provider: provider})
+	}
+	return providers, nil
+}
+
+func (s *statusCloudInfo) GetStatus(provider string) (string, error) {
+	return formatStatus(s.status[provider])
+}
+
+func (s *statusCloudInfo) GetRegionStatus(provider, service, region string) (string, error) {
+	return formatStatus(s.regionStatus[provider+"/"+service+"/"+region])
+}
+
+func (s *statusCloudInfo) GetRegionHash(provider, service, region string) (string, error) {
+	hash, ok := s.regionHash[provider+"/"+service+"/"+region]
+	if !ok {
+		return "", errors.New("region hash not yet cached")
+	}
+	return hash, nil
+}
+
+func (s *statusCloudInfo) GetRegionError(provider, service, region string) (types.ScrapeError, error) {
+	scrapeError, ok := s.regionError[provider+"/"+service+"/"+region]
+	if !ok {
+		return types.ScrapeError{}, errors.New("region error not yet cached")
+	}
+	return scrapeError, nil
+}
+
+func formatStatus(t time.Time) (string, error) {
+	if t.IsZero() {
+		return "", errors.New("status not yet cached")
+	}
+	return strconv.FormatInt(t.UnixNano()/int64(time.Millisecond), 10), nil
+}
+
+func TestRouteHandler_dataAgeMiddleware(t *testing.T) {
+	providerScrape := time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC)
+	regionScrape := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		ci        *statusCloudInfo
+		path      string
+		scrapedAt string
+		checkAge  bool
+	}{
+		{
+			name:      "never scraped provider",
+			ci:        &statusCloudInfo{},
+			path:      "/providers/amazon/services/compute/regions/eu-west-1",
+			scrapedAt: neverScraped,
+		},
+		{
+			name: "region scrape time is used when available",
+			ci: &statusCloudInfo{
+				status:       map[string]time.Time{"amazon": providerScrape},
+				regionStatus: map[string]time.Time{"amazon/compute/eu-west-1": regionScrape},
+			},
+			path:      "/providers/amazon/services/compute/regions/eu-west-1",
+			scrapedAt: "2021-06-01T12:00:00Z",
+			checkAge:  true,
+		},
+		{
+			name: "provider scrape time is used when the region was not scraped",
+			ci: &statusCloudInfo{
+				status: map[string]time.Time{"amazon": providerScrape},
+			},
+			path:      "/providers/amazon/services/compute/regions/eu-west-1",
+			scrapedAt: "2021-06-01T10:00:00Z",
+			checkAge:  true,
+		},
+		{
+			name: "oldest provider scrape time is used without provider",
+			ci: &statusCloudInfo{
+				status: map[string]time.Time{"amazon": regionScrape, "google": providerScrape},
+			},
+			path:      "/providers",
+			scrapedAt: "2021-06-01T10:00:00Z",
+			checkAge:  true,
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := &RouteHandler{prod: test.ci, log: cloudinfoadapter.NewNoopLogger()}
+
+			router := gin.New()
+			router.Use(r.dataAgeMiddleware())
+			router.GET("/providers", func(c *gin.Context) { c.Status(http.StatusOK) })
+			router.GET("/providers/:provider/services/:service/regions/:region", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, test.path, nil))
+
+			assert.Equal(t, test.scrapedAt, w.Header().Get(dataScrapedAtHeader))
+			if test.checkAge {
+				_, err := strconv.Atoi(w.Header().Get(dataAgeHeader))
+				assert.NoError(t, err, "the data age should be a number of seconds")
+			} else {
+				assert.Equal(t, neverScraped, w.Header().Get(dataAgeHeader))
+			}
+		})
+	}
+}
+
+func TestRouteHandler_slowRequestMiddleware(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		logged      bool
+	}{
+		{
+			name:        "slow request is logged",
+			contentType: "application/json; charset=utf-8",
+			logged:      true,
+		},
+		{
+			name:        "event stream is not logged",
+			contentType: eventStreamContentType,
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			logger := &logur.TestLogger{}
+			r := &RouteHandler{config: Config{SlowRequestThreshold: time.Nanosecond}, log: cloudinfoadapter.NewLogger(logger)}
+
+			router := gin.New()
+			router.Use(r.slowRequestMiddleware())
+			router.GET("/slow", func(c *gin.Context) {
+				time.Sleep(time.Millisecond)
+				c.Header("Content-Type", test.contentType)
+				c.Status(http.StatusOK)
+			})
+
+			router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/slow", nil))
+
+			if test.logged {
+				assert.Equal(t, 1, logger.Count(), "the slow request should be logged")
+			} else {
+				assert.Equal(t, 0, logger.Count(), "the event stream should not be logged")
+			}
+		})
+	}
+}
+
+func TestRouteHandler_etagMiddleware(t *testing.T) {
+	ci := &statusCloudInfo{regionHash: map[string]string{"amazon/compute/eu-west-1": "e3b0c44298fc1c14"}}
+	products := "/providers/amazon/services/compute/regions/eu-west-1/products"
+	etag := entityTag("e3b0c44298fc1c14", httptest.NewRequest(http.MethodGet, products, nil))
+
+	tests := []struct {
+		name        string
+		path        string
+		ifNoneMatch string
+		status      int
+		etag        string
+	}{
+		{
+			name:   "etag of the region content",
+			path:   products,
+			status: http.StatusOK,
+			etag:   etag,
+		},
+		{
+			name:        "matching etag",
+			path:        products,
+			ifNoneMatch: etag,
+			status:      http.StatusNotModified,
+			etag:        etag,
+		},
+		{
+			name:        "matching weak etag in a list",
+			path:        products,
+			ifNoneMatch: `"0123", W/` + etag,
+			status:      http.StatusNotModified,
+			etag:        etag,
+		},
+		{
+			name:        "stale etag",
+			path:        products,
+			ifNoneMatch: `"0123"`,
+			status:      http.StatusOK,
+			etag:        etag,
+		},
+		{
+			name:        "the query selects another representation",
+			path:        products + "?format=csv",
+			ifNoneMatch: etag,
+			status:      http.StatusOK,
+			etag:        entityTag("e3b0c44298fc1c14", httptest.NewRequest(http.MethodGet, products+"?format=csv", nil)),
+		},
+		{
+			name:        "region not scraped yet",
+			path:        "/providers/amazon/services/compute/regions/us-east-1/products",
+			ifNoneMatch: etag,
+			status:      http.StatusOK,
+		},
+		{
+			name:   "not region scoped",
+			path:   "/providers",
+			status: http.StatusOK,
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := &RouteHandler{prod: ci, log: cloudinfoadapter.NewNoopLogger()}
+
+			router := gin.New()
+			router.Use(r.etagMiddleware())
+			router.GET("/providers", func(c *gin.Context) { c.Status(http.StatusOK) })
+			router.GET("/providers/:provider/services/:service/regions/:region/products", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+			req := httptest.NewRequest(http.MethodGet, test.path, nil)
+			if test.ifNoneMatch != "" {
+				req.Header.Set(ifNoneMatchHeader, test.ifNoneMatch)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, test.status, w.Code)
+			assert.Equal(t, test.etag, w.Header().Get(etagHeader))
+		})
+	}
+}
+
+func TestRouteHandler_etagMiddleware_prices(t *testing.T) {
+	ci := &statusCloudInfo{regionHash: map[string]string{"amazon/compute/eu-west-1": "content/prices"}}
+	rates := currency.FixedSource{"EUR": 0.8}
+	converter := currency.NewConverter(cloudinfoadapter.NewNoopLogger(), rates)
+	converter.Refresh(context.Background())
+
+	r := &RouteHandler{prod: ci, currencyConverter: converter, log: cloudinfoadapter.NewNoopLogger()}
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(r.etagMiddleware())
+	router.GET("/providers/:provider/services/:service/regions/:region/products", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	etag := func(path string) string {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		return w.Header().Get(etagHeader)
+	}
+
+	products := "/providers/amazon/services/compute/regions/eu-west-1/products"
+	converted := products + "?currency=EUR"
+	productsTag, convertedTag := etag(products), etag(converted)
+
+	// the spot prices of the region are refreshed between the region scrapes
+	ci.regionHash["amazon/compute/eu-west-1"] = "content/repriced"
+	assert.NotEqual(t, productsTag, etag(products), "a changed spot price should change the etag")
+	assert.NotEqual(t, convertedTag, etag(converted), "a changed spot price should change the etag of the converted prices")
+
+	productsTag, convertedTag = etag(products), etag(converted)
+	rates["EUR"] = 0.9
+	converter.Refresh(context.Background())
+	assert.Equal(t, productsTag, etag(products), "the exchange rates should not change the etag of the prices in USD")
+	assert.NotEqual(t, convertedTag, etag(converted), "a changed exchange rate should change the etag of the converted prices")
+}
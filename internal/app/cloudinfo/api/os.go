@@ -0,0 +1,56 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"strings"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// filterOsPrices keeps the products with an on demand price for the given operating system (linux, windows, rhel or
+// suse) and replaces their on demand prices with it; the spot, reserved and savings plans prices only apply for linux
+func filterOsPrices(products []types.ProductDetails, os string) ([]types.ProductDetails, error) {
+	os = strings.ToLower(os)
+	switch os {
+	case types.OsLinux, types.OsWindows, types.OsRHEL, types.OsSUSE:
+	default:
+		return nil, errors.NewWithDetails("invalid os query parameter", "os", os)
+	}
+
+	if os == types.OsLinux {
+		// the on demand prices are the linux prices
+		return products, nil
+	}
+
+	filtered := make([]types.ProductDetails, 0, len(products))
+	for _, product := range products {
+		price, ok := product.OsPrices[os]
+		if !ok || price == 0 {
+			continue
+		}
+
+		product.OnDemandPrice = price
+		product.SpotPrice = nil
+		product.ReservedPrices = nil
+		product.SavingsPlans = nil
+		product.EffectiveMonthlyPrice = 0
+		filtered = append(filtered, product)
+	}
+
+	return filtered, nil
+}
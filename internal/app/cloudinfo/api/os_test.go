@@ -0,0 +1,51 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestFilterOsPrices(t *testing.T) {
+	products := []types.ProductDetails{
+		{VMInfo: types.VMInfo{
+			Type:           "m5.large",
+			OnDemandPrice:  0.096,
+			SpotPrice:      []types.ZonePrice{{Zone: "eu-west-1a", Price: 0.03}},
+			ReservedPrices: []types.ReservedPrice{{Term: "1yr", PaymentOption: "No Upfront", EffectiveHourlyPrice: 0.06}},
+			OsPrices:       map[string]float64{types.OsLinux: 0.096, types.OsWindows: 0.188},
+		}},
+		{VMInfo: types.VMInfo{Type: "a1.large", OnDemandPrice: 0.051}},
+	}
+
+	filtered, err := filterOsPrices(products, "linux")
+	assert.NoError(t, err)
+	assert.Equal(t, products, filtered)
+
+	filtered, err = filterOsPrices(products, "Windows")
+	assert.NoError(t, err)
+	assert.Equal(t, []types.ProductDetails{{VMInfo: types.VMInfo{
+		Type:          "m5.large",
+		OnDemandPrice: 0.188,
+		OsPrices:      map[string]float64{types.OsLinux: 0.096, types.OsWindows: 0.188},
+	}}}, filtered, "the products without windows prices should be left out")
+
+	_, err = filterOsPrices(products, "freebsd")
+	assert.Error(t, err)
+}
@@ -0,0 +1,168 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// maxProductSuggestions the number of the closest instance types suggested for an unknown one
+const maxProductSuggestions = 5
+
+// unknownInstanceTypeError is returned when the requested instance type is not offered in the region
+type unknownInstanceTypeError struct {
+	instanceType string
+	suggestions  []string
+}
+
+func (e unknownInstanceTypeError) Error() string {
+	return "unknown instance type: " + e.instanceType
+}
+
+// findProduct returns the product of an instance type;
+// the entries of the instance type in different zones are merged into one
+func findProduct(products []types.ProductDetails, instanceType string) (types.ProductDetails, bool) {
+	var (
+		product types.ProductDetails
+		found   bool
+	)
+
+	for _, p := range products {
+		if p.Type != instanceType {
+			continue
+		}
+
+		if !found {
+			product = p
+			product.Zones = append([]string(nil), p.Zones...)
+			product.SpotPrice = append([]types.ZonePrice(nil), p.SpotPrice...)
+			found = true
+			continue
+		}
+
+		for _, zone := range p.Zones {
+			if !cloudinfo.Contains(product.Zones, zone) {
+				product.Zones = append(product.Zones, zone)
+			}
+		}
+		for _, spotPrice := range p.SpotPrice {
+			if !hasZonePrice(product.SpotPrice, spotPrice.Zone) {
+				product.SpotPrice = append(product.SpotPrice, spotPrice)
+			}
+		}
+	}
+
+	if found {
+		sort.Strings(product.Zones)
+		sort.Slice(product.SpotPrice, func(i, j int) bool {
+			return product.SpotPrice[i].Zone < product.SpotPrice[j].Zone
+		})
+	}
+
+	return product, found
+}
+
+// hasZonePrice checks whether there is a price of the zone among the prices
+func hasZonePrice(prices []types.ZonePrice, zone string) bool {
+	for _, price := range prices {
+		if price.Zone == zone {
+			return true
+		}
+	}
+
+	return false
+}
+
+// suggestInstanceTypes returns the instance types with the names closest to the unknown one, the closest first;
+// the names too far from it to be a typo are left out
+func suggestInstanceTypes(products []types.ProductDetails, instanceType string) []string {
+	type suggestion struct {
+		name     string
+		distance int
+	}
+
+	name := strings.ToLower(instanceType)
+	maxDistance := len(name) / 2
+	if maxDistance < 2 {
+		maxDistance = 2
+	}
+
+	seen := make(map[string]bool, len(products))
+	var suggestions []suggestion
+	for _, product := range products {
+		if seen[product.Type] {
+			continue
+		}
+		seen[product.Type] = true
+
+		distance := editDistance(name, strings.ToLower(product.Type))
+		if distance <= maxDistance {
+			suggestions = append(suggestions, suggestion{name: product.Type, distance: distance})
+		}
+	}
+
+	sort.Slice(suggestions, func(i, j int) bool {
+		if suggestions[i].distance != suggestions[j].distance {
+			return suggestions[i].distance < suggestions[j].distance
+		}
+		return suggestions[i].name < suggestions[j].name
+	})
+
+	if len(suggestions) > maxProductSuggestions {
+		suggestions = suggestions[:maxProductSuggestions]
+	}
+
+	names := make([]string, 0, len(suggestions))
+	for _, s := range suggestions {
+		names = append(names, s.name)
+	}
+
+	return names
+}
+
+// editDistance returns the Levenshtein distance of two strings
+func editDistance(a, b string) int {
+	ar, br := []rune(a), []rune(b)
+	prev := make([]int, len(br)+1)
+	curr := make([]int, len(br)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ar); i++ {
+		curr[0] = i
+		for j := 1; j <= len(br); j++ {
+			cost := 1
+			if ar[i-1] == br[j-1] {
+				cost = 0
+			}
+
+			curr[j] = prev[j-1] + cost
+			if prev[j]+1 < curr[j] {
+				curr[j] = prev[j] + 1
+			}
+			if curr[j-1]+1 < curr[j] {
+				curr[j] = curr[j-1] + 1
+			}
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(br)]
+}
@@ -0,0 +1,103 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"net/http"
+	"testing"
+
+	"emperror.dev/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/banzaicloud/cloudinfo/internal/app/cloudinfo/problems"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestFindProduct(t *testing.T) {
+	products := []types.ProductDetails{
+		{VMInfo: types.VMInfo{Type: "m5.large", Zones: []string{"eu-west-1b"}, SpotPrice: []types.ZonePrice{{Zone: "eu-west-1b", Price: 0.04}}}},
+		{VMInfo: types.VMInfo{Type: "m5.xlarge", Zones: []string{"eu-west-1a"}}},
+		{VMInfo: types.VMInfo{Type: "m5.large", Zones: []string{"eu-west-1a"}, SpotPrice: []types.ZonePrice{{Zone: "eu-west-1a", Price: 0.03}}}},
+	}
+
+	product, ok := findProduct(products, "m5.large")
+	require.True(t, ok)
+	assert.Equal(t, "m5.large", product.Type)
+	assert.Equal(t, []string{"eu-west-1a", "eu-west-1b"}, product.Zones, "the zones of the entries should be merged")
+	assert.Equal(t, []types.ZonePrice{{Zone: "eu-west-1a", Price: 0.03}, {Zone: "eu-west-1b", Price: 0.04}}, product.SpotPrice)
+	assert.Equal(t, []string{"eu-west-1b"}, products[0].Zones, "the products should not be modified")
+
+	_, ok = findProduct(products, "m5.2xlarge")
+	assert.False(t, ok)
+}
+
+func TestSuggestInstanceTypes(t *testing.T) {
+	products := []types.ProductDetails{
+		{VMInfo: types.VMInfo{Type: "m5.large"}},
+		{VMInfo: types.VMInfo{Type: "m5.xlarge"}},
+		{VMInfo: types.VMInfo{Type: "m5.xlarge"}},
+		{VMInfo: types.VMInfo{Type: "m5a.large"}},
+		{VMInfo: types.VMInfo{Type: "c5.large"}},
+		{VMInfo: types.VMInfo{Type: "x1e.32xlarge"}},
+	}
+
+	tests := []struct {
+		name         string
+		instanceType string
+		suggestions  []string
+	}{
+		{
+			name:         "typo",
+			instanceType: "m5.lrage",
+			suggestions:  []string{"m5.large", "c5.large", "m5.xlarge", "m5a.large"},
+		},
+		{
+			name:         "case insensitive",
+			instanceType: "M5.XLARGE",
+			suggestions:  []string{"m5.xlarge", "m5.large", "c5.large", "m5a.large"},
+		},
+		{
+			name:         "nothing close",
+			instanceType: "Standard_D4s_v3",
+			suggestions:  []string{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.suggestions, suggestInstanceTypes(products, test.instanceType))
+		})
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	assert.Equal(t, 0, editDistance("m5.large", "m5.large"))
+	assert.Equal(t, 1, editDistance("m5.large", "m5a.large"))
+	assert.Equal(t, 2, editDistance("m5.lrage", "m5.large"))
+	assert.Equal(t, 3, editDistance("", "abc"))
+}
+
+func TestErrClassifier_UnknownInstanceType(t *testing.T) {
+	problem, err := NewErrorClassifier().Classify(errors.WithStack(unknownInstanceTypeError{
+		instanceType: "m5.lrage",
+		suggestions:  []string{"m5.large"},
+	}))
+	require.NoError(t, err)
+
+	wrapper := problem.(*problems.ProblemWrapper)
+	assert.Equal(t, http.StatusNotFound, wrapper.Status)
+	assert.Equal(t, []string{"m5.large"}, wrapper.Suggestions)
+}
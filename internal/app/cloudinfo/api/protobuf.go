@@ -0,0 +1,187 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	cloudinfov1 "github.com/banzaicloud/cloudinfo/.gen/api/grpc/cloudinfo/v1"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+const (
+	// formatProtobuf the listing is a protobuf message, only served by the product and spot price endpoints
+	formatProtobuf = "protobuf"
+
+	// mimeProtobuf the media type of the protobuf responses
+	mimeProtobuf = "application/x-protobuf"
+)
+
+// negotiateProtobufFormat returns the format of a listing of the endpoints serving protobuf as well: the one requested
+// explicitly with the format query parameter or negotiated with the Accept header of the request, see negotiateFormat
+func negotiateProtobufFormat(c *gin.Context, format string) (string, error) {
+	if format == formatProtobuf || (format == "" && wantsProtobuf(c)) {
+		return formatProtobuf, nil
+	}
+
+	return negotiateFormat(c, format)
+}
+
+// wantsProtobuf reports whether application/x-protobuf is preferred by the Accept header of the request
+func wantsProtobuf(c *gin.Context) bool {
+	return c.NegotiateFormat(gin.MIMEJSON, mimeCSV, mimeNDJSON, mimeProtobuf) == mimeProtobuf
+}
+
+// protoProductDetails converts a product to its protobuf form
+func protoProductDetails(product types.ProductDetails) *cloudinfov1.ProductDetails {
+	p := &cloudinfov1.ProductDetails{
+		Category:              product.Category,
+		Type:                  product.Type,
+		OnDemandPrice:         product.OnDemandPrice,
+		SpotPrice:             protoZonePrices(product.SpotPrice),
+		CpusPerVm:             product.Cpus,
+		MemPerVm:              product.Mem,
+		GpusPerVm:             product.Gpus,
+		NtwPerf:               product.NtwPerf,
+		NtwPerfCategory:       product.NtwPerfCat,
+		Zones:                 product.Zones,
+		Attributes:            product.Attributes,
+		CurrentGen:            product.CurrentGen,
+		Burst:                 product.Burst,
+		BaselineCpu:           product.BaselineCPU,
+		PlacementGroup:        product.PlacementGroup,
+		PlacementStrategies:   product.PlacementStrategies,
+		MonthlyPrice:          product.MonthlyPrice,
+		EffectiveMonthlyPrice: product.EffectiveMonthlyPrice,
+		Currency:              product.Currency,
+		Workloads:             product.Workloads,
+		BareMetal:             product.BareMetal,
+		Nics:                  int32(product.NICs),
+		OsPrices:              product.OsPrices,
+		Partition:             product.Partition,
+		GpuVendor:             product.GpuVendor,
+		GpuModel:              product.GpuModel,
+		GpuMemPerVm:           product.GpuMem,
+		Lifecycle:             product.Lifecycle,
+		Architecture:          product.Architecture,
+		AcceleratedNetworking: product.AcceleratedNetworking,
+		Ipv6:                  product.IPv6,
+		NetworkPerfGbps:       product.NtwPerfGbps,
+		MaxPods:               int32(product.MaxPods),
+		FreeTier:              product.FreeTier,
+		UpdatedAt:             timestamppb.New(product.UpdatedAt),
+	}
+
+	if product.CpuCredits != nil {
+		p.CpuCredits = &cloudinfov1.CpuCredits{
+			EarnedPerHour:      product.CpuCredits.EarnedPerHour,
+			MaxAccrued:         product.CpuCredits.MaxAccrued,
+			UnlimitedSurcharge: product.CpuCredits.UnlimitedSurcharge,
+		}
+	}
+
+	if product.NetworkLimits != nil {
+		p.NetworkLimits = &cloudinfov1.NetworkLimits{
+			MaxInterfaces:    int32(product.NetworkLimits.MaxInterfaces),
+			Ipv4PerInterface: int32(product.NetworkLimits.IPv4PerInterface),
+			Ipv6PerInterface: int32(product.NetworkLimits.IPv6PerInterface),
+		}
+	}
+
+	for _, plan := range product.SavingsPlans {
+		p.SavingsPlans = append(p.SavingsPlans, &cloudinfov1.SavingsPlanPrice{
+			PlanType:      plan.PlanType,
+			Term:          plan.Term,
+			PaymentOption: plan.PaymentOption,
+			Rate:          plan.Rate,
+		})
+	}
+
+	for _, reserved := range product.ReservedPrices {
+		p.ReservedPrices = append(p.ReservedPrices, &cloudinfov1.ReservedPrice{
+			Term:                 reserved.Term,
+			PaymentOption:        reserved.PaymentOption,
+			UpfrontPrice:         reserved.UpfrontPrice,
+			MonthlyPrice:         reserved.MonthlyPrice,
+			EffectiveHourlyPrice: reserved.EffectiveHourlyPrice,
+		})
+	}
+
+	for _, disk := range product.LocalDisks {
+		p.LocalDisks = append(p.LocalDisks, &cloudinfov1.LocalDisk{
+			Count: int32(disk.Count),
+			Size:  disk.Size,
+			Type:  disk.Type,
+		})
+	}
+
+	for _, accelerator := range product.Accelerators {
+		p.Accelerators = append(p.Accelerators, &cloudinfov1.Accelerator{
+			Type:   accelerator.Type,
+			Vendor: accelerator.Vendor,
+			Model:  accelerator.Model,
+			Count:  int32(accelerator.Count),
+			Memory: accelerator.Memory,
+		})
+	}
+
+	return p
+}
+
+// protoProductDetailsResponse converts a page of products to its protobuf form
+func protoProductDetailsResponse(response ProductDetailsResponse) *cloudinfov1.ProductDetailsResponse {
+	products := make([]*cloudinfov1.ProductDetails, 0, len(response.Products))
+	for _, product := range response.Products {
+		products = append(products, protoProductDetails(product))
+	}
+
+	return &cloudinfov1.ProductDetailsResponse{
+		Products:     products,
+		ScrapingTime: response.ScrapingTime,
+		Total:        int32(response.Total),
+		NextCursor:   response.NextCursor,
+	}
+}
+
+// protoProductResponse converts a single product to its protobuf form
+func protoProductResponse(response ProductResponse) *cloudinfov1.ProductResponse {
+	return &cloudinfov1.ProductResponse{
+		Product:      protoProductDetails(response.Product),
+		ScrapingTime: response.ScrapingTime,
+	}
+}
+
+// protoSpotPriceHistoryResponse converts the spot price records of an instance type to their protobuf form
+func protoSpotPriceHistoryResponse(history []types.SpotPriceRecord) *cloudinfov1.SpotPriceHistoryResponse {
+	records := make([]*cloudinfov1.SpotPriceRecord, 0, len(history))
+	for _, record := range history {
+		records = append(records, &cloudinfov1.SpotPriceRecord{
+			Timestamp: timestamppb.New(record.Timestamp),
+			SpotPrice: protoZonePrices(record.SpotPrice),
+		})
+	}
+
+	return &cloudinfov1.SpotPriceHistoryResponse{Records: records}
+}
+
+func protoZonePrices(prices []types.ZonePrice) []*cloudinfov1.ZonePrice {
+	zonePrices := make([]*cloudinfov1.ZonePrice, 0, len(prices))
+	for _, price := range prices {
+		zonePrices = append(zonePrices, &cloudinfov1.ZonePrice{Zone: price.Zone, Price: price.Price})
+	}
+
+	return zonePrices
+}
@@ -0,0 +1,129 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	cloudinfov1 "github.com/banzaicloud/cloudinfo/.gen/api/grpc/cloudinfo/v1"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestNegotiateProtobufFormat(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		accept string
+		want   string
+	}{
+		{
+			name: "json by default",
+			want: formatJSON,
+		},
+		{
+			name:   "protobuf accept header",
+			accept: "application/x-protobuf",
+			want:   formatProtobuf,
+		},
+		{
+			name:   "protobuf format",
+			format: "protobuf",
+			want:   formatProtobuf,
+		},
+		{
+			name:   "format overrides the accept header",
+			format: "csv",
+			accept: "application/x-protobuf",
+			want:   formatCSV,
+		},
+		{
+			name:   "ndjson accept header",
+			accept: "application/x-ndjson",
+			want:   formatNDJSON,
+		},
+	}
+
+	gin.SetMode(gin.TestMode)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+			if test.accept != "" {
+				c.Request.Header.Set("Accept", test.accept)
+			}
+
+			format, err := negotiateProtobufFormat(c, test.format)
+
+			require.NoError(t, err)
+			assert.Equal(t, test.want, format)
+		})
+	}
+}
+
+func TestProtoProductDetailsResponse(t *testing.T) {
+	updatedAt := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	response := ProductDetailsResponse{
+		Products: []types.ProductDetails{{VMInfo: types.VMInfo{
+			Type:          "m5.large",
+			OnDemandPrice: 0.096,
+			SpotPrice:     []types.ZonePrice{{Zone: "eu-west-1a", Price: 0.035}},
+			Cpus:          2,
+			Mem:           8,
+			Attributes:    map[string]string{"cpu": "2"},
+			OsPrices:      map[string]float64{"windows": 0.188},
+			NetworkLimits: &types.NetworkLimits{MaxInterfaces: 3, IPv4PerInterface: 10},
+			ReservedPrices: []types.ReservedPrice{
+				{Term: "1yr", PaymentOption: types.PaymentNoUpfront, EffectiveHourlyPrice: 0.06},
+			},
+			UpdatedAt: updatedAt,
+		}}},
+		ScrapingTime: "1622548800000",
+		Total:        12,
+		NextCursor:   "bTUubGFyZ2U",
+	}
+
+	raw, err := proto.Marshal(protoProductDetailsResponse(response))
+	require.NoError(t, err)
+
+	var decoded cloudinfov1.ProductDetailsResponse
+	require.NoError(t, proto.Unmarshal(raw, &decoded))
+
+	assert.Equal(t, "1622548800000", decoded.GetScrapingTime())
+	assert.Equal(t, int32(12), decoded.GetTotal())
+	assert.Equal(t, "bTUubGFyZ2U", decoded.GetNextCursor())
+	require.Len(t, decoded.GetProducts(), 1)
+
+	product := decoded.GetProducts()[0]
+	assert.Equal(t, "m5.large", product.GetType())
+	assert.Equal(t, 0.096, product.GetOnDemandPrice())
+	assert.Equal(t, "eu-west-1a", product.GetSpotPrice()[0].GetZone())
+	assert.Equal(t, 0.035, product.GetSpotPrice()[0].GetPrice())
+	assert.Equal(t, 2.0, product.GetCpusPerVm())
+	assert.Equal(t, 8.0, product.GetMemPerVm())
+	assert.Equal(t, map[string]string{"cpu": "2"}, product.GetAttributes())
+	assert.Equal(t, map[string]float64{"windows": 0.188}, product.GetOsPrices())
+	assert.Equal(t, int32(3), product.GetNetworkLimits().GetMaxInterfaces())
+	assert.Equal(t, 0.06, product.GetReservedPrices()[0].GetEffectiveHourlyPrice())
+	assert.Nil(t, product.GetCpuCredits(), "the missing cpu credits should be left out")
+	assert.Equal(t, updatedAt, product.GetUpdatedAt().AsTime())
+}
@@ -0,0 +1,146 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"sort"
+	"strconv"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+const (
+	// defaultRecommendations the number of recommendations returned when no limit is requested
+	defaultRecommendations = 20
+	// maxRecommendations the maximum number of recommendations returned
+	maxRecommendations = 200
+)
+
+// recommendationConstraints the minimum size of the recommended instance types and the price they are ranked by
+type recommendationConstraints struct {
+	cpu    float64
+	memory float64
+	gpu    float64
+	spot   bool
+}
+
+// parseRecommendationConstraints parses the constraints and the number of the recommendations
+func parseRecommendationConstraints(queryParams GetRecommendationsQueryParams) (recommendationConstraints, int, error) {
+	var constraints recommendationConstraints
+
+	for _, bound := range []struct {
+		name  string
+		value string
+		to    *float64
+	}{
+		{name: "cpu", value: queryParams.Cpu, to: &constraints.cpu},
+		{name: "memory", value: queryParams.Memory, to: &constraints.memory},
+		{name: "gpu", value: queryParams.Gpu, to: &constraints.gpu},
+	} {
+		value, err := parseBound(bound.name, bound.value)
+		if err != nil {
+			return constraints, 0, err
+		}
+		if value != nil {
+			*bound.to = *value
+		}
+	}
+
+	switch queryParams.Lifecycle {
+	case "", "ondemand":
+	case "spot":
+		constraints.spot = true
+	default:
+		return constraints, 0, errors.NewWithDetails("invalid lifecycle query parameter, expected ondemand or spot",
+			"lifecycle", queryParams.Lifecycle)
+	}
+
+	limit := defaultRecommendations
+	if queryParams.Limit != "" {
+		var err error
+		if limit, err = strconv.Atoi(queryParams.Limit); err != nil || limit < 1 || limit > maxRecommendations {
+			return constraints, 0, errors.NewWithDetails("limit must be a positive integer up to the maximum",
+				"limit", queryParams.Limit, "max", maxRecommendations)
+		}
+	}
+
+	return constraints, limit, nil
+}
+
+// recommend returns the priced products of a region satisfying the constraints; the spot recommendations are priced
+// with the cheapest zone of the products
+func recommend(provider, region string, products []types.ProductDetails, constraints recommendationConstraints) []Recommendation {
+	var recommendations []Recommendation
+
+	for _, product := range products {
+		if product.Cpus < constraints.cpu || product.Mem < constraints.memory || product.Gpus < constraints.gpu {
+			continue
+		}
+
+		recommendation := Recommendation{
+			Provider:      provider,
+			Region:        region,
+			Type:          product.Type,
+			Cpus:          product.Cpus,
+			Mem:           product.Mem,
+			Gpus:          product.Gpus,
+			Price:         product.OnDemandPrice,
+			OnDemandPrice: product.OnDemandPrice,
+		}
+
+		if constraints.spot {
+			recommendation.Price = 0
+			for _, price := range product.SpotPrice {
+				if price.Price > 0 && (recommendation.Price == 0 || price.Price < recommendation.Price) {
+					recommendation.Zone, recommendation.Price = price.Zone, price.Price
+				}
+			}
+		}
+
+		if recommendation.Price <= 0 {
+			continue
+		}
+
+		recommendations = append(recommendations, recommendation)
+	}
+
+	return recommendations
+}
+
+// rankRecommendations sorts the recommendations by their price (the cheapest first) and keeps the first limit of them
+func rankRecommendations(recommendations []Recommendation, limit int) []Recommendation {
+	sort.Slice(recommendations, func(i, j int) bool {
+		a, b := recommendations[i], recommendations[j]
+		if a.Price != b.Price {
+			return a.Price < b.Price
+		}
+		if a.Provider != b.Provider {
+			return a.Provider < b.Provider
+		}
+		if a.Region != b.Region {
+			return a.Region < b.Region
+		}
+
+		return a.Type < b.Type
+	})
+
+	if len(recommendations) > limit {
+		recommendations = recommendations[:limit]
+	}
+
+	return recommendations
+}
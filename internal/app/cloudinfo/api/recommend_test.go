@@ -0,0 +1,131 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestParseRecommendationConstraints(t *testing.T) {
+	tests := []struct {
+		name        string
+		queryParams GetRecommendationsQueryParams
+		check       func(constraints recommendationConstraints, limit int, err error)
+	}{
+		{
+			name:        "the constraints are parsed",
+			queryParams: GetRecommendationsQueryParams{Cpu: "4", Memory: "16", Gpu: "1", Lifecycle: "spot", Limit: "5"},
+			check: func(constraints recommendationConstraints, limit int, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, recommendationConstraints{cpu: 4, memory: 16, gpu: 1, spot: true}, constraints)
+				assert.Equal(t, 5, limit)
+			},
+		},
+		{
+			name: "the on demand prices are ranked by default",
+			check: func(constraints recommendationConstraints, limit int, err error) {
+				assert.NoError(t, err)
+				assert.False(t, constraints.spot)
+				assert.Equal(t, defaultRecommendations, limit)
+			},
+		},
+		{
+			name:        "the lifecycle is validated",
+			queryParams: GetRecommendationsQueryParams{Lifecycle: "reserved"},
+			check: func(constraints recommendationConstraints, limit int, err error) {
+				assert.EqualError(t, err, "invalid lifecycle query parameter, expected ondemand or spot")
+			},
+		},
+		{
+			name:        "the limit is validated",
+			queryParams: GetRecommendationsQueryParams{Limit: "1000"},
+			check: func(constraints recommendationConstraints, limit int, err error) {
+				assert.EqualError(t, err, "limit must be a positive integer up to the maximum")
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.check(parseRecommendationConstraints(test.queryParams))
+		})
+	}
+}
+
+func TestRecommend(t *testing.T) {
+	products := []types.ProductDetails{
+		{VMInfo: types.VMInfo{Type: "small", Cpus: 2, Mem: 8, OnDemandPrice: 0.1}},
+		{VMInfo: types.VMInfo{Type: "large", Cpus: 8, Mem: 32, OnDemandPrice: 0.4, SpotPrice: []types.ZonePrice{
+			{Zone: "eu-west-1a", Price: 0.15},
+			{Zone: "eu-west-1b", Price: 0.12},
+		}}},
+		{VMInfo: types.VMInfo{Type: "gpu", Cpus: 8, Mem: 61, Gpus: 1, OnDemandPrice: 0.9}},
+	}
+
+	tests := []struct {
+		name        string
+		constraints recommendationConstraints
+		check       func(recommendations []Recommendation)
+	}{
+		{
+			name:        "the products are filtered by size",
+			constraints: recommendationConstraints{cpu: 4, memory: 16},
+			check: func(recommendations []Recommendation) {
+				assert.Equal(t, []Recommendation{
+					{Provider: "amazon", Region: "eu-west-1", Type: "large", Cpus: 8, Mem: 32, Price: 0.4, OnDemandPrice: 0.4},
+					{Provider: "amazon", Region: "eu-west-1", Type: "gpu", Cpus: 8, Mem: 61, Gpus: 1, Price: 0.9, OnDemandPrice: 0.9},
+				}, recommendations)
+			},
+		},
+		{
+			name:        "the products are filtered by gpu",
+			constraints: recommendationConstraints{gpu: 1},
+			check: func(recommendations []Recommendation) {
+				assert.Len(t, recommendations, 1)
+				assert.Equal(t, "gpu", recommendations[0].Type)
+			},
+		},
+		{
+			name:        "the spot recommendations are priced with the cheapest zone",
+			constraints: recommendationConstraints{spot: true},
+			check: func(recommendations []Recommendation) {
+				assert.Equal(t, []Recommendation{
+					{Provider: "amazon", Region: "eu-west-1", Zone: "eu-west-1b", Type: "large", Cpus: 8, Mem: 32, Price: 0.12, OnDemandPrice: 0.4},
+				}, recommendations)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.check(recommend("amazon", "eu-west-1", products, test.constraints))
+		})
+	}
+}
+
+func TestRankRecommendations(t *testing.T) {
+	recommendations := []Recommendation{
+		{Provider: "google", Region: "europe-west1", Type: "n2-standard-8", Price: 0.38},
+		{Provider: "amazon", Region: "eu-west-1", Type: "m5.2xlarge", Price: 0.428},
+		{Provider: "azure", Region: "westeurope", Type: "Standard_D8s_v5", Price: 0.38},
+	}
+
+	assert.Equal(t, []Recommendation{
+		{Provider: "azure", Region: "westeurope", Type: "Standard_D8s_v5", Price: 0.38},
+		{Provider: "google", Region: "europe-west1", Type: "n2-standard-8", Price: 0.38},
+	}, rankRecommendations(recommendations, 2))
+}
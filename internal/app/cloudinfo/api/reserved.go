@@ -0,0 +1,62 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"math"
+	"strings"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// filterReservedPrices keeps the reserved prices of the products with the given term and payment option (any if empty),
+// the products left without reserved prices are left out
+func filterReservedPrices(products []types.ProductDetails, term, paymentOption string) []types.ProductDetails {
+	filtered := make([]types.ProductDetails, 0, len(products))
+	for _, product := range products {
+		var prices []types.ReservedPrice
+		for _, price := range product.ReservedPrices {
+			if term != "" && price.Term != term {
+				continue
+			}
+			if paymentOption != "" && !strings.EqualFold(price.PaymentOption, paymentOption) {
+				continue
+			}
+			prices = append(prices, price)
+		}
+
+		if len(prices) == 0 {
+			continue
+		}
+
+		product.ReservedPrices = prices
+		filtered = append(filtered, product)
+	}
+
+	return filtered
+}
+
+// lowestReservedPrice returns the lowest effective hourly price of the reservations of the product,
+// +Inf if the product can't be reserved
+func lowestReservedPrice(product types.ProductDetails) float64 {
+	lowest := math.Inf(1)
+	for _, price := range product.ReservedPrices {
+		if price.EffectiveHourlyPrice > 0 && price.EffectiveHourlyPrice < lowest {
+			lowest = price.EffectiveHourlyPrice
+		}
+	}
+
+	return lowest
+}
@@ -0,0 +1,52 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestFilterReservedPrices(t *testing.T) {
+	products := []types.ProductDetails{
+		{VMInfo: types.VMInfo{Type: "m5.large", ReservedPrices: []types.ReservedPrice{
+			{Term: "1yr", PaymentOption: types.PaymentNoUpfront, EffectiveHourlyPrice: 0.06},
+			{Term: "3yr", PaymentOption: types.PaymentNoUpfront, EffectiveHourlyPrice: 0.04},
+			{Term: "3yr", PaymentOption: types.PaymentAllUpfront, EffectiveHourlyPrice: 0.035},
+		}}},
+		{VMInfo: types.VMInfo{Type: "t3.micro"}},
+	}
+
+	filtered := filterReservedPrices(products, "3yr", "all upfront")
+
+	assert.Equal(t, []types.ProductDetails{
+		{VMInfo: types.VMInfo{Type: "m5.large", ReservedPrices: []types.ReservedPrice{
+			{Term: "3yr", PaymentOption: types.PaymentAllUpfront, EffectiveHourlyPrice: 0.035},
+		}}},
+	}, filtered, "only the matching reservations and the products with such reservations should be kept")
+	assert.Len(t, products[0].ReservedPrices, 3, "the original products should not be changed")
+}
+
+func TestLowestReservedPrice(t *testing.T) {
+	assert.Equal(t, 0.035, lowestReservedPrice(types.ProductDetails{VMInfo: types.VMInfo{ReservedPrices: []types.ReservedPrice{
+		{Term: "1yr", EffectiveHourlyPrice: 0.06},
+		{Term: "3yr", EffectiveHourlyPrice: 0.035},
+	}}}))
+	assert.True(t, math.IsInf(lowestReservedPrice(types.ProductDetails{}), 1), "the products without reservations should be sorted last")
+}
@@ -28,7 +28,11 @@ import (
 	"github.com/gin-contrib/static"
 	"github.com/gin-gonic/gin"
 
+	openapispec "github.com/banzaicloud/cloudinfo/api/openapi-spec"
+	"github.com/banzaicloud/cloudinfo/internal/app/cloudinfo/loader"
+	"github.com/banzaicloud/cloudinfo/internal/app/cloudinfo/messaging"
 	"github.com/banzaicloud/cloudinfo/internal/cloudinfo"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/currency"
 	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/metrics"
 	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
 	"github.com/banzaicloud/cloudinfo/internal/platform/buildinfo"
@@ -38,21 +42,35 @@ import (
 
 // RouteHandler configures the REST API routes in the gin router
 type RouteHandler struct {
-	log            cloudinfo.Logger
-	prod           types.CloudInfo
-	buildInfo      buildinfo.BuildInfo
-	errorResponder Responder
-	graphqlHandler http.Handler
+	config          Config
+	log             cloudinfo.Logger
+	prod            types.CloudInfo
+	buildInfo       buildinfo.BuildInfo
+	errorResponder  Responder
+	graphqlHandler  http.Handler
+	events          *eventBroker
+	readinessChecks map[string]HealthChecker
+	// currencyConverter converts the prices to the requested currency, nil if the conversion is disabled
+	currencyConverter *currency.Converter
+	// regionCarbon the carbon intensity data of the regions
+	regionCarbon *cloudinfo.RegionCarbonData
+	// regionCompliance the compliance scopes of the regions
+	regionCompliance loader.RegionCompliance
 }
 
 // NewRouteHandler creates a new RouteHandler and returns a reference to it
-func NewRouteHandler(p types.CloudInfo, bi buildinfo.BuildInfo, graphqlHandler http.Handler, log cloudinfo.Logger) *RouteHandler {
+func NewRouteHandler(config Config, p types.CloudInfo, bi buildinfo.BuildInfo, graphqlHandler http.Handler, eventBus messaging.EventBus,
+	log cloudinfo.Logger) *RouteHandler {
 	return &RouteHandler{
-		prod:           p,
-		buildInfo:      bi,
-		errorResponder: NewErrorResponder(),
-		graphqlHandler: graphqlHandler,
-		log:            log,
+		config:          config,
+		prod:            p,
+		buildInfo:       bi,
+		errorResponder:  NewErrorResponder(),
+		graphqlHandler:  graphqlHandler,
+		events:          newEventBroker(eventBus),
+		readinessChecks: make(map[string]HealthChecker),
+		regionCarbon:    cloudinfo.NewRegionCarbonData(nil),
+		log:             log,
 	}
 }
 
@@ -60,17 +78,24 @@ func NewRouteHandler(p types.CloudInfo, bi buildinfo.BuildInfo, graphqlHandler h
 func (r *RouteHandler) ConfigureRoutes(router *gin.Engine, basePath string) {
 	r.log.Info("configuring routes")
 
-	corsConfig := cors.DefaultConfig()
-	corsConfig.AllowAllOrigins = true
-	corsConfig.AllowHeaders = append(corsConfig.AllowHeaders, "Banzai-Cloud-Pipeline-UUID")
-
 	router.Use(log.MiddlewareCorrelationId())
 	router.Use(log.Middleware())
-	router.Use(cors.New(corsConfig))
+	if r.config.SlowRequestThreshold > 0 {
+		router.Use(r.slowRequestMiddleware())
+	}
+	if r.config.CORS.Enabled() {
+		router.Use(cors.New(r.config.CORS.corsConfig()))
+	}
+	if r.config.Compression.Enabled {
+		router.Use(r.compressionMiddleware())
+	}
 
 	webFiles, _ := fs.Sub(web.Files(), "dist/web")
 	router.Use(static.Serve(basePath, fileSystem(webFiles)))
 
+	swaggerUIFiles, _ := fs.Sub(openapispec.SwaggerUI(), "swagger-ui")
+	router.Use(static.Serve(basePath+swaggerUIAssetsPath, fileSystem(swaggerUIFiles)))
+
 	base := router.Group(basePath)
 
 	{
@@ -98,11 +123,30 @@ func (r *RouteHandler) ConfigureRoutes(router *gin.Engine, basePath string) {
 	{
 		base.GET("/status", r.signalStatus)
 		base.GET("/version", r.versionHandler)
+		base.GET("/healthz", r.liveness)
+		base.GET("/readyz", r.readiness)
+	}
+
+	{
+		base.GET("/api/v1/openapi.json", getOpenAPI(basePath))
+		base.GET("/api/v1/docs", getSwaggerUI())
 	}
 
 	v1 := base.Group("/api/v1")
+	if r.config.DataAgeHeaders {
+		v1.Use(r.dataAgeMiddleware())
+	}
+	v1.Use(r.etagMiddleware())
 
+	v1.GET("/changes", r.getChanges())
+	v1.GET("/compare", r.getComparison())
 	v1.GET("/continents", r.getContinents())
+	v1.GET("/continents/regions", r.getContinentRegions())
+	v1.GET("/events", r.getEvents())
+	v1.POST("/products/batch", r.getBatchProducts())
+	v1.GET("/recommendations", r.getRecommendations())
+	v1.GET("/search", r.getSearch())
+	v1.GET("/status", r.getFreshness())
 
 	providerGroup := v1.Group("/providers")
 	{
@@ -113,12 +157,41 @@ func (r *RouteHandler) ConfigureRoutes(router *gin.Engine, basePath string) {
 		providerGroup.GET("/:provider/services/:service/continents", r.getContinentsData())
 		providerGroup.GET("/:provider/services/:service/regions", r.getRegions())
 		providerGroup.GET("/:provider/services/:service/regions/:region", r.getRegion())
+		providerGroup.GET("/:provider/services/:service/regions/:region/zones", r.getZones())
+		providerGroup.GET("/:provider/services/:service/regions/:region/zones/:zone/products", r.getZoneProducts())
+		providerGroup.GET("/:provider/services/:service/regions/:region/accelerators", r.getAccelerators())
 		providerGroup.GET("/:provider/services/:service/regions/:region/images", r.getImages())
 		providerGroup.GET("/:provider/services/:service/regions/:region/versions", r.getVersions())
 		providerGroup.GET("/:provider/services/:service/regions/:region/products", r.getProducts())
+		providerGroup.GET("/:provider/services/:service/regions/:region/products/:type", r.getProduct())
+		providerGroup.GET("/:provider/services/:service/regions/:region/products/:type/spot-history", r.getSpotPriceHistory())
+		providerGroup.GET("/:provider/services/:service/regions/:region/products/:type/price-history", r.getOnDemandPriceHistory())
+		providerGroup.GET("/:provider/services/:service/regions/:region/products/:type/savings-plans", r.getSavingsPlanRates())
+		providerGroup.GET("/:provider/services/:service/regions/:region/serverless", r.getServerlessPrices())
+		providerGroup.GET("/:provider/regions/:region/storage", r.getStoragePrices())
+		providerGroup.GET("/:provider/regions/:region/storage/object", r.getObjectStoragePrices())
+		providerGroup.GET("/:provider/regions/:region/network", r.getNetworkPrices())
+		providerGroup.GET("/:provider/regions/:region/loadbalancer", r.getLoadBalancerPrices())
+		providerGroup.GET("/:provider/regions/:region/nat-gateway", r.getNatGatewayPrices())
+		providerGroup.GET("/:provider/regions/:region/public-ip", r.getPublicIpPrices())
+		providerGroup.GET("/:provider/regions/:region/databases", r.getDatabases())
+		providerGroup.GET("/:provider/regions/:region/dedicated-hosts", r.getDedicatedHosts())
+	}
+
+	// v2 serves the richer product model, v1 is kept stable for the existing consumers
+	v2 := base.Group("/api/v2")
+	if r.config.DataAgeHeaders {
+		v2.Use(r.dataAgeMiddleware())
+	}
+	v2.Use(r.etagMiddleware())
+	{
+		v2.GET("/providers/:provider/services/:service/regions/:region/products", r.getProductsV2())
+		v2.GET("/providers/:provider/services/:service/regions/:region/products/:type", r.getProductV2())
 	}
 
 	base.POST("/graphql", r.query())
+	// the subscriptions are served over websocket
+	base.GET("/graphql", r.query())
 }
 
 func (r *RouteHandler) signalStatus(c *gin.Context) {
@@ -129,6 +202,21 @@ func (r *RouteHandler) versionHandler(c *gin.Context) {
 	c.JSON(http.StatusOK, r.buildInfo)
 }
 
+// EnableCurrencyConversion enables converting the prices to the currency requested with the currency query parameter
+func (r *RouteHandler) EnableCurrencyConversion(converter *currency.Converter) {
+	r.currencyConverter = converter
+}
+
+// SetRegionCarbonData sets the carbon intensity data of the regions, the published data is used by default
+func (r *RouteHandler) SetRegionCarbonData(data *cloudinfo.RegionCarbonData) {
+	r.regionCarbon = data
+}
+
+// SetRegionCompliance sets the compliance scopes of the regions maintained by the service loader
+func (r *RouteHandler) SetRegionCompliance(compliance loader.RegionCompliance) {
+	r.regionCompliance = compliance
+}
+
 func (r *RouteHandler) EnableMetrics(router *gin.Engine, metricsAddr string) {
 	p := ginprometheus.NewPrometheus("http", []string{"provider", "service", "region"})
 	p.SetListenAddress(metricsAddr)
@@ -0,0 +1,69 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"math"
+	"strings"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// savingsPlanTerms the commitment terms of the savings plans
+var savingsPlanTerms = map[string]bool{"1yr": true, "3yr": true}
+
+// savingsPlanRates selects the savings plans rates of the product matching the given term, payment option and plan type
+// (any if empty) and calculates the effective rate as the lowest of them
+func savingsPlanRates(product types.ProductDetails, term, paymentOption, planType string) (SavingsPlanRatesResponse, error) {
+	if term != "" && !savingsPlanTerms[term] {
+		return SavingsPlanRatesResponse{}, errors.NewWithDetails("invalid term query parameter, expected 1yr or 3yr", "term", term)
+	}
+
+	response := SavingsPlanRatesResponse{
+		Type:          product.Type,
+		OnDemandPrice: product.OnDemandPrice,
+		Rates:         make([]types.SavingsPlanPrice, 0, len(product.SavingsPlans)),
+	}
+
+	effectiveRate := math.Inf(1)
+	for _, rate := range product.SavingsPlans {
+		if term != "" && rate.Term != term {
+			continue
+		}
+		if paymentOption != "" && !strings.EqualFold(rate.PaymentOption, paymentOption) {
+			continue
+		}
+		if planType != "" && !strings.EqualFold(rate.PlanType, planType) {
+			continue
+		}
+
+		response.Rates = append(response.Rates, rate)
+		effectiveRate = math.Min(effectiveRate, rate.Rate)
+	}
+
+	if len(response.Rates) == 0 {
+		return response, nil
+	}
+
+	response.EffectiveRate = &effectiveRate
+	if product.OnDemandPrice > 0 {
+		savings := math.Round((product.OnDemandPrice-effectiveRate)/product.OnDemandPrice*10000) / 100
+		response.Savings = &savings
+	}
+
+	return response, nil
+}
@@ -0,0 +1,83 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestSavingsPlanRates(t *testing.T) {
+	product := types.ProductDetails{VMInfo: types.VMInfo{Type: "m5.large", OnDemandPrice: 0.1, SavingsPlans: []types.SavingsPlanPrice{
+		{PlanType: "Compute", Term: "1yr", PaymentOption: types.PaymentNoUpfront, Rate: 0.07},
+		{PlanType: "Compute", Term: "3yr", PaymentOption: types.PaymentNoUpfront, Rate: 0.05},
+		{PlanType: "EC2Instance", Term: "3yr", PaymentOption: types.PaymentAllUpfront, Rate: 0.04},
+	}}}
+
+	tests := []struct {
+		name          string
+		term          string
+		paymentOption string
+		planType      string
+		check         func(response SavingsPlanRatesResponse, err error)
+	}{
+		{
+			name: "the effective rate is the lowest rate of the term",
+			term: "3yr",
+			check: func(response SavingsPlanRatesResponse, err error) {
+				assert.NoError(t, err)
+				assert.Len(t, response.Rates, 2)
+				assert.Equal(t, 0.04, *response.EffectiveRate)
+				assert.Equal(t, 60.0, *response.Savings)
+			},
+		},
+		{
+			name:          "the rates are filtered by payment option and plan type",
+			paymentOption: "no upfront",
+			planType:      "compute",
+			check: func(response SavingsPlanRatesResponse, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, product.SavingsPlans[:2], response.Rates)
+				assert.Equal(t, 0.05, *response.EffectiveRate)
+			},
+		},
+		{
+			name:     "no effective rate without matching rates",
+			term:     "1yr",
+			planType: "EC2Instance",
+			check: func(response SavingsPlanRatesResponse, err error) {
+				assert.NoError(t, err)
+				assert.Empty(t, response.Rates)
+				assert.Nil(t, response.EffectiveRate)
+				assert.Nil(t, response.Savings)
+			},
+		},
+		{
+			name: "the term is validated",
+			term: "2yr",
+			check: func(response SavingsPlanRatesResponse, err error) {
+				assert.Error(t, err)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.check(savingsPlanRates(product, test.term, test.paymentOption, test.planType))
+		})
+	}
+}
@@ -0,0 +1,243 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"math"
+	"sort"
+	"strings"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// defaultSortField is the field the product listings are sorted by if no sort order is configured or requested
+const defaultSortField = "type"
+
+// productComparators holds the "less" functions of the product fields the product listings can be sorted by
+var productComparators = map[string]func(a, b types.ProductDetails) bool{
+	"type":          func(a, b types.ProductDetails) bool { return a.Type < b.Type },
+	"category":      func(a, b types.ProductDetails) bool { return a.Category < b.Category },
+	"onDemandPrice": func(a, b types.ProductDetails) bool { return a.OnDemandPrice < b.OnDemandPrice },
+	"cpusPerVm":     func(a, b types.ProductDetails) bool { return a.Cpus < b.Cpus },
+	"memPerVm":      func(a, b types.ProductDetails) bool { return a.Mem < b.Mem },
+	"cpu":           func(a, b types.ProductDetails) bool { return a.Cpus < b.Cpus },
+	"memory":        func(a, b types.ProductDetails) bool { return a.Mem < b.Mem },
+	"gpusPerVm":     func(a, b types.ProductDetails) bool { return a.Gpus < b.Gpus },
+	"gpuMemPerVm":   func(a, b types.ProductDetails) bool { return a.GpuMem < b.GpuMem },
+	"reservedPrice": func(a, b types.ProductDetails) bool { return lowestReservedPrice(a) < lowestReservedPrice(b) },
+}
+
+// regionComparators holds the "less" functions of the region fields the region listings can be sorted by
+var regionComparators = map[string]func(a, b types.Region) bool{
+	"id":               func(a, b types.Region) bool { return a.ID < b.ID },
+	"name":             func(a, b types.Region) bool { return a.Name < b.Name },
+	"carbonIntensity":  func(a, b types.Region) bool { return carbonIntensity(a) < carbonIntensity(b) },
+	"carbonFreeEnergy": func(a, b types.Region) bool { return carbonFreeEnergy(a) < carbonFreeEnergy(b) },
+}
+
+// carbonIntensity returns the carbon intensity of the region, +Inf if not known
+func carbonIntensity(region types.Region) float64 {
+	if region.Carbon == nil {
+		return math.Inf(1)
+	}
+
+	return region.Carbon.Intensity
+}
+
+// carbonFreeEnergy returns the carbon-free energy percentage of the region, zero if not known
+func carbonFreeEnergy(region types.Region) float64 {
+	if region.Carbon == nil {
+		return 0
+	}
+
+	return region.Carbon.CarbonFreeEnergy
+}
+
+// imageComparators holds the "less" functions of the image fields the image listings can be sorted by
+var imageComparators = map[string]func(a, b types.Image) bool{
+	"name":         func(a, b types.Image) bool { return a.Name < b.Name },
+	"version":      func(a, b types.Image) bool { return a.Version < b.Version },
+	"creationDate": func(a, b types.Image) bool { return a.CreationDate.Before(b.CreationDate) },
+}
+
+// versionComparators holds the "less" functions of the version fields the version listings can be sorted by
+var versionComparators = map[string]func(a, b types.LocationVersion) bool{
+	"location": func(a, b types.LocationVersion) bool { return a.Location < b.Location },
+}
+
+// serviceComparators holds the "less" functions of the service fields the service listings can be sorted by
+var serviceComparators = map[string]func(a, b types.Service) bool{
+	"service": func(a, b types.Service) bool { return a.Service < b.Service },
+}
+
+// providerComparators holds the "less" functions of the provider fields the provider listings can be sorted by
+var providerComparators = map[string]func(a, b types.Provider) bool{
+	"provider": func(a, b types.Provider) bool { return a.Provider < b.Provider },
+}
+
+// parseSort splits a sort expression (eg.: "-onDemandPrice") into the field name and the direction
+// the "+" prefix arrives as a space if it's not url encoded in the query string
+func parseSort(expr string) (string, bool) {
+	expr = strings.TrimSpace(expr)
+	if strings.HasPrefix(expr, "-") {
+		return strings.TrimPrefix(expr, "-"), true
+	}
+
+	return strings.TrimPrefix(expr, "+"), false
+}
+
+// resolveSort determines the field and direction a listing is sorted by: the requested sort expression,
+// the configured default if the listing supports its field, or the default field of the listing
+func (r *RouteHandler) resolveSort(expr string, supported func(field string) bool, defaultField string) (string, bool, error) {
+	if strings.TrimSpace(expr) == "" {
+		expr = defaultField
+		if field, _ := parseSort(r.config.DefaultSort); field != "" && supported(field) {
+			expr = r.config.DefaultSort
+		}
+	}
+
+	field, desc := parseSort(expr)
+	if !supported(field) {
+		return "", false, errors.NewWithDetails("unsupported sort field", "field", field)
+	}
+
+	return field, desc, nil
+}
+
+// sortListing sorts a listing with the less function (reversed for descending order),
+// items with equal values are ordered by the tie breaker to keep the response deterministic
+func sortListing(listing interface{}, less func(i, j int) bool, tieBreaker func(i, j int) bool, desc bool) {
+	sort.SliceStable(listing, func(i, j int) bool {
+		a, b := i, j
+		if desc {
+			a, b = j, i
+		}
+
+		if less(a, b) {
+			return true
+		}
+		if less(b, a) {
+			return false
+		}
+
+		return tieBreaker(i, j)
+	})
+}
+
+// sortProducts sorts the products by the given sort expression, falling back to the configured default, in the given
+// order (asc or desc) if any; products with equal values are ordered by their type
+func (r *RouteHandler) sortProducts(products []types.ProductDetails, expr string, order string) error {
+	field, desc, err := r.resolveSort(expr, func(field string) bool { return productComparators[field] != nil }, defaultSortField)
+	if err != nil {
+		return err
+	}
+
+	if order != "" {
+		if desc, err = cloudinfo.ParseSortOrder(order); err != nil {
+			return err
+		}
+	}
+
+	less := productComparators[field]
+	sortListing(products,
+		func(i, j int) bool { return less(products[i], products[j]) },
+		func(i, j int) bool { return products[i].Type < products[j].Type },
+		desc)
+
+	return nil
+}
+
+// sortRegions sorts the regions by the given sort expression, regions with equal values are ordered by their id
+func (r *RouteHandler) sortRegions(regions []types.Region, expr string) error {
+	field, desc, err := r.resolveSort(expr, func(field string) bool { return regionComparators[field] != nil }, "id")
+	if err != nil {
+		return err
+	}
+
+	less := regionComparators[field]
+	sortListing(regions,
+		func(i, j int) bool { return less(regions[i], regions[j]) },
+		func(i, j int) bool { return regions[i].ID < regions[j].ID },
+		desc)
+
+	return nil
+}
+
+// sortImages sorts the images by the given sort expression, images with equal values are ordered by their name
+func (r *RouteHandler) sortImages(images []types.Image, expr string) error {
+	field, desc, err := r.resolveSort(expr, func(field string) bool { return imageComparators[field] != nil }, "name")
+	if err != nil {
+		return err
+	}
+
+	less := imageComparators[field]
+	sortListing(images,
+		func(i, j int) bool { return less(images[i], images[j]) },
+		func(i, j int) bool { return images[i].Name < images[j].Name },
+		desc)
+
+	return nil
+}
+
+// sortVersions sorts the location versions by the given sort expression
+func (r *RouteHandler) sortVersions(versions []types.LocationVersion, expr string) error {
+	field, desc, err := r.resolveSort(expr, func(field string) bool { return versionComparators[field] != nil }, "location")
+	if err != nil {
+		return err
+	}
+
+	less := versionComparators[field]
+	sortListing(versions,
+		func(i, j int) bool { return less(versions[i], versions[j]) },
+		func(i, j int) bool { return versions[i].Location < versions[j].Location },
+		desc)
+
+	return nil
+}
+
+// sortServices sorts the services by the given sort expression
+func (r *RouteHandler) sortServices(services []types.Service, expr string) error {
+	field, desc, err := r.resolveSort(expr, func(field string) bool { return serviceComparators[field] != nil }, "service")
+	if err != nil {
+		return err
+	}
+
+	less := serviceComparators[field]
+	sortListing(services,
+		func(i, j int) bool { return less(services[i], services[j]) },
+		func(i, j int) bool { return services[i].Service < services[j].Service },
+		desc)
+
+	return nil
+}
+
+// sortProviders sorts the providers by the given sort expression
+func (r *RouteHandler) sortProviders(providers []types.Provider, expr string) error {
+	field, desc, err := r.resolveSort(expr, func(field string) bool { return providerComparators[field] != nil }, "provider")
+	if err != nil {
+		return err
+	}
+
+	less := providerComparators[field]
+	sortListing(providers,
+		func(i, j int) bool { return less(providers[i], providers[j]) },
+		func(i, j int) bool { return providers[i].Provider < providers[j].Provider },
+		desc)
+
+	return nil
+}
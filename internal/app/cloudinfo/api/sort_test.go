@@ -0,0 +1,162 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestRouteHandler_SortProducts(t *testing.T) {
+	products := func() []types.ProductDetails {
+		return []types.ProductDetails{
+			{VMInfo: types.VMInfo{Type: "m5.large", OnDemandPrice: 0.096}},
+			{VMInfo: types.VMInfo{Type: "c5.large", OnDemandPrice: 0.085}},
+			{VMInfo: types.VMInfo{Type: "t3.large", OnDemandPrice: 0.0832}},
+			{VMInfo: types.VMInfo{Type: "a1.large", OnDemandPrice: 0.096}},
+		}
+	}
+	typesOf := func(products []types.ProductDetails) []string {
+		var instanceTypes []string
+		for _, product := range products {
+			instanceTypes = append(instanceTypes, product.Type)
+		}
+		return instanceTypes
+	}
+
+	tests := []struct {
+		name        string
+		defaultSort string
+		sort        string
+		order       string
+		check       func(products []types.ProductDetails, err error)
+	}{
+		{
+			name: "products are sorted by type if no sort order is configured or requested",
+			check: func(products []types.ProductDetails, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Equal(t, []string{"a1.large", "c5.large", "m5.large", "t3.large"}, typesOf(products))
+			},
+		},
+		{
+			name:        "products are sorted by the configured default",
+			defaultSort: "onDemandPrice",
+			check: func(products []types.ProductDetails, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Equal(t, []string{"t3.large", "c5.large", "a1.large", "m5.large"}, typesOf(products))
+			},
+		},
+		{
+			name:        "requested sort order overrides the configured default",
+			defaultSort: "onDemandPrice",
+			sort:        "-onDemandPrice",
+			check: func(products []types.ProductDetails, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Equal(t, []string{"a1.large", "m5.large", "c5.large", "t3.large"}, typesOf(products))
+			},
+		},
+		{
+			name:  "requested order overrides the direction of the sort expression",
+			sort:  "-onDemandPrice",
+			order: "asc",
+			check: func(products []types.ProductDetails, err error) {
+				assert.Nil(t, err, "the error should be nil")
+				assert.Equal(t, []string{"t3.large", "c5.large", "a1.large", "m5.large"}, typesOf(products))
+			},
+		},
+		{
+			name:  "unsupported sort order",
+			sort:  "onDemandPrice",
+			order: "random",
+			check: func(products []types.ProductDetails, err error) {
+				assert.EqualError(t, err, "unsupported sort order")
+			},
+		},
+		{
+			name: "unsupported sort field",
+			sort: "unsupported",
+			check: func(products []types.ProductDetails, err error) {
+				assert.EqualError(t, err, "unsupported sort field")
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := &RouteHandler{config: Config{DefaultSort: test.defaultSort}}
+			details := products()
+			test.check(details, r.sortProducts(details, test.sort, test.order))
+		})
+	}
+}
+
+func TestParseSort(t *testing.T) {
+	tests := []struct {
+		expr  string
+		field string
+		desc  bool
+	}{
+		{expr: "onDemandPrice", field: "onDemandPrice"},
+		{expr: "-onDemandPrice", field: "onDemandPrice", desc: true},
+		{expr: "+onDemandPrice", field: "onDemandPrice"},
+		{expr: " onDemandPrice", field: "onDemandPrice"},
+	}
+	for _, test := range tests {
+		t.Run(test.expr, func(t *testing.T) {
+			field, desc := parseSort(test.expr)
+			assert.Equal(t, test.field, field)
+			assert.Equal(t, test.desc, desc)
+		})
+	}
+}
+
+func TestRouteHandler_SortRegions(t *testing.T) {
+	regions := func() []types.Region {
+		return []types.Region{
+			{ID: "eu-west-1", Name: "EU (Ireland)", Carbon: &types.RegionCarbon{Intensity: 300, CarbonFreeEnergy: 60}},
+			{ID: "ap-south-1", Name: "Asia Pacific (Mumbai)", Carbon: &types.RegionCarbon{Intensity: 650}},
+			{ID: "us-east-1", Name: "US East (N. Virginia)"},
+		}
+	}
+	idsOf := func(regions []types.Region) []string {
+		var ids []string
+		for _, region := range regions {
+			ids = append(ids, region.ID)
+		}
+		return ids
+	}
+
+	r := &RouteHandler{config: Config{DefaultSort: "-onDemandPrice"}}
+
+	sorted := regions()
+	assert.NoError(t, r.sortRegions(sorted, ""))
+	assert.Equal(t, []string{"ap-south-1", "eu-west-1", "us-east-1"}, idsOf(sorted), "unsupported default should fall back to the id")
+
+	sorted = regions()
+	assert.NoError(t, r.sortRegions(sorted, "-name"))
+	assert.Equal(t, []string{"us-east-1", "eu-west-1", "ap-south-1"}, idsOf(sorted))
+
+	sorted = regions()
+	assert.NoError(t, r.sortRegions(sorted, "carbonIntensity"))
+	assert.Equal(t, []string{"eu-west-1", "ap-south-1", "us-east-1"}, idsOf(sorted), "the regions without carbon data should come last")
+
+	sorted = regions()
+	assert.NoError(t, r.sortRegions(sorted, "-carbonFreeEnergy"))
+	assert.Equal(t, []string{"eu-west-1", "ap-south-1", "us-east-1"}, idsOf(sorted))
+
+	assert.EqualError(t, r.sortRegions(regions(), "onDemandPrice"), "unsupported sort field")
+}
@@ -15,6 +15,8 @@
 package api
 
 import (
+	"time"
+
 	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
 )
 
@@ -34,13 +36,29 @@ type GetServicesPathParams struct {
 }
 
 // GetRegionPathParams is a placeholder for the regions related route path parameters
-// swagger:parameters getRegion getImages getProducts getVersions
+// swagger:parameters getRegion getImages getProducts getVersions getZones getAccelerators getServerlessPrices
 type GetRegionPathParams struct {
 	GetServicesPathParams `binding:"required" mapstructure:",squash"`
 	// in:path
 	Region string `binding:"required,region" json:"region"`
 }
 
+// GetZonePathParams is a placeholder for the zone scoped product route's path parameters
+// swagger:parameters getZoneProducts
+type GetZonePathParams struct {
+	GetRegionPathParams `binding:"required" mapstructure:",squash"`
+	// in:path
+	Zone string `binding:"required" json:"zone"`
+}
+
+// GetStoragePathParams is a placeholder for the regional storage, network, database and dedicated host route path parameters
+// swagger:parameters getStoragePrices getObjectStoragePrices getNetworkPrices getLoadBalancerPrices getNatGatewayPrices getPublicIpPrices getDatabases getDedicatedHosts
+type GetStoragePathParams struct {
+	GetProviderPathParams `binding:"required" mapstructure:",squash"`
+	// in:path
+	Region string `binding:"required,storageRegion" json:"region"`
+}
+
 // GetAttributeValuesPathParams is a placeholder for the get attribute values route's path parameters
 // swagger:parameters getAttrValues
 type GetAttributeValuesPathParams struct {
@@ -64,6 +82,178 @@ type GetImagesQueryParams struct {
 	PkeVersion string `json:"pkeVersion,omitempty"`
 	// in:query
 	LatestOnly string `json:"latestOnly"`
+	// in:query
+	Sort string `json:"sort"`
+}
+
+// GetListingQueryParams is a placeholder for the query parameters of the listings
+// swagger:parameters getProviders getServices getContinentsData getVersions
+type GetListingQueryParams struct {
+	// in:query
+	Sort string `json:"sort"`
+}
+
+// GetFormatQueryParams is a placeholder for the query parameters of the listings available as CSV and NDJSON
+// swagger:parameters getRegions getZones getProducts getZoneProducts getSpotPriceHistory getOnDemandPriceHistory
+type GetFormatQueryParams struct {
+	// the format of the response: json, csv, ndjson or protobuf (for the products and the spot price history), negotiated with the Accept header if empty
+	// in:query
+	Format string `json:"format"`
+}
+
+// GetRegionsQueryParams is a placeholder for the get regions query parameters
+// swagger:parameters getRegions
+type GetRegionsQueryParams struct {
+	GetListingQueryParams `mapstructure:",squash"`
+	GetFormatQueryParams  `mapstructure:",squash"`
+	// the continent of the regions to keep, eg.: europe, north-america
+	// in:query
+	Continent string `json:"continent"`
+	// the ISO 3166-1 alpha-2 code of the country of the regions to keep, eg.: DE
+	// in:query
+	Country string `json:"country"`
+	// the comma separated compliance scopes required from the regions to keep, eg.: hipaa,c5
+	// in:query
+	Compliance string `json:"compliance"`
+}
+
+// GetProductDetailsQueryParams is a placeholder for the get products query parameters
+// swagger:parameters getProducts getZoneProducts
+type GetProductDetailsQueryParams struct {
+	GetFormatQueryParams `mapstructure:",squash"`
+	// in:query
+	Burstable string `json:"burstable"`
+	// keep the bare metal (true) or the virtual (false) instance types only
+	// in:query
+	BareMetal string `json:"bareMetal"`
+	// keep the instance types supporting (true) or not supporting (false) accelerated networking (ENA, SR-IOV)
+	// in:query
+	AcceleratedNetworking string `json:"acceleratedNetworking"`
+	// keep the instance types supporting (true) or not supporting (false) IPv6
+	// in:query
+	IPv6 string `json:"ipv6"`
+	// the minimum network bandwidth of the instance types in Gbps, the instance types of unknown bandwidth are left out
+	// in:query
+	MinBandwidth string `json:"minBandwidth"`
+	// the placement strategy supported by the products: cluster, spread or partition
+	// keep the instance types eligible (true) or not eligible (false) for the free tier of the provider
+	// in:query
+	FreeTier string `json:"freeTier"`
+	// in:query
+	PlacementGroup string `json:"placementGroup"`
+	// in:query
+	Workload string `json:"workload"`
+	// in:query
+	Partition string `json:"partition"`
+	// the zone the products are offered in, the products without zone information are offered in all the zones
+	// in:query
+	Zone string `json:"zone"`
+	// the type of the local disks of the products: nvme, ssd, hdd or any
+	// in:query
+	LocalDisk string `json:"localDisk"`
+	// the cpu architecture of the products: amd64 or arm64
+	// in:query
+	Architecture string `json:"architecture"`
+	// in:query
+	GpuVendor string `json:"gpuVendor"`
+	// in:query
+	GpuModel string `json:"gpuModel"`
+	// the term of the reserved prices to keep, eg.: 1yr, 3yr; the products without such reservations are left out
+	// in:query
+	ReservedTerm string `json:"reservedTerm"`
+	// the payment option of the reserved prices to keep, eg.: No Upfront, Partial Upfront, All Upfront
+	// in:query
+	PaymentOption string `json:"paymentOption"`
+	// the operating system of the on demand prices: linux, windows, rhel or suse; the products without such prices are left out
+	// in:query
+	Os string `json:"os"`
+	// the ISO 4217 code of the currency to convert the prices to, eg.: EUR
+	// in:query
+	Currency string `json:"currency"`
+	// the minimum number of vCPUs of the products
+	// in:query
+	MinCpu string `json:"minCpu"`
+	// the maximum number of vCPUs of the products
+	// in:query
+	MaxCpu string `json:"maxCpu"`
+	// the minimum memory of the products in GB
+	// in:query
+	MinMemory string `json:"minMemory"`
+	// the maximum memory of the products in GB
+	// in:query
+	MaxMemory string `json:"maxMemory"`
+	// the number of GPUs of the products
+	// in:query
+	Gpu string `json:"gpu"`
+	// the maximum hourly on demand price of the products, in the currency and for the operating system requested
+	// in:query
+	MaxPricePerHour string `json:"maxPricePerHour"`
+	// in:query
+	Sort string `json:"sort"`
+	// the order of the sorted products: asc or desc, overrides the direction of the sort expression
+	// in:query
+	Order string `json:"order"`
+	// in:query
+	Debug string `json:"debug"`
+	// in:query
+	IncludeDerived string `json:"includeDerived"`
+	// the maximum number of products on a page, all the products are returned if empty
+	// in:query
+	Limit string `json:"limit"`
+	// the cursor of the page returned in the nextCursor field of the previous page, the first page if empty
+	// in:query
+	Cursor string `json:"cursor"`
+	// the comma separated list of the fields of the products to return, eg.: type,onDemandPrice,cpusPerVm; all if empty
+	// in:query
+	Fields string `json:"fields"`
+
+	// zoneSpotPrices narrows the spot prices of the products to the zone, set by the zone scoped route
+	zoneSpotPrices bool
+}
+
+// GetProductPathParams is a placeholder for the product related route path parameters
+// swagger:parameters getProduct getSpotPriceHistory getOnDemandPriceHistory getSavingsPlanRates
+type GetProductPathParams struct {
+	GetRegionPathParams `binding:"required" mapstructure:",squash"`
+	// in:path
+	Type string `binding:"required" json:"type"`
+}
+
+// GetSpotPriceHistoryQueryParams is a placeholder for the get spot and on demand price history query parameters
+// swagger:parameters getSpotPriceHistory getOnDemandPriceHistory
+type GetSpotPriceHistoryQueryParams struct {
+	GetFormatQueryParams `mapstructure:",squash"`
+	// the start of the time range in RFC 3339 format, defaults to the start of the history
+	// in:query
+	From string `json:"from"`
+	// the end of the time range in RFC 3339 format, defaults to now
+	// in:query
+	To string `json:"to"`
+}
+
+// GetSavingsPlanRatesQueryParams is a placeholder for the get savings plan rates query parameters
+// swagger:parameters getSavingsPlanRates
+type GetSavingsPlanRatesQueryParams struct {
+	// the length of the commitment: 1yr or 3yr, any if empty
+	// in:query
+	Term string `json:"term"`
+	// the payment option of the commitment, eg.: No Upfront, Partial Upfront, All Upfront
+	// in:query
+	PaymentOption string `json:"paymentOption"`
+	// the type of the savings plan: Compute or EC2Instance
+	// in:query
+	PlanType string `json:"planType"`
+}
+
+// GetDatabasesQueryParams is a placeholder for the get databases query parameters
+// swagger:parameters getDatabases
+type GetDatabasesQueryParams struct {
+	// the database engine, eg.: mysql, postgresql, mariadb
+	// in:query
+	Engine string `json:"engine"`
+	// the availability of the instances, single-zone or high-availability
+	// in:query
+	Deployment string `json:"deployment"`
 }
 
 // ProductDetailsResponse Api object to be mapped to product info response
@@ -73,6 +263,186 @@ type ProductDetailsResponse struct {
 	Products []types.ProductDetails `json:"products"`
 	// ScrapingTime represents scraping time for a given provider in milliseconds
 	ScrapingTime string `json:"scrapingTime"`
+	// Total the number of the products matching the query on all the pages
+	Total int `json:"total"`
+	// NextCursor the cursor of the next page, empty on the last page
+	NextCursor string `json:"nextCursor,omitempty"`
+}
+
+// GetProductQueryParams is a placeholder for the get product query parameters
+// swagger:parameters getProduct
+type GetProductQueryParams struct {
+	// the ISO 4217 code of the currency to convert the prices to, eg.: EUR
+	// in:query
+	Currency string `json:"currency"`
+}
+
+// ProductResponse holds the details of a single product
+// swagger:model ProductResponse
+type ProductResponse struct {
+	Product types.ProductDetails `json:"product"`
+	// ScrapingTime represents scraping time for a given provider in milliseconds
+	ScrapingTime string `json:"scrapingTime"`
+}
+
+// GetBatchProductsParams is a placeholder for the batch products request body
+// swagger:parameters getBatchProducts
+type GetBatchProductsParams struct {
+	// in:body
+	Body BatchProductsRequest
+}
+
+// BatchProductsRequest holds the regions to retrieve the products of in one request
+// swagger:model BatchProductsRequest
+type BatchProductsRequest struct {
+	// Regions the provider, service and region tuples to retrieve the products of
+	Regions []BatchProductsRegion `json:"regions"`
+}
+
+// BatchProductsRegion identifies a region of a provider's service
+type BatchProductsRegion struct {
+	Provider string `json:"provider"`
+	Service  string `json:"service"`
+	Region   string `json:"region"`
+}
+
+// BatchProductsResponse holds the products of the requested regions, in the order of the request
+// swagger:model BatchProductsResponse
+type BatchProductsResponse struct {
+	Results []BatchProductsResult `json:"results"`
+}
+
+// BatchProductsResult holds the products of a region of a batch products request
+type BatchProductsResult struct {
+	Provider string `json:"provider"`
+	Service  string `json:"service"`
+	Region   string `json:"region"`
+	// Products the products of the region, missing if they could not be retrieved
+	Products []types.ProductDetails `json:"products,omitempty"`
+	// ScrapingTime represents scraping time for the provider in milliseconds
+	ScrapingTime string `json:"scrapingTime,omitempty"`
+	// Error the reason the products of the region could not be retrieved
+	Error string `json:"error,omitempty"`
+}
+
+// GetComparisonQueryParams is a placeholder for the comparison query parameters
+// swagger:parameters getComparison
+type GetComparisonQueryParams struct {
+	// the number of vCPUs to match
+	// in:query
+	Cpu string `json:"cpu"`
+	// the memory to match in GB
+	// in:query
+	Memory string `json:"memory"`
+	// the service of the providers to compare, compute by default
+	// in:query
+	Service string `json:"service"`
+	// the continent of the regions to compare, eg.: europe, north-america
+	// in:query
+	Continent string `json:"continent"`
+	// the comma separated ids of the regions to compare
+	// in:query
+	Regions string `json:"regions"`
+}
+
+// ComparisonResponse holds the closest matching instance type of each provider, the cheapest first
+// swagger:model ComparisonResponse
+type ComparisonResponse []ProviderMatch
+
+// ProviderMatch holds the closest matching instance type of a provider in the compared regions
+type ProviderMatch struct {
+	Provider      string  `json:"provider"`
+	Region        string  `json:"region"`
+	Type          string  `json:"type"`
+	Cpus          float64 `json:"cpusPerVm"`
+	Mem           float64 `json:"memPerVm"`
+	OnDemandPrice float64 `json:"onDemandPrice"`
+}
+
+// GetRecommendationsQueryParams is a placeholder for the recommendations query parameters
+// swagger:parameters getRecommendations
+type GetRecommendationsQueryParams struct {
+	// the minimum number of vCPUs of the instance types
+	// in:query
+	Cpu string `json:"cpu"`
+	// the minimum memory of the instance types in GB
+	// in:query
+	Memory string `json:"memory"`
+	// the minimum number of GPUs of the instance types
+	// in:query
+	Gpu string `json:"gpu"`
+	// the price to rank the instance types by: ondemand (default) or spot
+	// in:query
+	Lifecycle string `json:"lifecycle"`
+	// the service of the providers to recommend the instance types of, compute by default
+	// in:query
+	Service string `json:"service"`
+	// the comma separated providers to recommend the instance types of, all of them by default
+	// in:query
+	Providers string `json:"providers"`
+	// the continent of the regions to recommend the instance types in, eg.: europe, north-america
+	// in:query
+	Continent string `json:"continent"`
+	// the comma separated ids of the regions to recommend the instance types in
+	// in:query
+	Regions string `json:"regions"`
+	// the maximum number of recommendations, 20 by default
+	// in:query
+	Limit string `json:"limit"`
+}
+
+// RecommendationsResponse holds the instance types matching the constraints, the cheapest first
+// swagger:model RecommendationsResponse
+type RecommendationsResponse []Recommendation
+
+// Recommendation holds an instance type matching the constraints in a region of a provider
+type Recommendation struct {
+	Provider string `json:"provider"`
+	Region   string `json:"region"`
+	// Zone the zone of the spot price, only set for the spot recommendations
+	Zone string  `json:"zone,omitempty"`
+	Type string  `json:"type"`
+	Cpus float64 `json:"cpusPerVm"`
+	Mem  float64 `json:"memPerVm"`
+	Gpus float64 `json:"gpusPerVm"`
+	// Price the hourly price the instance type is ranked by (on demand or spot)
+	Price         float64 `json:"price"`
+	OnDemandPrice float64 `json:"onDemandPrice"`
+}
+
+// GetSearchQueryParams is a placeholder for the search query parameters
+// swagger:parameters getSearch
+type GetSearchQueryParams struct {
+	// the text to search for in the instance type names, families and attribute values, eg.: m5.2xl
+	// in:query
+	// required:true
+	Q string `json:"q"`
+	// the service of the providers to search the instance types of, compute by default
+	// in:query
+	Service string `json:"service"`
+	// the maximum number of hits, 50 by default
+	// in:query
+	Limit string `json:"limit"`
+}
+
+// SearchResponse holds the instance types matching a search, the closest matches first
+// swagger:model SearchResponse
+type SearchResponse []SearchHit
+
+// SearchHit holds an instance type matching a search in a region of a provider
+type SearchHit struct {
+	// Kind what matched the search: instanceType, family or attribute
+	Kind         string `json:"kind"`
+	Provider     string `json:"provider"`
+	Service      string `json:"service"`
+	Region       string `json:"region"`
+	InstanceType string `json:"instanceType"`
+	// Field the name of the matching field: name, family or the name of the attribute
+	Field string `json:"field"`
+	// Value the matching value
+	Value string `json:"value"`
+	// Link the path of the products of the region
+	Link string `json:"link"`
 }
 
 // RegionsResponse holds the list of available regions of a cloud provider
@@ -89,6 +459,82 @@ type Continent struct {
 	Regions []types.Region `json:"regions"`
 }
 
+// ContinentRegionsResponse holds the regions of all the providers grouped by continent
+// swagger:model ContinentRegionsResponse
+type ContinentRegionsResponse []ContinentRegions
+
+// ContinentRegions holds a continent and the regions of all the providers on it
+type ContinentRegions struct {
+	Name    string           `json:"name"`
+	Regions []ProviderRegion `json:"regions"`
+}
+
+// ProviderRegion holds a region and the provider it belongs to
+type ProviderRegion struct {
+	Provider string `json:"provider"`
+	types.Region
+}
+
+// GetChangesQueryParams is a placeholder for the get changes query parameters
+// swagger:parameters getChanges
+type GetChangesQueryParams struct {
+	// the time in RFC 3339 format the products changed after are returned
+	// in:query
+	// required:true
+	Since string `json:"since"`
+	// the provider of the products, all the providers if empty
+	// in:query
+	Provider string `json:"provider"`
+}
+
+// GetFreshnessQueryParams is a placeholder for the get freshness query parameters
+// swagger:parameters getFreshness
+type GetFreshnessQueryParams struct {
+	// the provider to report on, all the providers if empty
+	// in:query
+	Provider string `json:"provider"`
+	// the maximum age of the data (eg.: 2h) the regions scraped earlier are reported stale after, no limit if empty
+	// in:query
+	MaxAge string `json:"maxAge"`
+}
+
+// FreshnessResponse holds the freshness of the data of the providers per service and region
+// swagger:model FreshnessResponse
+type FreshnessResponse struct {
+	Providers []ProviderFreshness `json:"providers"`
+}
+
+// ProviderFreshness holds the freshness of the data of a provider
+type ProviderFreshness struct {
+	Provider string `json:"provider"`
+	// ScrapedAt the time of the last completed scraping cycle of the provider, missing if it has never completed
+	ScrapedAt *time.Time         `json:"scrapedAt,omitempty"`
+	Services  []ServiceFreshness `json:"services"`
+}
+
+// ServiceFreshness holds the freshness of the data of the regions of a service
+type ServiceFreshness struct {
+	Service string            `json:"service"`
+	Regions []RegionFreshness `json:"regions"`
+}
+
+// RegionFreshness holds the freshness of the data of a region
+type RegionFreshness struct {
+	Region string `json:"region"`
+	// ScrapedAt the time of the last successful scrape of the region, missing if it has never been scraped
+	ScrapedAt *time.Time `json:"scrapedAt,omitempty"`
+	// DataAge the number of seconds elapsed since the last successful scrape, missing if it has never been scraped
+	DataAge *int64 `json:"dataAge,omitempty"`
+	// LastError the last failed scrape of the region, missing if it has never failed
+	LastError *types.ScrapeError `json:"lastError,omitempty"`
+	// Stale signals data that has never been scraped, failed to be scraped since or is older than the maxAge
+	Stale bool `json:"stale"`
+}
+
+// ProductChangesResponse holds the products changed after a point in time per provider, service and region
+// swagger:model ProductChangesResponse
+type ProductChangesResponse []types.ProductChanges
+
 // ContinentsResponse holds the list of available continents
 // swagger:model ContinentsResponse
 type ContinentsResponse []string
@@ -99,8 +545,43 @@ type GetRegionResp struct {
 	Id    string   `json:"id"`
 	Name  string   `json:"name"`
 	Zones []string `json:"zones"`
+	// the name of the location of the region, eg.: Frankfurt
+	DisplayName string `json:"displayName,omitempty"`
+	// the continent of the region, if known
+	Continent string `json:"continent,omitempty"`
+	// the ISO 3166-1 alpha-2 code of the country of the region, if known
+	Country string `json:"country,omitempty"`
+	// the approximate latitude of the region, if known
+	Latitude float64 `json:"latitude,omitempty"`
+	// the approximate longitude of the region, if known
+	Longitude float64 `json:"longitude,omitempty"`
+	// the carbon intensity of the electricity of the region, if known
+	Carbon *types.RegionCarbon `json:"carbon,omitempty"`
+	// the compliance scopes of the region, eg.: hipaa, fedramp-moderate, c5
+	Compliance []string `json:"compliance,omitempty"`
+}
+
+// ZonesResponse holds the availability zones of a region
+// swagger:model ZonesResponse
+type ZonesResponse []types.ZoneInfo
+
+// AcceleratorInfo describes an accelerator model (TPU, Inferentia, Trainium, FPGA) offered in a region
+type AcceleratorInfo struct {
+	Type   string `json:"type"`
+	Vendor string `json:"vendor,omitempty"`
+	Model  string `json:"model,omitempty"`
+	// Memory the memory of a single accelerator in GiB, if known
+	Memory float64 `json:"memory,omitempty"`
+	// Counts the numbers of accelerators the instance types come with
+	Counts        []int    `json:"counts"`
+	Zones         []string `json:"zones"`
+	InstanceTypes []string `json:"instanceTypes"`
 }
 
+// AcceleratorsResponse holds the accelerator models offered in a region
+// swagger:model AcceleratorsResponse
+type AcceleratorsResponse []AcceleratorInfo
+
 // AttributeResponse holds attribute values
 // swagger:model AttributeResponse
 type AttributeResponse struct {
@@ -140,6 +621,62 @@ type ImagesResponse []types.Image
 // swagger:model VersionsResponse
 type VersionsResponse []types.LocationVersion
 
+// SpotPriceHistoryResponse holds the spot price records of an instance type, ordered by time
+// swagger:model SpotPriceHistoryResponse
+type SpotPriceHistoryResponse []types.SpotPriceRecord
+
+// OnDemandPriceHistoryResponse holds the on demand price records of an instance type, ordered by time
+// swagger:model OnDemandPriceHistoryResponse
+type OnDemandPriceHistoryResponse []types.OnDemandPriceRecord
+
+// SavingsPlanRatesResponse holds the savings plans rates of an instance type matching the commitment
+// swagger:model SavingsPlanRatesResponse
+type SavingsPlanRatesResponse struct {
+	Type          string  `json:"type"`
+	OnDemandPrice float64 `json:"onDemandPrice"`
+	// EffectiveRate the lowest hourly rate of the matching commitments, missing if none matches
+	EffectiveRate *float64 `json:"effectiveRate,omitempty"`
+	// Savings the savings of the effective rate compared to the on demand price in percent
+	Savings *float64                 `json:"savings,omitempty"`
+	Rates   []types.SavingsPlanPrice `json:"rates"`
+}
+
+// StoragePricesResponse holds the block storage prices in a region
+// swagger:model StoragePricesResponse
+type StoragePricesResponse []types.StoragePrice
+
+// ObjectStoragePricesResponse holds the object storage prices in a region
+// swagger:model ObjectStoragePricesResponse
+type ObjectStoragePricesResponse []types.ObjectStoragePrice
+
+// NetworkPricesResponse holds the network traffic prices in a region
+// swagger:model NetworkPricesResponse
+type NetworkPricesResponse []types.NetworkPrice
+
+// LoadBalancerPricesResponse holds the load balancer prices in a region
+// swagger:model LoadBalancerPricesResponse
+type LoadBalancerPricesResponse []types.LoadBalancerPrice
+
+// NatGatewayPricesResponse holds the NAT gateway prices in a region
+// swagger:model NatGatewayPricesResponse
+type NatGatewayPricesResponse []types.NatGatewayPrice
+
+// PublicIpPricesResponse holds the public IP address prices in a region
+// swagger:model PublicIpPricesResponse
+type PublicIpPricesResponse []types.PublicIpPrice
+
+// DatabasesResponse holds the managed database instance classes in a region
+// swagger:model DatabasesResponse
+type DatabasesResponse []types.DatabaseInfo
+
+// DedicatedHostsResponse holds the dedicated host types in a region
+// swagger:model DedicatedHostsResponse
+type DedicatedHostsResponse []types.DedicatedHostInfo
+
+// ServerlessPricesResponse holds the serverless container prices in a region
+// swagger:model ServerlessPricesResponse
+type ServerlessPricesResponse []types.ServerlessPrice
+
 // NewServiceResponse assembles a service response
 func NewServiceResponse(sd types.Service) ServiceResponse {
 	return ServiceResponse{
@@ -0,0 +1,362 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"net/http"
+	"sort"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/gin-gonic/gin"
+	"github.com/mitchellh/mapstructure"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/currency"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+	"github.com/banzaicloud/cloudinfo/internal/platform/log"
+)
+
+// apiVersionV2 the version of the API served under /api/v2
+const apiVersionV2 = "v2"
+
+const (
+	// priceTypeOnDemand the pay as you go price of the instance type
+	priceTypeOnDemand = "onDemand"
+	// priceTypeSpot the spot (preemptible) price of the instance type in a zone
+	priceTypeSpot = "spot"
+	// priceTypeReserved the price of the instance type reserved for a term
+	priceTypeReserved = "reserved"
+	// priceTypeSavingsPlan the rate of the instance type covered by a savings plan commitment
+	priceTypeSavingsPlan = "savingsPlan"
+)
+
+// ProductV2 is the product model of the v2 API: the dimensions of the instance type are grouped instead of flattened
+// and all of its prices are listed as terms
+type ProductV2 struct {
+	Type         string              `json:"type"`
+	Category     string              `json:"category"`
+	Architecture string              `json:"architecture,omitempty"`
+	CurrentGen   bool                `json:"currentGen"`
+	Lifecycle    string              `json:"lifecycle,omitempty"`
+	BareMetal    bool                `json:"bareMetal"`
+	FreeTier     bool                `json:"freeTier"`
+	CPU          CPUV2               `json:"cpu"`
+	Memory       MemoryV2            `json:"memory"`
+	GPU          *GPUV2              `json:"gpu,omitempty"`
+	Accelerators []types.Accelerator `json:"accelerators,omitempty"`
+	Network      NetworkV2           `json:"network"`
+	LocalDisks   []types.LocalDisk   `json:"localDisks,omitempty"`
+	Zones        []string            `json:"zones"`
+	Prices       PricesV2            `json:"prices"`
+	Workloads    []string            `json:"workloads,omitempty"`
+	Attributes   map[string]string   `json:"attributes,omitempty"`
+	UpdatedAt    time.Time           `json:"updatedAt"`
+}
+
+// CPUV2 describes the vCPUs of an instance type
+type CPUV2 struct {
+	// Count the number of vCPUs
+	Count float64 `json:"count"`
+	// Burst signals burstable (shared or credit based) cpu performance
+	Burst bool `json:"burst"`
+	// Baseline the sustained performance of a burstable instance type in percent of a vCPU, if known
+	Baseline float64 `json:"baseline,omitempty"`
+	// Credits the cpu credit model of a burstable instance type, if known
+	Credits *types.CpuCredits `json:"credits,omitempty"`
+}
+
+// MemoryV2 describes the memory of an instance type
+type MemoryV2 struct {
+	// Size the size of the memory in GiB
+	Size float64 `json:"size"`
+}
+
+// GPUV2 describes the GPUs of an instance type
+type GPUV2 struct {
+	// Count the number of GPUs
+	Count float64 `json:"count"`
+	// Vendor the vendor of the GPUs, eg.: nvidia, if known
+	Vendor string `json:"vendor,omitempty"`
+	// Model the model of the GPUs, eg.: Tesla V100, if known
+	Model string `json:"model,omitempty"`
+	// Memory the memory of the GPUs altogether in GiB, if known
+	Memory float64 `json:"memory,omitempty"`
+}
+
+// NetworkV2 describes the networking of an instance type
+type NetworkV2 struct {
+	// Performance the network performance as advertised by the provider, eg.: Up to 10 Gigabit
+	Performance string `json:"performance"`
+	// Category the network performance category: low, medium, high or extra
+	Category string `json:"category"`
+	// Bandwidth the (maximum) network performance in Gbps, if known
+	Bandwidth float64 `json:"bandwidth,omitempty"`
+	// Interfaces the number of network interfaces, if known
+	Interfaces int `json:"interfaces,omitempty"`
+	// Limits the network interface and private IP address limits, if known
+	Limits                *types.NetworkLimits `json:"limits,omitempty"`
+	AcceleratedNetworking bool                 `json:"acceleratedNetworking"`
+	IPv6                  bool                 `json:"ipv6"`
+}
+
+// PricesV2 holds all the prices of an instance type in the same currency
+type PricesV2 struct {
+	// Currency the ISO 4217 code of the currency of the prices
+	Currency string `json:"currency"`
+	// Monthly the monthly price (cap), only for the providers billing monthly
+	Monthly float64 `json:"monthly,omitempty"`
+	// EffectiveMonthly the price of a whole month of usage with the sustained use discounts applied, if discounted
+	EffectiveMonthly float64 `json:"effectiveMonthly,omitempty"`
+	// Terms the on demand, spot, reserved and savings plan prices
+	Terms []PriceTermV2 `json:"terms"`
+}
+
+// PriceTermV2 is a price of an instance type, the hourly prices of the terms are comparable to each other
+type PriceTermV2 struct {
+	// Type the pricing of the term: onDemand, spot, reserved or savingsPlan
+	Type string `json:"type"`
+	// Os the operating system the on demand price includes the license fee of, empty for the base price
+	Os string `json:"os,omitempty"`
+	// Zone the availability zone of the spot price
+	Zone string `json:"zone,omitempty"`
+	// Term the length of the reservation or the commitment, eg.: 1yr, 3yr
+	Term string `json:"term,omitempty"`
+	// PaymentOption the way the reservation or the commitment is paid for, eg.: No Upfront
+	PaymentOption string `json:"paymentOption,omitempty"`
+	// PlanType the type of the savings plan, eg.: Compute, EC2Instance
+	PlanType string `json:"planType,omitempty"`
+	// Upfront the price paid at the start of the reservation
+	Upfront float64 `json:"upfront,omitempty"`
+	// Hourly the hourly price, with the upfront price of a reservation spread over its term
+	Hourly float64 `json:"hourly"`
+}
+
+// ProductsResponseV2 is the response of the products of a region of the v2 API
+type ProductsResponseV2 struct {
+	APIVersion string      `json:"apiVersion"`
+	Products   []ProductV2 `json:"products"`
+	// ScrapingTime the time of the last scrape of the provider in milliseconds
+	ScrapingTime string `json:"scrapingTime"`
+	// Total the number of the products matching the query on all the pages
+	Total int `json:"total"`
+	// NextCursor the cursor of the next page, empty on the last page
+	NextCursor string `json:"nextCursor,omitempty"`
+}
+
+// ProductResponseV2 is the response of a single product of the v2 API
+type ProductResponseV2 struct {
+	APIVersion string    `json:"apiVersion"`
+	Product    ProductV2 `json:"product"`
+	// ScrapingTime the time of the last scrape of the provider in milliseconds
+	ScrapingTime string `json:"scrapingTime"`
+}
+
+// newProductV2 converts a product to the v2 model
+func newProductV2(product types.ProductDetails) ProductV2 {
+	p := ProductV2{
+		Type:         product.Type,
+		Category:     product.Category,
+		Architecture: product.Architecture,
+		CurrentGen:   product.CurrentGen,
+		Lifecycle:    product.Lifecycle,
+		BareMetal:    product.BareMetal,
+		FreeTier:     product.FreeTier,
+		CPU: CPUV2{
+			Count:    product.Cpus,
+			Burst:    product.Burst,
+			Baseline: product.BaselineCPU,
+			Credits:  product.CpuCredits,
+		},
+		Memory:       MemoryV2{Size: product.Mem},
+		Accelerators: product.Accelerators,
+		Network: NetworkV2{
+			Performance:           product.NtwPerf,
+			Category:              product.NtwPerfCat,
+			Bandwidth:             product.NtwPerfGbps,
+			Interfaces:            product.NICs,
+			Limits:                product.NetworkLimits,
+			AcceleratedNetworking: product.AcceleratedNetworking,
+			IPv6:                  product.IPv6,
+		},
+		LocalDisks: product.LocalDisks,
+		Zones:      product.Zones,
+		Prices:     newPricesV2(product),
+		Workloads:  product.Workloads,
+		Attributes: product.Attributes,
+		UpdatedAt:  product.UpdatedAt,
+	}
+
+	if product.Gpus > 0 {
+		p.GPU = &GPUV2{
+			Count:  product.Gpus,
+			Vendor: product.GpuVendor,
+			Model:  product.GpuModel,
+			Memory: product.GpuMem,
+		}
+	}
+
+	if p.Zones == nil {
+		p.Zones = []string{}
+	}
+
+	return p
+}
+
+// newPricesV2 lists the prices of a product as terms: the on demand prices (the base and the per operating system ones),
+// the spot prices per zone, the reserved prices and the savings plan rates
+func newPricesV2(product types.ProductDetails) PricesV2 {
+	prices := PricesV2{
+		Currency:         product.Currency,
+		Monthly:          product.MonthlyPrice,
+		EffectiveMonthly: product.EffectiveMonthlyPrice,
+		Terms:            []PriceTermV2{{Type: priceTypeOnDemand, Hourly: product.OnDemandPrice}},
+	}
+	if prices.Currency == "" {
+		prices.Currency = currency.USD
+	}
+
+	oss := make([]string, 0, len(product.OsPrices))
+	for os := range product.OsPrices {
+		oss = append(oss, os)
+	}
+	sort.Strings(oss)
+	for _, os := range oss {
+		prices.Terms = append(prices.Terms, PriceTermV2{Type: priceTypeOnDemand, Os: os, Hourly: product.OsPrices[os]})
+	}
+
+	for _, spot := range product.SpotPrice {
+		prices.Terms = append(prices.Terms, PriceTermV2{Type: priceTypeSpot, Zone: spot.Zone, Hourly: spot.Price})
+	}
+
+	for _, reserved := range product.ReservedPrices {
+		prices.Terms = append(prices.Terms, PriceTermV2{
+			Type:          priceTypeReserved,
+			Term:          reserved.Term,
+			PaymentOption: reserved.PaymentOption,
+			Upfront:       reserved.UpfrontPrice,
+			Hourly:        reserved.EffectiveHourlyPrice,
+		})
+	}
+
+	for _, plan := range product.SavingsPlans {
+		prices.Terms = append(prices.Terms, PriceTermV2{
+			Type:          priceTypeSavingsPlan,
+			Term:          plan.Term,
+			PaymentOption: plan.PaymentOption,
+			PlanType:      plan.PlanType,
+			Hourly:        plan.Rate,
+		})
+	}
+
+	return prices
+}
+
+// getProductsV2 serves the products of a region in the v2 model, filtered, sorted and paged like the v1 products
+func (r *RouteHandler) getProductsV2() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		pathParams := GetRegionPathParams{}
+		if err := mapstructure.Decode(getPathParamMap(c), &pathParams); err != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
+			return
+		}
+		queryParams := GetProductDetailsQueryParams{}
+		if err := mapstructure.Decode(getQueryAsMap(c), &queryParams); err != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
+			return
+		}
+
+		if ve := ValidatePathData(pathParams); ve != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(ve, "validation"))
+			return
+		}
+
+		logger := log.WithFieldsForHandlers(c, r.log, map[string]interface{}{"provider": pathParams.Provider,
+			"service": pathParams.Service, "region": pathParams.Region, "apiVersion": apiVersionV2})
+		logger.Info("getting product details")
+
+		scrapingTime, err := r.prod.GetStatus(pathParams.Provider)
+		if err != nil {
+			r.errorResponder.Respond(c, errors.WrapIfWithDetails(err, "failed to retrieve status",
+				"provider", pathParams.Provider))
+			return
+		}
+
+		details, page, err := r.queryProducts(pathParams, queryParams)
+		if err != nil {
+			r.errorResponder.Respond(c, err)
+			return
+		}
+
+		products := make([]ProductV2, 0, page.End-page.Start)
+		for _, product := range details[page.Start:page.End] {
+			products = append(products, newProductV2(product))
+		}
+
+		logger.Debug("successfully retrieved product details")
+		c.JSON(http.StatusOK, ProductsResponseV2{
+			APIVersion:   apiVersionV2,
+			Products:     products,
+			ScrapingTime: scrapingTime,
+			Total:        len(details),
+			NextCursor:   page.NextCursor,
+		})
+	}
+}
+
+// getProductV2 serves a single product of a region in the v2 model
+func (r *RouteHandler) getProductV2() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		pathParams := GetProductPathParams{}
+		if err := mapstructure.Decode(getPathParamMap(c), &pathParams); err != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
+			return
+		}
+
+		if ve := ValidatePathData(pathParams); ve != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(ve, "validation"))
+			return
+		}
+
+		queryParams := GetProductQueryParams{}
+		if err := mapstructure.Decode(getQueryAsMap(c), &queryParams); err != nil {
+			r.errorResponder.Respond(c, errors.WithDetails(err, "validation"))
+			return
+		}
+
+		logger := log.WithFieldsForHandlers(c, r.log, map[string]interface{}{"provider": pathParams.Provider,
+			"service": pathParams.Service, "region": pathParams.Region, "type": pathParams.Type, "apiVersion": apiVersionV2})
+		logger.Info("getting product")
+
+		scrapingTime, err := r.prod.GetStatus(pathParams.Provider)
+		if err != nil {
+			r.errorResponder.Respond(c, errors.WrapIfWithDetails(err, "failed to retrieve status",
+				"provider", pathParams.Provider))
+			return
+		}
+
+		product, err := r.lookupProduct(pathParams, queryParams.Currency)
+		if err != nil {
+			r.errorResponder.Respond(c, err)
+			return
+		}
+
+		logger.Debug("successfully retrieved product")
+		c.JSON(http.StatusOK, ProductResponseV2{
+			APIVersion:   apiVersionV2,
+			Product:      newProductV2(product),
+			ScrapingTime: scrapingTime,
+		})
+	}
+}
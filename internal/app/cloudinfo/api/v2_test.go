@@ -0,0 +1,99 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/currency"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestNewProductV2(t *testing.T) {
+	tests := []struct {
+		name    string
+		product types.ProductDetails
+		check   func(t *testing.T, product ProductV2)
+	}{
+		{
+			name: "the dimensions are grouped",
+			product: types.ProductDetails{VMInfo: types.VMInfo{
+				Type: "p3.2xlarge", Category: "GPU instance", Architecture: "amd64", Cpus: 8, Mem: 61,
+				Gpus: 1, GpuVendor: "nvidia", GpuModel: "Tesla V100", GpuMem: 16,
+				NtwPerf: "Up to 10 Gigabit", NtwPerfCat: "high", NtwPerfGbps: 10, NICs: 4,
+				Zones: []string{"eu-west-1a", "eu-west-1b"},
+			}},
+			check: func(t *testing.T, product ProductV2) {
+				assert.Equal(t, "p3.2xlarge", product.Type)
+				assert.Equal(t, "amd64", product.Architecture)
+				assert.Equal(t, CPUV2{Count: 8}, product.CPU)
+				assert.Equal(t, MemoryV2{Size: 61}, product.Memory)
+				assert.Equal(t, &GPUV2{Count: 1, Vendor: "nvidia", Model: "Tesla V100", Memory: 16}, product.GPU)
+				assert.Equal(t, NetworkV2{Performance: "Up to 10 Gigabit", Category: "high", Bandwidth: 10, Interfaces: 4}, product.Network)
+				assert.Equal(t, []string{"eu-west-1a", "eu-west-1b"}, product.Zones)
+			},
+		},
+		{
+			name:    "the products without GPUs and zones",
+			product: types.ProductDetails{VMInfo: types.VMInfo{Type: "t3.micro", Cpus: 2, Mem: 1, Burst: true}},
+			check: func(t *testing.T, product ProductV2) {
+				assert.Nil(t, product.GPU, "the GPUs should be omitted")
+				assert.Equal(t, []string{}, product.Zones, "the zones should be an empty list")
+				assert.True(t, product.CPU.Burst)
+			},
+		},
+		{
+			name: "the prices are listed as terms",
+			product: types.ProductDetails{VMInfo: types.VMInfo{
+				Type:          "m5.large",
+				OnDemandPrice: 0.096,
+				OsPrices:      map[string]float64{"windows": 0.188, "rhel": 0.156},
+				SpotPrice:     []types.ZonePrice{{Zone: "eu-west-1a", Price: 0.035}, {Zone: "eu-west-1b", Price: 0.037}},
+				ReservedPrices: []types.ReservedPrice{
+					{Term: "1yr", PaymentOption: types.PaymentAllUpfront, UpfrontPrice: 526, EffectiveHourlyPrice: 0.06},
+				},
+				SavingsPlans: []types.SavingsPlanPrice{
+					{PlanType: "Compute", Term: "3yr", PaymentOption: types.PaymentNoUpfront, Rate: 0.045},
+				},
+			}},
+			check: func(t *testing.T, product ProductV2) {
+				assert.Equal(t, currency.USD, product.Prices.Currency, "the currency should default to USD")
+				assert.Equal(t, []PriceTermV2{
+					{Type: priceTypeOnDemand, Hourly: 0.096},
+					{Type: priceTypeOnDemand, Os: "rhel", Hourly: 0.156},
+					{Type: priceTypeOnDemand, Os: "windows", Hourly: 0.188},
+					{Type: priceTypeSpot, Zone: "eu-west-1a", Hourly: 0.035},
+					{Type: priceTypeSpot, Zone: "eu-west-1b", Hourly: 0.037},
+					{Type: priceTypeReserved, Term: "1yr", PaymentOption: types.PaymentAllUpfront, Upfront: 526, Hourly: 0.06},
+					{Type: priceTypeSavingsPlan, Term: "3yr", PaymentOption: types.PaymentNoUpfront, PlanType: "Compute", Hourly: 0.045},
+				}, product.Prices.Terms)
+			},
+		},
+		{
+			name:    "the currency of the converted prices is kept",
+			product: types.ProductDetails{VMInfo: types.VMInfo{OnDemandPrice: 0.09, Currency: "EUR"}},
+			check: func(t *testing.T, product ProductV2) {
+				assert.Equal(t, "EUR", product.Prices.Currency)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.check(t, newProductV2(test.product))
+		})
+	}
+}
@@ -15,39 +15,204 @@
 package api
 
 import (
+	"strings"
+	"sync"
+	"time"
+
 	"emperror.dev/errors"
 	"github.com/gin-gonic/gin/binding"
 	"github.com/go-playground/validator/v10"
 
+	"github.com/banzaicloud/cloudinfo/internal/app/cloudinfo/messaging"
 	"github.com/banzaicloud/cloudinfo/internal/cloudinfo"
 	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
 )
 
 // ConfigureValidator configures the Gin validator with custom validator functions
-func ConfigureValidator(providers []string, ci types.CloudInfo, logger cloudinfo.Logger) error {
+func ConfigureValidator(providers []string, ci types.CloudInfo, eventBus messaging.EventBus, logger cloudinfo.Logger) error {
 	// retrieve the gin validator
 	v := binding.Validator.Engine().(*validator.Validate)
 
+	index := newValidationIndex(ci, logger)
+	if eventBus != nil {
+		index.subscribe(providers, eventBus)
+	}
+
 	// register validator for the provider parameter in the request path
 	if err := v.RegisterValidation("provider", providerValidator(providers)); err != nil {
 		return errors.Wrap(err, "could not register provider validator")
 	}
 
 	// register validator for the service parameter in the request path
-	if err := v.RegisterValidation("service", serviceValidator(ci, logger)); err != nil {
+	if err := v.RegisterValidation("service", serviceValidator(index)); err != nil {
 		return errors.Wrap(err, "could not register service validator")
 	}
 
 	// register validator for the region parameter in the request path
-	if err := v.RegisterValidation("region", regionValidator(ci, logger)); err != nil {
+	if err := v.RegisterValidation("region", regionValidator(index)); err != nil {
 		return errors.Wrap(err, "could not register region validator")
 	}
 
+	// register validator for the region parameter in the storage request paths
+	if err := v.RegisterValidation("storageRegion", storageRegionValidator(index)); err != nil {
+		return errors.Wrap(err, "could not register storage region validator")
+	}
+
 	return nil
 }
 
+const (
+	// indexFallbackInterval the minimum time between two store lookups for a provider that is not indexed yet
+	indexFallbackInterval = 10 * time.Second
+
+	// indexTTL the age of the index of a provider it is rebuilt from the store after, so the regions appearing and
+	// disappearing are seen without refresh events as well (eg. the scraping is disabled and the store is shared)
+	indexTTL = 5 * time.Minute
+)
+
+// validationIndex holds the valid provider / service / region combinations for constant time lookups
+// the index of a provider is rebuilt when its data gets refreshed or it gets older than indexTTL; the store is
+// consulted at most once per indexFallbackInterval while the provider is not indexed yet
+type validationIndex struct {
+	ci           types.CloudInfo
+	log          cloudinfo.Logger
+	services     map[string]map[string]bool
+	regions      map[string]map[string]bool
+	indexedAt    map[string]time.Time
+	lastFallback map[string]time.Time
+	now          func() time.Time
+	mu           sync.RWMutex
+}
+
+// newValidationIndex creates an empty validation index
+func newValidationIndex(ci types.CloudInfo, logger cloudinfo.Logger) *validationIndex {
+	return &validationIndex{
+		ci:           ci,
+		log:          logger.WithFields(map[string]interface{}{"component": "validation-index"}),
+		services:     make(map[string]map[string]bool),
+		regions:      make(map[string]map[string]bool),
+		indexedAt:    make(map[string]time.Time),
+		lastFallback: make(map[string]time.Time),
+		now:          time.Now,
+	}
+}
+
+// subscribe rebuilds the index of the providers when their data gets refreshed
+func (vi *validationIndex) subscribe(providers []string, eventBus messaging.EventBus) {
+	for _, provider := range providers {
+		provider := provider
+		eventBus.SubscribeScrapingComplete(provider, func() {
+			vi.refresh(provider)
+		})
+	}
+
+	eventBus.SubscribeRegionRefreshed(func(provider, service, region string) {
+		// index the already stored data first, so the other regions stay valid until the scraping completes
+		if !vi.indexed(provider) {
+			vi.refresh(provider)
+		}
+
+		vi.mu.Lock()
+		defer vi.mu.Unlock()
+
+		if vi.services[provider] == nil {
+			vi.services[provider] = make(map[string]bool)
+			vi.regions[provider] = make(map[string]bool)
+		}
+		vi.services[provider][service] = true
+		vi.regions[provider][indexKey(service, region)] = true
+	})
+}
+
+// refresh rebuilds the index of the given provider from the cloud info store
+func (vi *validationIndex) refresh(provider string) {
+	services := make(map[string]bool)
+	regions := make(map[string]bool)
+
+	storedServices, err := vi.ci.GetServices(provider)
+	if err != nil {
+		vi.log.Debug("services not yet cached, skip indexing", map[string]interface{}{"provider": provider})
+		return
+	}
+
+	for _, service := range storedServices {
+		services[service.ServiceName()] = true
+
+		storedRegions, err := vi.ci.GetRegions(provider, service.ServiceName())
+		if err != nil {
+			continue
+		}
+
+		for region := range storedRegions {
+			regions[indexKey(service.ServiceName(), region)] = true
+		}
+	}
+
+	vi.mu.Lock()
+	defer vi.mu.Unlock()
+
+	vi.services[provider] = services
+	vi.regions[provider] = regions
+	vi.indexedAt[provider] = vi.now()
+}
+
+// indexed checks whether the index of the provider has been built
+func (vi *validationIndex) indexed(provider string) bool {
+	vi.mu.RLock()
+	defer vi.mu.RUnlock()
+
+	return vi.services[provider] != nil
+}
+
+// fallback builds the index of a provider that is not indexed yet or indexed longer than indexTTL ago from the store
+// the store is consulted at most once per indexFallbackInterval, so invalid requests can't amplify into store lookups
+func (vi *validationIndex) fallback(provider string) {
+	vi.mu.RLock()
+	indexedAt, ok := vi.indexedAt[provider]
+	vi.mu.RUnlock()
+	if ok && vi.now().Sub(indexedAt) < indexTTL {
+		return
+	}
+
+	vi.mu.Lock()
+	now := vi.now()
+	if last, ok := vi.lastFallback[provider]; ok && now.Sub(last) < indexFallbackInterval {
+		vi.mu.Unlock()
+		return
+	}
+	vi.lastFallback[provider] = now
+	vi.mu.Unlock()
+
+	vi.refresh(provider)
+}
+
+// hasService checks whether the service is valid for the provider
+func (vi *validationIndex) hasService(provider, service string) bool {
+	vi.fallback(provider)
+
+	return vi.lookup(vi.services, provider, service)
+}
+
+// hasRegion checks whether the region is valid for the provider and service
+func (vi *validationIndex) hasRegion(provider, service, region string) bool {
+	vi.fallback(provider)
+
+	return vi.lookup(vi.regions, provider, indexKey(service, region))
+}
+
+func (vi *validationIndex) lookup(index map[string]map[string]bool, provider, key string) bool {
+	vi.mu.RLock()
+	defer vi.mu.RUnlock()
+
+	return index[provider][key]
+}
+
+func indexKey(service, region string) string {
+	return strings.Join([]string{service, region}, "/")
+}
+
 // regionValidator validates the `region` path parameter
-func regionValidator(cpi types.CloudInfo, logger cloudinfo.Logger) validator.Func {
+func regionValidator(index *validationIndex) validator.Func {
 	return func(fl validator.FieldLevel) bool {
 		currentStruct, _, _, ok := fl.GetStructFieldOK2()
 		if !ok {
@@ -58,25 +223,28 @@ func regionValidator(cpi types.CloudInfo, logger cloudinfo.Logger) validator.Fun
 			return false
 		}
 
-		logger = logger.WithFields(map[string]interface{}{"provider": regionPathParams.Provider, "service": regionPathParams.Service, "region": regionPathParams.Region})
+		return index.hasRegion(regionPathParams.Provider, regionPathParams.Service, regionPathParams.Region)
+	}
+}
 
-		regions, err := cpi.GetRegions(regionPathParams.Provider, regionPathParams.Service)
-		if err != nil {
-			logger.Error("validation failed, could not retrieve regions")
+// storageRegionValidator validates the `region` path parameter of the storage and network routes against the compute regions
+func storageRegionValidator(index *validationIndex) validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		currentStruct, _, _, ok := fl.GetStructFieldOK2()
+		if !ok {
 			return false
 		}
-
-		for reg := range regions {
-			if reg == regionPathParams.Region {
-				return true
-			}
+		storagePathParams, ok := currentStruct.Interface().(GetStoragePathParams)
+		if !ok {
+			return false
 		}
-		return false
+
+		return index.hasRegion(storagePathParams.Provider, "compute", storagePathParams.Region)
 	}
 }
 
 // serviceValidator validates the `service` path parameter
-func serviceValidator(cpi types.CloudInfo, logger cloudinfo.Logger) validator.Func {
+func serviceValidator(index *validationIndex) validator.Func {
 	return func(fl validator.FieldLevel) bool {
 		currentStruct, _, _, ok := fl.GetStructFieldOK2()
 		if !ok {
@@ -87,20 +255,7 @@ func serviceValidator(cpi types.CloudInfo, logger cloudinfo.Logger) validator.Fu
 			return false
 		}
 
-		logger = logger.WithFields(map[string]interface{}{"provider": servicesPathParams.Provider, "service": servicesPathParams.Service})
-
-		services, err := cpi.GetServices(servicesPathParams.Provider)
-		if err != nil {
-			logger.Error("validation failed, could not retrieve services")
-			return false
-		}
-
-		for _, svc := range services {
-			if svc.ServiceName() == servicesPathParams.Service {
-				return true
-			}
-		}
-		return false
+		return index.hasService(servicesPathParams.Provider, servicesPathParams.Service)
 	}
 }
 
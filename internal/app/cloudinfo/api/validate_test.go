@@ -16,11 +16,45 @@ package api
 
 import (
 	"testing"
+	"time"
 
+	"emperror.dev/errors"
 	"github.com/go-playground/validator/v10"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/cloudinfoadapter"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
 )
 
+// dummyCloudInfo implements the CloudInfo interface for mocking the stored services and regions
+type dummyCloudInfo struct {
+	regions map[string]map[string]string
+	lookups int
+	// implement the interface
+	types.CloudInfo
+}
+
+func (d *dummyCloudInfo) GetServices(provider string) ([]types.Service, error) {
+	d.lookups++
+	if d.regions == nil {
+		return nil, errors.New("services not yet cached")
+	}
+
+	var services []types.Service
+	for service := range d.regions {
+		services = append(services, types.Service{Service: service})
+	}
+	return services, nil
+}
+
+func (d *dummyCloudInfo) GetRegions(provider, service string) (map[string]string, error) {
+	regions, ok := d.regions[service]
+	if !ok {
+		return nil, errors.New("regions not yet cached")
+	}
+	return regions, nil
+}
+
 func TestGetProviderPathParamsValidation(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -63,3 +97,78 @@ func TestGetProviderPathParamsValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestValidationIndex(t *testing.T) {
+	ci := &dummyCloudInfo{
+		regions: map[string]map[string]string{
+			"compute": {"eu-west-1": "EU (Ireland)"},
+		},
+	}
+	index := newValidationIndex(ci, cloudinfoadapter.NewNoopLogger())
+
+	assert.True(t, index.hasService("amazon", "compute"), "the service should be valid")
+	assert.False(t, index.hasService("amazon", "unsupported"), "the service should be invalid")
+	assert.True(t, index.hasRegion("amazon", "compute", "eu-west-1"), "the region should be valid")
+	assert.False(t, index.hasRegion("amazon", "compute", "us-east-1"), "the region should be invalid")
+
+	// the region disappears from the store
+	ci.regions["compute"] = map[string]string{"us-east-1": "US East (N. Virginia)"}
+	index.refresh("amazon")
+
+	assert.False(t, index.hasRegion("amazon", "compute", "eu-west-1"), "the removed region should be invalid")
+	assert.True(t, index.hasRegion("amazon", "compute", "us-east-1"), "the new region should be valid")
+}
+
+func TestValidationIndex_Fallback(t *testing.T) {
+	ci := &dummyCloudInfo{}
+	index := newValidationIndex(ci, cloudinfoadapter.NewNoopLogger())
+
+	now := time.Now()
+	index.now = func() time.Time { return now }
+
+	// the services are not cached yet: the provider is not indexed
+	assert.False(t, index.hasService("amazon", "compute"), "the service should be invalid")
+	assert.False(t, index.hasService("amazon", "compute"), "the service should be invalid")
+	assert.Equal(t, 1, ci.lookups, "the store should be consulted at most once within the fallback interval")
+
+	ci.regions = map[string]map[string]string{"compute": {"eu-west-1": "EU (Ireland)"}}
+	assert.False(t, index.hasService("amazon", "compute"), "the store lookup should be rate limited")
+	assert.Equal(t, 1, ci.lookups, "the store should not be consulted within the fallback interval")
+
+	now = now.Add(indexFallbackInterval)
+	assert.True(t, index.hasService("amazon", "compute"), "the service should be valid")
+	assert.Equal(t, 2, ci.lookups)
+
+	// the provider is indexed: the misses are answered from the index
+	for i := 0; i < 10; i++ {
+		assert.False(t, index.hasRegion("amazon", "compute", "unknown"), "the region should be invalid")
+	}
+	assert.Equal(t, 2, ci.lookups, "the store should not be consulted for an indexed provider")
+}
+
+func TestValidationIndex_TTL(t *testing.T) {
+	ci := &dummyCloudInfo{
+		regions: map[string]map[string]string{
+			"compute": {"eu-west-1": "EU (Ireland)"},
+		},
+	}
+	index := newValidationIndex(ci, cloudinfoadapter.NewNoopLogger())
+
+	now := time.Now()
+	index.now = func() time.Time { return now }
+
+	assert.True(t, index.hasRegion("amazon", "compute", "eu-west-1"), "the region should be valid")
+	assert.Equal(t, 1, ci.lookups)
+
+	// the regions change in the shared store without refresh events, eg. the scraping is disabled
+	ci.regions["compute"] = map[string]string{"us-east-1": "US East (N. Virginia)"}
+
+	now = now.Add(indexTTL - time.Second)
+	assert.True(t, index.hasRegion("amazon", "compute", "eu-west-1"), "the index should be used until it expires")
+	assert.Equal(t, 1, ci.lookups, "the store should not be consulted before the index expires")
+
+	now = now.Add(time.Second)
+	assert.False(t, index.hasRegion("amazon", "compute", "eu-west-1"), "the removed region should be invalid")
+	assert.True(t, index.hasRegion("amazon", "compute", "us-east-1"), "the new region should be valid")
+	assert.Equal(t, 2, ci.lookups, "the expired index should be rebuilt once")
+}
@@ -0,0 +1,82 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/cloudinfoadapter"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// productCloudInfo implements the CloudInfo interface for mocking the stored products
+type productCloudInfo struct {
+	products []types.ProductDetails
+	// implement the interface
+	types.CloudInfo
+}
+
+func (p *productCloudInfo) GetProductDetails(provider, service, region string) ([]types.ProductDetails, error) {
+	return append([]types.ProductDetails(nil), p.products...), nil
+}
+
+func TestRouteHandler_queryProducts_zone(t *testing.T) {
+	spotPrices := []types.ZonePrice{{Zone: "eu-west-1a", Price: 0.01}, {Zone: "eu-west-1b", Price: 0.02}}
+	ci := &productCloudInfo{products: []types.ProductDetails{
+		{VMInfo: types.VMInfo{Type: "m5.large", Zones: []string{"eu-west-1a", "eu-west-1b"}, SpotPrice: spotPrices}},
+		{VMInfo: types.VMInfo{Type: "m5.xlarge", Zones: []string{"eu-west-1a"}, SpotPrice: spotPrices[:1]}},
+		{VMInfo: types.VMInfo{Type: "t3.micro"}},
+	}}
+	r := &RouteHandler{prod: ci, log: cloudinfoadapter.NewNoopLogger()}
+	pathParams := GetRegionPathParams{Region: "eu-west-1"}
+
+	tests := []struct {
+		name        string
+		queryParams GetProductDetailsQueryParams
+		types       []string
+		spotPrices  map[string][]types.ZonePrice
+	}{
+		{
+			name:        "the zone query parameter keeps the spot prices of the region",
+			queryParams: GetProductDetailsQueryParams{Zone: "eu-west-1b"},
+			types:       []string{"m5.large", "t3.micro"},
+			spotPrices:  map[string][]types.ZonePrice{"m5.large": spotPrices, "t3.micro": nil},
+		},
+		{
+			name:        "the zone scoped products have the spot price of the zone",
+			queryParams: GetProductDetailsQueryParams{Zone: "eu-west-1b", zoneSpotPrices: true},
+			types:       []string{"m5.large", "t3.micro"},
+			spotPrices:  map[string][]types.ZonePrice{"m5.large": spotPrices[1:], "t3.micro": {}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			details, _, err := r.queryProducts(pathParams, test.queryParams)
+			if assert.NoError(t, err) {
+				var productTypes []string
+				for _, detail := range details {
+					productTypes = append(productTypes, detail.Type)
+					assert.Equal(t, test.spotPrices[detail.Type], detail.SpotPrice)
+				}
+				assert.Equal(t, test.types, productTypes)
+			}
+		})
+	}
+
+	// the stored products are not modified
+	assert.Equal(t, spotPrices, ci.products[0].SpotPrice)
+}
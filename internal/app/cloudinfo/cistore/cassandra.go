@@ -15,6 +15,7 @@
 package cistore
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -55,6 +56,18 @@ func (cps *cassandraProductStore) Ready() bool {
 	return true
 }
 
+func (cps *cassandraProductStore) Ping(ctx context.Context) error {
+	if err := cps.initSession(); err != nil {
+		return err
+	}
+
+	if err := cps.session.Query("SELECT now() FROM system.local").WithContext(ctx).Exec(); err != nil {
+		return emperror.Wrap(err, "failed to query cassandra")
+	}
+
+	return nil
+}
+
 func (cps *cassandraProductStore) StoreRegions(provider, service string, val map[string]string) {
 	cps.set(cps.getKey(cloudinfo.RegionKeyTemplate, provider, service), val)
 }
@@ -95,6 +108,138 @@ func (cps *cassandraProductStore) GetPrice(provider, region, instanceType string
 	return res, ok
 }
 
+func (cps *cassandraProductStore) StoreSpotPriceHistory(provider, region, instanceType string, val []types.SpotPriceRecord) {
+	cps.set(cps.getKey(cloudinfo.SpotPriceHistoryKeyTemplate, provider, region, instanceType), val)
+}
+
+func (cps *cassandraProductStore) GetSpotPriceHistory(provider, region, instanceType string) ([]types.SpotPriceRecord, bool) {
+	res := make([]types.SpotPriceRecord, 0)
+	_, ok := cps.get(cps.getKey(cloudinfo.SpotPriceHistoryKeyTemplate, provider, region, instanceType), &res)
+
+	return res, ok
+}
+
+func (cps *cassandraProductStore) StoreOnDemandPriceHistory(provider, region, instanceType string, val []types.OnDemandPriceRecord) {
+	cps.set(cps.getKey(cloudinfo.OnDemandPriceHistoryKeyTemplate, provider, region, instanceType), val)
+}
+
+func (cps *cassandraProductStore) GetOnDemandPriceHistory(provider, region, instanceType string) ([]types.OnDemandPriceRecord, bool) {
+	res := make([]types.OnDemandPriceRecord, 0)
+	_, ok := cps.get(cps.getKey(cloudinfo.OnDemandPriceHistoryKeyTemplate, provider, region, instanceType), &res)
+
+	return res, ok
+}
+
+func (cps *cassandraProductStore) StoreStoragePrices(provider, region string, val []types.StoragePrice) {
+	cps.set(cps.getKey(cloudinfo.StoragePriceKeyTemplate, provider, region), val)
+}
+
+func (cps *cassandraProductStore) GetStoragePrices(provider, region string) ([]types.StoragePrice, bool) {
+	res := make([]types.StoragePrice, 0)
+	_, ok := cps.get(cps.getKey(cloudinfo.StoragePriceKeyTemplate, provider, region), &res)
+
+	return res, ok
+}
+
+func (cps *cassandraProductStore) StoreObjectStoragePrices(provider, region string, val []types.ObjectStoragePrice) {
+	cps.set(cps.getKey(cloudinfo.ObjectStoragePriceKeyTemplate, provider, region), val)
+}
+
+func (cps *cassandraProductStore) GetObjectStoragePrices(provider, region string) ([]types.ObjectStoragePrice, bool) {
+	res := make([]types.ObjectStoragePrice, 0)
+	_, ok := cps.get(cps.getKey(cloudinfo.ObjectStoragePriceKeyTemplate, provider, region), &res)
+
+	return res, ok
+}
+
+func (cps *cassandraProductStore) StoreNetworkPrices(provider, region string, val []types.NetworkPrice) {
+	cps.set(cps.getKey(cloudinfo.NetworkPriceKeyTemplate, provider, region), val)
+}
+
+func (cps *cassandraProductStore) GetNetworkPrices(provider, region string) ([]types.NetworkPrice, bool) {
+	res := make([]types.NetworkPrice, 0)
+	_, ok := cps.get(cps.getKey(cloudinfo.NetworkPriceKeyTemplate, provider, region), &res)
+
+	return res, ok
+}
+
+func (cps *cassandraProductStore) StoreLoadBalancerPrices(provider, region string, val []types.LoadBalancerPrice) {
+	cps.set(cps.getKey(cloudinfo.LoadBalancerPriceKeyTemplate, provider, region), val)
+}
+
+func (cps *cassandraProductStore) GetLoadBalancerPrices(provider, region string) ([]types.LoadBalancerPrice, bool) {
+	res := make([]types.LoadBalancerPrice, 0)
+	_, ok := cps.get(cps.getKey(cloudinfo.LoadBalancerPriceKeyTemplate, provider, region), &res)
+
+	return res, ok
+}
+
+func (cps *cassandraProductStore) StoreNatGatewayPrices(provider, region string, val []types.NatGatewayPrice) {
+	cps.set(cps.getKey(cloudinfo.NatGatewayPriceKeyTemplate, provider, region), val)
+}
+
+func (cps *cassandraProductStore) GetNatGatewayPrices(provider, region string) ([]types.NatGatewayPrice, bool) {
+	res := make([]types.NatGatewayPrice, 0)
+	_, ok := cps.get(cps.getKey(cloudinfo.NatGatewayPriceKeyTemplate, provider, region), &res)
+
+	return res, ok
+}
+
+func (cps *cassandraProductStore) StorePublicIpPrices(provider, region string, val []types.PublicIpPrice) {
+	cps.set(cps.getKey(cloudinfo.PublicIpPriceKeyTemplate, provider, region), val)
+}
+
+func (cps *cassandraProductStore) GetPublicIpPrices(provider, region string) ([]types.PublicIpPrice, bool) {
+	res := make([]types.PublicIpPrice, 0)
+	_, ok := cps.get(cps.getKey(cloudinfo.PublicIpPriceKeyTemplate, provider, region), &res)
+
+	return res, ok
+}
+
+func (cps *cassandraProductStore) StoreDatabases(provider, region string, val []types.DatabaseInfo) {
+	cps.set(cps.getKey(cloudinfo.DatabaseKeyTemplate, provider, region), val)
+}
+
+func (cps *cassandraProductStore) GetDatabases(provider, region string) ([]types.DatabaseInfo, bool) {
+	res := make([]types.DatabaseInfo, 0)
+	_, ok := cps.get(cps.getKey(cloudinfo.DatabaseKeyTemplate, provider, region), &res)
+
+	return res, ok
+}
+
+func (cps *cassandraProductStore) StoreZoneInfo(provider, region string, val []types.ZoneInfo) {
+	cps.set(cps.getKey(cloudinfo.ZoneInfoKeyTemplate, provider, region), val)
+}
+
+func (cps *cassandraProductStore) GetZoneInfo(provider, region string) ([]types.ZoneInfo, bool) {
+	res := make([]types.ZoneInfo, 0)
+	_, ok := cps.get(cps.getKey(cloudinfo.ZoneInfoKeyTemplate, provider, region), &res)
+
+	return res, ok
+}
+
+func (cps *cassandraProductStore) StoreDedicatedHosts(provider, region string, val []types.DedicatedHostInfo) {
+	cps.set(cps.getKey(cloudinfo.DedicatedHostKeyTemplate, provider, region), val)
+}
+
+func (cps *cassandraProductStore) GetDedicatedHosts(provider, region string) ([]types.DedicatedHostInfo, bool) {
+	res := make([]types.DedicatedHostInfo, 0)
+	_, ok := cps.get(cps.getKey(cloudinfo.DedicatedHostKeyTemplate, provider, region), &res)
+
+	return res, ok
+}
+
+func (cps *cassandraProductStore) StoreServerlessPrices(provider, region string, val []types.ServerlessPrice) {
+	cps.set(cps.getKey(cloudinfo.ServerlessPriceKeyTemplate, provider, region), val)
+}
+
+func (cps *cassandraProductStore) GetServerlessPrices(provider, region string) ([]types.ServerlessPrice, bool) {
+	res := make([]types.ServerlessPrice, 0)
+	_, ok := cps.get(cps.getKey(cloudinfo.ServerlessPriceKeyTemplate, provider, region), &res)
+
+	return res, ok
+}
+
 func (cps *cassandraProductStore) StoreVm(provider, service, region string, val []types.VMInfo) {
 	cps.set(cps.getKey(cloudinfo.VmKeyTemplate, provider, service, region), val)
 }
@@ -151,6 +296,50 @@ func (cps *cassandraProductStore) GetStatus(provider string) (string, bool) {
 	return res, ok
 }
 
+func (cps *cassandraProductStore) StoreRegionStatus(provider, service, region string, val string) {
+	cps.set(cps.getKey(cloudinfo.RegionStatusKeyTemplate, provider, service, region), val)
+}
+
+func (cps *cassandraProductStore) GetRegionStatus(provider, service, region string) (string, bool) {
+	var res string
+	_, ok := cps.get(cps.getKey(cloudinfo.RegionStatusKeyTemplate, provider, service, region), &res)
+
+	return res, ok
+}
+
+func (cps *cassandraProductStore) StoreRegionHash(provider, service, region string, val string) {
+	cps.set(cps.getKey(cloudinfo.RegionHashKeyTemplate, provider, service, region), val)
+}
+
+func (cps *cassandraProductStore) GetRegionHash(provider, service, region string) (string, bool) {
+	var res string
+	_, ok := cps.get(cps.getKey(cloudinfo.RegionHashKeyTemplate, provider, service, region), &res)
+
+	return res, ok
+}
+
+func (cps *cassandraProductStore) StoreRegionPriceHash(provider, region string, val string) {
+	cps.set(cps.getKey(cloudinfo.RegionPriceHashKeyTemplate, provider, region), val)
+}
+
+func (cps *cassandraProductStore) GetRegionPriceHash(provider, region string) (string, bool) {
+	var res string
+	_, ok := cps.get(cps.getKey(cloudinfo.RegionPriceHashKeyTemplate, provider, region), &res)
+
+	return res, ok
+}
+
+func (cps *cassandraProductStore) StoreRegionError(provider, service, region string, val types.ScrapeError) {
+	cps.set(cps.getKey(cloudinfo.RegionErrorKeyTemplate, provider, service, region), val)
+}
+
+func (cps *cassandraProductStore) GetRegionError(provider, service, region string) (types.ScrapeError, bool) {
+	var res types.ScrapeError
+	_, ok := cps.get(cps.getKey(cloudinfo.RegionErrorKeyTemplate, provider, service, region), &res)
+
+	return res, ok
+}
+
 func (cps *cassandraProductStore) StoreServices(provider string, services []types.Service) {
 	cps.set(cps.getKey(cloudinfo.ServicesKeyTemplate, provider), services)
 }
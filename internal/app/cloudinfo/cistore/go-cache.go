@@ -15,6 +15,7 @@
 package cistore
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"time"
@@ -38,6 +39,11 @@ func (cis *cacheProductStore) Ready() bool {
 	return true
 }
 
+// Ping always succeeds, the in-memory store is always available
+func (cis *cacheProductStore) Ping(ctx context.Context) error {
+	return nil
+}
+
 func (cis *cacheProductStore) DeleteRegions(provider, service string) {
 	cis.Delete(cis.getKey(cloudinfo.RegionKeyTemplate, provider, service))
 }
@@ -88,6 +94,150 @@ func (cis *cacheProductStore) GetPrice(provider, region, instanceType string) (t
 	return types.Price{}, false
 }
 
+func (cis *cacheProductStore) StoreSpotPriceHistory(provider, region, instanceType string, val []types.SpotPriceRecord) {
+	cis.Set(cis.getKey(cloudinfo.SpotPriceHistoryKeyTemplate, provider, region, instanceType), val, cis.itemExpiry)
+}
+
+func (cis *cacheProductStore) GetSpotPriceHistory(provider, region, instanceType string) ([]types.SpotPriceRecord, bool) {
+	if res, ok := cis.get(cis.getKey(cloudinfo.SpotPriceHistoryKeyTemplate, provider, region, instanceType)); ok {
+		return res.([]types.SpotPriceRecord), ok
+	}
+
+	return nil, false
+}
+
+func (cis *cacheProductStore) StoreOnDemandPriceHistory(provider, region, instanceType string, val []types.OnDemandPriceRecord) {
+	cis.Set(cis.getKey(cloudinfo.OnDemandPriceHistoryKeyTemplate, provider, region, instanceType), val, cis.itemExpiry)
+}
+
+func (cis *cacheProductStore) GetOnDemandPriceHistory(provider, region, instanceType string) ([]types.OnDemandPriceRecord, bool) {
+	if res, ok := cis.get(cis.getKey(cloudinfo.OnDemandPriceHistoryKeyTemplate, provider, region, instanceType)); ok {
+		return res.([]types.OnDemandPriceRecord), ok
+	}
+
+	return nil, false
+}
+
+func (cis *cacheProductStore) StoreStoragePrices(provider, region string, val []types.StoragePrice) {
+	cis.Set(cis.getKey(cloudinfo.StoragePriceKeyTemplate, provider, region), val, cis.itemExpiry)
+}
+
+func (cis *cacheProductStore) GetStoragePrices(provider, region string) ([]types.StoragePrice, bool) {
+	if res, ok := cis.get(cis.getKey(cloudinfo.StoragePriceKeyTemplate, provider, region)); ok {
+		return res.([]types.StoragePrice), ok
+	}
+
+	return nil, false
+}
+
+func (cis *cacheProductStore) StoreObjectStoragePrices(provider, region string, val []types.ObjectStoragePrice) {
+	cis.Set(cis.getKey(cloudinfo.ObjectStoragePriceKeyTemplate, provider, region), val, cis.itemExpiry)
+}
+
+func (cis *cacheProductStore) GetObjectStoragePrices(provider, region string) ([]types.ObjectStoragePrice, bool) {
+	if res, ok := cis.get(cis.getKey(cloudinfo.ObjectStoragePriceKeyTemplate, provider, region)); ok {
+		return res.([]types.ObjectStoragePrice), ok
+	}
+
+	return nil, false
+}
+
+func (cis *cacheProductStore) StoreNetworkPrices(provider, region string, val []types.NetworkPrice) {
+	cis.Set(cis.getKey(cloudinfo.NetworkPriceKeyTemplate, provider, region), val, cis.itemExpiry)
+}
+
+func (cis *cacheProductStore) GetNetworkPrices(provider, region string) ([]types.NetworkPrice, bool) {
+	if res, ok := cis.get(cis.getKey(cloudinfo.NetworkPriceKeyTemplate, provider, region)); ok {
+		return res.([]types.NetworkPrice), ok
+	}
+
+	return nil, false
+}
+
+func (cis *cacheProductStore) StoreLoadBalancerPrices(provider, region string, val []types.LoadBalancerPrice) {
+	cis.Set(cis.getKey(cloudinfo.LoadBalancerPriceKeyTemplate, provider, region), val, cis.itemExpiry)
+}
+
+func (cis *cacheProductStore) GetLoadBalancerPrices(provider, region string) ([]types.LoadBalancerPrice, bool) {
+	if res, ok := cis.get(cis.getKey(cloudinfo.LoadBalancerPriceKeyTemplate, provider, region)); ok {
+		return res.([]types.LoadBalancerPrice), ok
+	}
+
+	return nil, false
+}
+
+func (cis *cacheProductStore) StoreNatGatewayPrices(provider, region string, val []types.NatGatewayPrice) {
+	cis.Set(cis.getKey(cloudinfo.NatGatewayPriceKeyTemplate, provider, region), val, cis.itemExpiry)
+}
+
+func (cis *cacheProductStore) GetNatGatewayPrices(provider, region string) ([]types.NatGatewayPrice, bool) {
+	if res, ok := cis.get(cis.getKey(cloudinfo.NatGatewayPriceKeyTemplate, provider, region)); ok {
+		return res.([]types.NatGatewayPrice), ok
+	}
+
+	return nil, false
+}
+
+func (cis *cacheProductStore) StorePublicIpPrices(provider, region string, val []types.PublicIpPrice) {
+	cis.Set(cis.getKey(cloudinfo.PublicIpPriceKeyTemplate, provider, region), val, cis.itemExpiry)
+}
+
+func (cis *cacheProductStore) GetPublicIpPrices(provider, region string) ([]types.PublicIpPrice, bool) {
+	if res, ok := cis.get(cis.getKey(cloudinfo.PublicIpPriceKeyTemplate, provider, region)); ok {
+		return res.([]types.PublicIpPrice), ok
+	}
+
+	return nil, false
+}
+
+func (cis *cacheProductStore) StoreDatabases(provider, region string, val []types.DatabaseInfo) {
+	cis.Set(cis.getKey(cloudinfo.DatabaseKeyTemplate, provider, region), val, cis.itemExpiry)
+}
+
+func (cis *cacheProductStore) GetDatabases(provider, region string) ([]types.DatabaseInfo, bool) {
+	if res, ok := cis.get(cis.getKey(cloudinfo.DatabaseKeyTemplate, provider, region)); ok {
+		return res.([]types.DatabaseInfo), ok
+	}
+
+	return nil, false
+}
+
+func (cis *cacheProductStore) StoreDedicatedHosts(provider, region string, val []types.DedicatedHostInfo) {
+	cis.Set(cis.getKey(cloudinfo.DedicatedHostKeyTemplate, provider, region), val, cis.itemExpiry)
+}
+
+func (cis *cacheProductStore) GetDedicatedHosts(provider, region string) ([]types.DedicatedHostInfo, bool) {
+	if res, ok := cis.get(cis.getKey(cloudinfo.DedicatedHostKeyTemplate, provider, region)); ok {
+		return res.([]types.DedicatedHostInfo), ok
+	}
+
+	return nil, false
+}
+
+func (cis *cacheProductStore) StoreServerlessPrices(provider, region string, val []types.ServerlessPrice) {
+	cis.Set(cis.getKey(cloudinfo.ServerlessPriceKeyTemplate, provider, region), val, cis.itemExpiry)
+}
+
+func (cis *cacheProductStore) GetServerlessPrices(provider, region string) ([]types.ServerlessPrice, bool) {
+	if res, ok := cis.get(cis.getKey(cloudinfo.ServerlessPriceKeyTemplate, provider, region)); ok {
+		return res.([]types.ServerlessPrice), ok
+	}
+
+	return nil, false
+}
+
+func (cis *cacheProductStore) StoreZoneInfo(provider, region string, val []types.ZoneInfo) {
+	cis.Set(cis.getKey(cloudinfo.ZoneInfoKeyTemplate, provider, region), val, cis.itemExpiry)
+}
+
+func (cis *cacheProductStore) GetZoneInfo(provider, region string) ([]types.ZoneInfo, bool) {
+	if res, ok := cis.get(cis.getKey(cloudinfo.ZoneInfoKeyTemplate, provider, region)); ok {
+		return res.([]types.ZoneInfo), ok
+	}
+
+	return nil, false
+}
+
 func (cis *cacheProductStore) StoreVm(provider, service, region string, val []types.VMInfo) {
 	cis.Set(cis.getKey(cloudinfo.VmKeyTemplate, provider, service, region), val, cis.itemExpiry)
 }
@@ -136,6 +286,54 @@ func (cis *cacheProductStore) GetStatus(provider string) (string, bool) {
 	return "", false
 }
 
+func (cis *cacheProductStore) StoreRegionStatus(provider, service, region string, val string) {
+	cis.Set(cis.getKey(cloudinfo.RegionStatusKeyTemplate, provider, service, region), val, cis.itemExpiry)
+}
+
+func (cis *cacheProductStore) GetRegionStatus(provider, service, region string) (string, bool) {
+	if res, ok := cis.get(cis.getKey(cloudinfo.RegionStatusKeyTemplate, provider, service, region)); ok {
+		return res.(string), ok
+	}
+
+	return "", false
+}
+
+func (cis *cacheProductStore) StoreRegionHash(provider, service, region string, val string) {
+	cis.Set(cis.getKey(cloudinfo.RegionHashKeyTemplate, provider, service, region), val, cis.itemExpiry)
+}
+
+func (cis *cacheProductStore) GetRegionHash(provider, service, region string) (string, bool) {
+	if res, ok := cis.get(cis.getKey(cloudinfo.RegionHashKeyTemplate, provider, service, region)); ok {
+		return res.(string), ok
+	}
+
+	return "", false
+}
+
+func (cis *cacheProductStore) StoreRegionPriceHash(provider, region string, val string) {
+	cis.Set(cis.getKey(cloudinfo.RegionPriceHashKeyTemplate, provider, region), val, cis.itemExpiry)
+}
+
+func (cis *cacheProductStore) GetRegionPriceHash(provider, region string) (string, bool) {
+	if res, ok := cis.get(cis.getKey(cloudinfo.RegionPriceHashKeyTemplate, provider, region)); ok {
+		return res.(string), ok
+	}
+
+	return "", false
+}
+
+func (cis *cacheProductStore) StoreRegionError(provider, service, region string, val types.ScrapeError) {
+	cis.Set(cis.getKey(cloudinfo.RegionErrorKeyTemplate, provider, service, region), val, cis.itemExpiry)
+}
+
+func (cis *cacheProductStore) GetRegionError(provider, service, region string) (types.ScrapeError, bool) {
+	if res, ok := cis.get(cis.getKey(cloudinfo.RegionErrorKeyTemplate, provider, service, region)); ok {
+		return res.(types.ScrapeError), ok
+	}
+
+	return types.ScrapeError{}, false
+}
+
 // Export writes the content of the store into the passed in writer
 func (cis *cacheProductStore) Export(w io.Writer) error {
 	if err := cis.Save(w); err != nil {
@@ -15,10 +15,13 @@
 package cistore
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"time"
 
+	"emperror.dev/errors"
 	redigo "github.com/gomodule/redigo/redis"
 
 	cloudinfo "github.com/banzaicloud/cloudinfo/internal/cloudinfo"
@@ -48,6 +51,33 @@ func (rps *redisProductStore) Ready() bool {
 	return true
 }
 
+func (rps *redisProductStore) Ping(ctx context.Context) error {
+	conn, err := rps.pool.GetContext(ctx)
+	if err != nil {
+		return errors.WrapIf(err, "failed to get redis connection")
+	}
+	defer conn.Close()
+
+	// a zero timeout falls back to the read timeout of the connection
+	var timeout time.Duration
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout = time.Until(deadline)
+		if timeout <= 0 {
+			return context.DeadlineExceeded
+		}
+	}
+
+	reply, err := redigo.String(redigo.DoWithTimeout(conn, timeout, "PING"))
+	if err != nil {
+		return errors.WrapIf(err, "failed to ping redis")
+	}
+	if reply != "PONG" {
+		return errors.NewWithDetails("redis PING returned unexpected value", "reply", reply)
+	}
+
+	return nil
+}
+
 func (rps *redisProductStore) DeleteRegions(provider, service string) {
 	rps.delete(rps.getKey(cloudinfo.RegionKeyTemplate, provider, service))
 }
@@ -186,6 +216,162 @@ func (rps *redisProductStore) GetPrice(provider, region, instanceType string) (t
 	return res, ok
 }
 
+func (rps *redisProductStore) StoreSpotPriceHistory(provider, region, instanceType string, val []types.SpotPriceRecord) {
+	rps.set(rps.getKey(cloudinfo.SpotPriceHistoryKeyTemplate, provider, region, instanceType), val)
+}
+
+func (rps *redisProductStore) GetSpotPriceHistory(provider, region, instanceType string) ([]types.SpotPriceRecord, bool) {
+	var (
+		res = make([]types.SpotPriceRecord, 0)
+	)
+	_, ok := rps.get(rps.getKey(cloudinfo.SpotPriceHistoryKeyTemplate, provider, region, instanceType), &res)
+
+	return res, ok
+}
+
+func (rps *redisProductStore) StoreOnDemandPriceHistory(provider, region, instanceType string, val []types.OnDemandPriceRecord) {
+	rps.set(rps.getKey(cloudinfo.OnDemandPriceHistoryKeyTemplate, provider, region, instanceType), val)
+}
+
+func (rps *redisProductStore) GetOnDemandPriceHistory(provider, region, instanceType string) ([]types.OnDemandPriceRecord, bool) {
+	var (
+		res = make([]types.OnDemandPriceRecord, 0)
+	)
+	_, ok := rps.get(rps.getKey(cloudinfo.OnDemandPriceHistoryKeyTemplate, provider, region, instanceType), &res)
+
+	return res, ok
+}
+
+func (rps *redisProductStore) StoreStoragePrices(provider, region string, val []types.StoragePrice) {
+	rps.set(rps.getKey(cloudinfo.StoragePriceKeyTemplate, provider, region), val)
+}
+
+func (rps *redisProductStore) GetStoragePrices(provider, region string) ([]types.StoragePrice, bool) {
+	var (
+		res = make([]types.StoragePrice, 0)
+	)
+	_, ok := rps.get(rps.getKey(cloudinfo.StoragePriceKeyTemplate, provider, region), &res)
+
+	return res, ok
+}
+
+func (rps *redisProductStore) StoreObjectStoragePrices(provider, region string, val []types.ObjectStoragePrice) {
+	rps.set(rps.getKey(cloudinfo.ObjectStoragePriceKeyTemplate, provider, region), val)
+}
+
+func (rps *redisProductStore) GetObjectStoragePrices(provider, region string) ([]types.ObjectStoragePrice, bool) {
+	var (
+		res = make([]types.ObjectStoragePrice, 0)
+	)
+	_, ok := rps.get(rps.getKey(cloudinfo.ObjectStoragePriceKeyTemplate, provider, region), &res)
+
+	return res, ok
+}
+
+func (rps *redisProductStore) StoreNetworkPrices(provider, region string, val []types.NetworkPrice) {
+	rps.set(rps.getKey(cloudinfo.NetworkPriceKeyTemplate, provider, region), val)
+}
+
+func (rps *redisProductStore) GetNetworkPrices(provider, region string) ([]types.NetworkPrice, bool) {
+	var (
+		res = make([]types.NetworkPrice, 0)
+	)
+	_, ok := rps.get(rps.getKey(cloudinfo.NetworkPriceKeyTemplate, provider, region), &res)
+
+	return res, ok
+}
+
+func (rps *redisProductStore) StoreLoadBalancerPrices(provider, region string, val []types.LoadBalancerPrice) {
+	rps.set(rps.getKey(cloudinfo.LoadBalancerPriceKeyTemplate, provider, region), val)
+}
+
+func (rps *redisProductStore) GetLoadBalancerPrices(provider, region string) ([]types.LoadBalancerPrice, bool) {
+	var (
+		res = make([]types.LoadBalancerPrice, 0)
+	)
+	_, ok := rps.get(rps.getKey(cloudinfo.LoadBalancerPriceKeyTemplate, provider, region), &res)
+
+	return res, ok
+}
+
+func (rps *redisProductStore) StoreNatGatewayPrices(provider, region string, val []types.NatGatewayPrice) {
+	rps.set(rps.getKey(cloudinfo.NatGatewayPriceKeyTemplate, provider, region), val)
+}
+
+func (rps *redisProductStore) GetNatGatewayPrices(provider, region string) ([]types.NatGatewayPrice, bool) {
+	var (
+		res = make([]types.NatGatewayPrice, 0)
+	)
+	_, ok := rps.get(rps.getKey(cloudinfo.NatGatewayPriceKeyTemplate, provider, region), &res)
+
+	return res, ok
+}
+
+func (rps *redisProductStore) StorePublicIpPrices(provider, region string, val []types.PublicIpPrice) {
+	rps.set(rps.getKey(cloudinfo.PublicIpPriceKeyTemplate, provider, region), val)
+}
+
+func (rps *redisProductStore) GetPublicIpPrices(provider, region string) ([]types.PublicIpPrice, bool) {
+	var (
+		res = make([]types.PublicIpPrice, 0)
+	)
+	_, ok := rps.get(rps.getKey(cloudinfo.PublicIpPriceKeyTemplate, provider, region), &res)
+
+	return res, ok
+}
+
+func (rps *redisProductStore) StoreDatabases(provider, region string, val []types.DatabaseInfo) {
+	rps.set(rps.getKey(cloudinfo.DatabaseKeyTemplate, provider, region), val)
+}
+
+func (rps *redisProductStore) GetDatabases(provider, region string) ([]types.DatabaseInfo, bool) {
+	var (
+		res = make([]types.DatabaseInfo, 0)
+	)
+	_, ok := rps.get(rps.getKey(cloudinfo.DatabaseKeyTemplate, provider, region), &res)
+
+	return res, ok
+}
+
+func (rps *redisProductStore) StoreZoneInfo(provider, region string, val []types.ZoneInfo) {
+	rps.set(rps.getKey(cloudinfo.ZoneInfoKeyTemplate, provider, region), val)
+}
+
+func (rps *redisProductStore) GetZoneInfo(provider, region string) ([]types.ZoneInfo, bool) {
+	var (
+		res = make([]types.ZoneInfo, 0)
+	)
+	_, ok := rps.get(rps.getKey(cloudinfo.ZoneInfoKeyTemplate, provider, region), &res)
+
+	return res, ok
+}
+
+func (rps *redisProductStore) StoreDedicatedHosts(provider, region string, val []types.DedicatedHostInfo) {
+	rps.set(rps.getKey(cloudinfo.DedicatedHostKeyTemplate, provider, region), val)
+}
+
+func (rps *redisProductStore) GetDedicatedHosts(provider, region string) ([]types.DedicatedHostInfo, bool) {
+	var (
+		res = make([]types.DedicatedHostInfo, 0)
+	)
+	_, ok := rps.get(rps.getKey(cloudinfo.DedicatedHostKeyTemplate, provider, region), &res)
+
+	return res, ok
+}
+
+func (rps *redisProductStore) StoreServerlessPrices(provider, region string, val []types.ServerlessPrice) {
+	rps.set(rps.getKey(cloudinfo.ServerlessPriceKeyTemplate, provider, region), val)
+}
+
+func (rps *redisProductStore) GetServerlessPrices(provider, region string) ([]types.ServerlessPrice, bool) {
+	var (
+		res = make([]types.ServerlessPrice, 0)
+	)
+	_, ok := rps.get(rps.getKey(cloudinfo.ServerlessPriceKeyTemplate, provider, region), &res)
+
+	return res, ok
+}
+
 func (rps *redisProductStore) StoreVm(provider, service, region string, val []types.VMInfo) {
 	rps.set(rps.getKey(cloudinfo.VmKeyTemplate, provider, service, region), val)
 }
@@ -242,6 +428,58 @@ func (rps *redisProductStore) GetStatus(provider string) (string, bool) {
 	return res, ok
 }
 
+func (rps *redisProductStore) StoreRegionStatus(provider, service, region string, val string) {
+	rps.set(rps.getKey(cloudinfo.RegionStatusKeyTemplate, provider, service, region), val)
+}
+
+func (rps *redisProductStore) GetRegionStatus(provider, service, region string) (string, bool) {
+	var (
+		res string
+	)
+	_, ok := rps.get(rps.getKey(cloudinfo.RegionStatusKeyTemplate, provider, service, region), &res)
+
+	return res, ok
+}
+
+func (rps *redisProductStore) StoreRegionHash(provider, service, region string, val string) {
+	rps.set(rps.getKey(cloudinfo.RegionHashKeyTemplate, provider, service, region), val)
+}
+
+func (rps *redisProductStore) GetRegionHash(provider, service, region string) (string, bool) {
+	var (
+		res string
+	)
+	_, ok := rps.get(rps.getKey(cloudinfo.RegionHashKeyTemplate, provider, service, region), &res)
+
+	return res, ok
+}
+
+func (rps *redisProductStore) StoreRegionPriceHash(provider, region string, val string) {
+	rps.set(rps.getKey(cloudinfo.RegionPriceHashKeyTemplate, provider, region), val)
+}
+
+func (rps *redisProductStore) GetRegionPriceHash(provider, region string) (string, bool) {
+	var (
+		res string
+	)
+	_, ok := rps.get(rps.getKey(cloudinfo.RegionPriceHashKeyTemplate, provider, region), &res)
+
+	return res, ok
+}
+
+func (rps *redisProductStore) StoreRegionError(provider, service, region string, val types.ScrapeError) {
+	rps.set(rps.getKey(cloudinfo.RegionErrorKeyTemplate, provider, service, region), val)
+}
+
+func (rps *redisProductStore) GetRegionError(provider, service, region string) (types.ScrapeError, bool) {
+	var (
+		res types.ScrapeError
+	)
+	_, ok := rps.get(rps.getKey(cloudinfo.RegionErrorKeyTemplate, provider, service, region), &res)
+
+	return res, ok
+}
+
 func (rps *redisProductStore) StoreServices(provider string, services []types.Service) {
 	rps.set(rps.getKey(cloudinfo.ServicesKeyTemplate, provider), services)
 }
@@ -0,0 +1,89 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/spf13/viper"
+)
+
+// RegionCompliance the compliance scopes (eg.: hipaa, fedramp-moderate, c5) of the regions by provider and region
+type RegionCompliance map[string]map[string][]string
+
+// LoadRegionCompliance loads the compliance scopes of the regions from the compliance file in the service config location,
+// no compliance scopes are known if the file doesn't exist
+func LoadRegionCompliance(config Config) (RegionCompliance, error) {
+	if config.ComplianceConfigName == "" {
+		return RegionCompliance{}, nil
+	}
+
+	vp := viper.New()
+	vp.AddConfigPath(config.ServiceConfigLocation)
+	vp.SetConfigName(config.ComplianceConfigName)
+	vp.SetConfigType(config.Format)
+
+	if err := vp.ReadInConfig(); err != nil {
+		if _, ok := err.(viper.ConfigFileNotFoundError); ok {
+			return RegionCompliance{}, nil
+		}
+
+		return nil, errors.WrapIf(err, "failed to read the compliance scopes of the regions")
+	}
+
+	var compliance RegionCompliance
+	if err := vp.Unmarshal(&compliance); err != nil {
+		return nil, errors.WrapIf(err, "failed to parse the compliance scopes of the regions")
+	}
+
+	for provider, regions := range compliance {
+		for region, scopes := range regions {
+			for i, scope := range scopes {
+				scope = strings.ToLower(strings.TrimSpace(scope))
+				if scope == "" {
+					return nil, errors.NewWithDetails("empty compliance scope", "provider", provider, "region", region)
+				}
+				scopes[i] = scope
+			}
+		}
+	}
+
+	return compliance, nil
+}
+
+// Scopes returns the compliance scopes of a region of the provider
+func (rc RegionCompliance) Scopes(provider, region string) []string {
+	return rc[strings.ToLower(provider)][strings.ToLower(region)]
+}
+
+// Covers checks whether a region of the provider is in all the required compliance scopes
+func (rc RegionCompliance) Covers(provider, region string, required []string) bool {
+	scopes := rc.Scopes(provider, region)
+	for _, req := range required {
+		found := false
+		for _, scope := range scopes {
+			if strings.EqualFold(scope, strings.TrimSpace(req)) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
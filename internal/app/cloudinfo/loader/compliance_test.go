@@ -0,0 +1,78 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadRegionCompliance(t *testing.T) {
+	dir, err := ioutil.TempDir("", "compliance")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	err = ioutil.WriteFile(filepath.Join(dir, "compliance.yaml"), []byte("amazon:\n  eu-central-1: [HIPAA, c5]\n  us-east-1: [hipaa]\n"), 0600)
+	assert.NoError(t, err)
+
+	compliance, err := LoadRegionCompliance(Config{ServiceConfigLocation: dir, ComplianceConfigName: "compliance", Format: "yaml"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"hipaa", "c5"}, compliance.Scopes("amazon", "eu-central-1"))
+
+	tests := []struct {
+		name     string
+		region   string
+		required []string
+		check    func(covers bool)
+	}{
+		{
+			name:     "all the required scopes",
+			region:   "eu-central-1",
+			required: []string{"c5", "Hipaa"},
+			check: func(covers bool) {
+				assert.True(t, covers)
+			},
+		},
+		{
+			name:     "missing required scope",
+			region:   "us-east-1",
+			required: []string{"hipaa", "c5"},
+			check: func(covers bool) {
+				assert.False(t, covers)
+			},
+		},
+		{
+			name:     "unknown region",
+			region:   "eu-west-1",
+			required: []string{"hipaa"},
+			check: func(covers bool) {
+				assert.False(t, covers)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.check(compliance.Covers("amazon", test.region, test.required))
+		})
+	}
+
+	missing, err := LoadRegionCompliance(Config{ServiceConfigLocation: dir, ComplianceConfigName: "missing", Format: "yaml"})
+	assert.NoError(t, err)
+	assert.Empty(t, missing)
+}
@@ -23,4 +23,7 @@ type Config struct {
 
 	// the format of the data file (json / yaml)
 	Format string
+
+	// the name of the file with the compliance scopes of the regions (in the service config location)
+	ComplianceConfigName string
 }
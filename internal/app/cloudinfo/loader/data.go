@@ -82,4 +82,6 @@ type Service struct {
 	DataLocation string
 	DataFile     string
 	DataType     string
+	// ControlPlaneFee the hourly fee of a managed Kubernetes cluster control plane in USD
+	ControlPlaneFee float64
 }
@@ -82,7 +82,7 @@ func (sm *defaultServiceManager) ConfigureServices(providers []string, distribut
 				sm.log.Debug("service not enabled", map[string]interface{}{"provider": provider, "service": psvc.Name})
 				continue
 			}
-			services = append(services, types.Service{Service: psvc.Name, IsStatic: psvc.IsStatic})
+			services = append(services, types.Service{Service: psvc.Name, IsStatic: psvc.IsStatic, ControlPlaneFee: psvc.ControlPlaneFee})
 		}
 		sm.log.Debug("initialized provider services", map[string]interface{}{"provider": provider, "services #": len(services)})
 		sm.store.StoreServices(provider, services)
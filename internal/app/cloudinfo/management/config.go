@@ -22,6 +22,10 @@ import (
 type Config struct {
 	Enabled bool
 	Address string
+
+	// ExportDir the directory the local Parquet exports requested through the API are written under,
+	// the local destinations are refused if empty
+	ExportDir string
 }
 
 func (cfg *Config) Validate() error {
@@ -17,20 +17,27 @@ package management
 import (
 	"context"
 	"net/http"
+	"path/filepath"
+	"strings"
 
 	"emperror.dev/emperror"
+	"emperror.dev/errors"
 	"github.com/gin-gonic/gin"
 	"github.com/mitchellh/mapstructure"
 
 	"github.com/banzaicloud/cloudinfo/internal/app/cloudinfo/api"
 	"github.com/banzaicloud/cloudinfo/internal/cloudinfo"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/export"
 )
 
 // mngmntRouteHandler struct collecting handlers for the management service
 type mngmntRouteHandler struct {
 	cis cloudinfo.CloudInfoStore
-	sd  cloudinfo.ScrapingDriver
+	sd  *cloudinfo.ScrapingDriver
+	exp *export.Exporter
 	log cloudinfo.Logger
+
+	exportDir string
 }
 
 // Export exports the content of the Store into the response body
@@ -67,6 +74,61 @@ func (mrh *mngmntRouteHandler) Import() gin.HandlerFunc {
 	}
 }
 
+// ExportParquet writes the product catalog of the providers as Parquet files to the destination
+// (s3://bucket/prefix, gs://bucket/prefix or a directory relative to the export directory) given in the query
+func (mrh *mngmntRouteHandler) ExportParquet() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		destination, err := exportDestination(mrh.exportDir, c.Query("destination"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		var providers []string
+		if p := c.Query("providers"); p != "" {
+			providers = strings.Split(p, ",")
+		}
+
+		mrh.log.Info("exporting product catalog as parquet", map[string]interface{}{"destination": destination})
+		files, err := mrh.exp.Export(c.Request.Context(), destination, providers)
+		if err != nil {
+			mrh.log.Error("failed to export product catalog", map[string]interface{}{"err": err})
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error(), "files": files})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"operation": "export", "files": files})
+	}
+}
+
+// exportDestination checks the export destination requested through the API: the buckets are passed on, the local
+// directories must be relative to the export directory and stay within it
+func exportDestination(exportDir, destination string) (string, error) {
+	if destination == "" {
+		return "", errors.New("the destination query parameter must be set")
+	}
+
+	if strings.HasPrefix(destination, "s3://") || strings.HasPrefix(destination, "gs://") {
+		return destination, nil
+	}
+
+	if strings.Contains(destination, "://") {
+		return "", errors.NewWithDetails("unsupported export destination scheme", "destination", destination)
+	}
+
+	if exportDir == "" {
+		return "", errors.New("local export destinations are disabled, the export directory is not configured")
+	}
+
+	dir := filepath.Clean(destination)
+	if filepath.IsAbs(dir) || dir == ".." || strings.HasPrefix(dir, ".."+string(filepath.Separator)) {
+		return "", errors.NewWithDetails("the local export destination must be a path within the export directory",
+			"destination", destination)
+	}
+
+	return filepath.Join(exportDir, dir), nil
+}
+
 // Refresh handler that triggers the refresh process for a provider
 func (mrh *mngmntRouteHandler) Refresh() gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -90,17 +152,18 @@ func (mrh *mngmntRouteHandler) Refresh() gin.HandlerFunc {
 	}
 }
 
-func StartManagementEngine(cfg Config, cis cloudinfo.CloudInfoStore, sd cloudinfo.ScrapingDriver, log cloudinfo.Logger) *gin.Engine {
+func StartManagementEngine(cfg Config, cis cloudinfo.CloudInfoStore, sd *cloudinfo.ScrapingDriver, exp *export.Exporter, log cloudinfo.Logger) *gin.Engine {
 	if err := cfg.Validate(); err != nil {
 		emperror.Panic(err)
 	}
 
-	rh := &mngmntRouteHandler{cis, sd, log}
+	rh := &mngmntRouteHandler{cis, sd, exp, log, cfg.ExportDir}
 
 	router := gin.New()
 	base := router.Group("/management/store")
 	base.GET("export", rh.Export())
 	base.PUT("import", rh.Import())
+	base.PUT("export/parquet", rh.ExportParquet())
 	base.PUT("refresh/:provider", rh.Refresh())
 	if err := router.Run(cfg.Address); err != nil {
 		emperror.Panic(err)
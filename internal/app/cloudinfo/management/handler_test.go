@@ -0,0 +1,85 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package management
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportDestination(t *testing.T) {
+	tests := []struct {
+		name        string
+		exportDir   string
+		destination string
+		expected    string
+		wantErr     bool
+	}{
+		{
+			name:        "the buckets are passed on",
+			destination: "s3://analytics/cloudinfo",
+			expected:    "s3://analytics/cloudinfo",
+		},
+		{
+			name:        "the local destinations are refused without an export directory",
+			destination: "cloudinfo",
+			wantErr:     true,
+		},
+		{
+			name:        "the local destination is written under the export directory",
+			exportDir:   "/var/lib/cloudinfo",
+			destination: "daily/cloudinfo",
+			expected:    filepath.Join("/var/lib/cloudinfo", "daily", "cloudinfo"),
+		},
+		{
+			name:        "the local destination can't be absolute",
+			exportDir:   "/var/lib/cloudinfo",
+			destination: "/etc/cron.d",
+			wantErr:     true,
+		},
+		{
+			name:        "the local destination can't escape the export directory",
+			exportDir:   "/var/lib/cloudinfo",
+			destination: "daily/../../../etc",
+			wantErr:     true,
+		},
+		{
+			name:        "the destination must be set",
+			exportDir:   "/var/lib/cloudinfo",
+			destination: "",
+			wantErr:     true,
+		},
+		{
+			name:        "the unsupported schemes are refused",
+			exportDir:   "/var/lib/cloudinfo",
+			destination: "file:///etc",
+			wantErr:     true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			destination, err := exportDestination(test.exportDir, test.destination)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, destination)
+		})
+	}
+}
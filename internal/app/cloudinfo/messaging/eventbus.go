@@ -29,10 +29,61 @@ type EventBus interface {
 
 	// SubscribeScrapingComplete
 	SubscribeScrapingComplete(provider string, callback interface{})
+
+	// SubscribeAnyScrapingComplete subscribes the callback to the "scraping complete" messages of all providers
+	// the callback receives the provider as argument
+	SubscribeAnyScrapingComplete(callback interface{})
+
+	// PublishRegionRefreshed emits a "region refreshed" message for the given provider, service and region
+	PublishRegionRefreshed(provider, service, region string)
+
+	// SubscribeRegionRefreshed subscribes the callback to the "region refreshed" messages of all providers
+	// the callback receives the provider, service and region as arguments
+	SubscribeRegionRefreshed(callback interface{})
+
+	// PublishProductsChanged emits a "products changed" message for the given provider, service and region
+	PublishProductsChanged(provider, service, region string, change ProductsChange)
+
+	// SubscribeProductsChanged subscribes the callback to the "products changed" messages of all providers
+	// the callback receives the provider, service, region and the change as arguments
+	SubscribeProductsChanged(callback interface{})
+
+	// PublishSpotPricesChanged emits a "spot prices changed" message for the given provider and region
+	PublishSpotPricesChanged(provider, region string, prices SpotPrices)
+
+	// SubscribeSpotPricesChanged subscribes the callback to the "spot prices changed" messages of all providers
+	// the callback receives the provider, region and the changed spot prices as arguments
+	SubscribeSpotPricesChanged(callback interface{})
+}
+
+// SpotPrices holds the spot prices of instance types by instance type and zone
+type SpotPrices map[string]map[string]float64
+
+// ProductsChange describes the changes of the products of a region between two scrapes
+type ProductsChange struct {
+	// Added the types of the new products
+	Added []string
+	// Removed the types of the products not offered anymore
+	Removed []string
+	// Repriced the types of the products with a changed on demand price
+	Repriced []string
+}
+
+// Empty checks whether the products are unchanged
+func (pc ProductsChange) Empty() bool {
+	return len(pc.Added) == 0 && len(pc.Removed) == 0 && len(pc.Repriced) == 0
 }
 
 const (
 	topicPrefix = "load:service"
+
+	scrapingCompleteTopic = "load:provider"
+
+	regionRefreshedTopic = "refresh:region"
+
+	productsChangedTopic = "change:products"
+
+	spotPricesChangedTopic = "change:spot-prices"
 )
 
 // defaultEventBus default EventBus component implementation backed by https://github.com/asaskevich/EventBus
@@ -43,6 +94,7 @@ type defaultEventBus struct {
 
 func (eb *defaultEventBus) PublishScrapingComplete(provider string) {
 	eb.eventBus.Publish(eb.providerScrapingTopic(provider))
+	eb.eventBus.Publish(scrapingCompleteTopic, provider)
 }
 
 func (eb *defaultEventBus) SubscribeScrapingComplete(provider string, callback interface{}) {
@@ -51,6 +103,42 @@ func (eb *defaultEventBus) SubscribeScrapingComplete(provider string, callback i
 	}
 }
 
+func (eb *defaultEventBus) SubscribeAnyScrapingComplete(callback interface{}) {
+	if err := eb.eventBus.SubscribeAsync(scrapingCompleteTopic, callback, false); err != nil {
+		eb.errorHandler.Handle(err)
+	}
+}
+
+func (eb *defaultEventBus) PublishRegionRefreshed(provider, service, region string) {
+	eb.eventBus.Publish(regionRefreshedTopic, provider, service, region)
+}
+
+func (eb *defaultEventBus) SubscribeRegionRefreshed(callback interface{}) {
+	if err := eb.eventBus.SubscribeAsync(regionRefreshedTopic, callback, false); err != nil {
+		eb.errorHandler.Handle(err)
+	}
+}
+
+func (eb *defaultEventBus) PublishProductsChanged(provider, service, region string, change ProductsChange) {
+	eb.eventBus.Publish(productsChangedTopic, provider, service, region, change)
+}
+
+func (eb *defaultEventBus) SubscribeProductsChanged(callback interface{}) {
+	if err := eb.eventBus.SubscribeAsync(productsChangedTopic, callback, false); err != nil {
+		eb.errorHandler.Handle(err)
+	}
+}
+
+func (eb *defaultEventBus) PublishSpotPricesChanged(provider, region string, prices SpotPrices) {
+	eb.eventBus.Publish(spotPricesChangedTopic, provider, region, prices)
+}
+
+func (eb *defaultEventBus) SubscribeSpotPricesChanged(callback interface{}) {
+	if err := eb.eventBus.SubscribeAsync(spotPricesChangedTopic, callback, false); err != nil {
+		eb.errorHandler.Handle(err)
+	}
+}
+
 func (eb *defaultEventBus) providerScrapingTopic(provider string) string {
 	return strings.Join([]string{topicPrefix, provider}, ":")
 }
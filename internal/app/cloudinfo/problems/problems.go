@@ -25,31 +25,40 @@ import (
 const (
 	validationProblemTitle = "validation problem"
 	providerProblemTitle   = "cloud provider problem"
+	notFoundProblemTitle   = "not found"
 )
 
 type ProblemWrapper struct {
 	*problems.DefaultProblem
+	// Suggestions the closest existing resources when the requested one is not found
+	Suggestions []string `json:"suggestions,omitempty"`
 }
 
 func (pw *ProblemWrapper) String() string {
-	str, _ := json.Marshal(pw.DefaultProblem)
+	str, _ := json.Marshal(pw)
 	return string(str)
 }
 
 func NewValidationProblem(code int, details string) *ProblemWrapper {
 	pb := problems.NewDetailedProblem(code, details)
 	pb.Title = validationProblemTitle
-	return &ProblemWrapper{pb}
+	return &ProblemWrapper{DefaultProblem: pb}
 }
 
 func NewProviderProblem(code int, details string) *ProblemWrapper {
 	pb := problems.NewDetailedProblem(code, details)
 	pb.Title = providerProblemTitle
-	return &ProblemWrapper{pb}
+	return &ProblemWrapper{DefaultProblem: pb}
+}
+
+func NewNotFoundProblem(details string, suggestions []string) *ProblemWrapper {
+	pb := problems.NewDetailedProblem(http.StatusNotFound, details)
+	pb.Title = notFoundProblemTitle
+	return &ProblemWrapper{DefaultProblem: pb, Suggestions: suggestions}
 }
 
 func NewUnknownProblem(un interface{}) *ProblemWrapper {
-	return &ProblemWrapper{problems.NewDetailedProblem(http.StatusInternalServerError, fmt.Sprintf("%s", un))}
+	return &ProblemWrapper{DefaultProblem: problems.NewDetailedProblem(http.StatusInternalServerError, fmt.Sprintf("%s", un))}
 }
 
 func IsDefaultProblem(d interface{}) bool {
@@ -58,7 +67,7 @@ func IsDefaultProblem(d interface{}) bool {
 }
 
 func NewDetailedProblem(status int, details string) *ProblemWrapper {
-	return &ProblemWrapper{problems.NewDetailedProblem(status, details)}
+	return &ProblemWrapper{DefaultProblem: problems.NewDetailedProblem(status, details)}
 }
 
 func ProblemStatus(d interface{}) int {
@@ -0,0 +1,39 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"strings"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// armArchitectures holds the (lowercase) names of the 64-bit ARM architecture used by the providers
+var armArchitectures = []string{"arm", "arm64", "aarch64"}
+
+// Architecture maps the provider specific name of a cpu architecture (eg.: Arm64, aarch64, x86_64) to the architecture
+// of the products, the architectures other than ARM are considered amd64
+func Architecture(name string) string {
+	if Contains(armArchitectures, strings.ToLower(strings.TrimSpace(name))) {
+		return types.ArchitectureARM64
+	}
+
+	return types.ArchitectureAMD64
+}
+
+// IsArchitecture checks whether the given value is a known architecture of the products
+func IsArchitecture(architecture string) bool {
+	return architecture == types.ArchitectureAMD64 || architecture == types.ArchitectureARM64
+}
@@ -0,0 +1,49 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestArchitecture(t *testing.T) {
+	tests := []struct {
+		name         string
+		architecture string
+	}{
+		{name: "Arm64", architecture: types.ArchitectureARM64},
+		{name: "arm", architecture: types.ArchitectureARM64},
+		{name: "aarch64", architecture: types.ArchitectureARM64},
+		{name: "x64", architecture: types.ArchitectureAMD64},
+		{name: "x86_64", architecture: types.ArchitectureAMD64},
+		{name: "", architecture: types.ArchitectureAMD64},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.architecture, Architecture(test.name))
+		})
+	}
+}
+
+func TestIsArchitecture(t *testing.T) {
+	assert.True(t, IsArchitecture(types.ArchitectureAMD64))
+	assert.True(t, IsArchitecture(types.ArchitectureARM64))
+	assert.False(t, IsArchitecture("x86_64"))
+}
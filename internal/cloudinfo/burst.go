@@ -0,0 +1,36 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// creditAccrualHours the number of hours the earned cpu credits of a burstable instance type can be accrued for
+const creditAccrualHours = 24
+
+// CpuCredits returns the cpu credit model of a burstable instance type from its baseline performance in percent of a vCPU,
+// nil if the baseline is unknown
+func CpuCredits(baseline, cpus float64) *types.CpuCredits {
+	if baseline <= 0 || cpus <= 0 {
+		return nil
+	}
+
+	earned := baseline * cpus * 60 / 100
+	return &types.CpuCredits{
+		EarnedPerHour: earned,
+		MaxAccrued:    earned * creditAccrualHours,
+	}
+}
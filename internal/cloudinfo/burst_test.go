@@ -0,0 +1,55 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestCpuCredits(t *testing.T) {
+	tests := []struct {
+		name     string
+		baseline float64
+		cpus     float64
+		expected *types.CpuCredits
+	}{
+		{
+			name:     "t3.micro earns 12 credits per hour",
+			baseline: 10,
+			cpus:     2,
+			expected: &types.CpuCredits{EarnedPerHour: 12, MaxAccrued: 288},
+		},
+		{
+			name:     "t2.xlarge earns 54 credits per hour",
+			baseline: 22.5,
+			cpus:     4,
+			expected: &types.CpuCredits{EarnedPerHour: 54, MaxAccrued: 1296},
+		},
+		{
+			name:     "unknown baseline",
+			baseline: 0,
+			cpus:     2,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, CpuCredits(test.baseline, test.cpus))
+		})
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"io/ioutil"
+
+	"emperror.dev/errors"
+	"gopkg.in/yaml.v2"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// googleCarbonSource the publication of the carbon data of the google regions
+const googleCarbonSource = "google 2021"
+
+// publishedRegionCarbon the carbon data of the regions published by the providers: the google carbon-free energy
+// percentages and grid carbon intensities (https://cloud.google.com/sustainability/region-carbon); amazon and azure
+// don't publish data per region
+var publishedRegionCarbon = map[string]map[string]types.RegionCarbon{
+	"google": {
+		"asia-east1":              {Intensity: 541, CarbonFreeEnergy: 17, Source: googleCarbonSource},
+		"asia-east2":              {Intensity: 626, CarbonFreeEnergy: 1, Source: googleCarbonSource},
+		"asia-northeast1":         {Intensity: 463, CarbonFreeEnergy: 16, Source: googleCarbonSource},
+		"asia-northeast2":         {Intensity: 384, CarbonFreeEnergy: 30, Source: googleCarbonSource},
+		"asia-northeast3":         {Intensity: 425, CarbonFreeEnergy: 31, Source: googleCarbonSource},
+		"asia-south1":             {Intensity: 670, CarbonFreeEnergy: 12, Source: googleCarbonSource},
+		"asia-southeast1":         {Intensity: 372, CarbonFreeEnergy: 4, Source: googleCarbonSource},
+		"australia-southeast1":    {Intensity: 598, CarbonFreeEnergy: 27, Source: googleCarbonSource},
+		"europe-north1":           {Intensity: 127, CarbonFreeEnergy: 97, Source: googleCarbonSource},
+		"europe-west1":            {Intensity: 110, CarbonFreeEnergy: 80, Source: googleCarbonSource},
+		"europe-west2":            {Intensity: 172, CarbonFreeEnergy: 81, Source: googleCarbonSource},
+		"europe-west3":            {Intensity: 293, CarbonFreeEnergy: 62, Source: googleCarbonSource},
+		"europe-west4":            {Intensity: 356, CarbonFreeEnergy: 69, Source: googleCarbonSource},
+		"europe-west6":            {Intensity: 86, CarbonFreeEnergy: 87, Source: googleCarbonSource},
+		"northamerica-northeast1": {Intensity: 0, CarbonFreeEnergy: 100, Source: googleCarbonSource},
+		"southamerica-east1":      {Intensity: 103, CarbonFreeEnergy: 91, Source: googleCarbonSource},
+		"us-central1":             {Intensity: 394, CarbonFreeEnergy: 97, Source: googleCarbonSource},
+		"us-east1":                {Intensity: 434, CarbonFreeEnergy: 28, Source: googleCarbonSource},
+		"us-east4":                {Intensity: 361, CarbonFreeEnergy: 60, Source: googleCarbonSource},
+		"us-west1":                {Intensity: 78, CarbonFreeEnergy: 89, Source: googleCarbonSource},
+		"us-west2":                {Intensity: 253, CarbonFreeEnergy: 55, Source: googleCarbonSource},
+	},
+}
+
+// RegionCarbonData holds the carbon intensity data of the regions by provider and region: the data published by the
+// providers and the overrides, replacing the published data of a region or adding the data of the unpublished ones
+type RegionCarbonData struct {
+	overrides map[string]map[string]types.RegionCarbon
+}
+
+// NewRegionCarbonData creates new region carbon data with the given provider - region - carbon data overrides
+func NewRegionCarbonData(overrides map[string]map[string]types.RegionCarbon) *RegionCarbonData {
+	return &RegionCarbonData{
+		overrides: overrides,
+	}
+}
+
+// Carbon returns the carbon data of the region, nil if not known
+func (rcd *RegionCarbonData) Carbon(provider, region string) *types.RegionCarbon {
+	if carbon, ok := rcd.overrides[provider][region]; ok {
+		return &carbon
+	}
+	if carbon, ok := publishedRegionCarbon[provider][region]; ok {
+		return &carbon
+	}
+
+	return nil
+}
+
+// LoadRegionCarbonOverrides reads the provider - region - carbon data mapping from the given YAML file,
+// no overrides are returned if the path is empty
+func LoadRegionCarbonOverrides(path string) (map[string]map[string]types.RegionCarbon, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.WrapIfWithDetails(err, "failed to read region carbon overrides", "path", path)
+	}
+
+	var mapping map[string]map[string]types.RegionCarbon
+	if err := yaml.Unmarshal(content, &mapping); err != nil {
+		return nil, errors.WrapIfWithDetails(err, "failed to parse region carbon overrides", "path", path)
+	}
+
+	for provider, regions := range mapping {
+		for region, carbon := range regions {
+			if carbon.Intensity < 0 || carbon.CarbonFreeEnergy < 0 || carbon.CarbonFreeEnergy > 100 {
+				return nil, errors.NewWithDetails("invalid region carbon data", "path", path, "provider", provider, "region", region)
+			}
+		}
+	}
+
+	return mapping, nil
+}
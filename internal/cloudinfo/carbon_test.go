@@ -0,0 +1,60 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestRegionCarbonData_Carbon(t *testing.T) {
+	data := NewRegionCarbonData(map[string]map[string]types.RegionCarbon{
+		"google": {"europe-west1": {Intensity: 150, CarbonFreeEnergy: 75, Source: "own measurement"}},
+		"amazon": {"eu-north-1": {Intensity: 30}},
+	})
+
+	assert.Equal(t, &types.RegionCarbon{Intensity: 150, CarbonFreeEnergy: 75, Source: "own measurement"}, data.Carbon("google", "europe-west1"),
+		"the overrides should replace the published data")
+	assert.Equal(t, &types.RegionCarbon{Intensity: 127, CarbonFreeEnergy: 97, Source: googleCarbonSource}, data.Carbon("google", "europe-north1"))
+	assert.Equal(t, &types.RegionCarbon{Intensity: 30}, data.Carbon("amazon", "eu-north-1"))
+	assert.Nil(t, data.Carbon("amazon", "eu-west-1"))
+}
+
+func TestLoadRegionCarbonOverrides(t *testing.T) {
+	mapping, err := LoadRegionCarbonOverrides("")
+	assert.NoError(t, err)
+	assert.Nil(t, mapping)
+
+	dir := t.TempDir()
+
+	valid := filepath.Join(dir, "valid.yaml")
+	assert.NoError(t, ioutil.WriteFile(valid, []byte("amazon:\n  eu-north-1:\n    intensity: 30\n    carbonFreeEnergy: 95\n"), 0600))
+	mapping, err = LoadRegionCarbonOverrides(valid)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]map[string]types.RegionCarbon{"amazon": {"eu-north-1": {Intensity: 30, CarbonFreeEnergy: 95}}}, mapping)
+
+	invalid := filepath.Join(dir, "invalid.yaml")
+	assert.NoError(t, ioutil.WriteFile(invalid, []byte("amazon:\n  eu-north-1:\n    carbonFreeEnergy: 120\n"), 0600))
+	_, err = LoadRegionCarbonOverrides(invalid)
+	assert.Error(t, err)
+
+	_, err = LoadRegionCarbonOverrides(filepath.Join(dir, "missing.yaml"))
+	assert.Error(t, err)
+}
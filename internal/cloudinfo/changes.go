@@ -0,0 +1,106 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/banzaicloud/cloudinfo/internal/app/cloudinfo/messaging"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// stampProductChanges sets the time the virtual machines were last changed at: the time of the scrape for the new and
+// the changed ones, the previous time for the unchanged ones
+func stampProductChanges(previous, vms []types.VMInfo, now time.Time) {
+	previousVms := make(map[string]types.VMInfo, len(previous))
+	for _, vm := range previous {
+		previousVms[vm.Type] = vm
+	}
+
+	for i := range vms {
+		if vm, ok := previousVms[vms[i].Type]; ok && !vm.UpdatedAt.IsZero() && sameProduct(vm, vms[i]) {
+			vms[i].UpdatedAt = vm.UpdatedAt
+			continue
+		}
+		vms[i].UpdatedAt = now
+	}
+}
+
+// diffProducts returns the added, removed and repriced virtual machines compared to the previously stored ones
+func diffProducts(previous, vms []types.VMInfo) messaging.ProductsChange {
+	var change messaging.ProductsChange
+
+	previousVms := make(map[string]types.VMInfo, len(previous))
+	for _, vm := range previous {
+		previousVms[vm.Type] = vm
+	}
+
+	current := make(map[string]bool, len(vms))
+	for _, vm := range vms {
+		current[vm.Type] = true
+
+		previousVm, ok := previousVms[vm.Type]
+		switch {
+		case !ok:
+			change.Added = append(change.Added, vm.Type)
+		case previousVm.OnDemandPrice != vm.OnDemandPrice || previousVm.Currency != vm.Currency:
+			change.Repriced = append(change.Repriced, vm.Type)
+		}
+	}
+
+	for _, vm := range previous {
+		if !current[vm.Type] {
+			change.Removed = append(change.Removed, vm.Type)
+		}
+	}
+
+	sort.Strings(change.Added)
+	sort.Strings(change.Removed)
+	sort.Strings(change.Repriced)
+
+	return change
+}
+
+// sameProduct checks whether the price and the attributes of the virtual machines are the same; they are compared in
+// their stored (JSON) form, so the empty and the missing values are considered the same
+func sameProduct(vm1, vm2 types.VMInfo) bool {
+	vm1.UpdatedAt, vm2.UpdatedAt = time.Time{}, time.Time{}
+
+	json1, err := json.Marshal(vm1)
+	if err != nil {
+		return false
+	}
+	json2, err := json.Marshal(vm2)
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(json1, json2)
+}
+
+// changedProducts returns the products changed after the given time
+func changedProducts(details []types.ProductDetails, since time.Time) []types.ProductDetails {
+	changed := make([]types.ProductDetails, 0)
+	for _, detail := range details {
+		if detail.UpdatedAt.After(since) {
+			changed = append(changed, detail)
+		}
+	}
+
+	return changed
+}
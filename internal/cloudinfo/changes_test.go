@@ -0,0 +1,116 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/app/cloudinfo/messaging"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestStampProductChanges(t *testing.T) {
+	before := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	now := before.Add(time.Hour)
+
+	previous := []types.VMInfo{
+		{Type: "unchanged", OnDemandPrice: 0.1, LocalDisks: []types.LocalDisk{}, UpdatedAt: before},
+		{Type: "repriced", OnDemandPrice: 0.1, UpdatedAt: before},
+		{Type: "unstamped", OnDemandPrice: 0.1},
+	}
+	vms := []types.VMInfo{
+		{Type: "unchanged", OnDemandPrice: 0.1},
+		{Type: "repriced", OnDemandPrice: 0.2},
+		{Type: "unstamped", OnDemandPrice: 0.1},
+		{Type: "new", OnDemandPrice: 0.1},
+	}
+
+	stampProductChanges(previous, vms, now)
+
+	assert.Equal(t, before, vms[0].UpdatedAt, "the empty and the missing values should be the same")
+	assert.Equal(t, now, vms[1].UpdatedAt)
+	assert.Equal(t, now, vms[2].UpdatedAt)
+	assert.Equal(t, now, vms[3].UpdatedAt)
+}
+
+// changesStore stores the products of a single provider and service in memory
+type changesStore struct {
+	vms map[string][]types.VMInfo
+	// implement the interface
+	CloudInfoStore
+}
+
+func (cs *changesStore) GetServices(provider string) ([]types.Service, bool) {
+	return []types.Service{{Service: "compute"}, {Service: "pke", IsStatic: true}}, true
+}
+
+func (cs *changesStore) GetRegions(provider, service string) (map[string]string, bool) {
+	return map[string]string{"region-1": "Region 1", "region-2": "Region 2", "region-3": "Region 3"}, true
+}
+
+func (cs *changesStore) GetVm(provider, service, region string) ([]types.VMInfo, bool) {
+	vms, ok := cs.vms[service+"/"+region]
+	return vms, ok
+}
+
+func (cs *changesStore) GetPrice(provider, region, instanceType string) (types.Price, bool) {
+	return types.Price{}, false
+}
+
+func TestDiffProducts(t *testing.T) {
+	previous := []types.VMInfo{
+		{Type: "m5.large", OnDemandPrice: 0.096},
+		{Type: "m5.xlarge", OnDemandPrice: 0.192},
+		{Type: "m4.large", OnDemandPrice: 0.1},
+		{Type: "c5.large", OnDemandPrice: 0.085, SpotPrice: []types.ZonePrice{{Zone: "a", Price: 0.03}}},
+	}
+	vms := []types.VMInfo{
+		{Type: "m5.large", OnDemandPrice: 0.096},
+		{Type: "m5.xlarge", OnDemandPrice: 0.2},
+		{Type: "m6i.large", OnDemandPrice: 0.096},
+		{Type: "c5.large", OnDemandPrice: 0.085, SpotPrice: []types.ZonePrice{{Zone: "a", Price: 0.04}}},
+	}
+
+	change := diffProducts(previous, vms)
+
+	assert.Equal(t, messaging.ProductsChange{
+		Added:    []string{"m6i.large"},
+		Removed:  []string{"m4.large"},
+		Repriced: []string{"m5.xlarge"},
+	}, change)
+	assert.True(t, diffProducts(previous, previous).Empty())
+}
+
+func TestCloudInfo_GetProductChanges(t *testing.T) {
+	since := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	old := types.VMInfo{Type: "old", UpdatedAt: since.Add(-time.Hour)}
+	changed := types.VMInfo{Type: "changed", UpdatedAt: since.Add(time.Hour)}
+
+	info, _ := NewCloudInfo([]string{"dummy"}, &changesStore{vms: map[string][]types.VMInfo{
+		"compute/region-1": {old, changed},
+		"compute/region-2": {old},
+	}}, cloudinfoLogger)
+
+	changes, err := info.GetProductChanges("", since)
+	assert.NoError(t, err)
+	assert.Equal(t, []types.ProductChanges{{Provider: "dummy", Service: "compute", Region: "region-1",
+		Products: []types.ProductDetails{*types.NewProductDetails(changed)}}}, changes)
+
+	_, err = info.GetProductChanges("unknown", since)
+	assert.Error(t, err)
+}
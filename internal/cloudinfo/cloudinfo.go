@@ -15,7 +15,9 @@
 package cloudinfo
 
 import (
+	"sort"
 	"strings"
+	"time"
 
 	"emperror.dev/errors"
 
@@ -145,6 +147,9 @@ func (cpi *cloudInfo) GetProductDetails(provider, service, region string) ([]typ
 		for zone, price := range cachedVal.SpotPrice {
 			pd.SpotPrice = append(pd.SpotPrice, *types.NewZonePrice(zone, price))
 		}
+		sort.Slice(pd.SpotPrice, func(i, j int) bool {
+			return pd.SpotPrice[i].Zone < pd.SpotPrice[j].Zone
+		})
 
 		details = append(details, *pd)
 	}
@@ -160,6 +165,37 @@ func (cpi *cloudInfo) GetStatus(provider string) (string, error) {
 	return "", errors.NewWithDetails("status not yet cached", "provider", provider)
 }
 
+// GetRegionStatus retrieves the status of the given provider, service and region
+func (cpi *cloudInfo) GetRegionStatus(provider, service, region string) (string, error) {
+	if cachedStatus, ok := cpi.cloudInfoStore.GetRegionStatus(provider, service, region); ok {
+		return cachedStatus, nil
+	}
+	return "", errors.NewWithDetails("region status not yet cached", "provider", provider,
+		"service", service, "region", region)
+}
+
+// GetRegionHash retrieves the content hash of the given provider, service and region,
+// combined with the hash of the prices of the region refreshed between the region scrapes
+func (cpi *cloudInfo) GetRegionHash(provider, service, region string) (string, error) {
+	if hash, ok := cpi.cloudInfoStore.GetRegionHash(provider, service, region); ok {
+		if priceHash, ok := cpi.cloudInfoStore.GetRegionPriceHash(provider, region); ok {
+			return hash + "/" + priceHash, nil
+		}
+		return hash, nil
+	}
+	return "", errors.NewWithDetails("region hash not yet cached", "provider", provider,
+		"service", service, "region", region)
+}
+
+// GetRegionError retrieves the error of the last failed scrape of the given provider, service and region
+func (cpi *cloudInfo) GetRegionError(provider, service, region string) (types.ScrapeError, error) {
+	if scrapeError, ok := cpi.cloudInfoStore.GetRegionError(provider, service, region); ok {
+		return scrapeError, nil
+	}
+	return types.ScrapeError{}, errors.NewWithDetails("region error not yet cached", "provider", provider,
+		"service", service, "region", region)
+}
+
 // GetServiceImages retrieves available images for the given provider, service and region
 func (cpi *cloudInfo) GetServiceImages(provider, service, region string) ([]types.Image, error) {
 	if cachedImages, ok := cpi.cloudInfoStore.GetImage(provider, service, region); ok {
@@ -179,9 +215,177 @@ func (cpi *cloudInfo) GetVersions(provider, service, region string) ([]types.Loc
 		"service", service, "region", region)
 }
 
+// GetSpotPriceHistory retrieves the spot price records of an instance type in effect between from and to
+func (cpi *cloudInfo) GetSpotPriceHistory(provider, region, instanceType string, from, to time.Time) ([]types.SpotPriceRecord, error) {
+	history, ok := cpi.cloudInfoStore.GetSpotPriceHistory(provider, region, instanceType)
+	if !ok {
+		return nil, errors.NewWithDetails("spot price history not yet cached", "provider", provider,
+			"region", region, "instanceType", instanceType)
+	}
+
+	return filterSpotPriceHistory(history, from, to), nil
+}
+
+// GetOnDemandPriceHistory retrieves the on demand price records of an instance type in effect between from and to
+func (cpi *cloudInfo) GetOnDemandPriceHistory(provider, region, instanceType string, from, to time.Time) ([]types.OnDemandPriceRecord, error) {
+	history, ok := cpi.cloudInfoStore.GetOnDemandPriceHistory(provider, region, instanceType)
+	if !ok {
+		return nil, errors.NewWithDetails("on demand price history not yet cached", "provider", provider,
+			"region", region, "instanceType", instanceType)
+	}
+
+	return filterOnDemandPriceHistory(history, from, to), nil
+}
+
+// GetProductChanges retrieves the products changed after the given time per provider, service and region,
+// all the providers are considered if the provider is empty
+func (cpi *cloudInfo) GetProductChanges(provider string, since time.Time) ([]types.ProductChanges, error) {
+	providers := cpi.providers
+	if provider != "" {
+		if !cpi.providerEnabled(provider) {
+			return nil, errors.NewWithDetails("unsupported provider", "provider", provider)
+		}
+		providers = []string{provider}
+	}
+
+	changes := make([]types.ProductChanges, 0)
+	for _, p := range providers {
+		services, ok := cpi.cloudInfoStore.GetServices(p)
+		if !ok {
+			continue
+		}
+
+		for _, service := range services {
+			regions, ok := cpi.cloudInfoStore.GetRegions(p, service.ServiceName())
+			if !ok {
+				continue
+			}
+
+			for region := range regions {
+				// the static services and the regions not yet scraped have no products
+				details, err := cpi.GetProductDetails(p, service.ServiceName(), region)
+				if err != nil {
+					continue
+				}
+
+				if changed := changedProducts(details, since); len(changed) > 0 {
+					changes = append(changes, types.ProductChanges{
+						Provider: p,
+						Service:  service.ServiceName(),
+						Region:   region,
+						Products: changed,
+					})
+				}
+			}
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Provider != changes[j].Provider {
+			return changes[i].Provider < changes[j].Provider
+		}
+		if changes[i].Service != changes[j].Service {
+			return changes[i].Service < changes[j].Service
+		}
+		return changes[i].Region < changes[j].Region
+	})
+
+	return changes, nil
+}
+
+// GetStoragePrices retrieves the block storage prices in a region
+func (cpi *cloudInfo) GetStoragePrices(provider, region string) ([]types.StoragePrice, error) {
+	if prices, ok := cpi.cloudInfoStore.GetStoragePrices(provider, region); ok {
+		return prices, nil
+	}
+
+	return nil, errors.NewWithDetails("storage prices not yet cached", "provider", provider, "region", region)
+}
+
+// GetObjectStoragePrices retrieves the object storage prices in a region
+func (cpi *cloudInfo) GetObjectStoragePrices(provider, region string) ([]types.ObjectStoragePrice, error) {
+	if prices, ok := cpi.cloudInfoStore.GetObjectStoragePrices(provider, region); ok {
+		return prices, nil
+	}
+
+	return nil, errors.NewWithDetails("object storage prices not yet cached", "provider", provider, "region", region)
+}
+
+// GetNetworkPrices retrieves the network traffic prices in a region
+func (cpi *cloudInfo) GetNetworkPrices(provider, region string) ([]types.NetworkPrice, error) {
+	if prices, ok := cpi.cloudInfoStore.GetNetworkPrices(provider, region); ok {
+		return prices, nil
+	}
+
+	return nil, errors.NewWithDetails("network prices not yet cached", "provider", provider, "region", region)
+}
+
+// GetLoadBalancerPrices retrieves the load balancer prices in a region
+func (cpi *cloudInfo) GetLoadBalancerPrices(provider, region string) ([]types.LoadBalancerPrice, error) {
+	if prices, ok := cpi.cloudInfoStore.GetLoadBalancerPrices(provider, region); ok {
+		return prices, nil
+	}
+
+	return nil, errors.NewWithDetails("load balancer prices not yet cached", "provider", provider, "region", region)
+}
+
+// GetNatGatewayPrices retrieves the NAT gateway prices in a region
+func (cpi *cloudInfo) GetNatGatewayPrices(provider, region string) ([]types.NatGatewayPrice, error) {
+	if prices, ok := cpi.cloudInfoStore.GetNatGatewayPrices(provider, region); ok {
+		return prices, nil
+	}
+
+	return nil, errors.NewWithDetails("NAT gateway prices not yet cached", "provider", provider, "region", region)
+}
+
+// GetPublicIpPrices retrieves the public IP prices in a region
+func (cpi *cloudInfo) GetPublicIpPrices(provider, region string) ([]types.PublicIpPrice, error) {
+	if prices, ok := cpi.cloudInfoStore.GetPublicIpPrices(provider, region); ok {
+		return prices, nil
+	}
+
+	return nil, errors.NewWithDetails("public IP prices not yet cached", "provider", provider, "region", region)
+}
+
+// GetDatabases retrieves the database instance classes in a region
+func (cpi *cloudInfo) GetDatabases(provider, region string) ([]types.DatabaseInfo, error) {
+	if prices, ok := cpi.cloudInfoStore.GetDatabases(provider, region); ok {
+		return prices, nil
+	}
+
+	return nil, errors.NewWithDetails("database instance classes not yet cached", "provider", provider, "region", region)
+}
+
+// GetDedicatedHosts retrieves the dedicated hosts in a region
+func (cpi *cloudInfo) GetDedicatedHosts(provider, region string) ([]types.DedicatedHostInfo, error) {
+	if prices, ok := cpi.cloudInfoStore.GetDedicatedHosts(provider, region); ok {
+		return prices, nil
+	}
+
+	return nil, errors.NewWithDetails("dedicated hosts not yet cached", "provider", provider, "region", region)
+}
+
+// GetServerlessPrices retrieves the serverless container prices in a region
+func (cpi *cloudInfo) GetServerlessPrices(provider, region string) ([]types.ServerlessPrice, error) {
+	if prices, ok := cpi.cloudInfoStore.GetServerlessPrices(provider, region); ok {
+		return prices, nil
+	}
+
+	return nil, errors.NewWithDetails("serverless container prices not yet cached", "provider", provider, "region", region)
+}
+
+// GetZoneInfo retrieves the availability zones in a region
+func (cpi *cloudInfo) GetZoneInfo(provider, region string) ([]types.ZoneInfo, error) {
+	if zones, ok := cpi.cloudInfoStore.GetZoneInfo(provider, region); ok {
+		return zones, nil
+	}
+
+	return nil, errors.NewWithDetails("availability zones not yet cached", "provider", provider, "region", region)
+}
+
 // GetContinents retrieves available continents
 func (cpi *cloudInfo) GetContinents() []string {
-	return []string{types.ContinentAsia, types.ContinentAustralia, types.ContinentEurope, types.ContinentNorthAmerica, types.ContinentSouthAmerica}
+	return []string{types.ContinentAfrica, types.ContinentAsia, types.ContinentAustralia, types.ContinentEurope, types.ContinentNorthAmerica, types.ContinentSouthAmerica}
 }
 
 // GetContinents gets the continents and regions for the provided provider
@@ -201,27 +405,107 @@ func (cpi *cloudInfo) GetContinentsData(provider, service string) (map[string][]
 	return nil, errors.NewWithDetails("regions not yet cached", "provider", provider, "services", service)
 }
 
+// cityContinents maps the regions named by the IATA code of their city (vultr)
+// or by a metro code (equinix metal) to continents
+var cityContinents = map[string]string{
+	"atl": types.ContinentNorthAmerica,
+	"dfw": types.ContinentNorthAmerica,
+	"ewr": types.ContinentNorthAmerica,
+	"hnl": types.ContinentNorthAmerica,
+	"lax": types.ContinentNorthAmerica,
+	"mex": types.ContinentNorthAmerica,
+	"mia": types.ContinentNorthAmerica,
+	"ord": types.ContinentNorthAmerica,
+	"sea": types.ContinentNorthAmerica,
+	"sjc": types.ContinentNorthAmerica,
+	"yto": types.ContinentNorthAmerica,
+	"sao": types.ContinentSouthAmerica,
+	"scl": types.ContinentSouthAmerica,
+	"ams": types.ContinentEurope,
+	"cdg": types.ContinentEurope,
+	"fra": types.ContinentEurope,
+	"lhr": types.ContinentEurope,
+	"mad": types.ContinentEurope,
+	"man": types.ContinentEurope,
+	"sto": types.ContinentEurope,
+	"waw": types.ContinentEurope,
+	"blr": types.ContinentAsia,
+	"bom": types.ContinentAsia,
+	"del": types.ContinentAsia,
+	"icn": types.ContinentAsia,
+	"itm": types.ContinentAsia,
+	"nrt": types.ContinentAsia,
+	"sgp": types.ContinentAsia,
+	"tlv": types.ContinentAsia,
+	"mel": types.ContinentAustralia,
+	"syd": types.ContinentAustralia,
+	"jnb": types.ContinentAfrica,
+
+	"at": types.ContinentNorthAmerica,
+	"ch": types.ContinentNorthAmerica,
+	"da": types.ContinentNorthAmerica,
+	"dc": types.ContinentNorthAmerica,
+	"la": types.ContinentNorthAmerica,
+	"mt": types.ContinentNorthAmerica,
+	"ny": types.ContinentNorthAmerica,
+	"se": types.ContinentNorthAmerica,
+	"sv": types.ContinentNorthAmerica,
+	"tr": types.ContinentNorthAmerica,
+	"sp": types.ContinentSouthAmerica,
+	"am": types.ContinentEurope,
+	"fr": types.ContinentEurope,
+	"ld": types.ContinentEurope,
+	"md": types.ContinentEurope,
+	"ml": types.ContinentEurope,
+	"pa": types.ContinentEurope,
+	"sk": types.ContinentEurope,
+	"hk": types.ContinentAsia,
+	"mb": types.ContinentAsia,
+	"os": types.ContinentAsia,
+	"sg": types.ContinentAsia,
+	"sl": types.ContinentAsia,
+	"ty": types.ContinentAsia,
+	"sy": types.ContinentAustralia,
+}
+
 // getContinent categorizes regions by continents
 func getContinent(region string) string {
+	if continent, ok := cityContinents[region]; ok {
+		return continent
+	}
+
 	switch {
-	case checkContinent(region, []string{"ap-southeast-2", "australia"}):
+	case checkContinent(region, []string{"ap-southeast-2", "australia"}),
+		region == "ap-southeast", // linode sydney
+		strings.HasPrefix(region, "au-"):
 		return types.ContinentAustralia
-	case checkContinent(region, []string{"cn-", "ap-", "me-", "asia", "japan", "india", "korea"}),
+	case checkContinent(region, []string{"cn-", "ap-", "me-", "asia", "japan", "india", "korea", "china"}),
 		strings.HasPrefix(region, "sgp"),
 		strings.HasPrefix(region, "blr"),
-		strings.HasPrefix(region, "uae"):
+		strings.HasPrefix(region, "mum"), // civo mumbai
+		strings.HasPrefix(region, "uae"),
+		checkPrefix(region, []string{"id-", "in-", "jp-", "sg-"}),
+		strings.HasPrefix(region, "sin"): // hetzner singapore
 		return types.ContinentAsia
 	case checkContinent(region, []string{"eu", "uk", "france", "switzerland", "germany", "norway"}),
 		strings.HasPrefix(region, "ams"),
 		strings.HasPrefix(region, "lon"),
-		strings.HasPrefix(region, "fra"):
+		strings.HasPrefix(region, "fra"),
+		checkPrefix(region, []string{"at-", "bg-", "ch-", "de-", "dk-", "es-", "fi-", "fr-", "gb-", "it-", "nl-", "no-", "pl-", "ru-", "se-", "tr-"}),
+		checkPrefix(region, []string{"fsn", "nbg", "hel"}): // hetzner falkenstein, nuremberg, helsinki
 		return types.ContinentEurope
 	case checkContinent(region, []string{"us", "ca-central-1", "canada", "northamerica"}),
 		strings.HasPrefix(region, "nyc"),
 		strings.HasPrefix(region, "sfo"),
-		strings.HasPrefix(region, "tor"):
+		strings.HasPrefix(region, "tor"),
+		strings.HasPrefix(region, "ca-"),
+		checkPrefix(region, []string{"ash", "hil"}),       // hetzner ashburn, hillsboro
+		checkPrefix(region, []string{"na-", "la-north-"}), // huawei mexico city
+		strings.HasPrefix(region, "phx"):                  // civo phoenix
 		return types.ContinentNorthAmerica
-	case checkContinent(region, []string{"southamerica", "brazil", "sa-"}):
+	case checkContinent(region, []string{"southamerica", "brazil", "sa-"}),
+		strings.HasPrefix(region, "br-"),
+		strings.HasPrefix(region, "la-south-"): // huawei santiago
 		return types.ContinentSouthAmerica
 	case checkContinent(region, []string{"africa", "af-"}):
 		return types.ContinentAfrica
@@ -239,6 +523,15 @@ func checkContinent(region string, substrs []string) bool {
 	return false
 }
 
+func checkPrefix(region string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(region, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
 // Contains is a helper function to check if a slice contains a string
 func Contains(slice []string, s string) bool {
 	for _, e := range slice {
@@ -352,3 +352,91 @@ func TestCachingCloudInfo_GetStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestGetContinent(t *testing.T) {
+	tests := []struct {
+		region    string
+		continent string
+	}{
+		{region: "eu-west-1", continent: types.ContinentEurope},
+		{region: "ap-southeast-1", continent: types.ContinentAsia},
+		{region: "ap-southeast-2", continent: types.ContinentAustralia},
+		{region: "africa-south1", continent: types.ContinentAfrica},
+		// linode
+		{region: "ca-central", continent: types.ContinentNorthAmerica},
+		{region: "ap-southeast", continent: types.ContinentAustralia},
+		{region: "ap-south", continent: types.ContinentAsia},
+		{region: "us-iad", continent: types.ContinentNorthAmerica},
+		{region: "gb-lon", continent: types.ContinentEurope},
+		{region: "se-sto", continent: types.ContinentEurope},
+		{region: "jp-osa", continent: types.ContinentAsia},
+		{region: "id-cgk", continent: types.ContinentAsia},
+		{region: "au-mel", continent: types.ContinentAustralia},
+		{region: "br-gru", continent: types.ContinentSouthAmerica},
+		// hetzner
+		{region: "fsn1", continent: types.ContinentEurope},
+		{region: "nbg1", continent: types.ContinentEurope},
+		{region: "hel1", continent: types.ContinentEurope},
+		{region: "ash", continent: types.ContinentNorthAmerica},
+		{region: "hil", continent: types.ContinentNorthAmerica},
+		{region: "sin", continent: types.ContinentAsia},
+		// scaleway
+		{region: "fr-par", continent: types.ContinentEurope},
+		{region: "nl-ams", continent: types.ContinentEurope},
+		{region: "pl-waw", continent: types.ContinentEurope},
+		// vultr
+		{region: "ewr", continent: types.ContinentNorthAmerica},
+		{region: "sea", continent: types.ContinentNorthAmerica},
+		{region: "mex", continent: types.ContinentNorthAmerica},
+		{region: "sao", continent: types.ContinentSouthAmerica},
+		{region: "lhr", continent: types.ContinentEurope},
+		{region: "nrt", continent: types.ContinentAsia},
+		{region: "tlv", continent: types.ContinentAsia},
+		{region: "syd", continent: types.ContinentAustralia},
+		{region: "jnb", continent: types.ContinentAfrica},
+		// exoscale
+		{region: "ch-gva-2", continent: types.ContinentEurope},
+		{region: "at-vie-1", continent: types.ContinentEurope},
+		{region: "bg-sof-1", continent: types.ContinentEurope},
+		// equinix metal
+		{region: "da", continent: types.ContinentNorthAmerica},
+		{region: "ch", continent: types.ContinentNorthAmerica},
+		{region: "sp", continent: types.ContinentSouthAmerica},
+		{region: "ld", continent: types.ContinentEurope},
+		{region: "sg", continent: types.ContinentAsia},
+		{region: "sy", continent: types.ContinentAustralia},
+		// yandex
+		{region: "ru-central1", continent: types.ContinentEurope},
+		// huawei
+		{region: "cn-north-4", continent: types.ContinentAsia},
+		{region: "ap-southeast-3", continent: types.ContinentAsia},
+		{region: "af-south-1", continent: types.ContinentAfrica},
+		{region: "tr-west-1", continent: types.ContinentEurope},
+		{region: "na-mexico-1", continent: types.ContinentNorthAmerica},
+		{region: "la-north-2", continent: types.ContinentNorthAmerica},
+		{region: "la-south-2", continent: types.ContinentSouthAmerica},
+		{region: "sa-brazil-1", continent: types.ContinentSouthAmerica},
+		// outscale
+		{region: "cloudgouv-eu-west-1", continent: types.ContinentEurope},
+		{region: "ap-northeast-1", continent: types.ContinentAsia},
+		// upcloud
+		{region: "fi-hel1", continent: types.ContinentEurope},
+		{region: "dk-cph1", continent: types.ContinentEurope},
+		{region: "no-svg1", continent: types.ContinentEurope},
+		{region: "us-sjo1", continent: types.ContinentNorthAmerica},
+		{region: "sg-sin1", continent: types.ContinentAsia},
+		// civo
+		{region: "lon1", continent: types.ContinentEurope},
+		{region: "phx1", continent: types.ContinentNorthAmerica},
+		{region: "mum1", continent: types.ContinentAsia},
+		// azure sovereign clouds
+		{region: "chinaeast2", continent: types.ContinentAsia},
+		{region: "usgovvirginia", continent: types.ContinentNorthAmerica},
+	}
+
+	for _, test := range tests {
+		t.Run(test.region, func(t *testing.T) {
+			assert.Equal(t, test.continent, getContinent(test.region))
+		})
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfodriver
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/go-kit/kit/endpoint"
+	kitoc "github.com/go-kit/kit/tracing/opencensus"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo"
+)
+
+// ImageEndpoints collects all of the endpoints that compose an image service.
+// It's meant to be used as a helper struct, to collect all of the endpoints into a
+// single parameter.
+type ImageEndpoints struct {
+	ListImages endpoint.Endpoint
+}
+
+// MakeImageEndpoints returns an Endpoints struct where each endpoint invokes
+// the corresponding method on the provided service.
+func MakeImageEndpoints(s ImageService, logger cloudinfo.Logger) ImageEndpoints {
+	return ImageEndpoints{
+		ListImages: endpoint.Chain(
+			kitoc.TraceEndpoint(OperationImageListImages),
+			LogEndpoint(OperationImageListImages, logger),
+		)(MakeListImagesEndpoint(s)),
+	}
+}
+
+type listImagesRequest struct {
+	Provider string
+	Service  string
+	Region   string
+	Filter   cloudinfo.ImageQueryFilter
+}
+
+type listImagesResponse struct {
+	Images []cloudinfo.Image
+	Err    error
+}
+
+func (r listImagesResponse) Failed() error {
+	return r.Err
+}
+
+// MakeListImagesEndpoint returns an endpoint for the matching method of the underlying service.
+func MakeListImagesEndpoint(s ImageService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(listImagesRequest)
+
+		images, err := s.ListImages(ctx, req.Provider, req.Service, req.Region, req.Filter)
+
+		if err != nil {
+			if b, ok := errors.Cause(err).(businessError); ok && b.IsBusinessError() {
+				return listImagesResponse{
+					Err: err,
+				}, nil
+			}
+
+			return nil, err
+		}
+
+		resp := listImagesResponse{
+			Images: images,
+		}
+
+		return resp, nil
+	}
+}
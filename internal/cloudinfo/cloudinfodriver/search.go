@@ -0,0 +1,32 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfodriver
+
+import (
+	"context"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo"
+)
+
+const (
+	OperationSearchSearch = "cloudinfo.Search.Search"
+)
+
+// SearchService searches the instance types of every provider and region.
+type SearchService interface {
+	// Search returns the instance types whose name or attribute values contain the query
+	// or whose family is the query, the closest matches first.
+	Search(ctx context.Context, query string, service *string, limit *int) ([]cloudinfo.SearchHit, error)
+}
@@ -0,0 +1,83 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfodriver
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/go-kit/kit/endpoint"
+	kitoc "github.com/go-kit/kit/tracing/opencensus"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo"
+)
+
+// SearchEndpoints collects all of the endpoints that compose a search service.
+// It's meant to be used as a helper struct, to collect all of the endpoints into a
+// single parameter.
+type SearchEndpoints struct {
+	Search endpoint.Endpoint
+}
+
+// MakeSearchEndpoints returns an Endpoints struct where each endpoint invokes
+// the corresponding method on the provided service.
+func MakeSearchEndpoints(s SearchService, logger cloudinfo.Logger) SearchEndpoints {
+	return SearchEndpoints{
+		Search: endpoint.Chain(
+			kitoc.TraceEndpoint(OperationSearchSearch),
+			LogEndpoint(OperationSearchSearch, logger),
+		)(MakeSearchEndpoint(s)),
+	}
+}
+
+type searchRequest struct {
+	Query   string
+	Service *string
+	Limit   *int
+}
+
+type searchResponse struct {
+	Hits []cloudinfo.SearchHit
+	Err  error
+}
+
+func (r searchResponse) Failed() error {
+	return r.Err
+}
+
+// MakeSearchEndpoint returns an endpoint for the matching method of the underlying service.
+func MakeSearchEndpoint(s SearchService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(searchRequest)
+
+		hits, err := s.Search(ctx, req.Query, req.Service, req.Limit)
+
+		if err != nil {
+			if b, ok := errors.Cause(err).(businessError); ok && b.IsBusinessError() {
+				return searchResponse{
+					Err: err,
+				}, nil
+			}
+
+			return nil, err
+		}
+
+		resp := searchResponse{
+			Hits: hits,
+		}
+
+		return resp, nil
+	}
+}
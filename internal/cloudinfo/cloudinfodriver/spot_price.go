@@ -0,0 +1,32 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfodriver
+
+import (
+	"context"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo"
+)
+
+const (
+	OperationSpotPriceListSpotPrices = "cloudinfo.SpotPrice.ListSpotPrices"
+)
+
+// SpotPriceService provides access to the current spot prices of the instance types.
+type SpotPriceService interface {
+	// ListSpotPrices returns a list of the current spot prices in a region,
+	// optionally restricted to a zone and an instance type.
+	ListSpotPrices(ctx context.Context, provider string, service string, region string, zone *string, instanceType *string) ([]cloudinfo.SpotPrice, error)
+}
@@ -0,0 +1,85 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfodriver
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/go-kit/kit/endpoint"
+	kitoc "github.com/go-kit/kit/tracing/opencensus"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo"
+)
+
+// SpotPriceEndpoints collects all of the endpoints that compose a spot price service.
+// It's meant to be used as a helper struct, to collect all of the endpoints into a
+// single parameter.
+type SpotPriceEndpoints struct {
+	ListSpotPrices endpoint.Endpoint
+}
+
+// MakeSpotPriceEndpoints returns an Endpoints struct where each endpoint invokes
+// the corresponding method on the provided service.
+func MakeSpotPriceEndpoints(s SpotPriceService, logger cloudinfo.Logger) SpotPriceEndpoints {
+	return SpotPriceEndpoints{
+		ListSpotPrices: endpoint.Chain(
+			kitoc.TraceEndpoint(OperationSpotPriceListSpotPrices),
+			LogEndpoint(OperationSpotPriceListSpotPrices, logger),
+		)(MakeListSpotPricesEndpoint(s)),
+	}
+}
+
+type listSpotPricesRequest struct {
+	Provider     string
+	Service      string
+	Region       string
+	Zone         *string
+	InstanceType *string
+}
+
+type listSpotPricesResponse struct {
+	SpotPrices []cloudinfo.SpotPrice
+	Err        error
+}
+
+func (r listSpotPricesResponse) Failed() error {
+	return r.Err
+}
+
+// MakeListSpotPricesEndpoint returns an endpoint for the matching method of the underlying service.
+func MakeListSpotPricesEndpoint(s SpotPriceService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(listSpotPricesRequest)
+
+		spotPrices, err := s.ListSpotPrices(ctx, req.Provider, req.Service, req.Region, req.Zone, req.InstanceType)
+
+		if err != nil {
+			if b, ok := errors.Cause(err).(businessError); ok && b.IsBusinessError() {
+				return listSpotPricesResponse{
+					Err: err,
+				}, nil
+			}
+
+			return nil, err
+		}
+
+		resp := listSpotPricesResponse{
+			SpotPrices: spotPrices,
+		}
+
+		return resp, nil
+	}
+}
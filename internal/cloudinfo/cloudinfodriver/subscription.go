@@ -0,0 +1,170 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfodriver
+
+import (
+	"context"
+	"sync"
+
+	"github.com/banzaicloud/cloudinfo/internal/app/cloudinfo/messaging"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo"
+)
+
+// subscriptionBuffer is the number of updates buffered for a subscription, updates are dropped for slow subscribers
+const subscriptionBuffer = 64
+
+// subscriptionFilter selects the updates of a subscription; empty service and region mean all of them
+type subscriptionFilter struct {
+	provider string
+	service  string
+	region   string
+}
+
+func (f subscriptionFilter) matches(provider, service, region string) bool {
+	return f.provider == provider && (f.service == "" || f.service == service) && (f.region == "" || f.region == region)
+}
+
+// subscriptionBroker fans out the spot price and product changes received from the event bus to the GraphQL subscriptions
+type subscriptionBroker struct {
+	spotPrices map[chan *cloudinfo.SpotPriceChange]subscriptionFilter
+	products   map[chan *cloudinfo.ProductUpdate]subscriptionFilter
+	mu         sync.RWMutex
+}
+
+// newSubscriptionBroker creates a subscription broker subscribed to the spot price and product changes of the event bus
+func newSubscriptionBroker(eventBus messaging.EventBus) *subscriptionBroker {
+	broker := &subscriptionBroker{
+		spotPrices: make(map[chan *cloudinfo.SpotPriceChange]subscriptionFilter),
+		products:   make(map[chan *cloudinfo.ProductUpdate]subscriptionFilter),
+	}
+
+	if eventBus != nil {
+		eventBus.SubscribeSpotPricesChanged(broker.publishSpotPrices)
+		eventBus.SubscribeProductsChanged(broker.publishProducts)
+	}
+
+	return broker
+}
+
+// publishSpotPrices sends the changed spot prices to the subscriptions interested in the provider and region
+func (b *subscriptionBroker) publishSpotPrices(provider, region string, prices messaging.SpotPrices) {
+	var changes []*cloudinfo.SpotPriceChange
+	for instanceType, zonePrices := range prices {
+		for zone, price := range zonePrices {
+			changes = append(changes, &cloudinfo.SpotPriceChange{
+				Provider:     provider,
+				Region:       region,
+				Zone:         zone,
+				InstanceType: instanceType,
+				Price:        price,
+			})
+		}
+	}
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for subscription, filter := range b.spotPrices {
+		if !filter.matches(provider, "", region) {
+			continue
+		}
+
+		for _, change := range changes {
+			select {
+			case subscription <- change:
+			default:
+				// the subscriber doesn't keep up, drop the update
+			}
+		}
+	}
+}
+
+// publishProducts sends the product changes to the subscriptions interested in the provider, service and region
+func (b *subscriptionBroker) publishProducts(provider, service, region string, change messaging.ProductsChange) {
+	var updates []*cloudinfo.ProductUpdate
+	appendUpdates := func(instanceTypes []string, kind string) {
+		for _, instanceType := range instanceTypes {
+			updates = append(updates, &cloudinfo.ProductUpdate{
+				Provider:     provider,
+				Service:      service,
+				Region:       region,
+				InstanceType: instanceType,
+				Change:       kind,
+			})
+		}
+	}
+	appendUpdates(change.Added, cloudinfo.ProductAdded)
+	appendUpdates(change.Removed, cloudinfo.ProductRemoved)
+	appendUpdates(change.Repriced, cloudinfo.ProductRepriced)
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for subscription, filter := range b.products {
+		if !filter.matches(provider, service, region) {
+			continue
+		}
+
+		for _, update := range updates {
+			select {
+			case subscription <- update:
+			default:
+				// the subscriber doesn't keep up, drop the update
+			}
+		}
+	}
+}
+
+// subscribeSpotPrices registers a spot price subscription until the context is done
+func (b *subscriptionBroker) subscribeSpotPrices(ctx context.Context, filter subscriptionFilter) <-chan *cloudinfo.SpotPriceChange {
+	subscription := make(chan *cloudinfo.SpotPriceChange, subscriptionBuffer)
+
+	b.mu.Lock()
+	b.spotPrices[subscription] = filter
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		delete(b.spotPrices, subscription)
+		close(subscription)
+	}()
+
+	return subscription
+}
+
+// subscribeProducts registers a product subscription until the context is done
+func (b *subscriptionBroker) subscribeProducts(ctx context.Context, filter subscriptionFilter) <-chan *cloudinfo.ProductUpdate {
+	subscription := make(chan *cloudinfo.ProductUpdate, subscriptionBuffer)
+
+	b.mu.Lock()
+	b.products[subscription] = filter
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		delete(b.products, subscription)
+		close(subscription)
+	}()
+
+	return subscription
+}
@@ -0,0 +1,89 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfodriver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/app/cloudinfo/messaging"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo"
+)
+
+func TestSubscriptionBroker_PublishSpotPrices(t *testing.T) {
+	tests := []struct {
+		name     string
+		filter   subscriptionFilter
+		received int
+	}{
+		{
+			name:     "changes are sent to the subscriptions of the provider",
+			filter:   subscriptionFilter{provider: "amazon"},
+			received: 2,
+		},
+		{
+			name:     "changes are sent to the subscriptions of the region",
+			filter:   subscriptionFilter{provider: "amazon", region: "eu-west-1"},
+			received: 2,
+		},
+		{
+			name:   "changes are not sent to the subscriptions of other regions",
+			filter: subscriptionFilter{provider: "amazon", region: "us-east-1"},
+		},
+		{
+			name:   "changes are not sent to the subscriptions of other providers",
+			filter: subscriptionFilter{provider: "google"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			broker := newSubscriptionBroker(nil)
+			subscription := broker.subscribeSpotPrices(ctx, test.filter)
+
+			broker.publishSpotPrices("amazon", "eu-west-1", messaging.SpotPrices{
+				"m5.large": {"eu-west-1a": 0.035, "eu-west-1b": 0.036},
+			})
+
+			assert.Len(t, subscription, test.received)
+		})
+	}
+}
+
+func TestSubscriptionBroker_PublishProducts(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	broker := newSubscriptionBroker(nil)
+	subscription := broker.subscribeProducts(ctx, subscriptionFilter{provider: "amazon", service: "compute"})
+
+	broker.publishProducts("amazon", "compute", "eu-west-1", messaging.ProductsChange{
+		Added:    []string{"m6i.large"},
+		Repriced: []string{"m5.large"},
+	})
+	broker.publishProducts("amazon", "eks", "eu-west-1", messaging.ProductsChange{Added: []string{"m6i.large"}})
+
+	assert.Equal(t, &cloudinfo.ProductUpdate{Provider: "amazon", Service: "compute", Region: "eu-west-1",
+		InstanceType: "m6i.large", Change: cloudinfo.ProductAdded}, <-subscription)
+	assert.Equal(t, &cloudinfo.ProductUpdate{Provider: "amazon", Service: "compute", Region: "eu-west-1",
+		InstanceType: "m5.large", Change: cloudinfo.ProductRepriced}, <-subscription)
+
+	cancel()
+	_, open := <-subscription
+	assert.False(t, open, "the subscription should be closed")
+}
@@ -23,35 +23,52 @@ import (
 	"github.com/go-kit/kit/endpoint"
 
 	"github.com/banzaicloud/cloudinfo/.gen/api/graphql"
+	"github.com/banzaicloud/cloudinfo/internal/app/cloudinfo/messaging"
 	"github.com/banzaicloud/cloudinfo/internal/cloudinfo"
 )
 
 // MakeGraphQLHandler mounts all of the service endpoints into a GraphQL handler.
+// The subscriptions (served over websocket) are fed from the event bus.
 func MakeGraphQLHandler(
 	endpoints Endpoints,
 	providerEndpoints ProviderEndpoints,
 	serviceEndpoints ServiceEndpoints,
 	regionEndpoints RegionEndpoints,
+	imageEndpoints ImageEndpoints,
+	versionEndpoints VersionEndpoints,
+	spotPriceEndpoints SpotPriceEndpoints,
+	searchEndpoints SearchEndpoints,
+	eventBus messaging.EventBus,
 	errorHandler cloudinfo.ErrorHandler,
 ) http.Handler {
 	// nolint: staticcheck
 	return handler.GraphQL(graphql.NewExecutableSchema(graphql.Config{
 		Resolvers: &resolver{
-			endpoints:         endpoints,
-			providerEndpoints: providerEndpoints,
-			serviceEndpoints:  serviceEndpoints,
-			regionEndpoints:   regionEndpoints,
-			errorHandler:      errorHandler,
+			endpoints:          endpoints,
+			providerEndpoints:  providerEndpoints,
+			serviceEndpoints:   serviceEndpoints,
+			regionEndpoints:    regionEndpoints,
+			imageEndpoints:     imageEndpoints,
+			versionEndpoints:   versionEndpoints,
+			spotPriceEndpoints: spotPriceEndpoints,
+			searchEndpoints:    searchEndpoints,
+			subscriptions:      newSubscriptionBroker(eventBus),
+			errorHandler:       errorHandler,
 		},
 	}))
 }
 
 type resolver struct {
-	endpoints         Endpoints
-	providerEndpoints ProviderEndpoints
-	serviceEndpoints  ServiceEndpoints
-	regionEndpoints   RegionEndpoints
-	errorHandler      cloudinfo.ErrorHandler
+	endpoints          Endpoints
+	providerEndpoints  ProviderEndpoints
+	serviceEndpoints   ServiceEndpoints
+	regionEndpoints    RegionEndpoints
+	imageEndpoints     ImageEndpoints
+	versionEndpoints   VersionEndpoints
+	spotPriceEndpoints SpotPriceEndpoints
+	searchEndpoints    SearchEndpoints
+	subscriptions      *subscriptionBroker
+	errorHandler       cloudinfo.ErrorHandler
 }
 
 func (r *resolver) Query() graphql.QueryResolver {
@@ -75,7 +92,156 @@ func (r *queryResolver) Providers(ctx context.Context) ([]cloudinfo.Provider, er
 	return resp.(listProvidersResponse).Providers, nil
 }
 
-func (r *queryResolver) InstanceTypes(ctx context.Context, provider string, service string, region *string, zone *string, filter *cloudinfo.InstanceTypeQueryFilter) ([]cloudinfo.InstanceType, error) {
+func (r *queryResolver) Services(ctx context.Context, provider string) ([]cloudinfo.Service, error) {
+	req := listServicesRequest{
+		Provider: provider,
+	}
+
+	resp, err := r.serviceEndpoints.List(ctx, req)
+	if err != nil {
+		r.errorHandler.Handle(err)
+
+		return nil, errors.New("internal server error")
+	}
+
+	if f, ok := resp.(endpoint.Failer); ok && f.Failed() != nil {
+		return nil, f.Failed()
+	}
+
+	return resp.(listServicesResponse).Services, nil
+}
+
+func (r *queryResolver) Regions(ctx context.Context, provider string, service string) ([]cloudinfo.Region, error) {
+	req := listRegionsRequest{
+		Provider: provider,
+		Service:  service,
+	}
+
+	resp, err := r.regionEndpoints.ListRegions(ctx, req)
+	if err != nil {
+		r.errorHandler.Handle(err)
+
+		return nil, errors.New("internal server error")
+	}
+
+	if f, ok := resp.(endpoint.Failer); ok && f.Failed() != nil {
+		return nil, f.Failed()
+	}
+
+	return resp.(listRegionsResponse).Regions, nil
+}
+
+func (r *queryResolver) Zones(ctx context.Context, provider string, service string, region string) ([]cloudinfo.Zone, error) {
+	req := listZonesRequest{
+		Provider: provider,
+		Service:  service,
+		Region:   region,
+	}
+
+	resp, err := r.regionEndpoints.ListZones(ctx, req)
+	if err != nil {
+		r.errorHandler.Handle(err)
+
+		return nil, errors.New("internal server error")
+	}
+
+	if f, ok := resp.(endpoint.Failer); ok && f.Failed() != nil {
+		return nil, f.Failed()
+	}
+
+	return resp.(listZonesResponse).Zones, nil
+}
+
+func (r *queryResolver) Images(ctx context.Context, provider string, service string, region string, filter *cloudinfo.ImageQueryFilter) ([]cloudinfo.Image, error) {
+	req := listImagesRequest{
+		Provider: provider,
+		Service:  service,
+		Region:   region,
+	}
+	if filter != nil {
+		req.Filter = *filter
+	}
+
+	resp, err := r.imageEndpoints.ListImages(ctx, req)
+	if err != nil {
+		r.errorHandler.Handle(err)
+
+		return nil, errors.New("internal server error")
+	}
+
+	if f, ok := resp.(endpoint.Failer); ok && f.Failed() != nil {
+		return nil, f.Failed()
+	}
+
+	return resp.(listImagesResponse).Images, nil
+}
+
+func (r *queryResolver) Versions(ctx context.Context, provider string, service string, region string) ([]cloudinfo.LocationVersion, error) {
+	req := listVersionsRequest{
+		Provider: provider,
+		Service:  service,
+		Region:   region,
+	}
+
+	resp, err := r.versionEndpoints.ListVersions(ctx, req)
+	if err != nil {
+		r.errorHandler.Handle(err)
+
+		return nil, errors.New("internal server error")
+	}
+
+	if f, ok := resp.(endpoint.Failer); ok && f.Failed() != nil {
+		return nil, f.Failed()
+	}
+
+	return resp.(listVersionsResponse).Versions, nil
+}
+
+func (r *queryResolver) SpotPrices(ctx context.Context, provider string, service string, region string, zone *string, instanceType *string) ([]cloudinfo.SpotPrice, error) {
+	req := listSpotPricesRequest{
+		Provider:     provider,
+		Service:      service,
+		Region:       region,
+		Zone:         zone,
+		InstanceType: instanceType,
+	}
+
+	resp, err := r.spotPriceEndpoints.ListSpotPrices(ctx, req)
+	if err != nil {
+		r.errorHandler.Handle(err)
+
+		return nil, errors.New("internal server error")
+	}
+
+	if f, ok := resp.(endpoint.Failer); ok && f.Failed() != nil {
+		return nil, f.Failed()
+	}
+
+	return resp.(listSpotPricesResponse).SpotPrices, nil
+}
+
+func (r *queryResolver) Search(ctx context.Context, q string, service *string, limit *int) ([]cloudinfo.SearchHit, error) {
+	req := searchRequest{
+		Query:   q,
+		Service: service,
+		Limit:   limit,
+	}
+
+	resp, err := r.searchEndpoints.Search(ctx, req)
+	if err != nil {
+		r.errorHandler.Handle(err)
+
+		return nil, errors.New("internal server error")
+	}
+
+	if f, ok := resp.(endpoint.Failer); ok && f.Failed() != nil {
+		return nil, f.Failed()
+	}
+
+	return resp.(searchResponse).Hits, nil
+}
+
+func (r *queryResolver) InstanceTypes(ctx context.Context, provider string, service string, region *string, zone *string, filter *cloudinfo.InstanceTypeQueryFilter, sort *string, order *string) ([]cloudinfo.InstanceType, error) {
 	req := instanceTypeQueryRequest{
 		Provider: provider,
 		Service:  service,
@@ -95,7 +261,57 @@ func (r *queryResolver) InstanceTypes(ctx context.Context, provider string, serv
 		return nil, f.Failed()
 	}
 
-	return resp.(instanceTypeQueryResponse).InstanceTypes, nil
+	instanceTypes := resp.(instanceTypeQueryResponse).InstanceTypes
+
+	if sort != nil {
+		var sortOrder string
+		if order != nil {
+			sortOrder = *order
+		}
+
+		if err := cloudinfo.SortInstanceTypes(instanceTypes, *sort, sortOrder); err != nil {
+			return nil, err
+		}
+	} else if order != nil {
+		return nil, errors.New("the sort order requires a sort field")
+	}
+
+	return instanceTypes, nil
+}
+
+func (r *queryResolver) InstanceTypePage(ctx context.Context, provider string, service string, region *string, zone *string, filter *cloudinfo.InstanceTypeQueryFilter, sort *string, order *string, limit *int, cursor *string) (*cloudinfo.InstanceTypePage, error) {
+	instanceTypes, err := r.InstanceTypes(ctx, provider, service, region, zone, filter, sort, order)
+	if err != nil {
+		return nil, err
+	}
+
+	var pageLimit int
+	if limit != nil {
+		if *limit < 1 {
+			return nil, errors.New("limit must be a positive integer")
+		}
+		pageLimit = *limit
+	}
+
+	var pageCursor string
+	if cursor != nil {
+		pageCursor = *cursor
+	}
+
+	page, err := cloudinfo.Paginate(len(instanceTypes), pageLimit, pageCursor)
+	if err != nil {
+		return nil, err
+	}
+
+	instanceTypePage := &cloudinfo.InstanceTypePage{
+		InstanceTypes: instanceTypes[page.Start:page.End],
+		TotalCount:    len(instanceTypes),
+	}
+	if page.NextCursor != "" {
+		instanceTypePage.NextCursor = &page.NextCursor
+	}
+
+	return instanceTypePage, nil
 }
 
 func (r *resolver) Provider() graphql.ProviderResolver {
@@ -175,3 +391,27 @@ func (r *regionResolver) Zones(ctx context.Context, obj *cloudinfo.Region) ([]cl
 
 	return resp.(listZonesResponse).Zones, nil
 }
+
+func (r *resolver) Subscription() graphql.SubscriptionResolver {
+	return &subscriptionResolver{r}
+}
+
+type subscriptionResolver struct{ *resolver }
+
+func (r *subscriptionResolver) SpotPriceChanged(ctx context.Context, provider string, region *string) (<-chan *cloudinfo.SpotPriceChange, error) {
+	filter := subscriptionFilter{provider: provider}
+	if region != nil {
+		filter.region = *region
+	}
+
+	return r.subscriptions.subscribeSpotPrices(ctx, filter), nil
+}
+
+func (r *subscriptionResolver) ProductUpdated(ctx context.Context, provider string, service string, region *string) (<-chan *cloudinfo.ProductUpdate, error) {
+	filter := subscriptionFilter{provider: provider, service: service}
+	if region != nil {
+		filter.region = *region
+	}
+
+	return r.subscriptions.subscribeProducts(ctx, filter), nil
+}
@@ -0,0 +1,224 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfodriver
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+
+	cloudinfov1 "github.com/banzaicloud/cloudinfo/.gen/api/grpc/cloudinfo/v1"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo"
+)
+
+// MakeGRPCServer mounts all of the service endpoints into a gRPC server.
+func MakeGRPCServer(
+	endpoints Endpoints,
+	providerEndpoints ProviderEndpoints,
+	serviceEndpoints ServiceEndpoints,
+	regionEndpoints RegionEndpoints,
+	errorHandler cloudinfo.ErrorHandler,
+) *grpc.Server {
+	server := grpc.NewServer()
+
+	cloudinfov1.RegisterCloudInfoServiceServer(server, &grpcServer{
+		endpoints:         endpoints,
+		providerEndpoints: providerEndpoints,
+		serviceEndpoints:  serviceEndpoints,
+		regionEndpoints:   regionEndpoints,
+		errorHandler:      errorHandler,
+	})
+	reflection.Register(server)
+
+	return server
+}
+
+type grpcServer struct {
+	cloudinfov1.UnimplementedCloudInfoServiceServer
+
+	endpoints         Endpoints
+	providerEndpoints ProviderEndpoints
+	serviceEndpoints  ServiceEndpoints
+	regionEndpoints   RegionEndpoints
+	errorHandler      cloudinfo.ErrorHandler
+}
+
+// call invokes the endpoint and maps its errors to gRPC status errors.
+func (s *grpcServer) call(ctx context.Context, e endpoint.Endpoint, req interface{}) (interface{}, error) {
+	resp, err := e(ctx, req)
+	if err != nil {
+		s.errorHandler.Handle(err)
+
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	if f, ok := resp.(endpoint.Failer); ok && f.Failed() != nil {
+		return nil, status.Error(codes.InvalidArgument, f.Failed().Error())
+	}
+
+	return resp, nil
+}
+
+func (s *grpcServer) ListProviders(ctx context.Context, _ *cloudinfov1.ListProvidersRequest) (*cloudinfov1.ListProvidersResponse, error) {
+	resp, err := s.call(ctx, s.providerEndpoints.List, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	providers := resp.(listProvidersResponse).Providers
+	res := &cloudinfov1.ListProvidersResponse{
+		Providers: make([]*cloudinfov1.Provider, 0, len(providers)),
+	}
+	for _, provider := range providers {
+		res.Providers = append(res.Providers, &cloudinfov1.Provider{
+			Code: provider.Code,
+			Name: provider.Name,
+		})
+	}
+
+	return res, nil
+}
+
+func (s *grpcServer) ListServices(ctx context.Context, req *cloudinfov1.ListServicesRequest) (*cloudinfov1.ListServicesResponse, error) {
+	resp, err := s.call(ctx, s.serviceEndpoints.List, listServicesRequest{
+		Provider: req.GetProvider(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	services := resp.(listServicesResponse).Services
+	res := &cloudinfov1.ListServicesResponse{
+		Services: make([]*cloudinfov1.Service, 0, len(services)),
+	}
+	for _, service := range services {
+		res.Services = append(res.Services, &cloudinfov1.Service{
+			Code: service.Code,
+		})
+	}
+
+	return res, nil
+}
+
+func (s *grpcServer) ListRegions(ctx context.Context, req *cloudinfov1.ListRegionsRequest) (*cloudinfov1.ListRegionsResponse, error) {
+	resp, err := s.call(ctx, s.regionEndpoints.ListRegions, listRegionsRequest{
+		Provider: req.GetProvider(),
+		Service:  req.GetService(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	regions := resp.(listRegionsResponse).Regions
+	res := &cloudinfov1.ListRegionsResponse{
+		Regions: make([]*cloudinfov1.Region, 0, len(regions)),
+	}
+	for _, region := range regions {
+		res.Regions = append(res.Regions, &cloudinfov1.Region{
+			Code: region.Code,
+			Name: region.Name,
+		})
+	}
+
+	return res, nil
+}
+
+// instanceTypes returns the instance types of a region, restricted to the zone if not empty.
+func (s *grpcServer) instanceTypes(ctx context.Context, provider, service, region, zone string) ([]cloudinfo.InstanceType, error) {
+	resp, err := s.call(ctx, s.endpoints.InstanceTypeQuery, instanceTypeQueryRequest{
+		Provider: provider,
+		Service:  service,
+		Region:   &region,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	instanceTypes := resp.(instanceTypeQueryResponse).InstanceTypes
+	if zone == "" {
+		return instanceTypes, nil
+	}
+
+	zoneInstanceTypes := make([]cloudinfo.InstanceType, 0, len(instanceTypes))
+	for _, instanceType := range instanceTypes {
+		if instanceType.Zone == zone {
+			zoneInstanceTypes = append(zoneInstanceTypes, instanceType)
+		}
+	}
+
+	return zoneInstanceTypes, nil
+}
+
+func (s *grpcServer) ListProducts(ctx context.Context, req *cloudinfov1.ListProductsRequest) (*cloudinfov1.ListProductsResponse, error) {
+	instanceTypes, err := s.instanceTypes(ctx, req.GetProvider(), req.GetService(), req.GetRegion(), req.GetZone())
+	if err != nil {
+		return nil, err
+	}
+
+	res := &cloudinfov1.ListProductsResponse{
+		Products: make([]*cloudinfov1.Product, 0, len(instanceTypes)),
+	}
+	for _, instanceType := range instanceTypes {
+		res.Products = append(res.Products, &cloudinfov1.Product{
+			Name:            instanceType.Name,
+			Region:          instanceType.Region,
+			Zone:            instanceType.Zone,
+			Price:           instanceType.Price,
+			SpotPrice:       instanceType.SpotPrice,
+			Cpu:             instanceType.CPU,
+			Memory:          instanceType.Memory,
+			Gpu:             instanceType.Gpu,
+			GpuVendor:       instanceType.GpuVendor,
+			GpuModel:        instanceType.GpuModel,
+			GpuMemory:       instanceType.GpuMemory,
+			NetworkCategory: instanceType.NetworkCategory.String(),
+			Category:        instanceType.Category.String(),
+			Burst:           instanceType.Burst,
+			Architecture:    instanceType.Architecture,
+			BareMetal:       instanceType.BareMetal,
+			FreeTier:        instanceType.FreeTier,
+		})
+	}
+
+	return res, nil
+}
+
+func (s *grpcServer) ListSpotPrices(ctx context.Context, req *cloudinfov1.ListSpotPricesRequest) (*cloudinfov1.ListSpotPricesResponse, error) {
+	instanceTypes, err := s.instanceTypes(ctx, req.GetProvider(), req.GetService(), req.GetRegion(), req.GetZone())
+	if err != nil {
+		return nil, err
+	}
+
+	res := &cloudinfov1.ListSpotPricesResponse{
+		SpotPrices: make([]*cloudinfov1.SpotPrice, 0, len(instanceTypes)),
+	}
+	for _, instanceType := range instanceTypes {
+		if instanceType.SpotPrice == 0 {
+			continue
+		}
+
+		res.SpotPrices = append(res.SpotPrices, &cloudinfov1.SpotPrice{
+			InstanceType: instanceType.Name,
+			Zone:         instanceType.Zone,
+			Price:        instanceType.SpotPrice,
+		})
+	}
+
+	return res, nil
+}
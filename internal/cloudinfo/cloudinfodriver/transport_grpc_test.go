@@ -0,0 +1,88 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfodriver
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"emperror.dev/emperror"
+	"emperror.dev/errors"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	cloudinfov1 "github.com/banzaicloud/cloudinfo/.gen/api/grpc/cloudinfo/v1"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo"
+)
+
+func TestGRPCServer_ListSpotPrices(t *testing.T) {
+	endpoints := Endpoints{
+		InstanceTypeQuery: func(ctx context.Context, request interface{}) (interface{}, error) {
+			req := request.(instanceTypeQueryRequest)
+			if *req.Region != "eu-west-1" {
+				return nil, errors.New("store unavailable")
+			}
+
+			return instanceTypeQueryResponse{InstanceTypes: []cloudinfo.InstanceType{
+				{Name: "m5.large", Region: "eu-west-1", Zone: "eu-west-1a", Price: 0.107, SpotPrice: 0.034},
+				{Name: "m5.large", Region: "eu-west-1", Zone: "eu-west-1b", Price: 0.107, SpotPrice: 0.036},
+				{Name: "t3.large", Region: "eu-west-1", Zone: "eu-west-1a", Price: 0.0912},
+			}}, nil
+		},
+	}
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := MakeGRPCServer(endpoints, ProviderEndpoints{}, ServiceEndpoints{}, RegionEndpoints{}, emperror.NewNoopHandler())
+	go func() { _ = server.Serve(listener) }()
+	defer server.Stop()
+
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return listener.Dial() }),
+		grpc.WithInsecure())
+	assert.NoError(t, err)
+	defer conn.Close()
+
+	client := cloudinfov1.NewCloudInfoServiceClient(conn)
+
+	resp, err := client.ListSpotPrices(context.Background(), &cloudinfov1.ListSpotPricesRequest{
+		Provider: "amazon",
+		Service:  "compute",
+		Region:   "eu-west-1",
+		Zone:     "eu-west-1a",
+	})
+	assert.NoError(t, err)
+	assert.Len(t, resp.GetSpotPrices(), 1, "only the instance types with spot prices in the zone should be returned")
+	assert.Equal(t, "m5.large", resp.GetSpotPrices()[0].GetInstanceType())
+	assert.Equal(t, 0.034, resp.GetSpotPrices()[0].GetPrice())
+
+	products, err := client.ListProducts(context.Background(), &cloudinfov1.ListProductsRequest{
+		Provider: "amazon",
+		Service:  "compute",
+		Region:   "eu-west-1",
+	})
+	assert.NoError(t, err)
+	assert.Len(t, products.GetProducts(), 3)
+
+	_, err = client.ListProducts(context.Background(), &cloudinfov1.ListProductsRequest{
+		Provider: "amazon",
+		Service:  "compute",
+		Region:   "us-east-1",
+	})
+	assert.Equal(t, codes.Internal, status.Code(err), "the internal errors should not leak to the clients")
+}
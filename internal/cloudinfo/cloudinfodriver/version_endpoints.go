@@ -0,0 +1,83 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfodriver
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"github.com/go-kit/kit/endpoint"
+	kitoc "github.com/go-kit/kit/tracing/opencensus"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo"
+)
+
+// VersionEndpoints collects all of the endpoints that compose a version service.
+// It's meant to be used as a helper struct, to collect all of the endpoints into a
+// single parameter.
+type VersionEndpoints struct {
+	ListVersions endpoint.Endpoint
+}
+
+// MakeVersionEndpoints returns an Endpoints struct where each endpoint invokes
+// the corresponding method on the provided service.
+func MakeVersionEndpoints(s VersionService, logger cloudinfo.Logger) VersionEndpoints {
+	return VersionEndpoints{
+		ListVersions: endpoint.Chain(
+			kitoc.TraceEndpoint(OperationVersionListVersions),
+			LogEndpoint(OperationVersionListVersions, logger),
+		)(MakeListVersionsEndpoint(s)),
+	}
+}
+
+type listVersionsRequest struct {
+	Provider string
+	Service  string
+	Region   string
+}
+
+type listVersionsResponse struct {
+	Versions []cloudinfo.LocationVersion
+	Err      error
+}
+
+func (r listVersionsResponse) Failed() error {
+	return r.Err
+}
+
+// MakeListVersionsEndpoint returns an endpoint for the matching method of the underlying service.
+func MakeListVersionsEndpoint(s VersionService) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(listVersionsRequest)
+
+		versions, err := s.ListVersions(ctx, req.Provider, req.Service, req.Region)
+
+		if err != nil {
+			if b, ok := errors.Cause(err).(businessError); ok && b.IsBusinessError() {
+				return listVersionsResponse{
+					Err: err,
+				}, nil
+			}
+
+			return nil, err
+		}
+
+		resp := listVersionsResponse{
+			Versions: versions,
+		}
+
+		return resp, nil
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package currency
+
+import (
+	"time"
+
+	"emperror.dev/errors"
+)
+
+// Config holds the configuration of the currency conversion
+type Config struct {
+	// RefreshInterval the interval of refreshing the exchange rates
+	RefreshInterval time.Duration
+
+	// ECB configures the exchange rates of the European Central Bank
+	ECB struct {
+		Enabled bool
+
+		// URL of the daily reference rates feed, the ECB one if empty
+		URL string
+	}
+
+	// Rates the fixed exchange rates in units of the currencies per USD, overriding the ones of the ECB
+	Rates map[string]float64
+}
+
+// Validate checks that the configuration is valid.
+func (c Config) Validate() error {
+	if c.RefreshInterval <= 0 {
+		return errors.New("currency refresh interval must be positive")
+	}
+
+	for currency, rate := range c.Rates {
+		if rate <= 0 {
+			return errors.NewWithDetails("exchange rate must be positive", "currency", currency)
+		}
+	}
+
+	return nil
+}
+
+// Sources returns the configured exchange rate sources, the later ones overriding the rates of the earlier ones
+func (c Config) Sources() []RateSource {
+	var sources []RateSource
+	if c.ECB.Enabled {
+		sources = append(sources, NewECBSource(c.ECB.URL))
+	}
+	if len(c.Rates) > 0 {
+		sources = append(sources, FixedSource(c.Rates))
+	}
+
+	return sources
+}
@@ -0,0 +1,133 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package currency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"sync"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo"
+)
+
+// USD the base currency of the exchange rates, the currency of the prices without an explicit one
+const USD = "USD"
+
+// RateSource provides exchange rates, eg.: a feed of a central bank or a fixed set of rates
+type RateSource interface {
+	// Name returns the name of the source for logging purposes
+	Name() string
+	// Rates returns the exchange rates in units of the currencies per USD, keyed by ISO 4217 currency code
+	Rates(ctx context.Context) (map[string]float64, error)
+}
+
+// Converter converts prices between currencies with the exchange rates of its sources.
+//
+// The rates of the later sources override the ones of the earlier sources, the rates of a source are kept
+// from its last successful refresh.
+type Converter struct {
+	sources     []RateSource
+	sourceRates []map[string]float64
+	rates       map[string]float64
+	mu          sync.RWMutex
+	log         cloudinfo.Logger
+}
+
+// NewConverter creates a new currency converter with the given exchange rate sources
+func NewConverter(log cloudinfo.Logger, sources ...RateSource) *Converter {
+	return &Converter{
+		sources:     sources,
+		sourceRates: make([]map[string]float64, len(sources)),
+		rates:       map[string]float64{USD: 1},
+		log:         log.WithFields(map[string]interface{}{"component": "currency"}),
+	}
+}
+
+// Refresh retrieves the exchange rates of the sources
+func (c *Converter) Refresh(ctx context.Context) {
+	for i, source := range c.sources {
+		rates, err := source.Rates(ctx)
+		if err != nil {
+			c.log.Warn("failed to retrieve exchange rates", map[string]interface{}{"source": source.Name(), "error": err.Error()})
+			continue
+		}
+
+		c.sourceRates[i] = rates
+	}
+
+	merged := map[string]float64{USD: 1}
+	for _, rates := range c.sourceRates {
+		for currency, rate := range rates {
+			if rate > 0 {
+				merged[normalize(currency)] = rate
+			}
+		}
+	}
+
+	c.mu.Lock()
+	c.rates = merged
+	c.mu.Unlock()
+
+	c.log.Debug("exchange rates refreshed", map[string]interface{}{"currencies": len(merged)})
+}
+
+// Rate returns the exchange rate between two currencies, the multiplier of the prices in the from currency;
+// an empty currency stands for USD
+func (c *Converter) Rate(from, to string) (float64, error) {
+	from, to = normalize(from), normalize(to)
+	if from == to {
+		return 1, nil
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	fromRate, ok := c.rates[from]
+	if !ok {
+		return 0, errors.NewWithDetails("unknown currency", "currency", from)
+	}
+	toRate, ok := c.rates[to]
+	if !ok {
+		return 0, errors.NewWithDetails("unknown currency", "currency", to)
+	}
+
+	return toRate / fromRate, nil
+}
+
+// Fingerprint returns a hash of the current exchange rates, changed whenever any of them changes
+func (c *Converter) Fingerprint() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	// the keys of the maps are marshaled in order, a map of numbers always marshals
+	content, _ := json.Marshal(c.rates)
+	sum := sha256.Sum256(content)
+
+	return hex.EncodeToString(sum[:8])
+}
+
+// normalize returns the upper case ISO 4217 code of the currency, USD if empty
+func normalize(currency string) string {
+	if currency == "" {
+		return USD
+	}
+
+	return strings.ToUpper(currency)
+}
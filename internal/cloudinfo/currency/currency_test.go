@@ -0,0 +1,94 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package currency
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"logur.dev/logur"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/cloudinfoadapter"
+)
+
+// failingSource is a rate source failing after the first retrieval
+type failingSource struct {
+	rates map[string]float64
+	calls int
+}
+
+func (s *failingSource) Name() string {
+	return "failing"
+}
+
+func (s *failingSource) Rates(_ context.Context) (map[string]float64, error) {
+	s.calls++
+	if s.calls > 1 {
+		return nil, errors.New("unavailable")
+	}
+
+	return s.rates, nil
+}
+
+func TestConverter_Rate(t *testing.T) {
+	source := &failingSource{rates: map[string]float64{"EUR": 0.8, "GBP": 0.7}}
+	converter := NewConverter(cloudinfoadapter.NewLogger(&logur.TestLogger{}), source, FixedSource{"eur": 0.9, "CNY": 6.4})
+
+	_, err := converter.Rate("USD", "EUR")
+	assert.Error(t, err, "the rates should be unknown before the first refresh")
+
+	converter.Refresh(context.Background())
+	// the rates of the failing source are kept
+	converter.Refresh(context.Background())
+
+	tests := []struct {
+		name string
+		from string
+		to   string
+		rate float64
+	}{
+		{name: "same currency", from: "EUR", to: "eur", rate: 1},
+		{name: "empty currency is USD", from: "", to: "GBP", rate: 0.7},
+		{name: "later sources override", from: "USD", to: "EUR", rate: 0.9},
+		{name: "cross rate", from: "CNY", to: "EUR", rate: 0.9 / 6.4},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rate, err := converter.Rate(test.from, test.to)
+			assert.NoError(t, err)
+			assert.InDelta(t, test.rate, rate, 0.000001)
+		})
+	}
+
+	_, err = converter.Rate("USD", "XYZ")
+	assert.Error(t, err)
+}
+
+func TestConverter_Fingerprint(t *testing.T) {
+	source := FixedSource{"EUR": 0.8}
+	converter := NewConverter(cloudinfoadapter.NewLogger(&logur.TestLogger{}), source)
+
+	converter.Refresh(context.Background())
+	fingerprint := converter.Fingerprint()
+
+	converter.Refresh(context.Background())
+	assert.Equal(t, fingerprint, converter.Fingerprint(), "the unchanged rates should keep the fingerprint")
+
+	source["EUR"] = 0.9
+	converter.Refresh(context.Background())
+	assert.NotEqual(t, fingerprint, converter.Fingerprint(), "a changed rate should change the fingerprint")
+}
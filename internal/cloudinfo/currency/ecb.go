@@ -0,0 +1,103 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package currency
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"time"
+
+	"emperror.dev/errors"
+)
+
+const (
+	// ecbURL the daily euro foreign exchange reference rates of the European Central Bank
+	ecbURL = "https://www.ecb.europa.eu/stats/eurofxref/eurofxref-daily.xml"
+
+	// requestTimeout is the timeout of retrieving the exchange rates
+	requestTimeout = 30 * time.Second
+)
+
+// ecbEnvelope is the euro foreign exchange reference rates document of the ECB
+type ecbEnvelope struct {
+	Rates []struct {
+		Currency string  `xml:"currency,attr"`
+		Rate     float64 `xml:"rate,attr"`
+	} `xml:"Cube>Cube>Cube"`
+}
+
+// ECBSource provides the exchange rates of the European Central Bank, published on working days
+type ECBSource struct {
+	httpClient *http.Client
+	url        string
+}
+
+// NewECBSource creates a new ECB exchange rate source, the default feed is used if the url is empty
+func NewECBSource(url string) *ECBSource {
+	if url == "" {
+		url = ecbURL
+	}
+
+	return &ECBSource{
+		httpClient: &http.Client{Timeout: requestTimeout},
+		url:        url,
+	}
+}
+
+// Name returns the name of the source
+func (s *ECBSource) Name() string {
+	return "ecb"
+}
+
+// Rates returns the exchange rates of the ECB, converted from EUR based rates to USD based ones
+func (s *ECBSource) Rates(ctx context.Context) (map[string]float64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to create request")
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.WrapIfWithDetails(err, "failed to retrieve the ecb exchange rates", "url", s.url)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.NewWithDetails("unexpected response from the ecb", "url", s.url, "status", resp.StatusCode)
+	}
+
+	var envelope ecbEnvelope
+	if err := xml.NewDecoder(resp.Body).Decode(&envelope); err != nil {
+		return nil, errors.WrapIfWithDetails(err, "failed to decode the ecb exchange rates", "url", s.url)
+	}
+
+	eurRates := map[string]float64{"EUR": 1}
+	for _, rate := range envelope.Rates {
+		eurRates[rate.Currency] = rate.Rate
+	}
+
+	usdRate := eurRates[USD]
+	if usdRate <= 0 {
+		return nil, errors.New("missing USD exchange rate from the ecb")
+	}
+
+	rates := make(map[string]float64, len(eurRates))
+	for currency, rate := range eurRates {
+		rates[currency] = rate / usdRate
+	}
+
+	return rates, nil
+}
@@ -0,0 +1,50 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package currency
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const ecbDaily = `<?xml version="1.0" encoding="UTF-8"?>
+<gesmes:Envelope xmlns:gesmes="http://www.gesmes.org/xml/2002-08-01" xmlns="http://www.ecb.int/vocabulary/2002-08-01/eurofxref">
+	<gesmes:subject>Reference rates</gesmes:subject>
+	<gesmes:Sender>
+		<gesmes:name>European Central Bank</gesmes:name>
+	</gesmes:Sender>
+	<Cube>
+		<Cube time="2021-06-01">
+			<Cube currency="USD" rate="1.25"/>
+			<Cube currency="JPY" rate="125"/>
+			<Cube currency="GBP" rate="0.85"/>
+		</Cube>
+	</Cube>
+</gesmes:Envelope>`
+
+func TestECBSource_Rates(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(ecbDaily))
+	}))
+	defer server.Close()
+
+	rates, err := NewECBSource(server.URL).Rates(context.Background())
+	assert.NoError(t, err)
+	assert.InDeltaMapValues(t, map[string]float64{"USD": 1, "EUR": 0.8, "JPY": 100, "GBP": 0.68}, rates, 0.000001)
+}
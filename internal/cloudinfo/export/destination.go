@@ -0,0 +1,179 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"bytes"
+	"context"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"google.golang.org/api/storage/v1"
+)
+
+// parquetContentType the media type of the uploaded objects
+const parquetContentType = "application/vnd.apache.parquet"
+
+// destination stores the exported files
+type destination interface {
+	// put stores the named file and returns its location
+	put(ctx context.Context, name string, data []byte) (string, error)
+}
+
+// newDestination creates the destination of the export from a local path, an s3://bucket/prefix or a gs://bucket/prefix URL
+func newDestination(ctx context.Context, dst string) (destination, error) {
+	scheme, bucket, prefix, err := parseDestination(dst)
+	if err != nil {
+		return nil, err
+	}
+
+	switch scheme {
+	case "s3":
+		return newS3Destination(ctx, bucket, prefix)
+	case "gs":
+		return newGCSDestination(ctx, bucket, prefix)
+	default:
+		return localDestination{dir: dst}, nil
+	}
+}
+
+// parseDestination splits a bucket URL into its scheme, bucket and key prefix, the scheme is empty for local paths
+func parseDestination(dst string) (scheme, bucket, prefix string, err error) {
+	if dst == "" {
+		return "", "", "", errors.New("empty export destination")
+	}
+
+	if !strings.Contains(dst, "://") {
+		return "", "", "", nil
+	}
+
+	u, err := url.Parse(dst)
+	if err != nil {
+		return "", "", "", errors.WrapIfWithDetails(err, "invalid export destination", "destination", dst)
+	}
+
+	if u.Scheme != "s3" && u.Scheme != "gs" {
+		return "", "", "", errors.NewWithDetails("unsupported export destination scheme", "scheme", u.Scheme)
+	}
+
+	if u.Host == "" {
+		return "", "", "", errors.NewWithDetails("missing bucket of export destination", "destination", dst)
+	}
+
+	return u.Scheme, u.Host, strings.Trim(u.Path, "/"), nil
+}
+
+// objectKey joins the key prefix and the file name
+func objectKey(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+
+	return path.Join(prefix, name)
+}
+
+// localDestination writes the files into a local directory
+type localDestination struct {
+	dir string
+}
+
+func (d localDestination) put(_ context.Context, name string, data []byte) (string, error) {
+	if err := os.MkdirAll(d.dir, 0755); err != nil {
+		return "", errors.WrapIfWithDetails(err, "failed to create export directory", "dir", d.dir)
+	}
+
+	file := filepath.Join(d.dir, name)
+	if err := os.WriteFile(file, data, 0644); err != nil {
+		return "", errors.WrapIfWithDetails(err, "failed to write export file", "file", file)
+	}
+
+	return file, nil
+}
+
+// s3Destination uploads the files into an S3 bucket, using the default AWS credential chain
+type s3Destination struct {
+	uploader *s3manager.Uploader
+	bucket   string
+	prefix   string
+}
+
+func newS3Destination(ctx context.Context, bucket, prefix string) (destination, error) {
+	sess, err := session.NewSession()
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to create AWS session")
+	}
+
+	region, err := s3manager.GetBucketRegion(ctx, sess, bucket, "us-east-1")
+	if err != nil {
+		return nil, errors.WrapIfWithDetails(err, "failed to find the region of the bucket", "bucket", bucket)
+	}
+
+	return s3Destination{
+		uploader: s3manager.NewUploader(sess.Copy(aws.NewConfig().WithRegion(region))),
+		bucket:   bucket,
+		prefix:   prefix,
+	}, nil
+}
+
+func (d s3Destination) put(ctx context.Context, name string, data []byte) (string, error) {
+	key := objectKey(d.prefix, name)
+	if _, err := d.uploader.UploadWithContext(ctx, &s3manager.UploadInput{
+		Bucket:      aws.String(d.bucket),
+		Key:         aws.String(key),
+		Body:        bytes.NewReader(data),
+		ContentType: aws.String(parquetContentType),
+	}); err != nil {
+		return "", errors.WrapIfWithDetails(err, "failed to upload export file", "bucket", d.bucket, "key", key)
+	}
+
+	return "s3://" + d.bucket + "/" + key, nil
+}
+
+// gcsDestination uploads the files into a Google Cloud Storage bucket, using the application default credentials
+type gcsDestination struct {
+	service *storage.Service
+	bucket  string
+	prefix  string
+}
+
+func newGCSDestination(ctx context.Context, bucket, prefix string) (destination, error) {
+	service, err := storage.NewService(ctx)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to create storage client")
+	}
+
+	return gcsDestination{
+		service: service,
+		bucket:  bucket,
+		prefix:  prefix,
+	}, nil
+}
+
+func (d gcsDestination) put(ctx context.Context, name string, data []byte) (string, error) {
+	key := objectKey(d.prefix, name)
+	if _, err := d.service.Objects.Insert(d.bucket, &storage.Object{Name: key, ContentType: parquetContentType}).
+		Media(bytes.NewReader(data)).Context(ctx).Do(); err != nil {
+		return "", errors.WrapIfWithDetails(err, "failed to upload export file", "bucket", d.bucket, "key", key)
+	}
+
+	return "gs://" + d.bucket + "/" + key, nil
+}
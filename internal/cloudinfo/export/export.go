@@ -0,0 +1,258 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"strconv"
+
+	"emperror.dev/errors"
+	"github.com/xitongsys/parquet-go/writer"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// parallelism the number of goroutines marshalling the rows of a Parquet file
+const parallelism = 4
+
+// Store is the source of the exported product catalog
+type Store interface {
+	GetProviders() ([]types.Provider, error)
+	GetRegions(provider, service string) (map[string]string, error)
+	GetProductDetails(provider, service, region string) ([]types.ProductDetails, error)
+	GetStatus(provider string) (string, error)
+}
+
+// Row is a product of a region of a provider service, the row of the exported Parquet files
+type Row struct {
+	Provider        string            `parquet:"name=provider, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Service         string            `parquet:"name=service, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Region          string            `parquet:"name=region, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Type            string            `parquet:"name=type, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Category        string            `parquet:"name=category, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Cpus            float64           `parquet:"name=cpusPerVm, type=DOUBLE"`
+	Mem             float64           `parquet:"name=memPerVm, type=DOUBLE"`
+	Gpus            float64           `parquet:"name=gpusPerVm, type=DOUBLE"`
+	GpuModel        string            `parquet:"name=gpuModel, type=BYTE_ARRAY, convertedtype=UTF8"`
+	OnDemandPrice   float64           `parquet:"name=onDemandPrice, type=DOUBLE"`
+	Currency        string            `parquet:"name=currency, type=BYTE_ARRAY, convertedtype=UTF8"`
+	NtwPerf         string            `parquet:"name=ntwPerf, type=BYTE_ARRAY, convertedtype=UTF8"`
+	NtwPerfCategory string            `parquet:"name=ntwPerfCategory, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Architecture    string            `parquet:"name=architecture, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Burst           bool              `parquet:"name=burst, type=BOOLEAN"`
+	CurrentGen      bool              `parquet:"name=currentGen, type=BOOLEAN"`
+	Zones           []string          `parquet:"name=zones, type=LIST, valuetype=BYTE_ARRAY, valueconvertedtype=UTF8"`
+	SpotPrices      []SpotPriceRow    `parquet:"name=spotPrices, type=LIST"`
+	Attributes      map[string]string `parquet:"name=attributes, type=MAP, keytype=BYTE_ARRAY, keyconvertedtype=UTF8, valuetype=BYTE_ARRAY, valueconvertedtype=UTF8"`
+	// ScrapedAt the time of the last successful scrape of the provider in milliseconds since the epoch, 0 if unknown
+	ScrapedAt int64 `parquet:"name=scrapedAt, type=INT64, convertedtype=TIMESTAMP_MILLIS"`
+}
+
+// SpotPriceRow is the spot price of a product in an availability zone
+type SpotPriceRow struct {
+	Zone  string  `parquet:"name=zone, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Price float64 `parquet:"name=price, type=DOUBLE"`
+}
+
+// Exporter writes the product catalog of the providers as Parquet files, a file per provider
+type Exporter struct {
+	store Store
+	log   cloudinfo.Logger
+}
+
+// NewExporter creates a new Parquet exporter
+func NewExporter(store Store, log cloudinfo.Logger) *Exporter {
+	return &Exporter{
+		store: store,
+		log:   log.WithFields(map[string]interface{}{"component": "export"}),
+	}
+}
+
+// Export writes the <provider>.parquet files of the providers (all of them if empty) to the destination
+// (a local directory, s3://bucket/prefix or gs://bucket/prefix) and returns the locations of the written files
+func (e *Exporter) Export(ctx context.Context, destination string, providers []string) ([]string, error) {
+	dst, err := newDestination(ctx, destination)
+	if err != nil {
+		return nil, err
+	}
+
+	known, err := e.store.GetProviders()
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to retrieve providers")
+	}
+
+	selected, err := selectProviders(known, providers)
+	if err != nil {
+		return nil, err
+	}
+
+	locations := make([]string, 0, len(selected))
+	for _, provider := range selected {
+		rows, err := e.rows(provider)
+		if err != nil {
+			return locations, err
+		}
+
+		data, err := marshalRows(rows)
+		if err != nil {
+			return locations, errors.WrapIfWithDetails(err, "failed to write parquet file", "provider", provider.Provider)
+		}
+
+		location, err := dst.put(ctx, provider.Provider+".parquet", data)
+		if err != nil {
+			return locations, errors.WrapIfWithDetails(err, "failed to store parquet file", "provider", provider.Provider)
+		}
+
+		e.log.Info("exported product catalog", map[string]interface{}{"provider": provider.Provider,
+			"products": len(rows), "location": location})
+		locations = append(locations, location)
+	}
+
+	return locations, nil
+}
+
+// selectProviders returns the requested providers, all the known ones if none is requested
+func selectProviders(known []types.Provider, requested []string) ([]types.Provider, error) {
+	if len(requested) == 0 {
+		return known, nil
+	}
+
+	byName := make(map[string]types.Provider, len(known))
+	for _, provider := range known {
+		byName[provider.Provider] = provider
+	}
+
+	selected := make([]types.Provider, 0, len(requested))
+	for _, name := range requested {
+		provider, ok := byName[name]
+		if !ok {
+			return nil, errors.NewWithDetails("unknown provider", "provider", name)
+		}
+		selected = append(selected, provider)
+	}
+
+	return selected, nil
+}
+
+// rows collects the products of all the services and regions of the provider, ordered by service, region and type
+func (e *Exporter) rows(provider types.Provider) ([]Row, error) {
+	var scrapedAt int64
+	if status, err := e.store.GetStatus(provider.Provider); err == nil {
+		scrapedAt, _ = strconv.ParseInt(status, 10, 64)
+	}
+
+	services := make([]string, 0, len(provider.Services))
+	for _, service := range provider.Services {
+		services = append(services, service.Service)
+	}
+	sort.Strings(services)
+
+	var rows []Row
+	for _, service := range services {
+		regions, err := e.store.GetRegions(provider.Provider, service)
+		if err != nil {
+			return nil, errors.WrapIfWithDetails(err, "failed to retrieve regions",
+				"provider", provider.Provider, "service", service)
+		}
+
+		regionIDs := make([]string, 0, len(regions))
+		for region := range regions {
+			regionIDs = append(regionIDs, region)
+		}
+		sort.Strings(regionIDs)
+
+		for _, region := range regionIDs {
+			details, err := e.store.GetProductDetails(provider.Provider, service, region)
+			if err != nil {
+				e.log.Warn("skipping region without products", map[string]interface{}{"provider": provider.Provider,
+					"service": service, "region": region, "error": err.Error()})
+				continue
+			}
+
+			sort.Slice(details, func(i, j int) bool { return details[i].Type < details[j].Type })
+			for _, product := range details {
+				rows = append(rows, newRow(provider.Provider, service, region, product, scrapedAt))
+			}
+		}
+	}
+
+	return rows, nil
+}
+
+// newRow flattens a product into a row
+func newRow(provider, service, region string, product types.ProductDetails, scrapedAt int64) Row {
+	spotPrices := make([]SpotPriceRow, 0, len(product.SpotPrice))
+	for _, price := range product.SpotPrice {
+		spotPrices = append(spotPrices, SpotPriceRow{Zone: price.Zone, Price: price.Price})
+	}
+
+	zones := product.Zones
+	if zones == nil {
+		zones = []string{}
+	}
+
+	attributes := product.Attributes
+	if attributes == nil {
+		attributes = map[string]string{}
+	}
+
+	return Row{
+		Provider:        provider,
+		Service:         service,
+		Region:          region,
+		Type:            product.Type,
+		Category:        product.Category,
+		Cpus:            product.Cpus,
+		Mem:             product.Mem,
+		Gpus:            product.Gpus,
+		GpuModel:        product.GpuModel,
+		OnDemandPrice:   product.OnDemandPrice,
+		Currency:        product.Currency,
+		NtwPerf:         product.NtwPerf,
+		NtwPerfCategory: product.NtwPerfCat,
+		Architecture:    product.Architecture,
+		Burst:           product.Burst,
+		CurrentGen:      product.CurrentGen,
+		Zones:           zones,
+		SpotPrices:      spotPrices,
+		Attributes:      attributes,
+		ScrapedAt:       scrapedAt,
+	}
+}
+
+// marshalRows encodes the rows as a Parquet file
+func marshalRows(rows []Row) ([]byte, error) {
+	var buf bytes.Buffer
+
+	pw, err := writer.NewParquetWriterFromWriter(&buf, new(Row), parallelism)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to create parquet writer")
+	}
+
+	for _, row := range rows {
+		if err := pw.Write(row); err != nil {
+			return nil, errors.WrapIfWithDetails(err, "failed to write row", "type", row.Type)
+		}
+	}
+
+	if err := pw.WriteStop(); err != nil {
+		return nil, errors.WrapIf(err, "failed to finish parquet file")
+	}
+
+	return buf.Bytes(), nil
+}
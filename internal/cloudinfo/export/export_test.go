@@ -0,0 +1,196 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"emperror.dev/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xitongsys/parquet-go/reader"
+	"github.com/xitongsys/parquet-go/source"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/cloudinfoadapter"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+type storeStub struct {
+	providers []types.Provider
+	regions   map[string]map[string]string
+	products  map[string][]types.ProductDetails
+}
+
+func (s storeStub) GetProviders() ([]types.Provider, error) {
+	return s.providers, nil
+}
+
+func (s storeStub) GetRegions(provider, service string) (map[string]string, error) {
+	return s.regions[provider+"/"+service], nil
+}
+
+func (s storeStub) GetProductDetails(provider, service, region string) ([]types.ProductDetails, error) {
+	products, ok := s.products[provider+"/"+service+"/"+region]
+	if !ok {
+		return nil, errors.New("no products")
+	}
+
+	return products, nil
+}
+
+func (s storeStub) GetStatus(provider string) (string, error) {
+	return "1609459200000", nil
+}
+
+// bufferFile is an in-memory parquet source
+type bufferFile struct {
+	*bytes.Reader
+	data []byte
+}
+
+func (f bufferFile) Write(p []byte) (int, error) { return 0, errors.New("read only") }
+
+func (f bufferFile) Close() error { return nil }
+
+func (f bufferFile) Open(string) (source.ParquetFile, error) {
+	return bufferFile{Reader: bytes.NewReader(f.data), data: f.data}, nil
+}
+
+func (f bufferFile) Create(string) (source.ParquetFile, error) { return nil, errors.New("read only") }
+
+func readRows(t *testing.T, file string) []Row {
+	data, err := os.ReadFile(file)
+	require.NoError(t, err)
+
+	pf := bufferFile{Reader: bytes.NewReader(data), data: data}
+	pr, err := reader.NewParquetReader(pf, new(Row), 1)
+	require.NoError(t, err)
+	defer pr.ReadStop()
+
+	rows := make([]Row, pr.GetNumRows())
+	require.NoError(t, pr.Read(&rows))
+
+	return rows
+}
+
+func TestParseDestination(t *testing.T) {
+	tests := []struct {
+		name   string
+		dst    string
+		scheme string
+		bucket string
+		prefix string
+		err    bool
+	}{
+		{name: "local path", dst: "/var/lib/cloudinfo/export"},
+		{name: "s3 bucket", dst: "s3://catalog", scheme: "s3", bucket: "catalog"},
+		{name: "s3 bucket with prefix", dst: "s3://catalog/cloudinfo/daily/", scheme: "s3", bucket: "catalog", prefix: "cloudinfo/daily"},
+		{name: "gcs bucket with prefix", dst: "gs://catalog/cloudinfo", scheme: "gs", bucket: "catalog", prefix: "cloudinfo"},
+		{name: "empty", dst: "", err: true},
+		{name: "unsupported scheme", dst: "ftp://catalog/cloudinfo", err: true},
+		{name: "missing bucket", dst: "s3:///cloudinfo", err: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			scheme, bucket, prefix, err := parseDestination(test.dst)
+			if test.err {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.scheme, scheme)
+			assert.Equal(t, test.bucket, bucket)
+			assert.Equal(t, test.prefix, prefix)
+		})
+	}
+}
+
+func TestExporter_Export(t *testing.T) {
+	store := storeStub{
+		providers: []types.Provider{
+			{Provider: "amazon", Services: []types.Service{{Service: "eks"}, {Service: "compute"}}},
+			{Provider: "google", Services: []types.Service{{Service: "compute"}}},
+		},
+		regions: map[string]map[string]string{
+			"amazon/compute": {"eu-west-1": "EU (Ireland)", "us-east-1": "US East (N. Virginia)"},
+			"amazon/eks":     {"eu-west-1": "EU (Ireland)"},
+			"google/compute": {"europe-west1": "Belgium"},
+		},
+		products: map[string][]types.ProductDetails{
+			"amazon/compute/eu-west-1": {
+				{VMInfo: types.VMInfo{Type: "m5.large", Category: types.CategoryGeneral, Cpus: 2, Mem: 8, OnDemandPrice: 0.107,
+					Zones: []string{"eu-west-1a", "eu-west-1b"}, SpotPrice: []types.ZonePrice{{Zone: "eu-west-1a", Price: 0.035}},
+					Attributes: map[string]string{"cpu": "2", "memory": "8"}}},
+				{VMInfo: types.VMInfo{Type: "c5.large", Category: types.CategoryCompute, Cpus: 2, Mem: 4, OnDemandPrice: 0.096}},
+			},
+			"amazon/eks/eu-west-1": {
+				{VMInfo: types.VMInfo{Type: "m5.large", Category: types.CategoryGeneral, Cpus: 2, Mem: 8, OnDemandPrice: 0.107}},
+			},
+			"google/compute/europe-west1": {
+				{VMInfo: types.VMInfo{Type: "n1-standard-1", Category: types.CategoryGeneral, Cpus: 1, Mem: 3.75, OnDemandPrice: 0.0475}},
+			},
+		},
+	}
+	exporter := NewExporter(store, cloudinfoadapter.NewNoopLogger())
+
+	t.Run("all providers", func(t *testing.T) {
+		dir := t.TempDir()
+
+		locations, err := exporter.Export(context.Background(), dir, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{filepath.Join(dir, "amazon.parquet"), filepath.Join(dir, "google.parquet")}, locations)
+
+		rows := readRows(t, locations[0])
+		require.Len(t, rows, 3)
+		assert.Equal(t, Row{
+			Provider:      "amazon",
+			Service:       "compute",
+			Region:        "eu-west-1",
+			Type:          "m5.large",
+			Category:      types.CategoryGeneral,
+			Cpus:          2,
+			Mem:           8,
+			OnDemandPrice: 0.107,
+			Zones:         []string{"eu-west-1a", "eu-west-1b"},
+			SpotPrices:    []SpotPriceRow{{Zone: "eu-west-1a", Price: 0.035}},
+			Attributes:    map[string]string{"cpu": "2", "memory": "8"},
+			ScrapedAt:     1609459200000,
+		}, rows[1])
+		assert.Equal(t, "c5.large", rows[0].Type)
+		assert.Equal(t, "eks", rows[2].Service)
+
+		rows = readRows(t, locations[1])
+		require.Len(t, rows, 1)
+		assert.Equal(t, "n1-standard-1", rows[0].Type)
+	})
+
+	t.Run("selected provider", func(t *testing.T) {
+		dir := filepath.Join(t.TempDir(), "nested")
+
+		locations, err := exporter.Export(context.Background(), dir, []string{"google"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{filepath.Join(dir, "google.parquet")}, locations)
+	})
+
+	t.Run("unknown provider", func(t *testing.T) {
+		_, err := exporter.Export(context.Background(), t.TempDir(), []string{"digitalocean"})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,75 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// freeTierOffer describes the instance types of a free tier offering of a provider
+type freeTierOffer struct {
+	// instanceTypes the instance types eligible for the free tier, the first one offered in the region is marked
+	instanceTypes []string
+	// regions the regions the offering is limited to, all the regions if empty
+	regions []string
+}
+
+// freeTierOffers holds the free tier / always free compute offerings of the providers
+var freeTierOffers = map[string][]freeTierOffer{
+	// 750 hours per month for 12 months, t3.micro in the regions without t2.micro
+	// source: https://aws.amazon.com/free/
+	"amazon": {{instanceTypes: []string{"t2.micro", "t3.micro"}}},
+	// 750 hours per month for 12 months
+	// source: https://azure.microsoft.com/en-us/pricing/free-services
+	"azure": {
+		{instanceTypes: []string{"Standard_B1s"}},
+		{instanceTypes: []string{"Standard_B2pts_v2"}},
+		{instanceTypes: []string{"Standard_B2ats_v2"}},
+	},
+	// one always free e2-micro instance per month in the listed US regions
+	// source: https://cloud.google.com/free/docs/free-cloud-features#compute
+	"google": {{instanceTypes: []string{"e2-micro"}, regions: []string{"us-west1", "us-central1", "us-east1"}}},
+	// always free: two VM.Standard.E2.1.Micro instances and 4 OCPUs, 24 GB memory of VM.Standard.A1.Flex altogether
+	// source: https://docs.oracle.com/en-us/iaas/Content/FreeTier/freetier_topic-Always_Free_Resources.htm
+	"oracle": {
+		{instanceTypes: []string{"VM.Standard.E2.1.Micro"}},
+		{instanceTypes: []string{"VM.Standard.A1.Flex"}},
+	},
+}
+
+// MarkFreeTier marks the instance types of a region eligible for the free tier offerings of the provider
+func MarkFreeTier(provider, region string, vms []types.VMInfo) {
+	for _, offer := range freeTierOffers[provider] {
+		if len(offer.regions) > 0 && !Contains(offer.regions, region) {
+			continue
+		}
+		if i := firstOffered(vms, offer.instanceTypes); i >= 0 {
+			vms[i].FreeTier = true
+		}
+	}
+}
+
+// firstOffered returns the index of the first of the instance types found in the virtual machines, -1 if none of them
+func firstOffered(vms []types.VMInfo, instanceTypes []string) int {
+	for _, instanceType := range instanceTypes {
+		for i, vm := range vms {
+			if vm.Type == instanceType {
+				return i
+			}
+		}
+	}
+
+	return -1
+}
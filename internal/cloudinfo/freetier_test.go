@@ -0,0 +1,88 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestMarkFreeTier(t *testing.T) {
+	tests := []struct {
+		name     string
+		provider string
+		region   string
+		vms      []types.VMInfo
+		freeTier []string
+	}{
+		{
+			name:     "t2.micro is the amazon free tier instance type",
+			provider: "amazon",
+			region:   "eu-west-1",
+			vms:      []types.VMInfo{{Type: "t2.micro"}, {Type: "t3.micro"}, {Type: "m5.large"}},
+			freeTier: []string{"t2.micro"},
+		},
+		{
+			name:     "t3.micro in the amazon regions without t2.micro",
+			provider: "amazon",
+			region:   "eu-north-1",
+			vms:      []types.VMInfo{{Type: "t3.micro"}, {Type: "m5.large"}},
+			freeTier: []string{"t3.micro"},
+		},
+		{
+			name:     "e2-micro in the google free tier regions",
+			provider: "google",
+			region:   "us-central1",
+			vms:      []types.VMInfo{{Type: "e2-micro"}, {Type: "e2-small"}},
+			freeTier: []string{"e2-micro"},
+		},
+		{
+			name:     "no e2-micro out of the google free tier regions",
+			provider: "google",
+			region:   "europe-west1",
+			vms:      []types.VMInfo{{Type: "e2-micro"}, {Type: "e2-small"}},
+		},
+		{
+			name:     "all the azure free tier sizes",
+			provider: "azure",
+			region:   "westeurope",
+			vms:      []types.VMInfo{{Type: "Standard_B1s"}, {Type: "Standard_B2ats_v2"}, {Type: "Standard_D2s_v5"}},
+			freeTier: []string{"Standard_B1s", "Standard_B2ats_v2"},
+		},
+		{
+			name:     "no free tier",
+			provider: "hetzner",
+			region:   "fsn1",
+			vms:      []types.VMInfo{{Type: "cx11"}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			MarkFreeTier(test.provider, test.region, test.vms)
+
+			var freeTier []string
+			for _, vm := range test.vms {
+				if vm.FreeTier {
+					freeTier = append(freeTier, vm.Type)
+				}
+			}
+			assert.Equal(t, test.freeTier, freeTier)
+		})
+	}
+}
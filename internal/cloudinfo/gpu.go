@@ -0,0 +1,120 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// gpuModel describes a well-known GPU model
+type gpuModel struct {
+	vendor string
+	model  string
+	// memory of a single GPU in GiB
+	memory float64
+}
+
+// gpuModels holds the well-known GPU models by the (lowercase) token identifying them in the provider descriptions
+var gpuModels = map[string]gpuModel{
+	"k520":    {vendor: types.GpuVendorNvidia, model: "GRID K520", memory: 4},
+	"k80":     {vendor: types.GpuVendorNvidia, model: "Tesla K80", memory: 12},
+	"m60":     {vendor: types.GpuVendorNvidia, model: "Tesla M60", memory: 8},
+	"p4":      {vendor: types.GpuVendorNvidia, model: "Tesla P4", memory: 8},
+	"p40":     {vendor: types.GpuVendorNvidia, model: "Tesla P40", memory: 24},
+	"p100":    {vendor: types.GpuVendorNvidia, model: "Tesla P100", memory: 16},
+	"v100":    {vendor: types.GpuVendorNvidia, model: "Tesla V100", memory: 16},
+	"v100s":   {vendor: types.GpuVendorNvidia, model: "Tesla V100S", memory: 32},
+	"t4":      {vendor: types.GpuVendorNvidia, model: "Tesla T4", memory: 16},
+	"t4g":     {vendor: types.GpuVendorNvidia, model: "T4G", memory: 16},
+	"a10":     {vendor: types.GpuVendorNvidia, model: "A10", memory: 24},
+	"a10g":    {vendor: types.GpuVendorNvidia, model: "A10G", memory: 24},
+	"a40":     {vendor: types.GpuVendorNvidia, model: "A40", memory: 48},
+	"a100":    {vendor: types.GpuVendorNvidia, model: "A100", memory: 40},
+	"rtx6000": {vendor: types.GpuVendorNvidia, model: "Quadro RTX 6000", memory: 24},
+	"rtx3070": {vendor: types.GpuVendorNvidia, model: "GeForce RTX 3070", memory: 8},
+	"mi25":    {vendor: types.GpuVendorAmd, model: "Radeon Instinct MI25", memory: 16},
+	"s7150":   {vendor: types.GpuVendorAmd, model: "FirePro S7150", memory: 8},
+	"v520":    {vendor: types.GpuVendorAmd, model: "Radeon Pro V520", memory: 8},
+}
+
+// gpuVendors holds the GPU vendors by the (lowercase) tokens identifying them in the provider descriptions
+var gpuVendors = map[string]string{
+	"nvidia": types.GpuVendorNvidia,
+	"amd":    types.GpuVendorAmd,
+	"radeon": types.GpuVendorAmd,
+}
+
+var (
+	// gpuTokenSeparator splits the provider descriptions into tokens, eg.: nvidia-tesla-v100, NVIDIA® Tesla® V100
+	gpuTokenSeparator = regexp.MustCompile(`[^a-z0-9]+`)
+	// gpuMemoryPattern matches the explicit memory of a GPU in the provider descriptions, eg.: a100-80gb, V100 / 32 GB
+	gpuMemoryPattern = regexp.MustCompile(`(?i)(\d+)\s*gi?b\b`)
+)
+
+// SetGpu sets the GPU vendor, model and memory of a virtual machine from the provider specific description of its GPUs
+// (eg.: nvidia-tesla-v100, NVIDIA V100, 1 * NVIDIA V100 / 16 GB); the number of GPUs of the virtual machine must be set already.
+// Unknown models are retained as described by the provider, without memory unless it's part of the description.
+func SetGpu(vm *types.VMInfo, description string) {
+	description = strings.TrimSpace(description)
+	if description == "" || vm.Gpus <= 0 {
+		return
+	}
+
+	var (
+		known  gpuModel
+		found  bool
+		vendor string
+	)
+
+	tokens := gpuTokenSeparator.Split(strings.ToLower(description), -1)
+	for i, token := range tokens {
+		if v, ok := gpuVendors[token]; ok && vendor == "" {
+			vendor = v
+		}
+
+		if found {
+			continue
+		}
+
+		// models spelled in two tokens, eg.: RTX 6000
+		if i+1 < len(tokens) {
+			if model, ok := gpuModels[token+tokens[i+1]]; ok {
+				known, found = model, true
+				continue
+			}
+		}
+
+		known, found = gpuModels[token]
+	}
+
+	if found {
+		vm.GpuVendor = known.vendor
+		vm.GpuModel = known.model
+		vm.GpuMem = known.memory * vm.Gpus
+	} else {
+		vm.GpuVendor = vendor
+		vm.GpuModel = description
+	}
+
+	if match := gpuMemoryPattern.FindStringSubmatch(description); match != nil {
+		if memory, err := strconv.ParseFloat(match[1], 64); err == nil {
+			vm.GpuMem = memory * vm.Gpus
+		}
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestSetGpu(t *testing.T) {
+	tests := []struct {
+		name        string
+		gpus        float64
+		description string
+		want        types.VMInfo
+	}{
+		{
+			name:        "google accelerator type",
+			gpus:        2,
+			description: "nvidia-tesla-v100",
+			want:        types.VMInfo{Gpus: 2, GpuVendor: "nvidia", GpuModel: "Tesla V100", GpuMem: 32},
+		},
+		{
+			name:        "explicit memory",
+			gpus:        1,
+			description: "nvidia-a100-80gb",
+			want:        types.VMInfo{Gpus: 1, GpuVendor: "nvidia", GpuModel: "A100", GpuMem: 80},
+		},
+		{
+			name:        "count and memory per gpu",
+			gpus:        4,
+			description: "4 * NVIDIA V100 / 32 GB",
+			want:        types.VMInfo{Gpus: 4, GpuVendor: "nvidia", GpuModel: "Tesla V100", GpuMem: 128},
+		},
+		{
+			name:        "model in two tokens",
+			gpus:        1,
+			description: "NVIDIA® Quadro® RTX 6000",
+			want:        types.VMInfo{Gpus: 1, GpuVendor: "nvidia", GpuModel: "Quadro RTX 6000", GpuMem: 24},
+		},
+		{
+			name:        "unknown model",
+			gpus:        1,
+			description: "AMD Instinct MI100",
+			want:        types.VMInfo{Gpus: 1, GpuVendor: "amd", GpuModel: "AMD Instinct MI100"},
+		},
+		{
+			name:        "no gpus",
+			description: "nvidia-tesla-t4",
+			want:        types.VMInfo{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			vm := types.VMInfo{Gpus: test.gpus}
+			SetGpu(&vm, test.description)
+
+			assert.Equal(t, test.want, vm)
+		})
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"emperror.dev/emperror"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// ImageStore retrieves images.
+type ImageStore interface {
+	// GetServiceImages returns the images of a service in a region.
+	GetServiceImages(provider, service, region string) ([]types.Image, error)
+}
+
+// ImageService provides access to the images of a service.
+type ImageService struct {
+	store ImageStore
+}
+
+// NewImageService returns a new ImageService.
+func NewImageService(store ImageStore) *ImageService {
+	return &ImageService{
+		store: store,
+	}
+}
+
+// Image is a machine image of a service.
+type Image struct {
+	Name         string
+	Version      string
+	CreationDate time.Time
+	Gpu          bool
+	Tags         []Tag
+}
+
+// Tag is a key-value pair attached to a resource.
+type Tag struct {
+	Key   string
+	Value string
+}
+
+// ImageQueryFilter filters the images of a service.
+type ImageQueryFilter struct {
+	Version    *string
+	Gpu        *bool
+	Os         *string
+	Cr         *string
+	PkeVersion *string
+	LatestOnly *bool
+}
+
+// ListImages returns a list of images of a service in a region matching the filter.
+func (s *ImageService) ListImages(ctx context.Context, provider string, service string, region string, filter ImageQueryFilter) ([]Image, error) {
+	cloudImages, err := s.store.GetServiceImages(provider, service, region)
+	if err != nil {
+		return nil, emperror.WrapWith(
+			err,
+			"failed to list images",
+			"provider", provider,
+			"service", service,
+			"region", region,
+		)
+	}
+
+	images := make([]Image, 0, len(cloudImages))
+
+	for _, image := range cloudImages {
+		if !applyImageFilter(image, filter) {
+			continue
+		}
+
+		images = append(images, transformImage(image))
+	}
+
+	if filter.LatestOnly != nil && *filter.LatestOnly && len(images) > 0 {
+		latest := images[0]
+		for _, image := range images[1:] {
+			if image.CreationDate.After(latest.CreationDate) {
+				latest = image
+			}
+		}
+
+		images = []Image{latest}
+	}
+
+	return images, nil
+}
+
+func applyImageFilter(image types.Image, filter ImageQueryFilter) bool {
+	if filter.Version != nil && image.Version != *filter.Version {
+		return false
+	}
+
+	if filter.Gpu != nil && image.GpuAvailable != *filter.Gpu {
+		return false
+	}
+
+	if filter.Os != nil && image.Tags["os-type"] != *filter.Os {
+		return false
+	}
+
+	if filter.Cr != nil && image.Tags["cr"] != *filter.Cr {
+		return false
+	}
+
+	if filter.PkeVersion != nil && image.Tags["pke-version"] != *filter.PkeVersion {
+		return false
+	}
+
+	return true
+}
+
+func transformImage(image types.Image) Image {
+	tags := make([]Tag, 0, len(image.Tags))
+	for key, value := range image.Tags {
+		tags = append(tags, Tag{Key: key, Value: value})
+	}
+	sort.Slice(tags, func(i, j int) bool { return tags[i].Key < tags[j].Key })
+
+	return Image{
+		Name:         image.Name,
+		Version:      image.Version,
+		CreationDate: image.CreationDate,
+		Gpu:          image.GpuAvailable,
+		Tags:         tags,
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// InMemoryImageStore keeps images in the memory.
+// Use it in tests or for development/demo purposes.
+type InMemoryImageStore struct {
+	images map[string]map[string]map[string][]types.Image
+}
+
+// NewInMemoryImageStore returns a new InMemoryImageStore.
+func NewInMemoryImageStore() *InMemoryImageStore {
+	return &InMemoryImageStore{
+		images: make(map[string]map[string]map[string][]types.Image),
+	}
+}
+
+func (s *InMemoryImageStore) GetServiceImages(provider, service, region string) ([]types.Image, error) {
+	return s.images[provider][service][region], nil
+}
@@ -0,0 +1,140 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestImageService_ListImages(t *testing.T) {
+	store := NewInMemoryImageStore()
+	store.images = map[string]map[string]map[string][]types.Image{
+		"amazon": {
+			"eks": {
+				"eu-west-1": []types.Image{
+					{
+						Name:         "ami-1",
+						Version:      "1.20",
+						CreationDate: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+						Tags:         map[string]string{"os-type": "ubuntu", "cr": "containerd"},
+					},
+					{
+						Name:         "ami-2",
+						Version:      "1.20",
+						CreationDate: time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC),
+						Tags:         map[string]string{"os-type": "ubuntu", "cr": "docker"},
+					},
+					{
+						Name:         "ami-3",
+						Version:      "1.21",
+						CreationDate: time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC),
+						GpuAvailable: true,
+					},
+				},
+			},
+		},
+	}
+
+	imageService := NewImageService(store)
+
+	version := "1.20"
+	gpu := true
+	os := "ubuntu"
+	cr := "containerd"
+	latestOnly := true
+
+	tests := []struct {
+		name   string
+		filter ImageQueryFilter
+		images []string
+	}{
+		{
+			name:   "no filter",
+			filter: ImageQueryFilter{},
+			images: []string{"ami-1", "ami-2", "ami-3"},
+		},
+		{
+			name:   "version",
+			filter: ImageQueryFilter{Version: &version},
+			images: []string{"ami-1", "ami-2"},
+		},
+		{
+			name:   "gpu",
+			filter: ImageQueryFilter{Gpu: &gpu},
+			images: []string{"ami-3"},
+		},
+		{
+			name:   "os and container runtime",
+			filter: ImageQueryFilter{Os: &os, Cr: &cr},
+			images: []string{"ami-1"},
+		},
+		{
+			name:   "latest only",
+			filter: ImageQueryFilter{Version: &version, LatestOnly: &latestOnly},
+			images: []string{"ami-2"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			images, err := imageService.ListImages(context.Background(), "amazon", "eks", "eu-west-1", test.filter)
+			require.NoError(t, err)
+
+			names := make([]string, 0, len(images))
+			for _, image := range images {
+				names = append(names, image.Name)
+			}
+
+			assert.Equal(t, test.images, names)
+		})
+	}
+}
+
+func TestImageService_ListImages_Tags(t *testing.T) {
+	store := NewInMemoryImageStore()
+	store.images = map[string]map[string]map[string][]types.Image{
+		"amazon": {
+			"eks": {
+				"eu-west-1": []types.Image{
+					{Name: "ami-1", Version: "1.20", Tags: map[string]string{"os-type": "ubuntu", "cr": "docker"}},
+				},
+			},
+		},
+	}
+
+	imageService := NewImageService(store)
+
+	images, err := imageService.ListImages(context.Background(), "amazon", "eks", "eu-west-1", ImageQueryFilter{})
+	require.NoError(t, err)
+
+	assert.Equal(
+		t,
+		[]Image{
+			{
+				Name:    "ami-1",
+				Version: "1.20",
+				Tags:    []Tag{{Key: "cr", Value: "docker"}, {Key: "os-type", Value: "ubuntu"}},
+			},
+		},
+		images,
+	)
+}
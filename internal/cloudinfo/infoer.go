@@ -55,3 +55,65 @@ type CloudInfoer interface {
 	// GetServiceProducts retrieves the products supported by the given service in the given region
 	GetServiceProducts(region, service string) ([]types.ProductDetails, error)
 }
+
+// StoragePricer is implemented by the cloud infoers that know the prices of the block storage volumes
+type StoragePricer interface {
+	// GetStoragePrices retrieves the block storage prices in a region
+	GetStoragePrices(region string) ([]types.StoragePrice, error)
+}
+
+// ObjectStoragePricer is implemented by the cloud infoers that know the prices of the object storage classes
+type ObjectStoragePricer interface {
+	// GetObjectStoragePrices retrieves the object storage prices in a region
+	GetObjectStoragePrices(region string) ([]types.ObjectStoragePrice, error)
+}
+
+// NetworkPricer is implemented by the cloud infoers that know the prices of the network traffic
+type NetworkPricer interface {
+	// GetNetworkPrices retrieves the internet egress, inter-zone and inter-region traffic prices in a region
+	GetNetworkPrices(region string) ([]types.NetworkPrice, error)
+}
+
+// LoadBalancerPricer is implemented by the cloud infoers that know the prices of the managed load balancers
+type LoadBalancerPricer interface {
+	// GetLoadBalancerPrices retrieves the load balancer prices in a region
+	GetLoadBalancerPrices(region string) ([]types.LoadBalancerPrice, error)
+}
+
+// NatGatewayPricer is implemented by the cloud infoers that know the prices of the managed NAT gateways
+type NatGatewayPricer interface {
+	// GetNatGatewayPrices retrieves the NAT gateway prices in a region
+	GetNatGatewayPrices(region string) ([]types.NatGatewayPrice, error)
+}
+
+// PublicIpPricer is implemented by the cloud infoers that know the prices of the public IP addresses
+type PublicIpPricer interface {
+	// GetPublicIpPrices retrieves the public IP address prices in a region
+	GetPublicIpPrices(region string) ([]types.PublicIpPrice, error)
+}
+
+// DatabasePricer is implemented by the cloud infoers that know the managed database instance classes and their prices
+type DatabasePricer interface {
+	// GetDatabases retrieves the managed database instance classes of all the engines in a region
+	GetDatabases(region string) ([]types.DatabaseInfo, error)
+}
+
+// ZoneDescriber is implemented by the cloud infoers that know the native ids of the zones and the instance types
+// offered in them
+type ZoneDescriber interface {
+	// GetZoneInfo retrieves the availability zones in a region
+	GetZoneInfo(region string) ([]types.ZoneInfo, error)
+}
+
+// DedicatedHostPricer is implemented by the cloud infoers that know the dedicated host types and their prices
+type DedicatedHostPricer interface {
+	// GetDedicatedHosts retrieves the dedicated host types in a region
+	GetDedicatedHosts(region string) ([]types.DedicatedHostInfo, error)
+}
+
+// ServerlessPricer is implemented by the cloud infoers that know the prices of the serverless containers
+// (eg.: Fargate, Cloud Run, Container Instances)
+type ServerlessPricer interface {
+	// GetServerlessPrices retrieves the per vCPU-second and per GB-second serverless container prices in a region
+	GetServerlessPrices(region string) ([]types.ServerlessPrice, error)
+}
@@ -50,16 +50,38 @@ func NewInstanceTypeService(store InstanceTypeStore) *InstanceTypeService {
 
 // InstanceType represents a single instance type.
 type InstanceType struct {
-	Name            string
-	Region          string
-	Zone            string
-	Price           float64
-	SpotPrice       float64
-	CPU             float64
-	Memory          float64
-	Gpu             float64
-	NetworkCategory NetworkCategory
-	Category        InstanceTypeCategory
+	Name                  string
+	Region                string
+	Zone                  string
+	Price                 float64
+	SpotPrice             float64
+	CPU                   float64
+	Memory                float64
+	Gpu                   float64
+	GpuVendor             string
+	GpuModel              string
+	GpuMemory             float64
+	NetworkCategory       NetworkCategory
+	Category              InstanceTypeCategory
+	Burst                 bool
+	PlacementGroup        string
+	PlacementStrategies   []string
+	FreeTier              bool
+	Workloads             []string
+	BareMetal             bool
+	NICs                  int
+	Partition             string
+	Architecture          string
+	AcceleratedNetworking bool
+	IPv6                  bool
+	MaxBandwidth          float64
+}
+
+// InstanceTypePage represents a page of the instance types matching a query.
+type InstanceTypePage struct {
+	InstanceTypes []InstanceType
+	TotalCount    int
+	NextCursor    *string
 }
 
 // InstanceTypeQuery represents the input parameters if an instance type query.
@@ -71,14 +93,27 @@ type InstanceTypeQuery struct {
 
 // InstanceTypeQueryFilter filters instance types by their fields.
 type InstanceTypeQueryFilter struct {
-	Price           *FloatFilter
-	SpotPrice       *FloatFilter
-	Spot            *bool
-	CPU             *FloatFilter
-	Memory          *FloatFilter
-	Gpu             *FloatFilter
-	NetworkCategory *NetworkCategoryFilter
-	Category        *InstanceTypeCategoryFilter
+	Price                 *FloatFilter
+	SpotPrice             *FloatFilter
+	Spot                  *bool
+	CPU                   *FloatFilter
+	Memory                *FloatFilter
+	Gpu                   *FloatFilter
+	GpuVendor             *string
+	GpuModel              *string
+	GpuMemory             *FloatFilter
+	NetworkCategory       *NetworkCategoryFilter
+	Category              *InstanceTypeCategoryFilter
+	Burst                 *bool
+	PlacementGroup        *string
+	Workload              *string
+	BareMetal             *bool
+	Partition             *string
+	Architecture          *string
+	AcceleratedNetworking *bool
+	IPv6                  *bool
+	MaxBandwidth          *FloatFilter
+	FreeTier              *bool
 }
 
 // IntFilter represents the query operators for an instance type network category field.
@@ -292,6 +327,18 @@ func applyInstanceTypeFilter(product types.ProductDetails, zone string, filter I
 		return false
 	}
 
+	if filter.GpuVendor != nil && !strings.EqualFold(product.GpuVendor, *filter.GpuVendor) {
+		return false
+	}
+
+	if filter.GpuModel != nil && !strings.EqualFold(product.GpuModel, *filter.GpuModel) {
+		return false
+	}
+
+	if filter.GpuMemory != nil && !applyFloatFilter(product.GpuMem, *filter.GpuMemory) {
+		return false
+	}
+
 	if filter.NetworkCategory != nil && !applyNetworkCategoryFilter(product.NtwPerfCat, *filter.NetworkCategory) {
 		return false
 	}
@@ -300,6 +347,47 @@ func applyInstanceTypeFilter(product types.ProductDetails, zone string, filter I
 		return false
 	}
 
+	if filter.Burst != nil && product.Burst != *filter.Burst {
+		return false
+	}
+
+	if filter.PlacementGroup != nil && product.PlacementGroup != *filter.PlacementGroup &&
+		!Contains(product.PlacementStrategies, *filter.PlacementGroup) {
+		return false
+	}
+
+	if filter.Workload != nil && !Contains(product.Workloads, *filter.Workload) {
+		return false
+	}
+
+	if filter.BareMetal != nil && product.BareMetal != *filter.BareMetal {
+		return false
+	}
+
+	if filter.Partition != nil && product.Partition != *filter.Partition {
+		return false
+	}
+
+	if filter.Architecture != nil && product.Architecture != *filter.Architecture {
+		return false
+	}
+
+	if filter.AcceleratedNetworking != nil && product.AcceleratedNetworking != *filter.AcceleratedNetworking {
+		return false
+	}
+
+	if filter.IPv6 != nil && product.IPv6 != *filter.IPv6 {
+		return false
+	}
+
+	if filter.MaxBandwidth != nil && !applyFloatFilter(product.NtwPerfGbps, *filter.MaxBandwidth) {
+		return false
+	}
+
+	if filter.FreeTier != nil && product.FreeTier != *filter.FreeTier {
+		return false
+	}
+
 	if filter.SpotPrice != nil || filter.Spot != nil {
 		var spotPrice float64
 
@@ -403,15 +491,30 @@ func transform(details types.ProductDetails, region string, zone string) Instanc
 	}
 
 	return InstanceType{
-		Name:            details.Type,
-		Region:          region,
-		Zone:            zone,
-		Price:           details.OnDemandPrice,
-		SpotPrice:       spotPrice,
-		CPU:             details.Cpus,
-		Memory:          details.Mem,
-		Gpu:             details.Gpus,
-		NetworkCategory: NetworkCategory(strings.ToUpper(details.NtwPerfCat)),
-		Category:        instanceTypeCategoryReverseMap[details.Category],
+		Name:                  details.Type,
+		Region:                region,
+		Zone:                  zone,
+		Price:                 details.OnDemandPrice,
+		SpotPrice:             spotPrice,
+		CPU:                   details.Cpus,
+		Memory:                details.Mem,
+		Gpu:                   details.Gpus,
+		GpuVendor:             details.GpuVendor,
+		GpuModel:              details.GpuModel,
+		GpuMemory:             details.GpuMem,
+		NetworkCategory:       NetworkCategory(strings.ToUpper(details.NtwPerfCat)),
+		Category:              instanceTypeCategoryReverseMap[details.Category],
+		Burst:                 details.Burst,
+		PlacementGroup:        details.PlacementGroup,
+		PlacementStrategies:   details.PlacementStrategies,
+		FreeTier:              details.FreeTier,
+		Workloads:             details.Workloads,
+		BareMetal:             details.BareMetal,
+		NICs:                  details.NICs,
+		Partition:             details.Partition,
+		Architecture:          details.Architecture,
+		AcceleratedNetworking: details.AcceleratedNetworking,
+		IPv6:                  details.IPv6,
+		MaxBandwidth:          details.NtwPerfGbps,
 	}
 }
@@ -124,3 +124,93 @@ func TestInstanceTypeService_Query(t *testing.T) {
 
 	t.Logf("%+v", result)
 }
+
+func TestApplyInstanceTypeFilter_Burst(t *testing.T) {
+	burstable := types.ProductDetails{VMInfo: types.VMInfo{Type: "t3.micro", Burst: true}}
+	fixed := types.ProductDetails{VMInfo: types.VMInfo{Type: "m5.large"}}
+
+	burst := true
+	require.True(t, applyInstanceTypeFilter(burstable, "", InstanceTypeQueryFilter{Burst: &burst}))
+	require.False(t, applyInstanceTypeFilter(fixed, "", InstanceTypeQueryFilter{Burst: &burst}))
+
+	burst = false
+	require.False(t, applyInstanceTypeFilter(burstable, "", InstanceTypeQueryFilter{Burst: &burst}))
+	require.True(t, applyInstanceTypeFilter(fixed, "", InstanceTypeQueryFilter{Burst: &burst}))
+}
+
+func TestApplyInstanceTypeFilter_BareMetal(t *testing.T) {
+	metal := types.ProductDetails{VMInfo: types.VMInfo{Type: "c3.small.x86", BareMetal: true, NICs: 2}}
+	virtual := types.ProductDetails{VMInfo: types.VMInfo{Type: "m5.large"}}
+
+	bareMetal := true
+	require.True(t, applyInstanceTypeFilter(metal, "", InstanceTypeQueryFilter{BareMetal: &bareMetal}))
+	require.False(t, applyInstanceTypeFilter(virtual, "", InstanceTypeQueryFilter{BareMetal: &bareMetal}))
+
+	bareMetal = false
+	require.False(t, applyInstanceTypeFilter(metal, "", InstanceTypeQueryFilter{BareMetal: &bareMetal}))
+	require.True(t, applyInstanceTypeFilter(virtual, "", InstanceTypeQueryFilter{BareMetal: &bareMetal}))
+}
+
+func TestApplyInstanceTypeFilter_Architecture(t *testing.T) {
+	arm := types.ProductDetails{VMInfo: types.VMInfo{Type: "m6g.large", Architecture: types.ArchitectureARM64}}
+	amd := types.ProductDetails{VMInfo: types.VMInfo{Type: "m5.large", Architecture: types.ArchitectureAMD64}}
+
+	architecture := types.ArchitectureARM64
+	require.True(t, applyInstanceTypeFilter(arm, "", InstanceTypeQueryFilter{Architecture: &architecture}))
+	require.False(t, applyInstanceTypeFilter(amd, "", InstanceTypeQueryFilter{Architecture: &architecture}))
+}
+
+func TestApplyInstanceTypeFilter_Gpu(t *testing.T) {
+	gpu := types.ProductDetails{VMInfo: types.VMInfo{Type: "p3.2xlarge", Gpus: 1, GpuVendor: types.GpuVendorNvidia, GpuModel: "Tesla V100", GpuMem: 16}}
+	cpu := types.ProductDetails{VMInfo: types.VMInfo{Type: "m5.large"}}
+
+	vendor := "NVIDIA"
+	require.True(t, applyInstanceTypeFilter(gpu, "", InstanceTypeQueryFilter{GpuVendor: &vendor}))
+	require.False(t, applyInstanceTypeFilter(cpu, "", InstanceTypeQueryFilter{GpuVendor: &vendor}))
+
+	model := "tesla v100"
+	require.True(t, applyInstanceTypeFilter(gpu, "", InstanceTypeQueryFilter{GpuModel: &model}))
+	require.False(t, applyInstanceTypeFilter(cpu, "", InstanceTypeQueryFilter{GpuModel: &model}))
+
+	minMemory := 16.0
+	require.True(t, applyInstanceTypeFilter(gpu, "", InstanceTypeQueryFilter{GpuMemory: &FloatFilter{Gte: &minMemory}}))
+	require.False(t, applyInstanceTypeFilter(cpu, "", InstanceTypeQueryFilter{GpuMemory: &FloatFilter{Gte: &minMemory}}))
+}
+
+func TestApplyInstanceTypeFilter_Network(t *testing.T) {
+	fast := types.ProductDetails{VMInfo: types.VMInfo{Type: "c5n.large", AcceleratedNetworking: true, IPv6: true, NtwPerfGbps: 25}}
+	slow := types.ProductDetails{VMInfo: types.VMInfo{Type: "t2.micro", NtwPerfGbps: 1}}
+
+	enabled := true
+	require.True(t, applyInstanceTypeFilter(fast, "", InstanceTypeQueryFilter{AcceleratedNetworking: &enabled, IPv6: &enabled}))
+	require.False(t, applyInstanceTypeFilter(slow, "", InstanceTypeQueryFilter{AcceleratedNetworking: &enabled}))
+	require.False(t, applyInstanceTypeFilter(slow, "", InstanceTypeQueryFilter{IPv6: &enabled}))
+
+	minBandwidth := 10.0
+	require.True(t, applyInstanceTypeFilter(fast, "", InstanceTypeQueryFilter{MaxBandwidth: &FloatFilter{Gte: &minBandwidth}}))
+	require.False(t, applyInstanceTypeFilter(slow, "", InstanceTypeQueryFilter{MaxBandwidth: &FloatFilter{Gte: &minBandwidth}}))
+}
+
+func TestApplyInstanceTypeFilter_Placement(t *testing.T) {
+	cluster := types.ProductDetails{VMInfo: types.VMInfo{Type: "c5n.large", PlacementGroup: types.PlacementCluster,
+		PlacementStrategies: []string{types.PlacementCluster, types.PlacementSpread, types.PlacementPartition}}}
+	spread := types.ProductDetails{VMInfo: types.VMInfo{Type: "t3.micro",
+		PlacementStrategies: []string{types.PlacementSpread, types.PlacementPartition}}}
+
+	placement := types.PlacementCluster
+	require.True(t, applyInstanceTypeFilter(cluster, "", InstanceTypeQueryFilter{PlacementGroup: &placement}))
+	require.False(t, applyInstanceTypeFilter(spread, "", InstanceTypeQueryFilter{PlacementGroup: &placement}))
+
+	placement = types.PlacementPartition
+	require.True(t, applyInstanceTypeFilter(cluster, "", InstanceTypeQueryFilter{PlacementGroup: &placement}))
+	require.True(t, applyInstanceTypeFilter(spread, "", InstanceTypeQueryFilter{PlacementGroup: &placement}))
+}
+
+func TestApplyInstanceTypeFilter_FreeTier(t *testing.T) {
+	free := types.ProductDetails{VMInfo: types.VMInfo{Type: "t2.micro", FreeTier: true}}
+	paid := types.ProductDetails{VMInfo: types.VMInfo{Type: "m5.large"}}
+
+	freeTier := true
+	require.True(t, applyInstanceTypeFilter(free, "", InstanceTypeQueryFilter{FreeTier: &freeTier}))
+	require.False(t, applyInstanceTypeFilter(paid, "", InstanceTypeQueryFilter{FreeTier: &freeTier}))
+}
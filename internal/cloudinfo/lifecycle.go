@@ -0,0 +1,100 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"io/ioutil"
+	"strings"
+
+	"emperror.dev/errors"
+	"gopkg.in/yaml.v2"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// lifecycles the known lifecycle stages of the instance types
+var lifecycles = map[string]bool{
+	types.LifecyclePreview:            true,
+	types.LifecycleGA:                 true,
+	types.LifecyclePreviousGeneration: true,
+	types.LifecycleRetiring:           true,
+}
+
+// LifecycleOverrides sets the lifecycle stage of the instance types of a provider,
+// for the ones the provider doesn't publish (or publishes incorrectly).
+//
+// The overrides are a mapping of instance type prefixes to lifecycle stages, the longest matching prefix wins
+// (case insensitive). The lifecycle published by the provider is kept for the instance types without a matching prefix.
+type LifecycleOverrides struct {
+	mapping map[string]string
+}
+
+// NewLifecycleOverrides creates new lifecycle overrides with the given instance type prefix - lifecycle mapping
+func NewLifecycleOverrides(mapping map[string]string) *LifecycleOverrides {
+	return &LifecycleOverrides{
+		mapping: mapping,
+	}
+}
+
+// Lifecycle returns the lifecycle stage of the instance type
+func (lo *LifecycleOverrides) Lifecycle(vm types.VMInfo) string {
+	var match string
+	lifecycle := vm.Lifecycle
+
+	for prefix, l := range lo.mapping {
+		if strings.HasPrefix(strings.ToLower(vm.Type), strings.ToLower(prefix)) && len(prefix) > len(match) {
+			match, lifecycle = prefix, l
+		}
+	}
+
+	return lifecycle
+}
+
+// LoadLifecycleOverrides reads the provider - instance type prefix - lifecycle mapping from the given YAML file,
+// no overrides are returned if the path is empty
+func LoadLifecycleOverrides(path string) (map[string]map[string]string, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.WrapIfWithDetails(err, "failed to read lifecycle overrides", "path", path)
+	}
+
+	var mapping map[string]map[string]string
+	if err := yaml.Unmarshal(content, &mapping); err != nil {
+		return nil, errors.WrapIfWithDetails(err, "failed to parse lifecycle overrides", "path", path)
+	}
+
+	if err := ValidateLifecycleOverrides(mapping); err != nil {
+		return nil, errors.WithDetails(err, "path", path)
+	}
+
+	return mapping, nil
+}
+
+// ValidateLifecycleOverrides checks that the provider - instance type prefix - lifecycle mapping only holds known stages
+func ValidateLifecycleOverrides(mapping map[string]map[string]string) error {
+	for provider, overrides := range mapping {
+		for prefix, lifecycle := range overrides {
+			if !lifecycles[lifecycle] {
+				return errors.NewWithDetails("unknown lifecycle", "provider", provider, "prefix", prefix, "lifecycle", lifecycle)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestLifecycleOverrides_Lifecycle(t *testing.T) {
+	overrides := NewLifecycleOverrides(map[string]string{
+		"Standard_A":     types.LifecycleRetiring,
+		"standard_a1_v2": types.LifecycleGA,
+	})
+
+	tests := []struct {
+		name      string
+		vm        types.VMInfo
+		lifecycle string
+	}{
+		{
+			name:      "matching prefix overrides the published lifecycle",
+			vm:        types.VMInfo{Type: "Standard_A1", Lifecycle: types.LifecycleGA},
+			lifecycle: types.LifecycleRetiring,
+		},
+		{
+			name:      "longest prefix wins case insensitive",
+			vm:        types.VMInfo{Type: "Standard_A1_v2"},
+			lifecycle: types.LifecycleGA,
+		},
+		{
+			name:      "published lifecycle is kept without a matching prefix",
+			vm:        types.VMInfo{Type: "Standard_D2_v3", Lifecycle: types.LifecyclePreviousGeneration},
+			lifecycle: types.LifecyclePreviousGeneration,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.lifecycle, overrides.Lifecycle(test.vm))
+		})
+	}
+}
+
+func TestLoadLifecycleOverrides(t *testing.T) {
+	mapping, err := LoadLifecycleOverrides("")
+	assert.NoError(t, err)
+	assert.Nil(t, mapping)
+
+	dir := t.TempDir()
+
+	valid := filepath.Join(dir, "valid.yaml")
+	assert.NoError(t, ioutil.WriteFile(valid, []byte("azure:\n  Basic_A: retiring\n"), 0600))
+	mapping, err = LoadLifecycleOverrides(valid)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]map[string]string{"azure": {"Basic_A": types.LifecycleRetiring}}, mapping)
+
+	invalid := filepath.Join(dir, "invalid.yaml")
+	assert.NoError(t, ioutil.WriteFile(invalid, []byte("azure:\n  Basic_A: deprecated\n"), 0600))
+	_, err = LoadLifecycleOverrides(invalid)
+	assert.Error(t, err)
+
+	_, err = LoadLifecycleOverrides(filepath.Join(dir, "missing.yaml"))
+	assert.Error(t, err)
+}
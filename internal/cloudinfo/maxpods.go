@@ -0,0 +1,44 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// maxPodsDefaults holds the default maximum number of pods per node of the managed kubernetes services not bound by
+// the network interfaces of the nodes
+var maxPodsDefaults = map[string]map[string]int{
+	// the kubelet default of the standard clusters
+	// source: https://cloud.google.com/kubernetes-engine/docs/how-to/flexible-pod-cidr
+	"google": {"gke": 110},
+	// the default of the Azure CNI Overlay network plugin
+	// source: https://learn.microsoft.com/en-us/azure/aks/azure-cni-overlay
+	"azure": {"aks": 250},
+}
+
+// MaxPods returns the maximum number of pods of a node of a managed kubernetes service, 0 if unknown
+func MaxPods(provider, service string, vm types.VMInfo) int {
+	if provider == "amazon" && service == "eks" {
+		// the amazon VPC CNI assigns the secondary IPs of the network interfaces to the pods, the host network pods
+		// (aws-node, kube-proxy) don't need one
+		if vm.NetworkLimits == nil || vm.NetworkLimits.IPv4PerInterface == 0 {
+			return 0
+		}
+		return vm.NetworkLimits.MaxInterfaces*(vm.NetworkLimits.IPv4PerInterface-1) + 2
+	}
+
+	return maxPodsDefaults[provider][service]
+}
@@ -0,0 +1,72 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestMaxPods(t *testing.T) {
+	tests := []struct {
+		name     string
+		provider string
+		service  string
+		vm       types.VMInfo
+		expected int
+	}{
+		{
+			name:     "eks nodes are bound by their network interfaces",
+			provider: "amazon",
+			service:  "eks",
+			vm:       types.VMInfo{Type: "m5.large", NetworkLimits: &types.NetworkLimits{MaxInterfaces: 3, IPv4PerInterface: 10}},
+			expected: 29,
+		},
+		{
+			name:     "eks nodes with unknown network limits",
+			provider: "amazon",
+			service:  "eks",
+			vm:       types.VMInfo{Type: "m5.large"},
+		},
+		{
+			name:     "gke default",
+			provider: "google",
+			service:  "gke",
+			vm:       types.VMInfo{Type: "n2-standard-4"},
+			expected: 110,
+		},
+		{
+			name:     "aks default",
+			provider: "azure",
+			service:  "aks",
+			vm:       types.VMInfo{Type: "Standard_D4s_v3", NetworkLimits: &types.NetworkLimits{MaxInterfaces: 2, IPv4PerInterface: 256}},
+			expected: 250,
+		},
+		{
+			name:     "not a kubernetes service",
+			provider: "amazon",
+			service:  "compute",
+			vm:       types.VMInfo{Type: "m5.large", NetworkLimits: &types.NetworkLimits{MaxInterfaces: 3, IPv4PerInterface: 10}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, MaxPods(test.provider, test.service, test.vm))
+		})
+	}
+}
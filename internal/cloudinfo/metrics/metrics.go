@@ -167,7 +167,7 @@ func GetPriceGatherers() prometheus.Gatherers {
 
 func GetSpotPriceGatherers() prometheus.Gatherers {
 	spotReg := prometheus.NewRegistry()
-	spotReg.MustRegister(amazonSpotPriceGauge, alibabaSpotPriceGauge)
+	spotReg.MustRegister(amazonSpotPriceGauge, alibabaSpotPriceGauge, tencentSpotPriceGauge)
 	return prometheus.Gatherers{spotReg}
 }
 
@@ -223,6 +223,19 @@ func ReportGoogleSpotPrice(region, zone, instanceType string, price float64) {
 	googleSpotPriceGauge.WithLabelValues(region, zone, instanceType).Set(price)
 }
 
+// SpotPriceGauge collects metrics for the prometheus
+var tencentSpotPriceGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: "cloudinfo",
+	Name:      "tencent_spot_price",
+	Help:      "spot price for each instance type",
+},
+	[]string{"region", "zone", "instanceType"},
+)
+
+func ReportTencentSpotPrice(region, zone, instanceType string, price float64) {
+	tencentSpotPriceGauge.WithLabelValues(region, zone, instanceType).Set(price)
+}
+
 type noOpReporter struct {
 }
 
@@ -0,0 +1,48 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// bandwidthRegexp matches the bandwidth in the network performance descriptions of the providers,
+// eg.: Up to 10 Gigabit, 25 Gigabit, 2x25 Gbps, 300 Mbit/s
+var bandwidthRegexp = regexp.MustCompile(`(?i)(?:(\d+)\s*x\s*)?(\d+(?:\.\d+)?)\s*(gigabit|gbit|gbps|gb/s|megabit|mbit|mbps|mb/s)`)
+
+// NetworkPerfGbps normalizes the network performance description of an instance type to its (maximum) bandwidth in Gbps,
+// the descriptions without a figure (eg.: Low, Moderate, High, N/A) result in 0
+func NetworkPerfGbps(ntwPerf string) float64 {
+	match := bandwidthRegexp.FindStringSubmatch(ntwPerf)
+	if match == nil {
+		return 0
+	}
+
+	bandwidth, err := strconv.ParseFloat(match[2], 64)
+	if err != nil {
+		return 0
+	}
+	if match[1] != "" {
+		multiplier, _ := strconv.ParseFloat(match[1], 64)
+		bandwidth *= multiplier
+	}
+	if strings.HasPrefix(strings.ToLower(match[3]), "m") {
+		bandwidth /= 1000
+	}
+
+	return bandwidth
+}
@@ -0,0 +1,44 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetworkPerfGbps(t *testing.T) {
+	tests := []struct {
+		ntwPerf   string
+		bandwidth float64
+	}{
+		{ntwPerf: "Up to 10 Gigabit", bandwidth: 10},
+		{ntwPerf: "25 Gigabit", bandwidth: 25},
+		{ntwPerf: "Up to 12.5 Gigabit", bandwidth: 12.5},
+		{ntwPerf: "2x25 Gbps", bandwidth: 50},
+		{ntwPerf: "16 Gbit/s", bandwidth: 16},
+		{ntwPerf: "300 Mbit/s", bandwidth: 0.3},
+		{ntwPerf: "Moderate", bandwidth: 0},
+		{ntwPerf: "N/A", bandwidth: 0},
+		{ntwPerf: "", bandwidth: 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.ntwPerf, func(t *testing.T) {
+			assert.InDelta(t, test.bandwidth, NetworkPerfGbps(test.ntwPerf), 1e-9)
+		})
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"encoding/base64"
+	"strconv"
+	"strings"
+
+	"emperror.dev/errors"
+)
+
+// cursorPrefix marks the cursors of the listings, the cursors are opaque for the clients
+const cursorPrefix = "offset:"
+
+// Page is the range of the items of a listing on a page
+type Page struct {
+	// Start the index of the first item of the page
+	Start int
+	// End the index after the last item of the page
+	End int
+	// NextCursor the cursor of the next page, empty on the last page
+	NextCursor string
+}
+
+// Paginate returns the page of a listing of the given size starting at the cursor (the first page if empty) with at
+// most limit items (all of the remaining items if zero)
+func Paginate(size, limit int, cursor string) (Page, error) {
+	if limit < 0 {
+		return Page{}, errors.NewWithDetails("limit must not be negative", "limit", limit)
+	}
+
+	start, err := decodeCursor(cursor)
+	if err != nil {
+		return Page{}, err
+	}
+
+	if start > size {
+		start = size
+	}
+
+	page := Page{Start: start, End: size}
+	if limit > 0 && start+limit < size {
+		page.End = start + limit
+		page.NextCursor = encodeCursor(page.End)
+	}
+
+	return page, nil
+}
+
+// encodeCursor returns the cursor of the page starting at the offset
+func encodeCursor(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(cursorPrefix + strconv.Itoa(offset)))
+}
+
+// decodeCursor returns the offset of the page of the cursor, zero for an empty cursor
+func decodeCursor(cursor string) (int, error) {
+	if cursor == "" {
+		return 0, nil
+	}
+
+	decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil || !strings.HasPrefix(string(decoded), cursorPrefix) {
+		return 0, errors.NewWithDetails("invalid cursor", "cursor", cursor)
+	}
+
+	offset, err := strconv.Atoi(strings.TrimPrefix(string(decoded), cursorPrefix))
+	if err != nil || offset < 0 {
+		return 0, errors.NewWithDetails("invalid cursor", "cursor", cursor)
+	}
+
+	return offset, nil
+}
@@ -0,0 +1,93 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaginate(t *testing.T) {
+	tests := []struct {
+		name   string
+		size   int
+		limit  int
+		cursor string
+		page   Page
+		valid  bool
+	}{
+		{
+			name:  "all the items without limit",
+			size:  5,
+			page:  Page{Start: 0, End: 5},
+			valid: true,
+		},
+		{
+			name:  "first page",
+			size:  5,
+			limit: 2,
+			page:  Page{Start: 0, End: 2, NextCursor: encodeCursor(2)},
+			valid: true,
+		},
+		{
+			name:   "middle page",
+			size:   5,
+			limit:  2,
+			cursor: encodeCursor(2),
+			page:   Page{Start: 2, End: 4, NextCursor: encodeCursor(4)},
+			valid:  true,
+		},
+		{
+			name:   "last page",
+			size:   5,
+			limit:  2,
+			cursor: encodeCursor(4),
+			page:   Page{Start: 4, End: 5},
+			valid:  true,
+		},
+		{
+			name:   "cursor past the end of the shrunk listing",
+			size:   3,
+			limit:  2,
+			cursor: encodeCursor(4),
+			page:   Page{Start: 3, End: 3},
+			valid:  true,
+		},
+		{
+			name:   "invalid cursor",
+			size:   5,
+			limit:  2,
+			cursor: "4",
+		},
+		{
+			name:  "negative limit",
+			size:  5,
+			limit: -1,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			page, err := Paginate(test.size, test.limit, test.cursor)
+			if !test.valid {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.page, page)
+		})
+	}
+}
@@ -0,0 +1,30 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestIsPlacementStrategy(t *testing.T) {
+	assert.True(t, IsPlacementStrategy(types.PlacementCluster))
+	assert.True(t, IsPlacementStrategy(types.PlacementSpread))
+	assert.True(t, IsPlacementStrategy(types.PlacementPartition))
+	assert.False(t, IsPlacementStrategy("compact"))
+}
@@ -0,0 +1,89 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestAppendOnDemandPriceRecord(t *testing.T) {
+	start := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	record := func(hours int, price float64) types.OnDemandPriceRecord {
+		return types.OnDemandPriceRecord{Timestamp: start.Add(time.Duration(hours) * time.Hour), OnDemandPrice: price}
+	}
+
+	tests := []struct {
+		name    string
+		history []types.OnDemandPriceRecord
+		record  types.OnDemandPriceRecord
+		want    []types.OnDemandPriceRecord
+		changed bool
+	}{
+		{
+			name:    "first record",
+			record:  record(0, 0.1),
+			want:    []types.OnDemandPriceRecord{record(0, 0.1)},
+			changed: true,
+		},
+		{
+			name:    "unchanged price",
+			history: []types.OnDemandPriceRecord{record(0, 0.1)},
+			record:  record(1, 0.1),
+			want:    []types.OnDemandPriceRecord{record(0, 0.1)},
+		},
+		{
+			name:    "changed currency",
+			history: []types.OnDemandPriceRecord{record(0, 0.1)},
+			record:  types.OnDemandPriceRecord{Timestamp: start.Add(time.Hour), OnDemandPrice: 0.1, Currency: "EUR"},
+			want: []types.OnDemandPriceRecord{record(0, 0.1),
+				{Timestamp: start.Add(time.Hour), OnDemandPrice: 0.1, Currency: "EUR"}},
+			changed: true,
+		},
+		{
+			name:    "superseded before the retention period",
+			history: []types.OnDemandPriceRecord{record(0, 0.1), record(1, 0.2), record(5, 0.3)},
+			record:  record(12, 0.4),
+			want:    []types.OnDemandPriceRecord{record(1, 0.2), record(5, 0.3), record(12, 0.4)},
+			changed: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			history, changed := appendOnDemandPriceRecord(test.history, test.record, 10*time.Hour)
+
+			assert.Equal(t, test.want, history)
+			assert.Equal(t, test.changed, changed)
+		})
+	}
+}
+
+func TestFilterOnDemandPriceHistory(t *testing.T) {
+	start := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	history := []types.OnDemandPriceRecord{
+		{Timestamp: start, OnDemandPrice: 0.1},
+		{Timestamp: start.Add(2 * time.Hour), OnDemandPrice: 0.2},
+		{Timestamp: start.Add(4 * time.Hour), OnDemandPrice: 0.3},
+	}
+
+	assert.Equal(t, history, filterOnDemandPriceHistory(history, time.Time{}, time.Time{}))
+	assert.Equal(t, history[1:], filterOnDemandPriceHistory(history, start.Add(3*time.Hour), time.Time{}))
+	assert.Equal(t, history[:2], filterOnDemandPriceHistory(history, start.Add(time.Hour), start.Add(3*time.Hour)))
+	assert.Equal(t, []types.OnDemandPriceRecord{}, filterOnDemandPriceHistory(history, time.Time{}, start.Add(-time.Hour)))
+}
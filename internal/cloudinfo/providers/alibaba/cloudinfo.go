@@ -17,6 +17,8 @@ package alibaba
 import (
 	"encoding/json"
 	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
 
 	"emperror.dev/emperror"
@@ -31,6 +33,19 @@ import (
 	"github.com/banzaicloud/cloudinfo/internal/platform/log"
 )
 
+// armFamilyPattern matches the instance families with Arm processors, eg.: ecs.g8y (Yitian 710), ecs.c6r (Ampere Altra)
+var armFamilyPattern = regexp.MustCompile(`^ecs\.[a-z]+\d+[ry]$`)
+
+// fpgaInstanceTypePattern matches the FPGA instance types with the number of FPGAs, eg.: ecs.f3-c16f1.4xlarge
+var fpgaInstanceTypePattern = regexp.MustCompile(`^ecs\.(f\d)-c\d+f(\d+)\.`)
+
+// fpgaFamilies holds the FPGAs of the FPGA instance families
+// source: https://www.alibabacloud.com/help/en/ecs/user-guide/compute-optimized-instance-families-with-fpgas
+var fpgaFamilies = map[string]types.Accelerator{
+	"f1": {Type: types.AcceleratorFPGA, Vendor: "intel", Model: "Arria 10 GX 1150"},
+	"f3": {Type: types.AcceleratorFPGA, Vendor: "xilinx", Model: "Virtex UltraScale+ VU9P"},
+}
+
 // AlibabaInfoer encapsulates the data and operations needed to access external Alibaba resources
 type AlibabaInfoer struct {
 	client CommonDescriber
@@ -56,6 +71,13 @@ func NewAlibabaInfoer(config Config, logger cloudinfo.Logger) (*AlibabaInfoer, e
 	client.GetConfig().WithDebug(true)
 	client.GetConfig().WithMaxRetryTime(10)
 
+	if config.UserAgent != "" {
+		// the sdk appends the user agent in key/value format
+		agent := strings.SplitN(config.UserAgent, "/", 2)
+		agent = append(agent, "")
+		client.AppendUserAgent(agent[0], agent[1])
+	}
+
 	return &AlibabaInfoer{
 		client: client,
 		log:    logger,
@@ -168,17 +190,33 @@ func (a *AlibabaInfoer) GetVirtualMachines(region string) ([]types.VMInfo, error
 					map[string]interface{}{"instanceType": instanceType.InstanceTypeId})
 			}
 
-			vms = append(vms, types.VMInfo{
-				Category:   category,
-				Type:       instanceType.InstanceTypeId,
-				Cpus:       float64(instanceType.CpuCoreCount),
-				Mem:        instanceType.MemorySize,
-				Gpus:       float64(instanceType.GPUAmount),
-				NtwPerf:    ntwPerf,
-				NtwPerfCat: ntwPerfCat,
-				Zones:      zones,
-				Attributes: cloudinfo.Attributes(fmt.Sprint(instanceType.CpuCoreCount), fmt.Sprint(instanceType.MemorySize), ntwPerfCat, category),
-			})
+			// the baseline performance of the burstable (t5, t6) instance types is given for all their vCPUs
+			var baseline float64
+			if instanceType.CpuCoreCount > 0 {
+				baseline = float64(instanceType.BaselineCredit) / float64(instanceType.CpuCoreCount)
+			}
+
+			vm := types.VMInfo{
+				Category:     category,
+				Type:         instanceType.InstanceTypeId,
+				Cpus:         float64(instanceType.CpuCoreCount),
+				Mem:          instanceType.MemorySize,
+				Gpus:         float64(instanceType.GPUAmount),
+				NtwPerf:      ntwPerf,
+				NtwPerfCat:   ntwPerfCat,
+				NtwPerfGbps:  float64(instanceType.InstanceBandwidthRx) / 1024000,
+				Zones:        zones,
+				Burst:        baseline > 0,
+				BaselineCPU:  baseline,
+				CpuCredits:   cloudinfo.CpuCredits(baseline, float64(instanceType.CpuCoreCount)),
+				LocalDisks:   localDisks(instanceType),
+				Architecture: architecture(instanceType.InstanceTypeFamily),
+				BareMetal:    strings.HasPrefix(instanceType.InstanceTypeFamily, "ecs.ebm"),
+				Accelerators: accelerators(instanceType.InstanceTypeId),
+				Attributes:   cloudinfo.Attributes(fmt.Sprint(instanceType.CpuCoreCount), fmt.Sprint(instanceType.MemorySize), ntwPerfCat, category),
+			}
+			cloudinfo.SetGpu(&vm, instanceType.GPUSpec)
+			vms = append(vms, vm)
 		}
 	}
 
@@ -214,6 +252,52 @@ func (a *AlibabaInfoer) GetProducts(vms []types.VMInfo, service, regionId string
 	}
 }
 
+// localDisks returns the local disks of the instance type, eg.: the local_ssd_pro or local_hdd_pro disks
+// of the storage optimized instance families
+func localDisks(instanceType ecs.InstanceType) []types.LocalDisk {
+	if instanceType.LocalStorageAmount == 0 || instanceType.LocalStorageCapacity == 0 {
+		return nil
+	}
+
+	diskType := types.LocalDiskSSD
+	switch category := strings.ToLower(instanceType.LocalStorageCategory); {
+	case strings.Contains(category, "nvme"):
+		diskType = types.LocalDiskNVMe
+	case strings.Contains(category, "hdd"):
+		diskType = types.LocalDiskHDD
+	}
+
+	return []types.LocalDisk{{
+		Count: instanceType.LocalStorageAmount,
+		Size:  float64(instanceType.LocalStorageCapacity),
+		Type:  diskType,
+	}}
+}
+
+// architecture returns the cpu architecture of the instance types of an instance family
+func architecture(instanceFamily string) string {
+	if armFamilyPattern.MatchString(instanceFamily) {
+		return types.ArchitectureARM64
+	}
+
+	return types.ArchitectureAMD64
+}
+
+// accelerators returns the FPGAs of the instance type
+func accelerators(instanceType string) []types.Accelerator {
+	match := fpgaInstanceTypePattern.FindStringSubmatch(instanceType)
+	if match == nil {
+		return nil
+	}
+	accelerator, ok := fpgaFamilies[match[1]]
+	if !ok {
+		return nil
+	}
+	accelerator.Count, _ = strconv.Atoi(match[2])
+
+	return []types.Accelerator{accelerator}
+}
+
 func (a *AlibabaInfoer) getInstanceTypes() ([]ecs.InstanceType, error) {
 	describeInstanceTypes, err := a.client.ProcessCommonRequest(a.describeInstanceTypesRequest())
 	if err != nil {
@@ -276,9 +360,19 @@ func (a *AlibabaInfoer) getOnDemandPrice(vms []types.VMInfo, region string) ([]t
 			Cpus:          vm.Cpus,
 			Mem:           vm.Mem,
 			Gpus:          vm.Gpus,
+			GpuVendor:     vm.GpuVendor,
+			GpuModel:      vm.GpuModel,
+			GpuMem:        vm.GpuMem,
 			NtwPerf:       vm.NtwPerf,
 			NtwPerfCat:    vm.NtwPerfCat,
 			Zones:         vm.Zones,
+			Burst:         vm.Burst,
+			BaselineCPU:   vm.BaselineCPU,
+			CpuCredits:    vm.CpuCredits,
+			LocalDisks:    vm.LocalDisks,
+			Architecture:  vm.Architecture,
+			BareMetal:     vm.BareMetal,
+			Accelerators:  vm.Accelerators,
 			Attributes:    vm.Attributes,
 		})
 	}
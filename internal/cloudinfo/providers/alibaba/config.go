@@ -18,4 +18,6 @@ type Config struct {
 	Region    string
 	AccessKey string
 	SecretKey string
+
+	UserAgent string
 }
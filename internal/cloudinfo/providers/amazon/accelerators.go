@@ -0,0 +1,77 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amazon
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+var (
+	// neuronDevices holds the Neuron devices of the instance types not described by the inference accelerator info
+	// of the EC2 API, with 32 GiB memory per device
+	// source: https://awsdocs-neuron.readthedocs-hosted.com/en/latest/general/arch/neuron-hardware/
+	neuronDevices = map[string]types.Accelerator{
+		"inf2.xlarge":    {Type: types.AcceleratorInferentia, Vendor: "aws", Model: "Inferentia2", Count: 1, Memory: 32},
+		"inf2.8xlarge":   {Type: types.AcceleratorInferentia, Vendor: "aws", Model: "Inferentia2", Count: 1, Memory: 32},
+		"inf2.24xlarge":  {Type: types.AcceleratorInferentia, Vendor: "aws", Model: "Inferentia2", Count: 6, Memory: 192},
+		"inf2.48xlarge":  {Type: types.AcceleratorInferentia, Vendor: "aws", Model: "Inferentia2", Count: 12, Memory: 384},
+		"trn1.2xlarge":   {Type: types.AcceleratorTrainium, Vendor: "aws", Model: "Trainium", Count: 1, Memory: 32},
+		"trn1.32xlarge":  {Type: types.AcceleratorTrainium, Vendor: "aws", Model: "Trainium", Count: 16, Memory: 512},
+		"trn1n.32xlarge": {Type: types.AcceleratorTrainium, Vendor: "aws", Model: "Trainium", Count: 16, Memory: 512},
+	}
+)
+
+// instanceTypeAccelerators returns the inference accelerators and the FPGAs of an instance type
+func instanceTypeAccelerators(info *ec2.InstanceTypeInfo) []types.Accelerator {
+	var accelerators []types.Accelerator
+	if info.InferenceAcceleratorInfo != nil {
+		for _, device := range info.InferenceAcceleratorInfo.Accelerators {
+			acceleratorType := types.AcceleratorInferentia
+			if strings.Contains(strings.ToLower(aws.StringValue(device.Name)), "trainium") {
+				acceleratorType = types.AcceleratorTrainium
+			}
+
+			accelerators = append(accelerators, types.Accelerator{
+				Type:   acceleratorType,
+				Vendor: strings.ToLower(aws.StringValue(device.Manufacturer)),
+				Model:  aws.StringValue(device.Name),
+				Count:  int(aws.Int64Value(device.Count)),
+			})
+		}
+	}
+
+	if info.FpgaInfo != nil {
+		for _, device := range info.FpgaInfo.Fpgas {
+			accelerator := types.Accelerator{
+				Type:   types.AcceleratorFPGA,
+				Vendor: strings.ToLower(aws.StringValue(device.Manufacturer)),
+				Model:  aws.StringValue(device.Name),
+				Count:  int(aws.Int64Value(device.Count)),
+			}
+			if device.MemoryInfo != nil {
+				accelerator.Memory = float64(accelerator.Count) * float64(aws.Int64Value(device.MemoryInfo.SizeInMiB)) / 1024
+			}
+
+			accelerators = append(accelerators, accelerator)
+		}
+	}
+
+	return accelerators
+}
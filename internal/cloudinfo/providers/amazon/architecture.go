@@ -0,0 +1,39 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amazon
+
+import (
+	"strings"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+var (
+	// armProcessors holds the (lowercase) tokens of the physical processor descriptions of the arm64 instance types,
+	// eg.: AWS Graviton2 Processor, Apple M1 chip with 8 core CPU
+	armProcessors = []string{"graviton", "apple"}
+)
+
+// architecture returns the cpu architecture of an instance type from the description of its physical processor
+func architecture(physicalProcessor string) string {
+	processor := strings.ToLower(physicalProcessor)
+	for _, token := range armProcessors {
+		if strings.Contains(processor, token) {
+			return types.ArchitectureARM64
+		}
+	}
+
+	return types.ArchitectureAMD64
+}
@@ -0,0 +1,43 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amazon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestArchitecture(t *testing.T) {
+	tests := []struct {
+		physicalProcessor string
+		architecture      string
+	}{
+		{physicalProcessor: "AWS Graviton Processor", architecture: types.ArchitectureARM64},
+		{physicalProcessor: "AWS Graviton3 Processor", architecture: types.ArchitectureARM64},
+		{physicalProcessor: "Apple M1 chip with 8 core CPU", architecture: types.ArchitectureARM64},
+		{physicalProcessor: "Intel Xeon Platinum 8175", architecture: types.ArchitectureAMD64},
+		{physicalProcessor: "AMD EPYC 7R13 Processor", architecture: types.ArchitectureAMD64},
+		{physicalProcessor: "", architecture: types.ArchitectureAMD64},
+	}
+
+	for _, test := range tests {
+		t.Run(test.physicalProcessor, func(t *testing.T) {
+			assert.Equal(t, test.architecture, architecture(test.physicalProcessor))
+		})
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amazon
+
+import (
+	"strings"
+	"unicode"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+var (
+	// burstBaselines holds the baseline performance of the burstable instance types in percent of a vCPU
+	// source: https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/burstable-credits-baseline-concepts.html
+	burstBaselines = map[string]float64{
+		"t1.micro":    10,
+		"t2.nano":     5,
+		"t2.micro":    10,
+		"t2.small":    20,
+		"t2.medium":   20,
+		"t2.large":    30,
+		"t2.xlarge":   22.5,
+		"t2.2xlarge":  17,
+		"t3.nano":     5,
+		"t3.micro":    10,
+		"t3.small":    20,
+		"t3.medium":   20,
+		"t3.large":    30,
+		"t3.xlarge":   40,
+		"t3.2xlarge":  40,
+		"t3a.nano":    5,
+		"t3a.micro":   10,
+		"t3a.small":   20,
+		"t3a.medium":  20,
+		"t3a.large":   30,
+		"t3a.xlarge":  40,
+		"t3a.2xlarge": 40,
+		"t4g.nano":    5,
+		"t4g.micro":   10,
+		"t4g.small":   20,
+		"t4g.medium":  20,
+		"t4g.large":   30,
+		"t4g.xlarge":  40,
+		"t4g.2xlarge": 40,
+	}
+)
+
+// isBurst decides whether the instance type belongs to a burstable (T) family, eg.: t2, t3a, t4g
+// other families starting with "t" (eg.: trn1) are not burstable
+func isBurst(instanceType string) bool {
+	it := strings.ToLower(instanceType)
+	return len(it) > 1 && it[0] == 't' && unicode.IsDigit(rune(it[1]))
+}
+
+// burstBaseline returns the baseline cpu performance of a burstable instance type, 0 if unknown
+func burstBaseline(instanceType string) float64 {
+	return burstBaselines[strings.ToLower(instanceType)]
+}
+
+// addCpuCreditSurcharges sets the price of the surplus cpu credits spent in unlimited mode on the burstable instance
+// types, the surcharges are the same for all the instance types of a family (on Linux)
+func (e *Ec2Infoer) addCpuCreditSurcharges(vms []types.VMInfo, region string) error {
+	priceList, err := e.pricingSvc.GetPriceList(e.newGetFamilyProductsInput(region, "AmazonEC2", "CPU Credits"))
+	if err != nil {
+		return errors.WrapIf(err, "failed to retrieve cpu credit prices")
+	}
+
+	surcharges := make(map[string]float64)
+	for _, item := range priceList {
+		pd, err := newPriceData(item)
+		if err != nil {
+			continue
+		}
+
+		if os, err := pd.getDataForKey("operatingSystem"); err == nil && os != "Linux" {
+			continue
+		}
+
+		usageType, price, ok := usageTypePrice(pd, e.currency)
+		if !ok {
+			continue
+		}
+
+		// eg.: USE1-CPUCredits:t3
+		if i := strings.Index(usageType, "CPUCredits:"); i >= 0 {
+			surcharges[usageType[i+len("CPUCredits:"):]] = price
+		}
+	}
+
+	for i := range vms {
+		if vms[i].CpuCredits == nil {
+			continue
+		}
+		if surcharge, ok := surcharges[strings.Split(vms[i].Type, ".")[0]]; ok {
+			vms[i].CpuCredits.UnlimitedSurcharge = surcharge
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amazon
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/stretchr/testify/assert"
+	"logur.dev/logur"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/cloudinfoadapter"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestIsBurst(t *testing.T) {
+	tests := []struct {
+		name         string
+		instanceType string
+		burst        bool
+	}{
+		{
+			name:         "t3 instance types are burstable",
+			instanceType: "t3.micro",
+			burst:        true,
+		},
+		{
+			name:         "t4g instance types are burstable",
+			instanceType: "t4g.2xlarge",
+			burst:        true,
+		},
+		{
+			name:         "m5 instance types are not burstable",
+			instanceType: "m5.large",
+			burst:        false,
+		},
+		{
+			name:         "trn1 instance types are not burstable",
+			instanceType: "trn1.32xlarge",
+			burst:        false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.burst, isBurst(test.instanceType))
+		})
+	}
+}
+
+func cpuCreditPriceItem(usageType, operatingSystem, price string) aws.JSONValue {
+	return aws.JSONValue{
+		"product": map[string]interface{}{
+			"attributes": map[string]interface{}{
+				"usagetype":       usageType,
+				"operatingSystem": operatingSystem,
+			}},
+		"terms": map[string]interface{}{
+			"OnDemand": map[string]interface{}{
+				"term": map[string]interface{}{
+					"priceDimensions": map[string]interface{}{
+						"dimension": map[string]interface{}{
+							"unit":         "vCPU-Hours",
+							"pricePerUnit": map[string]interface{}{"USD": price},
+						}}}}},
+	}
+}
+
+func TestEc2Infoer_addCpuCreditSurcharges(t *testing.T) {
+	infoer := &Ec2Infoer{
+		pricingSvc: &dummyStoragePricing{priceLists: map[string][]aws.JSONValue{
+			"CPU Credits": {
+				cpuCreditPriceItem("EU-CPUCredits:t3", "Linux", "0.05"),
+				cpuCreditPriceItem("EU-CPUCredits:t3", "Windows", "0.096"),
+				cpuCreditPriceItem("EU-CPUCredits:t4g", "Linux", "0.04"),
+			},
+		}},
+		partition: endpoints.AwsPartition(),
+		log:       cloudinfoadapter.NewLogger(&logur.TestLogger{}),
+	}
+	vms := []types.VMInfo{
+		{Type: "t3.micro", CpuCredits: cloudinfo.CpuCredits(10, 2)},
+		{Type: "t4g.large", CpuCredits: cloudinfo.CpuCredits(30, 2)},
+		{Type: "t2.micro", CpuCredits: cloudinfo.CpuCredits(10, 1)},
+		{Type: "m5.large"},
+	}
+
+	assert.NoError(t, infoer.addCpuCreditSurcharges(vms, "eu-west-1"))
+	assert.Equal(t, &types.CpuCredits{EarnedPerHour: 12, MaxAccrued: 288, UnlimitedSurcharge: 0.05}, vms[0].CpuCredits)
+	assert.Equal(t, &types.CpuCredits{EarnedPerHour: 36, MaxAccrued: 864, UnlimitedSurcharge: 0.04}, vms[1].CpuCredits)
+	assert.Equal(t, &types.CpuCredits{EarnedPerHour: 6, MaxAccrued: 144}, vms[2].CpuCredits)
+	assert.Nil(t, vms[3].CpuCredits)
+}
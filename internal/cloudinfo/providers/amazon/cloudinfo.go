@@ -24,6 +24,7 @@ import (
 	"emperror.dev/errors"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/eks"
@@ -46,11 +47,19 @@ const (
 // Ec2Infoer encapsulates the data and operations needed to access external resources
 type Ec2Infoer struct {
 	pricingSvc   PricingSource
+	savingsPlans SavingsPlansSource
 	prometheus   v1.API
 	promQuery    string
 	ec2Describer func(region string) Ec2Describer
 	partition    endpoints.Partition
+	rawPayloads  bool
 	log          cloudinfo.Logger
+
+	// currency is the currency of the prices of the partition, empty for USD
+	currency string
+
+	// partitions are the infoers of the other partitions scraped
+	partitions []*Ec2Infoer
 }
 
 // Ec2Describer interface for operations describing EC2 artifacts. (a subset of the Ec2 cli operations used by this app)
@@ -58,6 +67,8 @@ type Ec2Describer interface {
 	DescribeAvailabilityZones(input *ec2.DescribeAvailabilityZonesInput) (*ec2.DescribeAvailabilityZonesOutput, error)
 	DescribeImages(*ec2.DescribeImagesInput) (*ec2.DescribeImagesOutput, error)
 	DescribeSpotPriceHistoryPages(input *ec2.DescribeSpotPriceHistoryInput, fn func(*ec2.DescribeSpotPriceHistoryOutput, bool) bool) error
+	DescribeInstanceTypeOfferingsPages(input *ec2.DescribeInstanceTypeOfferingsInput, fn func(*ec2.DescribeInstanceTypeOfferingsOutput, bool) bool) error
+	DescribeInstanceTypesPages(input *ec2.DescribeInstanceTypesInput, fn func(*ec2.DescribeInstanceTypesOutput, bool) bool) error
 }
 
 // NewAmazonInfoer builds an infoer instance based on the provided configuration
@@ -82,6 +93,11 @@ func NewAmazonInfoer(config Config, logger cloudinfo.Logger) (*Ec2Infoer, error)
 		return nil, errors.Wrap(err, "creating ec2 aws session")
 	}
 
+	if config.UserAgent != "" {
+		psess.Handlers.Build.PushBack(request.MakeAddToUserAgentFreeFormHandler(config.UserAgent))
+		esess.Handlers.Build.PushBack(request.MakeAddToUserAgentFreeFormHandler(config.UserAgent))
+	}
+
 	partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), config.Region)
 	if !ok {
 		return nil, errors.NewWithDetails("find aws partition: could not find partition for region", "region", config.Region)
@@ -103,16 +119,35 @@ func NewAmazonInfoer(config Config, logger cloudinfo.Logger) (*Ec2Infoer, error)
 		}
 	}
 
-	return &Ec2Infoer{
-		pricingSvc: NewPricingSource(psess),
-		prometheus: promApi,
-		promQuery:  config.PrometheusQuery,
+	var savingsPlans SavingsPlansSource
+	if config.SavingsPlans {
+		// the savings plans api is only available in the us-east-1 region
+		savingsPlans = NewSavingsPlansSource(psess, aws.NewConfig().WithRegion(savingsPlansRegion))
+	}
+
+	infoer := &Ec2Infoer{
+		pricingSvc:   NewPricingSource(psess),
+		savingsPlans: savingsPlans,
+		prometheus:   promApi,
+		promQuery:    config.PrometheusQuery,
 		ec2Describer: func(region string) Ec2Describer {
 			return ec2.New(esess, aws.NewConfig().WithRegion(region))
 		},
-		partition: partition,
-		log:       logger,
-	}, nil
+		partition:   partition,
+		rawPayloads: config.RawPayloads,
+		log:         logger,
+	}
+
+	for id, partitionConfig := range config.Partitions {
+		partitionInfoer, err := newPartitionInfoer(id, partitionConfig, infoer, config.UserAgent)
+		if err != nil {
+			return nil, err
+		}
+
+		infoer.partitions = append(infoer.partitions, partitionInfoer)
+	}
+
+	return infoer, nil
 }
 
 // Initialize is not needed on EC2 because price info is changing frequently
@@ -121,6 +156,10 @@ func (e *Ec2Infoer) Initialize() (map[string]map[string]types.Price, error) {
 }
 
 func (e *Ec2Infoer) GetVirtualMachines(region string) ([]types.VMInfo, error) {
+	if p := e.partitionInfoer(region); p != nil {
+		return p.GetVirtualMachines(region)
+	}
+
 	logger := log.WithFields(e.log, map[string]interface{}{"region": region})
 	logger.Debug("getting available instance types from AWS API")
 
@@ -164,7 +203,7 @@ func (e *Ec2Infoer) GetVirtualMachines(region string) ([]types.VMInfo, error) {
 		if err != nil {
 			missingGpu = append(missingGpu, instanceType)
 		}
-		odPriceStr, err := pd.getOnDemandPrice()
+		odPriceStr, err := pd.getOnDemandPrice(e.currency)
 		if err != nil {
 			missingAttributes[instanceType] = append(missingAttributes[instanceType], "onDemandPrice")
 		}
@@ -173,10 +212,15 @@ func (e *Ec2Infoer) GetVirtualMachines(region string) ([]types.VMInfo, error) {
 			missingAttributes[instanceType] = append(missingAttributes[instanceType], "networkPerformance")
 		}
 
-		currGen := true
+		// the storage attribute is optional, the instance types without it have no instance store
+		storage, _ := pd.getDataForKey("storage")
+		// the instance types without a physical processor description are considered amd64
+		physicalProcessor, _ := pd.getDataForKey("physicalProcessor")
+
+		currGen, lifecycle := true, types.LifecycleGA
 		if currentGenStr, err := pd.getDataForKey("currentGeneration"); err == nil {
 			if strings.ToLower(currentGenStr) == "no" {
-				currGen = false
+				currGen, lifecycle = false, types.LifecyclePreviousGeneration
 			}
 		}
 
@@ -192,19 +236,59 @@ func (e *Ec2Infoer) GetVirtualMachines(region string) ([]types.VMInfo, error) {
 		mem, _ := strconv.ParseFloat(strings.Split(memStr, " ")[0], 64)
 		gpus, _ := strconv.ParseFloat(gpu, 64)
 		vm := types.VMInfo{
-			Category:      instanceFamily,
-			Type:          instanceType,
-			OnDemandPrice: onDemandPrice,
-			Cpus:          cpus,
-			Mem:           mem,
-			Gpus:          gpus,
-			NtwPerf:       ntwPerf,
-			NtwPerfCat:    ntwPerfCat,
-			CurrentGen:    currGen,
-			Attributes:    cloudinfo.Attributes(cpusStr, strings.Split(memStr, " ")[0], ntwPerfCat, instanceFamily),
+			Category:            instanceFamily,
+			Type:                instanceType,
+			OnDemandPrice:       onDemandPrice,
+			Cpus:                cpus,
+			Mem:                 mem,
+			Gpus:                gpus,
+			NtwPerf:             ntwPerf,
+			NtwPerfCat:          ntwPerfCat,
+			NtwPerfGbps:         cloudinfo.NetworkPerfGbps(ntwPerf),
+			CurrentGen:          currGen,
+			Burst:               isBurst(instanceType),
+			BaselineCPU:         burstBaseline(instanceType),
+			CpuCredits:          cloudinfo.CpuCredits(burstBaseline(instanceType), cpus),
+			PlacementGroup:      placementGroup(instanceType, currGen),
+			PlacementStrategies: placementStrategies(instanceType, currGen),
+			Currency:            e.currency,
+			Partition:           e.partition.ID(),
+			ReservedPrices:      pd.getReservedPrices(e.currency),
+			LocalDisks:          localDisks(storage),
+			Lifecycle:           lifecycle,
+			Architecture:        architecture(physicalProcessor),
+			BareMetal:           strings.Contains(instanceType, ".metal"),
+			Attributes:          cloudinfo.Attributes(cpusStr, strings.Split(memStr, " ")[0], ntwPerfCat, instanceFamily),
+		}
+		cloudinfo.SetGpu(&vm, gpuDescription(instanceType))
+		if e.rawPayloads {
+			vm.RawPayload = cloudinfo.RawPayload(price)
 		}
 		vms = append(vms, vm)
 	}
+	if e.savingsPlans != nil {
+		if err := e.addSavingsPlansRates(vms, region); err != nil {
+			// savings plans rates are optional, don't break the flow
+			logger.Warn("failed to retrieve savings plans rates", map[string]interface{}{"error": err.Error()})
+		}
+	}
+	if err := e.addOsPrices(vms, region); err != nil {
+		// the prices of the commercial operating systems are optional, don't break the flow
+		logger.Warn("failed to retrieve operating system prices", map[string]interface{}{"error": err.Error()})
+	}
+	if err := e.addCpuCreditSurcharges(vms, region); err != nil {
+		// the unlimited mode surcharges are optional, don't break the flow
+		logger.Warn("failed to retrieve cpu credit prices", map[string]interface{}{"error": err.Error()})
+	}
+	if err := e.addZoneOfferings(vms, region); err != nil {
+		// the instance types are offered in all the zones of the region as a fallback, don't break the flow
+		logger.Warn("failed to retrieve instance type offerings", map[string]interface{}{"error": err.Error()})
+	}
+	if err := e.addInstanceTypeDetails(vms, region); err != nil {
+		// the accelerators and the network limits are optional, don't break the flow
+		logger.Warn("failed to retrieve instance type details", map[string]interface{}{"error": err.Error()})
+	}
+
 	logger.Debug("instance types with missing attributes", map[string]interface{}{"missingAttrs": missingAttributes})
 	logger.Debug("instance types with missing gpu", map[string]interface{}{"missingGPU": missingGpu})
 
@@ -274,33 +358,74 @@ func (pd *priceData) getDataForKey(attr string) (string, error) {
 	return "", errors.Errorf("could not get %s or could not cast %s to string", attr, attr)
 }
 
-func (pd *priceData) getOnDemandPrice() (string, error) {
-	termsMap, err := getMapForKey("terms", pd.awsData)
+// getOnDemandPrice returns the on demand price in the currency, empty for USD
+func (pd *priceData) getOnDemandPrice(currency string) (string, error) {
+	if currency == "" {
+		currency = "USD"
+	}
+
+	dimension, err := pd.getOnDemandDimension()
+	if err != nil || dimension == nil {
+		return "", err
+	}
+
+	pricePerUnitMap, err := getMapForKey("pricePerUnit", dimension)
 	if err != nil {
 		return "", err
 	}
+	odPrice, ok := pricePerUnitMap[currency].(string)
+	if !ok {
+		return "", errors.New("could not get on demand price or could not cast on demand price to string")
+	}
+	return odPrice, nil
+}
+
+// getOnDemandUnit returns the unit the on demand price is given for, eg.: Hrs, GB-Mo
+func (pd *priceData) getOnDemandUnit() (string, error) {
+	dimension, err := pd.getOnDemandDimension()
+	if err != nil || dimension == nil {
+		return "", err
+	}
+
+	unit, ok := dimension["unit"].(string)
+	if !ok {
+		return "", errors.New("could not get on demand price unit or could not cast on demand price unit to string")
+	}
+	return unit, nil
+}
+
+// getOnDemandDimension returns the (first) price dimension of the on demand terms, nil if there are no on demand terms
+func (pd *priceData) getOnDemandDimension() (map[string]interface{}, error) {
+	dimensions, err := pd.getOnDemandDimensions()
+	if err != nil || len(dimensions) == 0 {
+		return nil, err
+	}
+
+	return dimensions[0], nil
+}
+
+// getOnDemandDimensions returns the price dimensions (eg.: the pricing tiers) of the (first) on demand term
+func (pd *priceData) getOnDemandDimensions() ([]map[string]interface{}, error) {
+	termsMap, err := getMapForKey("terms", pd.awsData)
+	if err != nil {
+		return nil, err
+	}
 	onDemandMap, err := getMapForKey("OnDemand", termsMap)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	for _, term := range onDemandMap {
 		priceDimensionsMap, err := getMapForKey("priceDimensions", term.(map[string]interface{}))
 		if err != nil {
-			return "", err
+			return nil, err
 		}
+		dimensions := make([]map[string]interface{}, 0, len(priceDimensionsMap))
 		for _, dimension := range priceDimensionsMap {
-			pricePerUnitMap, err := getMapForKey("pricePerUnit", dimension.(map[string]interface{}))
-			if err != nil {
-				return "", err
-			}
-			odPrice, ok := pricePerUnitMap["USD"].(string)
-			if !ok {
-				return "", errors.New("could not get on demand price or could not cast on demand price to string")
-			}
-			return odPrice, nil
+			dimensions = append(dimensions, dimension.(map[string]interface{}))
 		}
+		return dimensions, nil
 	}
-	return "", nil
+	return nil, nil
 }
 
 func getMapForKey(key string, srcMap map[string]interface{}) (map[string]interface{}, error) {
@@ -328,22 +453,21 @@ func (e *Ec2Infoer) GetRegion(id string) *endpoints.Region {
 
 // newAttributeValuesInput assembles a GetProductsInput instance for querying the provider
 func (e *Ec2Infoer) newGetProductsInput(regionId string) *pricing.GetProductsInput {
-	location := e.GetRegion(regionId).Description()
+	return e.newGetOsProductsInput(regionId, "Linux")
+}
 
-	// This is a temporary fix for the pricing API still using "EU" in the location instead of "Europe"
-	// Reported in: https://github.com/banzaicloud/cloudinfo/issues/365
-	// If the pricing API continues using "EU", we should probably find a better place for this.
-	if strings.HasPrefix(location, "Europe") {
-		location = fmt.Sprintf("EU %s", location[7:])
-	}
+// newGetOsProductsInput assembles a GetProductsInput instance for querying the instance prices of an operating system,
+// the licenses of the commercial operating systems are included (no BYOL prices)
+func (e *Ec2Infoer) newGetOsProductsInput(regionId, operatingSystem string) *pricing.GetProductsInput {
+	location := e.pricingLocation(regionId)
 
-	return &pricing.GetProductsInput{
+	input := &pricing.GetProductsInput{
 		ServiceCode: aws.String("AmazonEC2"),
 		Filters: []*pricing.Filter{
 			{
 				Type:  aws.String(pricing.FilterTypeTermMatch),
 				Field: aws.String("operatingSystem"),
-				Value: aws.String("Linux"),
+				Value: aws.String(operatingSystem),
 			},
 			{
 				Type:  aws.String(pricing.FilterTypeTermMatch),
@@ -367,11 +491,55 @@ func (e *Ec2Infoer) newGetProductsInput(regionId string) *pricing.GetProductsInp
 			},
 		},
 	}
+	if operatingSystem != "Linux" {
+		input.Filters = append(input.Filters, &pricing.Filter{
+			Type:  aws.String(pricing.FilterTypeTermMatch),
+			Field: aws.String("licenseModel"),
+			Value: aws.String("No License required"),
+		})
+	}
+
+	return input
+}
+
+// pricingLocation returns the location of the region the pricing API knows the region by
+func (e *Ec2Infoer) pricingLocation(regionId string) string {
+	location := e.GetRegion(regionId).Description()
+
+	// This is a temporary fix for the pricing API still using "EU" in the location instead of "Europe"
+	// Reported in: https://github.com/banzaicloud/cloudinfo/issues/365
+	// If the pricing API continues using "EU", we should probably find a better place for this.
+	if strings.HasPrefix(location, "Europe") {
+		location = fmt.Sprintf("EU %s", location[7:])
+	}
+
+	return location
 }
 
 // GetRegions returns a map with available regions
 // transforms the api representation into a "plain" map
 func (e *Ec2Infoer) GetRegions(service string) (map[string]string, error) {
+	regions, err := e.getRegions(service)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range e.partitions {
+		partitionRegions, err := p.getRegions(service)
+		if err != nil {
+			return nil, errors.WrapIfWithDetails(err, "failed to get regions of partition", "partition", p.partition.ID())
+		}
+
+		for id, description := range partitionRegions {
+			regions[id] = description
+		}
+	}
+
+	return regions, nil
+}
+
+// getRegions returns the available regions of the partition
+func (e *Ec2Infoer) getRegions(service string) (map[string]string, error) {
 	logger := log.WithFields(e.log, map[string]interface{}{"service": service})
 	logger.Debug("getting regions")
 
@@ -431,6 +599,10 @@ func (e *Ec2Infoer) GetRegions(service string) (map[string]string, error) {
 
 // GetZones returns the availability zones in a region
 func (e *Ec2Infoer) GetZones(region string) ([]string, error) {
+	if p := e.partitionInfoer(region); p != nil {
+		return p.GetZones(region)
+	}
+
 	logger := log.WithFields(e.log, map[string]interface{}{"region": region})
 	logger.Debug("getting zones")
 
@@ -512,6 +684,10 @@ func (e *Ec2Infoer) getCurrentSpotPrices(region string) (map[string]types.SpotPr
 
 // GetCurrentPrices returns the current spot prices of every instance type in every availability zone in a given region
 func (e *Ec2Infoer) GetCurrentPrices(region string) (map[string]types.Price, error) {
+	if p := e.partitionInfoer(region); p != nil {
+		return p.GetCurrentPrices(region)
+	}
+
 	logger := log.WithFields(e.log, map[string]interface{}{"region": region})
 	var spotPrices map[string]types.SpotPriceInfo
 	var err error
@@ -551,6 +727,10 @@ func (e *Ec2Infoer) HasImages() bool {
 
 // GetServiceImages retrieves the images supported by the given service in the given region
 func (e *Ec2Infoer) GetServiceImages(service, region string) ([]types.Image, error) {
+	if p := e.partitionInfoer(region); p != nil {
+		return p.GetServiceImages(service, region)
+	}
+
 	serviceImages := make([]types.Image, 0)
 	switch service {
 	case svcEks:
@@ -112,11 +112,13 @@ func (dps *testStruct) DescribeAvailabilityZones(input *ec2.DescribeAvailability
 				State:      aws.String(ec2.AvailabilityZoneStateAvailable),
 				RegionName: aws.String("eu-central-1"),
 				ZoneName:   aws.String("eu-central-1a"),
+				ZoneId:     aws.String("euc1-az2"),
 			},
 			{
 				State:      aws.String("available"),
 				RegionName: aws.String("eu-central-1"),
 				ZoneName:   aws.String("eu-central-1b"),
+				ZoneId:     aws.String("euc1-az3"),
 			},
 		},
 	}, nil
@@ -133,6 +135,62 @@ func (dps *testStruct) DescribeSpotPriceHistoryPages(input *ec2.DescribeSpotPric
 	return nil
 }
 
+func (dps *testStruct) DescribeInstanceTypeOfferingsPages(input *ec2.DescribeInstanceTypeOfferingsInput, fn func(*ec2.DescribeInstanceTypeOfferingsOutput, bool) bool) error {
+	if dps.TcId == 12 {
+		return errors.New("could not get instance type offerings")
+	}
+	fn(&ec2.DescribeInstanceTypeOfferingsOutput{
+		InstanceTypeOfferings: []*ec2.InstanceTypeOffering{
+			{InstanceType: aws.String("m5.large"), Location: aws.String("eu-central-1a")},
+			{InstanceType: aws.String("c5.large"), Location: aws.String("eu-central-1a")},
+		},
+	}, false)
+	fn(&ec2.DescribeInstanceTypeOfferingsOutput{
+		InstanceTypeOfferings: []*ec2.InstanceTypeOffering{
+			{InstanceType: aws.String("m5.large"), Location: aws.String("eu-central-1b")},
+		},
+	}, true)
+	return nil
+}
+
+func (dps *testStruct) DescribeInstanceTypesPages(input *ec2.DescribeInstanceTypesInput, fn func(*ec2.DescribeInstanceTypesOutput, bool) bool) error {
+	if dps.TcId == 13 {
+		return errors.New("could not describe instance types")
+	}
+	fn(&ec2.DescribeInstanceTypesOutput{
+		InstanceTypes: []*ec2.InstanceTypeInfo{
+			{
+				InstanceType: aws.String("m5.large"),
+				NetworkInfo: &ec2.NetworkInfo{
+					MaximumNetworkInterfaces:  aws.Int64(3),
+					Ipv4AddressesPerInterface: aws.Int64(10),
+					Ipv6AddressesPerInterface: aws.Int64(10),
+					EnaSupport:                aws.String(ec2.EnaSupportRequired),
+					Ipv6Supported:             aws.Bool(true),
+				},
+			},
+			{
+				InstanceType: aws.String("inf1.xlarge"),
+				InferenceAcceleratorInfo: &ec2.InferenceAcceleratorInfo{Accelerators: []*ec2.InferenceDeviceInfo{
+					{Count: aws.Int64(1), Manufacturer: aws.String("AWS"), Name: aws.String("Inferentia")},
+				}},
+			},
+		},
+	}, false)
+	fn(&ec2.DescribeInstanceTypesOutput{
+		InstanceTypes: []*ec2.InstanceTypeInfo{
+			{
+				InstanceType: aws.String("f1.2xlarge"),
+				FpgaInfo: &ec2.FpgaInfo{Fpgas: []*ec2.FpgaDeviceInfo{
+					{Count: aws.Int64(1), Manufacturer: aws.String("Xilinx"), Name: aws.String("Virtex UltraScale (VU9P)"),
+						MemoryInfo: &ec2.FpgaDeviceMemoryInfo{SizeInMiB: aws.Int64(65536)}},
+				}},
+			},
+		},
+	}, true)
+	return nil
+}
+
 func TestNewEc2Infoer(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -614,7 +672,7 @@ func TestPriceData_GetOnDemandPrice(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			test.check(test.price.getOnDemandPrice())
+			test.check(test.price.getOnDemandPrice(""))
 		})
 	}
 }
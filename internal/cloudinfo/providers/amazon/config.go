@@ -29,11 +29,39 @@ type Config struct {
 
 	Pricing PricingConfig
 
+	// UserAgent is appended to the user agent of the API requests
+	UserAgent string
+
+	// SavingsPlans enables scraping the savings plans rates (requires the savingsplans:DescribeSavingsPlansOfferingRates permission)
+	SavingsPlans bool
+
+	// RawPayloads enables retaining the raw provider responses of the products, set from the scrape configuration
+	RawPayloads bool `mapstructure:"-"`
+
+	// Partitions configures the partitions scraped besides the partition of the region, keyed by partition id (aws-us-gov or aws-cn)
+	Partitions map[string]PartitionConfig
+
 	// Prometheus settings
 	PrometheusAddress string
 	PrometheusQuery   string
 }
 
+// PartitionConfig represents configuration for obtaining cloud information from an other Amazon partition.
+type PartitionConfig struct {
+	Credentials `mapstructure:",squash"`
+
+	// Region is a region of the partition, the EC2 API is called in it to describe the partition
+	Region string
+
+	// Pricing configures the pricing API of the partition, GovCloud falls back to the commercial pricing API
+	Pricing PricingConfig
+}
+
+// GetPricingCredentials returns the pricing credentials of the partition, falling back to its primary credentials
+func (c PartitionConfig) GetPricingCredentials() Credentials {
+	return Config{Credentials: c.Credentials, Pricing: c.Pricing}.GetPricingCredentials()
+}
+
 // PricingConfig represents configuration for obtaining pricing information from Amazon.
 type PricingConfig struct {
 	Region string
@@ -0,0 +1,133 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amazon
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+	"github.com/banzaicloud/cloudinfo/internal/platform/log"
+)
+
+// rdsEngines maps the RDS database engines to the engine names of the API,
+// the commercial engines are left out as they are priced per edition and license model
+var rdsEngines = map[string]string{
+	"MySQL":             types.DatabaseMySQL,
+	"PostgreSQL":        types.DatabasePostgreSQL,
+	"MariaDB":           types.DatabaseMariaDB,
+	"Aurora MySQL":      "aurora-mysql",
+	"Aurora PostgreSQL": "aurora-postgresql",
+}
+
+// rdsDeployments maps the RDS deployment options to the database deployments of the API
+var rdsDeployments = map[string]string{
+	"Single-AZ": types.DatabaseSingleZone,
+	"Multi-AZ":  types.DatabaseHighAvailability,
+}
+
+// GetDatabases retrieves the RDS instance classes and their on demand prices in a region
+func (e *Ec2Infoer) GetDatabases(region string) ([]types.DatabaseInfo, error) {
+	if p := e.partitionInfoer(region); p != nil {
+		return p.GetDatabases(region)
+	}
+
+	logger := log.WithFields(e.log, map[string]interface{}{"region": region})
+	logger.Debug("getting RDS instance classes from AWS API")
+
+	priceList, err := e.pricingSvc.GetPriceList(e.newGetFamilyProductsInput(region, "AmazonRDS", "Database Instance"))
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to retrieve RDS instance prices")
+	}
+
+	databases := make([]types.DatabaseInfo, 0)
+	for _, item := range priceList {
+		pd, err := newPriceData(item)
+		if err != nil {
+			continue
+		}
+
+		database, ok := rdsDatabase(pd, e.currency)
+		if !ok {
+			continue
+		}
+		databases = append(databases, database)
+	}
+
+	sort.Slice(databases, func(i, j int) bool {
+		if databases[i].Engine != databases[j].Engine {
+			return databases[i].Engine < databases[j].Engine
+		}
+		if databases[i].InstanceClass != databases[j].InstanceClass {
+			return databases[i].InstanceClass < databases[j].InstanceClass
+		}
+		return databases[i].Deployment > databases[j].Deployment
+	})
+
+	logger.Debug("found RDS instance classes", map[string]interface{}{"numberOfDatabases": len(databases)})
+	return databases, nil
+}
+
+// rdsDatabase assembles the database information of an RDS price list item, the items of the unsupported engines
+// and deployment options are skipped
+func rdsDatabase(pd *priceData, currency string) (types.DatabaseInfo, bool) {
+	instanceClass, err := pd.getDataForKey("instanceType")
+	if err != nil {
+		return types.DatabaseInfo{}, false
+	}
+	engineStr, err := pd.getDataForKey("databaseEngine")
+	if err != nil {
+		return types.DatabaseInfo{}, false
+	}
+	engine, ok := rdsEngines[engineStr]
+	if !ok {
+		return types.DatabaseInfo{}, false
+	}
+	deploymentStr, err := pd.getDataForKey("deploymentOption")
+	if err != nil {
+		return types.DatabaseInfo{}, false
+	}
+	deployment, ok := rdsDeployments[deploymentStr]
+	if !ok {
+		return types.DatabaseInfo{}, false
+	}
+
+	priceStr, err := pd.getOnDemandPrice(currency)
+	if err != nil {
+		return types.DatabaseInfo{}, false
+	}
+	price, err := strconv.ParseFloat(priceStr, 64)
+	if err != nil || price == 0 {
+		return types.DatabaseInfo{}, false
+	}
+
+	cpusStr, _ := pd.getDataForKey("vcpu")
+	memStr, _ := pd.getDataForKey(types.Memory)
+	cpus, _ := strconv.ParseFloat(cpusStr, 64)
+	mem, _ := strconv.ParseFloat(strings.ReplaceAll(strings.Split(memStr, " ")[0], ",", ""), 64)
+
+	return types.DatabaseInfo{
+		InstanceClass: instanceClass,
+		Engine:        engine,
+		Deployment:    deployment,
+		Cpus:          cpus,
+		Mem:           mem,
+		OnDemandPrice: price,
+		Currency:      currency,
+	}, true
+}
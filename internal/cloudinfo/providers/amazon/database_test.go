@@ -0,0 +1,97 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amazon
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/stretchr/testify/assert"
+	"logur.dev/logur"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/cloudinfoadapter"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func rdsPriceItem(instanceClass, engine, deployment, price string) aws.JSONValue {
+	return aws.JSONValue{
+		"product": map[string]interface{}{
+			"attributes": map[string]interface{}{
+				"instanceType":     instanceClass,
+				"databaseEngine":   engine,
+				"deploymentOption": deployment,
+				"vcpu":             "2",
+				"memory":           "8 GiB",
+			}},
+		"terms": map[string]interface{}{
+			"OnDemand": map[string]interface{}{
+				"term": map[string]interface{}{
+					"priceDimensions": map[string]interface{}{
+						"dimension": map[string]interface{}{
+							"unit":         "Hrs",
+							"pricePerUnit": map[string]interface{}{"USD": price},
+						}}}}},
+	}
+}
+
+func TestEc2Infoer_GetDatabases(t *testing.T) {
+	tests := []struct {
+		name    string
+		pricing *dummyStoragePricing
+		check   func(databases []types.DatabaseInfo, err error)
+	}{
+		{
+			name: "the instance classes of the supported engines and deployments are returned",
+			pricing: &dummyStoragePricing{priceLists: map[string][]aws.JSONValue{
+				"Database Instance": {
+					rdsPriceItem("db.m5.large", "PostgreSQL", "Multi-AZ", "0.356"),
+					rdsPriceItem("db.m5.large", "PostgreSQL", "Single-AZ", "0.178"),
+					rdsPriceItem("db.m5.large", "MySQL", "Single-AZ", "0.171"),
+					rdsPriceItem("db.m5.large", "Oracle", "Single-AZ", "0.466"),
+					rdsPriceItem("db.m5.large", "MySQL", "Multi-AZ (readable standbys)", "0.436"),
+				},
+			}},
+			check: func(databases []types.DatabaseInfo, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, []types.DatabaseInfo{
+					{InstanceClass: "db.m5.large", Engine: types.DatabaseMySQL, Deployment: types.DatabaseSingleZone, Cpus: 2, Mem: 8, OnDemandPrice: 0.171},
+					{InstanceClass: "db.m5.large", Engine: types.DatabasePostgreSQL, Deployment: types.DatabaseSingleZone, Cpus: 2, Mem: 8, OnDemandPrice: 0.178},
+					{InstanceClass: "db.m5.large", Engine: types.DatabasePostgreSQL, Deployment: types.DatabaseHighAvailability, Cpus: 2, Mem: 8, OnDemandPrice: 0.356},
+				}, databases)
+			},
+		},
+		{
+			name:    "the error is returned",
+			pricing: &dummyStoragePricing{err: errors.New("throttled")},
+			check: func(databases []types.DatabaseInfo, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, databases)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			infoer := &Ec2Infoer{
+				pricingSvc: test.pricing,
+				partition:  endpoints.AwsPartition(),
+				log:        cloudinfoadapter.NewLogger(&logur.TestLogger{}),
+			}
+
+			test.check(infoer.GetDatabases("eu-west-1"))
+		})
+	}
+}
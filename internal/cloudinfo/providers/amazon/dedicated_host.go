@@ -0,0 +1,103 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amazon
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+	"github.com/banzaicloud/cloudinfo/internal/platform/log"
+)
+
+// GetDedicatedHosts retrieves the dedicated host types and their on demand prices in a region
+func (e *Ec2Infoer) GetDedicatedHosts(region string) ([]types.DedicatedHostInfo, error) {
+	if p := e.partitionInfoer(region); p != nil {
+		return p.GetDedicatedHosts(region)
+	}
+
+	logger := log.WithFields(e.log, map[string]interface{}{"region": region})
+	logger.Debug("getting dedicated hosts from AWS API")
+
+	priceList, err := e.pricingSvc.GetPriceList(e.newGetFamilyProductsInput(region, "AmazonEC2", "Dedicated Host"))
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to retrieve dedicated host prices")
+	}
+
+	hosts := make(map[string]types.DedicatedHostInfo)
+	for _, item := range priceList {
+		pd, err := newPriceData(item)
+		if err != nil {
+			continue
+		}
+
+		host, ok := dedicatedHost(pd, e.currency)
+		if !ok {
+			continue
+		}
+		// the host types are listed once per operating system, keep the cheapest (Linux) price
+		if h, ok := hosts[host.Type]; ok && h.OnDemandPrice <= host.OnDemandPrice {
+			continue
+		}
+		hosts[host.Type] = host
+	}
+
+	dedicatedHosts := make([]types.DedicatedHostInfo, 0, len(hosts))
+	for _, host := range hosts {
+		dedicatedHosts = append(dedicatedHosts, host)
+	}
+	sort.Slice(dedicatedHosts, func(i, j int) bool { return dedicatedHosts[i].Type < dedicatedHosts[j].Type })
+
+	logger.Debug("found dedicated hosts", map[string]interface{}{"numberOfHosts": len(dedicatedHosts)})
+	return dedicatedHosts, nil
+}
+
+// dedicatedHost assembles the dedicated host information of a price list item, the host type is the instance family
+// it runs, eg.: m5
+func dedicatedHost(pd *priceData, currency string) (types.DedicatedHostInfo, bool) {
+	hostType, err := pd.getDataForKey("instanceType")
+	if err != nil || hostType == "" {
+		return types.DedicatedHostInfo{}, false
+	}
+
+	priceStr, err := pd.getOnDemandPrice(currency)
+	if err != nil {
+		return types.DedicatedHostInfo{}, false
+	}
+	price, err := strconv.ParseFloat(priceStr, 64)
+	if err != nil || price == 0 {
+		return types.DedicatedHostInfo{}, false
+	}
+
+	coresStr, _ := pd.getDataForKey("physicalCores")
+	cpusStr, _ := pd.getDataForKey("vcpu")
+	memStr, _ := pd.getDataForKey(types.Memory)
+	cores, _ := strconv.ParseFloat(coresStr, 64)
+	cpus, _ := strconv.ParseFloat(cpusStr, 64)
+	mem, _ := strconv.ParseFloat(strings.ReplaceAll(strings.Split(memStr, " ")[0], ",", ""), 64)
+
+	return types.DedicatedHostInfo{
+		Type:          hostType,
+		Family:        strings.Split(hostType, ".")[0],
+		Cores:         cores,
+		Cpus:          cpus,
+		Mem:           mem,
+		OnDemandPrice: price,
+		Currency:      currency,
+	}, true
+}
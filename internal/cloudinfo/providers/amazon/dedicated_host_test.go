@@ -0,0 +1,94 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amazon
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/stretchr/testify/assert"
+	"logur.dev/logur"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/cloudinfoadapter"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func dedicatedHostPriceItem(hostType, cores, cpus, memory, price string) aws.JSONValue {
+	return aws.JSONValue{
+		"product": map[string]interface{}{
+			"attributes": map[string]interface{}{
+				"instanceType":  hostType,
+				"physicalCores": cores,
+				"vcpu":          cpus,
+				"memory":        memory,
+			}},
+		"terms": map[string]interface{}{
+			"OnDemand": map[string]interface{}{
+				"term": map[string]interface{}{
+					"priceDimensions": map[string]interface{}{
+						"dimension": map[string]interface{}{
+							"unit":         "Hrs",
+							"pricePerUnit": map[string]interface{}{"USD": price},
+						}}}}},
+	}
+}
+
+func TestEc2Infoer_GetDedicatedHosts(t *testing.T) {
+	tests := []struct {
+		name    string
+		pricing *dummyStoragePricing
+		check   func(hosts []types.DedicatedHostInfo, err error)
+	}{
+		{
+			name: "the host types are returned with their cheapest price",
+			pricing: &dummyStoragePricing{priceLists: map[string][]aws.JSONValue{
+				"Dedicated Host": {
+					dedicatedHostPriceItem("m5", "48", "96", "384 GiB", "5.069"),
+					dedicatedHostPriceItem("m5", "48", "96", "384 GiB", "9.485"),
+					dedicatedHostPriceItem("c5", "36", "72", "", "4.152"),
+					dedicatedHostPriceItem("r5", "48", "96", "768 GiB", "0"),
+				},
+			}},
+			check: func(hosts []types.DedicatedHostInfo, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, []types.DedicatedHostInfo{
+					{Type: "c5", Family: "c5", Cores: 36, Cpus: 72, OnDemandPrice: 4.152},
+					{Type: "m5", Family: "m5", Cores: 48, Cpus: 96, Mem: 384, OnDemandPrice: 5.069},
+				}, hosts)
+			},
+		},
+		{
+			name:    "the error is returned",
+			pricing: &dummyStoragePricing{err: errors.New("throttled")},
+			check: func(hosts []types.DedicatedHostInfo, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, hosts)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			infoer := &Ec2Infoer{
+				pricingSvc: test.pricing,
+				partition:  endpoints.AwsPartition(),
+				log:        cloudinfoadapter.NewLogger(&logur.TestLogger{}),
+			}
+
+			test.check(infoer.GetDedicatedHosts("eu-west-1"))
+		})
+	}
+}
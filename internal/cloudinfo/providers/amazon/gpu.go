@@ -0,0 +1,41 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amazon
+
+import (
+	"strings"
+)
+
+// gpuFamilies holds the descriptions of the GPUs of the GPU instance type families, the pricing api only provides their number
+// source: https://aws.amazon.com/ec2/instance-types/#Accelerated_Computing
+var gpuFamilies = map[string]string{
+	"g2":   "NVIDIA GRID K520",
+	"g3":   "NVIDIA Tesla M60",
+	"g3s":  "NVIDIA Tesla M60",
+	"g4dn": "NVIDIA T4",
+	"g4ad": "AMD Radeon Pro V520",
+	"g5":   "NVIDIA A10G",
+	"g5g":  "NVIDIA T4G",
+	"p2":   "NVIDIA Tesla K80",
+	"p3":   "NVIDIA Tesla V100",
+	"p3dn": "NVIDIA Tesla V100 32 GB",
+	"p4d":  "NVIDIA A100",
+	"p4de": "NVIDIA A100 80 GB",
+}
+
+// gpuDescription returns the description of the GPUs of the instance type, empty if unknown
+func gpuDescription(instanceType string) string {
+	return gpuFamilies[strings.SplitN(strings.ToLower(instanceType), ".", 2)[0]]
+}
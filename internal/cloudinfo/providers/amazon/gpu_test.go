@@ -0,0 +1,39 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amazon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGpuDescription(t *testing.T) {
+	tests := []struct {
+		instanceType string
+		description  string
+	}{
+		{instanceType: "p3.8xlarge", description: "NVIDIA Tesla V100"},
+		{instanceType: "p3dn.24xlarge", description: "NVIDIA Tesla V100 32 GB"},
+		{instanceType: "g4ad.xlarge", description: "AMD Radeon Pro V520"},
+		{instanceType: "m5.large", description: ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.instanceType, func(t *testing.T) {
+			assert.Equal(t, test.description, gpuDescription(test.instanceType))
+		})
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amazon
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// addInstanceTypeDetails sets the details of the virtual machines missing from the price list: the inference
+// accelerators (Inferentia, Trainium), the FPGAs, the network interface limits and the ENA and IPv6 support
+func (e *Ec2Infoer) addInstanceTypeDetails(vms []types.VMInfo, region string) error {
+	for i := range vms {
+		if device, ok := neuronDevices[vms[i].Type]; ok {
+			vms[i].Accelerators = []types.Accelerator{device}
+		}
+	}
+
+	details := make(map[string]*ec2.InstanceTypeInfo)
+	err := e.ec2Describer(region).DescribeInstanceTypesPages(&ec2.DescribeInstanceTypesInput{},
+		func(output *ec2.DescribeInstanceTypesOutput, lastPage bool) bool {
+			for _, info := range output.InstanceTypes {
+				details[aws.StringValue(info.InstanceType)] = info
+			}
+			return true
+		})
+	if err != nil {
+		return err
+	}
+
+	for i := range vms {
+		info, ok := details[vms[i].Type]
+		if !ok {
+			continue
+		}
+		if accelerators := instanceTypeAccelerators(info); len(accelerators) > 0 {
+			vms[i].Accelerators = accelerators
+		}
+		vms[i].NetworkLimits = networkLimits(info)
+		vms[i].AcceleratedNetworking, vms[i].IPv6 = networkSupport(info)
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amazon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"logur.dev/logur"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/cloudinfoadapter"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestEc2Infoer_addInstanceTypeDetails(t *testing.T) {
+	newVms := func() []types.VMInfo {
+		return []types.VMInfo{{Type: "m5.large"}, {Type: "inf1.xlarge"}, {Type: "f1.2xlarge"}, {Type: "trn1.32xlarge"}}
+	}
+	newInfoer := func(tcId int) *Ec2Infoer {
+		return &Ec2Infoer{
+			ec2Describer: func(region string) Ec2Describer {
+				return &testStruct{TcId: tcId}
+			},
+			log: cloudinfoadapter.NewLogger(&logur.TestLogger{}),
+		}
+	}
+
+	vms := newVms()
+	assert.NoError(t, newInfoer(0).addInstanceTypeDetails(vms, "us-east-1"))
+	assert.Empty(t, vms[0].Accelerators)
+	assert.Equal(t, &types.NetworkLimits{MaxInterfaces: 3, IPv4PerInterface: 10, IPv6PerInterface: 10}, vms[0].NetworkLimits)
+	assert.True(t, vms[0].AcceleratedNetworking)
+	assert.True(t, vms[0].IPv6)
+	assert.Nil(t, vms[1].NetworkLimits, "the network limits are missing if not described")
+	assert.False(t, vms[1].AcceleratedNetworking)
+	assert.Equal(t, []types.Accelerator{
+		{Type: types.AcceleratorInferentia, Vendor: "aws", Model: "Inferentia", Count: 1},
+	}, vms[1].Accelerators)
+	assert.Equal(t, []types.Accelerator{
+		{Type: types.AcceleratorFPGA, Vendor: "xilinx", Model: "Virtex UltraScale (VU9P)", Count: 1, Memory: 64},
+	}, vms[2].Accelerators)
+	assert.Equal(t, []types.Accelerator{neuronDevices["trn1.32xlarge"]}, vms[3].Accelerators,
+		"the neuron devices not described by the api should be set")
+
+	vms = newVms()
+	assert.Error(t, newInfoer(13).addInstanceTypeDetails(vms, "us-east-1"))
+	assert.Equal(t, []types.Accelerator{neuronDevices["trn1.32xlarge"]}, vms[3].Accelerators,
+		"the known neuron devices should be set even if the api fails")
+}
@@ -0,0 +1,125 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amazon
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pricing"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+	"github.com/banzaicloud/cloudinfo/internal/platform/log"
+)
+
+// elbFamilies maps the product families of the ELB prices to the load balancer types
+var elbFamilies = map[string]string{
+	"Load Balancer":             "classic",
+	"Load Balancer-Application": "application",
+	"Load Balancer-Network":     "network",
+	"Load Balancer-Gateway":     "gateway",
+}
+
+// GetLoadBalancerPrices retrieves the prices of the elastic load balancer types in a region
+func (e *Ec2Infoer) GetLoadBalancerPrices(region string) ([]types.LoadBalancerPrice, error) {
+	if p := e.partitionInfoer(region); p != nil {
+		return p.GetLoadBalancerPrices(region)
+	}
+
+	logger := log.WithFields(e.log, map[string]interface{}{"region": region})
+	logger.Debug("getting ELB prices from AWS API")
+
+	loadBalancerPrices := make([]types.LoadBalancerPrice, 0, len(elbFamilies))
+	for family, loadBalancerType := range elbFamilies {
+		priceList, err := e.pricingSvc.GetPriceList(e.newGetLoadBalancerProductsInput(region, family))
+		if err != nil {
+			return nil, errors.WrapIfWithDetails(err, "failed to retrieve ELB prices", "productFamily", family)
+		}
+
+		loadBalancerPrice := types.LoadBalancerPrice{Type: loadBalancerType, Currency: e.currency}
+		for _, item := range priceList {
+			pd, err := newPriceData(item)
+			if err != nil {
+				continue
+			}
+			applyElbPrice(&loadBalancerPrice, pd, e.currency)
+		}
+
+		if loadBalancerPrice.PricePerHour > 0 {
+			loadBalancerPrices = append(loadBalancerPrices, loadBalancerPrice)
+		}
+	}
+	sort.Slice(loadBalancerPrices, func(i, j int) bool { return loadBalancerPrices[i].Type < loadBalancerPrices[j].Type })
+
+	logger.Debug("found ELB prices", map[string]interface{}{"numberOfLoadBalancerTypes": len(loadBalancerPrices)})
+	return loadBalancerPrices, nil
+}
+
+// applyElbPrice sets the price of the ELB price list item on the load balancer price by the usage type and the unit:
+// the load balancer hours, the (network, gateway) load balancer capacity unit hours or the processed GBs (classic)
+func applyElbPrice(loadBalancerPrice *types.LoadBalancerPrice, pd *priceData, currency string) {
+	usageType, _ := pd.getDataForKey("usagetype")
+
+	priceStr, err := pd.getOnDemandPrice(currency)
+	if err != nil {
+		return
+	}
+	price, err := strconv.ParseFloat(priceStr, 64)
+	if err != nil || price == 0 {
+		return
+	}
+
+	unit, err := pd.getOnDemandUnit()
+	if err != nil {
+		return
+	}
+
+	switch strings.ToLower(unit) {
+	case "hrs":
+		if strings.Contains(usageType, "LoadBalancerUsage") {
+			loadBalancerPrice.PricePerHour = price
+		}
+	case "lcu-hrs", "nlcu-hrs", "glcu-hrs":
+		if strings.Contains(usageType, "LCUUsage") {
+			loadBalancerPrice.PricePerCapacityUnit = price
+		}
+	case "gb":
+		if strings.Contains(usageType, "DataProcessing-Bytes") {
+			loadBalancerPrice.PricePerGb = price
+		}
+	}
+}
+
+// newGetLoadBalancerProductsInput assembles a GetProductsInput instance for querying the ELB prices of a product family
+func (e *Ec2Infoer) newGetLoadBalancerProductsInput(regionId, productFamily string) *pricing.GetProductsInput {
+	return &pricing.GetProductsInput{
+		ServiceCode: aws.String("AWSELB"),
+		Filters: []*pricing.Filter{
+			{
+				Type:  aws.String(pricing.FilterTypeTermMatch),
+				Field: aws.String("productFamily"),
+				Value: aws.String(productFamily),
+			},
+			{
+				Type:  aws.String(pricing.FilterTypeTermMatch),
+				Field: aws.String("location"),
+				Value: aws.String(e.pricingLocation(regionId)),
+			},
+		},
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amazon
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/stretchr/testify/assert"
+	"logur.dev/logur"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/cloudinfoadapter"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func elbPriceItem(usageType, unit, price string) aws.JSONValue {
+	return aws.JSONValue{
+		"product": map[string]interface{}{
+			"attributes": map[string]interface{}{
+				"usagetype": usageType,
+			}},
+		"terms": map[string]interface{}{
+			"OnDemand": map[string]interface{}{
+				"term": map[string]interface{}{
+					"priceDimensions": map[string]interface{}{
+						"dimension": map[string]interface{}{
+							"unit":         unit,
+							"pricePerUnit": map[string]interface{}{"USD": price},
+						}}}}},
+	}
+}
+
+func TestEc2Infoer_GetLoadBalancerPrices(t *testing.T) {
+	tests := []struct {
+		name    string
+		pricing *dummyStoragePricing
+		check   func(prices []types.LoadBalancerPrice, err error)
+	}{
+		{
+			name: "the hourly, capacity unit and processed data prices are merged per load balancer type",
+			pricing: &dummyStoragePricing{priceLists: map[string][]aws.JSONValue{
+				"Load Balancer": {
+					elbPriceItem("EU-LoadBalancerUsage", "Hrs", "0.028"),
+					elbPriceItem("EU-DataProcessing-Bytes", "GB", "0.008"),
+				},
+				"Load Balancer-Application": {
+					elbPriceItem("EU-LoadBalancerUsage", "Hrs", "0.0252"),
+					elbPriceItem("EU-LCUUsage", "LCU-Hrs", "0.008"),
+				},
+				"Load Balancer-Network": {
+					elbPriceItem("EU-LoadBalancerUsage", "Hrs", "0.0252"),
+					elbPriceItem("EU-LCUUsage", "NLCU-Hrs", "0.006"),
+					elbPriceItem("EU-Reserved-LCUUsage", "Hrs", "0.004"),
+				},
+			}},
+			check: func(prices []types.LoadBalancerPrice, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, []types.LoadBalancerPrice{
+					{Type: "application", PricePerHour: 0.0252, PricePerCapacityUnit: 0.008},
+					{Type: "classic", PricePerHour: 0.028, PricePerGb: 0.008},
+					{Type: "network", PricePerHour: 0.0252, PricePerCapacityUnit: 0.006},
+				}, prices)
+			},
+		},
+		{
+			name:    "the error is returned",
+			pricing: &dummyStoragePricing{err: errors.New("throttled")},
+			check: func(prices []types.LoadBalancerPrice, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, prices)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			infoer := &Ec2Infoer{
+				pricingSvc: test.pricing,
+				partition:  endpoints.AwsPartition(),
+				log:        cloudinfoadapter.NewLogger(&logur.TestLogger{}),
+			}
+
+			test.check(infoer.GetLoadBalancerPrices("eu-west-1"))
+		})
+	}
+}
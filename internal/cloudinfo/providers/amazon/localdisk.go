@@ -0,0 +1,52 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amazon
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// instanceStorePattern matches the instance store descriptions of the price list, eg.: 2 x 1900 NVMe SSD
+var instanceStorePattern = regexp.MustCompile(`^(\d+) x ([\d.,]+)\s*(.*)$`)
+
+// localDisks parses the instance store of an instance type from the storage attribute of the price list,
+// the older instance types without disk type come with magnetic disks
+func localDisks(storage string) []types.LocalDisk {
+	match := instanceStorePattern.FindStringSubmatch(strings.TrimSpace(storage))
+	if match == nil {
+		// EBS only
+		return nil
+	}
+
+	count, _ := strconv.Atoi(match[1])
+	size, _ := strconv.ParseFloat(strings.ReplaceAll(match[2], ",", ""), 64)
+	if count == 0 || size == 0 {
+		return nil
+	}
+
+	diskType := types.LocalDiskHDD
+	switch {
+	case strings.Contains(match[3], "NVMe"):
+		diskType = types.LocalDiskNVMe
+	case strings.Contains(match[3], "SSD"):
+		diskType = types.LocalDiskSSD
+	}
+
+	return []types.LocalDisk{{Count: count, Size: size, Type: diskType}}
+}
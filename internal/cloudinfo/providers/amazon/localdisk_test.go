@@ -0,0 +1,43 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amazon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestLocalDisks(t *testing.T) {
+	tests := []struct {
+		storage string
+		disks   []types.LocalDisk
+	}{
+		{storage: "EBS only"},
+		{storage: "2 x 1900 NVMe SSD", disks: []types.LocalDisk{{Count: 2, Size: 1900, Type: types.LocalDiskNVMe}}},
+		{storage: "1 x 32 SSD", disks: []types.LocalDisk{{Count: 1, Size: 32, Type: types.LocalDiskSSD}}},
+		{storage: "24 x 2000 HDD", disks: []types.LocalDisk{{Count: 24, Size: 2000, Type: types.LocalDiskHDD}}},
+		{storage: "4 x 420", disks: []types.LocalDisk{{Count: 4, Size: 420, Type: types.LocalDiskHDD}}},
+		{storage: "1 x 1,900 NVMe SSD", disks: []types.LocalDisk{{Count: 1, Size: 1900, Type: types.LocalDiskNVMe}}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.storage, func(t *testing.T) {
+			assert.Equal(t, test.disks, localDisks(test.storage))
+		})
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amazon
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pricing"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+	"github.com/banzaicloud/cloudinfo/internal/platform/log"
+)
+
+// GetNatGatewayPrices retrieves the hourly and data processing prices of the NAT gateways in a region
+func (e *Ec2Infoer) GetNatGatewayPrices(region string) ([]types.NatGatewayPrice, error) {
+	if p := e.partitionInfoer(region); p != nil {
+		return p.GetNatGatewayPrices(region)
+	}
+
+	logger := log.WithFields(e.log, map[string]interface{}{"region": region})
+	logger.Debug("getting NAT gateway prices from AWS API")
+
+	priceList, err := e.pricingSvc.GetPriceList(e.newGetFamilyProductsInput(region, "AmazonEC2", "NAT Gateway"))
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to retrieve NAT gateway prices")
+	}
+
+	natGatewayPrice := types.NatGatewayPrice{Type: "nat-gateway", Currency: e.currency}
+	for _, item := range priceList {
+		pd, err := newPriceData(item)
+		if err != nil {
+			continue
+		}
+
+		usageType, price, ok := usageTypePrice(pd, e.currency)
+		if !ok {
+			continue
+		}
+
+		switch {
+		case strings.HasSuffix(usageType, "NatGateway-Hours"):
+			natGatewayPrice.PricePerHour = price
+		case strings.HasSuffix(usageType, "NatGateway-Bytes"):
+			natGatewayPrice.PricePerGb = price
+		}
+	}
+
+	if natGatewayPrice.PricePerHour == 0 {
+		logger.Debug("no NAT gateway prices found")
+		return []types.NatGatewayPrice{}, nil
+	}
+
+	return []types.NatGatewayPrice{natGatewayPrice}, nil
+}
+
+// publicIpUsageTypes maps the usage type suffixes of the public IPv4 address prices to the address kinds
+var publicIpUsageTypes = map[string]string{
+	"PublicIPv4:InUseAddress": "in-use",
+	"PublicIPv4:IdleAddress":  "idle",
+}
+
+// GetPublicIpPrices retrieves the hourly prices of the in-use and idle public IPv4 addresses in a region
+func (e *Ec2Infoer) GetPublicIpPrices(region string) ([]types.PublicIpPrice, error) {
+	if p := e.partitionInfoer(region); p != nil {
+		return p.GetPublicIpPrices(region)
+	}
+
+	logger := log.WithFields(e.log, map[string]interface{}{"region": region})
+	logger.Debug("getting public IP prices from AWS API")
+
+	priceList, err := e.pricingSvc.GetPriceList(e.newGetFamilyProductsInput(region, "AmazonVPC", "VPC Public IPv4 Address"))
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to retrieve public IP prices")
+	}
+
+	publicIpPrices := make([]types.PublicIpPrice, 0, len(publicIpUsageTypes))
+	for _, item := range priceList {
+		pd, err := newPriceData(item)
+		if err != nil {
+			continue
+		}
+
+		usageType, price, ok := usageTypePrice(pd, e.currency)
+		if !ok {
+			continue
+		}
+
+		for suffix, kind := range publicIpUsageTypes {
+			if strings.HasSuffix(usageType, suffix) {
+				publicIpPrices = append(publicIpPrices, types.PublicIpPrice{Type: kind, PricePerHour: price, Currency: e.currency})
+			}
+		}
+	}
+
+	sort.Slice(publicIpPrices, func(i, j int) bool { return publicIpPrices[i].Type < publicIpPrices[j].Type })
+
+	logger.Debug("found public IP prices", map[string]interface{}{"numberOfAddressKinds": len(publicIpPrices)})
+	return publicIpPrices, nil
+}
+
+// usageTypePrice extracts the usage type and the on demand price of a price list item, the free items are skipped
+func usageTypePrice(pd *priceData, currency string) (string, float64, bool) {
+	usageType, err := pd.getDataForKey("usagetype")
+	if err != nil {
+		return "", 0, false
+	}
+
+	priceStr, err := pd.getOnDemandPrice(currency)
+	if err != nil {
+		return "", 0, false
+	}
+	price, err := strconv.ParseFloat(priceStr, 64)
+	if err != nil || price == 0 {
+		return "", 0, false
+	}
+
+	return usageType, price, true
+}
+
+// newGetFamilyProductsInput assembles a GetProductsInput instance for querying the prices of a product family of a service
+func (e *Ec2Infoer) newGetFamilyProductsInput(regionId, serviceCode, productFamily string) *pricing.GetProductsInput {
+	return &pricing.GetProductsInput{
+		ServiceCode: aws.String(serviceCode),
+		Filters: []*pricing.Filter{
+			{
+				Type:  aws.String(pricing.FilterTypeTermMatch),
+				Field: aws.String("productFamily"),
+				Value: aws.String(productFamily),
+			},
+			{
+				Type:  aws.String(pricing.FilterTypeTermMatch),
+				Field: aws.String("location"),
+				Value: aws.String(e.pricingLocation(regionId)),
+			},
+		},
+	}
+}
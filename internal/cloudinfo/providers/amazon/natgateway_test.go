@@ -0,0 +1,52 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amazon
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/stretchr/testify/assert"
+	"logur.dev/logur"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/cloudinfoadapter"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestEc2Infoer_GetNatGatewayPrices(t *testing.T) {
+	infoer := &Ec2Infoer{
+		pricingSvc: &dummyStoragePricing{priceLists: map[string][]aws.JSONValue{
+			"NAT Gateway": {
+				elbPriceItem("EU-NatGateway-Hours", "Hrs", "0.048"),
+				elbPriceItem("EU-NatGateway-Bytes", "GB", "0.048"),
+				elbPriceItem("EU-NatGateway-Provisioned-Bytes", "GB", "0"),
+			},
+			"VPC Public IPv4 Address": {
+				elbPriceItem("EU-PublicIPv4:InUseAddress", "Hrs", "0.005"),
+			},
+		}},
+		partition: endpoints.AwsPartition(),
+		log:       cloudinfoadapter.NewLogger(&logur.TestLogger{}),
+	}
+
+	natGatewayPrices, err := infoer.GetNatGatewayPrices("eu-west-1")
+	assert.NoError(t, err)
+	assert.Equal(t, []types.NatGatewayPrice{{Type: "nat-gateway", PricePerHour: 0.048, PricePerGb: 0.048}}, natGatewayPrices)
+
+	publicIpPrices, err := infoer.GetPublicIpPrices("eu-west-1")
+	assert.NoError(t, err)
+	assert.Equal(t, []types.PublicIpPrice{{Type: "in-use", PricePerHour: 0.005}}, publicIpPrices)
+}
@@ -0,0 +1,140 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amazon
+
+import (
+	"sort"
+	"strconv"
+
+	"emperror.dev/errors"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pricing"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+	"github.com/banzaicloud/cloudinfo/internal/platform/log"
+)
+
+// dataTransferTypes maps the transfer types of the AWS data transfer prices to the network traffic types
+var dataTransferTypes = map[string]string{
+	"AWS Outbound":         types.TrafficInternetEgress,
+	"IntraRegion":          types.TrafficInterZone,
+	"InterRegion Outbound": types.TrafficInterRegion,
+}
+
+// GetNetworkPrices retrieves the data transfer prices of a region
+// the inter-region traffic is priced per destination region, the highest of the prices is reported
+func (e *Ec2Infoer) GetNetworkPrices(region string) ([]types.NetworkPrice, error) {
+	if p := e.partitionInfoer(region); p != nil {
+		return p.GetNetworkPrices(region)
+	}
+
+	logger := log.WithFields(e.log, map[string]interface{}{"region": region})
+	logger.Debug("getting data transfer prices from AWS API")
+
+	priceList, err := e.pricingSvc.GetPriceList(e.newGetNetworkProductsInput(region))
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to retrieve data transfer prices")
+	}
+
+	prices := make(map[string]float64)
+	for _, item := range priceList {
+		pd, err := newPriceData(item)
+		if err != nil {
+			continue
+		}
+
+		transferType, _ := pd.getDataForKey("transferType")
+		trafficType, ok := dataTransferTypes[transferType]
+		if !ok {
+			continue
+		}
+
+		price, ok := firstPaidTierPrice(pd, e.currency)
+		if !ok {
+			continue
+		}
+
+		if price > prices[trafficType] {
+			prices[trafficType] = price
+		}
+	}
+
+	networkPrices := make([]types.NetworkPrice, 0, len(prices))
+	for trafficType, price := range prices {
+		networkPrices = append(networkPrices, types.NetworkPrice{Type: trafficType, PricePerGb: price, Currency: e.currency})
+	}
+	sort.Slice(networkPrices, func(i, j int) bool { return networkPrices[i].Type < networkPrices[j].Type })
+
+	logger.Debug("found data transfer prices", map[string]interface{}{"numberOfTrafficTypes": len(networkPrices)})
+	return networkPrices, nil
+}
+
+// firstPaidTierPrice returns the price of the lowest tier of a tiered price list item that is not free
+// (eg.: the first GB of the internet egress is free)
+func firstPaidTierPrice(pd *priceData, currency string) (float64, bool) {
+	if currency == "" {
+		currency = "USD"
+	}
+
+	dimensions, err := pd.getOnDemandDimensions()
+	if err != nil {
+		return 0, false
+	}
+
+	type tier struct {
+		begin float64
+		price float64
+	}
+	var tiers []tier
+	for _, dimension := range dimensions {
+		beginRange, _ := dimension["beginRange"].(string)
+		begin, err := strconv.ParseFloat(beginRange, 64)
+		if err != nil {
+			continue
+		}
+
+		pricePerUnitMap, err := getMapForKey("pricePerUnit", dimension)
+		if err != nil {
+			continue
+		}
+		priceStr, _ := pricePerUnitMap[currency].(string)
+		price, err := strconv.ParseFloat(priceStr, 64)
+		if err != nil || price == 0 {
+			continue
+		}
+
+		tiers = append(tiers, tier{begin: begin, price: price})
+	}
+	if len(tiers) == 0 {
+		return 0, false
+	}
+
+	sort.Slice(tiers, func(i, j int) bool { return tiers[i].begin < tiers[j].begin })
+	return tiers[0].price, true
+}
+
+// newGetNetworkProductsInput assembles a GetProductsInput instance for querying the data transfer prices from a region
+func (e *Ec2Infoer) newGetNetworkProductsInput(regionId string) *pricing.GetProductsInput {
+	return &pricing.GetProductsInput{
+		ServiceCode: aws.String("AWSDataTransfer"),
+		Filters: []*pricing.Filter{
+			{
+				Type:  aws.String(pricing.FilterTypeTermMatch),
+				Field: aws.String("fromLocation"),
+				Value: aws.String(e.pricingLocation(regionId)),
+			},
+		},
+	}
+}
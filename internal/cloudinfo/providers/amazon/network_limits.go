@@ -0,0 +1,47 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amazon
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// networkLimits returns the network interface (ENI) and private IP address limits of an instance type
+func networkLimits(info *ec2.InstanceTypeInfo) *types.NetworkLimits {
+	if info.NetworkInfo == nil || aws.Int64Value(info.NetworkInfo.MaximumNetworkInterfaces) == 0 {
+		return nil
+	}
+
+	return &types.NetworkLimits{
+		MaxInterfaces:    int(aws.Int64Value(info.NetworkInfo.MaximumNetworkInterfaces)),
+		IPv4PerInterface: int(aws.Int64Value(info.NetworkInfo.Ipv4AddressesPerInterface)),
+		IPv6PerInterface: int(aws.Int64Value(info.NetworkInfo.Ipv6AddressesPerInterface)),
+	}
+}
+
+// networkSupport returns whether an instance type supports the Elastic Network Adapter (ENA) and IPv6
+func networkSupport(info *ec2.InstanceTypeInfo) (ena bool, ipv6 bool) {
+	if info.NetworkInfo == nil {
+		return false, false
+	}
+
+	ena = aws.StringValue(info.NetworkInfo.EnaSupport) == ec2.EnaSupportSupported ||
+		aws.StringValue(info.NetworkInfo.EnaSupport) == ec2.EnaSupportRequired
+
+	return ena, aws.BoolValue(info.NetworkInfo.Ipv6Supported)
+}
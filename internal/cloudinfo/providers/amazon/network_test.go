@@ -0,0 +1,54 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amazon
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/stretchr/testify/assert"
+	"logur.dev/logur"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/cloudinfoadapter"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestEc2Infoer_GetNetworkPrices(t *testing.T) {
+	// the data transfer prices are not filtered by product family
+	pricingSvc := &dummyStoragePricing{priceLists: map[string][]aws.JSONValue{
+		"": {
+			tieredPriceItem("transferType", "AWS Outbound", map[string]string{"0": "0", "1": "0.09", "10240": "0.085"}),
+			tieredPriceItem("transferType", "IntraRegion", map[string]string{"0": "0.01"}),
+			tieredPriceItem("transferType", "InterRegion Outbound", map[string]string{"0": "0.02"}),
+			tieredPriceItem("transferType", "InterRegion Outbound", map[string]string{"0": "0.08"}),
+			tieredPriceItem("transferType", "AWS Inbound", map[string]string{"0": "0"}),
+		},
+	}}
+	infoer := &Ec2Infoer{
+		pricingSvc: pricingSvc,
+		partition:  endpoints.AwsPartition(),
+		log:        cloudinfoadapter.NewLogger(&logur.TestLogger{}),
+	}
+
+	prices, err := infoer.GetNetworkPrices("eu-west-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []types.NetworkPrice{
+		{Type: types.TrafficInterRegion, PricePerGb: 0.08},
+		{Type: types.TrafficInterZone, PricePerGb: 0.01},
+		{Type: types.TrafficInternetEgress, PricePerGb: 0.09},
+	}, prices, "the first paid tier and the highest inter-region price should be reported")
+}
@@ -0,0 +1,183 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amazon
+
+import (
+	"sort"
+	"strconv"
+
+	"emperror.dev/errors"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pricing"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+	"github.com/banzaicloud/cloudinfo/internal/platform/log"
+)
+
+const (
+	// s3StorageFamily the product family of the S3 storage prices
+	s3StorageFamily = "Storage"
+	// s3RequestFamily the product family of the S3 request prices
+	s3RequestFamily = "API Request"
+)
+
+// s3StorageClasses maps the volume types of the S3 storage prices to storage classes
+var s3StorageClasses = map[string]string{
+	"Standard":                     "STANDARD",
+	"Standard - Infrequent Access": "STANDARD_IA",
+	"One Zone - Infrequent Access": "ONEZONE_IA",
+	"Glacier Instant Retrieval":    "GLACIER_IR",
+	"Amazon Glacier":               "GLACIER",
+	"Glacier Deep Archive":         "DEEP_ARCHIVE",
+}
+
+// s3RequestGroups maps the groups of the S3 request prices to storage classes and request kinds
+// the tier 1 requests (PUT, COPY, POST, LIST) are reported as writes, the tier 2 requests (GET, SELECT) as reads
+var s3RequestGroups = map[string]struct {
+	class string
+	write bool
+}{
+	"S3-API-Tier1":     {class: "STANDARD", write: true},
+	"S3-API-Tier2":     {class: "STANDARD"},
+	"S3-API-SIA-Tier1": {class: "STANDARD_IA", write: true},
+	"S3-API-SIA-Tier2": {class: "STANDARD_IA"},
+	"S3-API-ZIA-Tier1": {class: "ONEZONE_IA", write: true},
+	"S3-API-ZIA-Tier2": {class: "ONEZONE_IA"},
+	"S3-API-GIR-Tier1": {class: "GLACIER_IR", write: true},
+	"S3-API-GIR-Tier2": {class: "GLACIER_IR"},
+}
+
+// GetObjectStoragePrices retrieves the prices of the S3 storage classes in a region
+func (e *Ec2Infoer) GetObjectStoragePrices(region string) ([]types.ObjectStoragePrice, error) {
+	if p := e.partitionInfoer(region); p != nil {
+		return p.GetObjectStoragePrices(region)
+	}
+
+	logger := log.WithFields(e.log, map[string]interface{}{"region": region})
+	logger.Debug("getting S3 prices from AWS API")
+
+	prices := make(map[string]*types.ObjectStoragePrice)
+	for _, family := range []string{s3StorageFamily, s3RequestFamily} {
+		priceList, err := e.pricingSvc.GetPriceList(e.newGetObjectStorageProductsInput(region, family))
+		if err != nil {
+			return nil, errors.WrapIfWithDetails(err, "failed to retrieve S3 prices", "productFamily", family)
+		}
+
+		for _, item := range priceList {
+			pd, err := newPriceData(item)
+			if err != nil {
+				continue
+			}
+
+			price, ok := firstTierPrice(pd, e.currency)
+			if !ok {
+				continue
+			}
+
+			switch family {
+			case s3StorageFamily:
+				volumeType, _ := pd.getDataForKey("volumeType")
+				class, ok := s3StorageClasses[volumeType]
+				if !ok {
+					continue
+				}
+				objectStoragePrice(prices, class, e.currency).PricePerGb = price
+			case s3RequestFamily:
+				group, _ := pd.getDataForKey("group")
+				request, ok := s3RequestGroups[group]
+				if !ok {
+					continue
+				}
+				// the requests are priced per request
+				if request.write {
+					objectStoragePrice(prices, request.class, e.currency).PricePerThousandWrites = price * 1000
+				} else {
+					objectStoragePrice(prices, request.class, e.currency).PricePerThousandReads = price * 1000
+				}
+			}
+		}
+	}
+
+	objectStoragePrices := make([]types.ObjectStoragePrice, 0, len(prices))
+	for _, price := range prices {
+		// the request prices are only reported for the storage classes with a capacity price
+		if price.PricePerGb > 0 {
+			objectStoragePrices = append(objectStoragePrices, *price)
+		}
+	}
+	sort.Slice(objectStoragePrices, func(i, j int) bool { return objectStoragePrices[i].Class < objectStoragePrices[j].Class })
+
+	logger.Debug("found S3 prices", map[string]interface{}{"numberOfStorageClasses": len(objectStoragePrices)})
+	return objectStoragePrices, nil
+}
+
+// objectStoragePrice returns the price of the storage class, registering it if needed
+func objectStoragePrice(prices map[string]*types.ObjectStoragePrice, class, currency string) *types.ObjectStoragePrice {
+	price, ok := prices[class]
+	if !ok {
+		price = &types.ObjectStoragePrice{Class: class, Currency: currency}
+		prices[class] = price
+	}
+	return price
+}
+
+// firstTierPrice returns the price of the first tier (the one beginning at 0) of a tiered price list item
+func firstTierPrice(pd *priceData, currency string) (float64, bool) {
+	if currency == "" {
+		currency = "USD"
+	}
+
+	dimensions, err := pd.getOnDemandDimensions()
+	if err != nil {
+		return 0, false
+	}
+
+	for _, dimension := range dimensions {
+		if beginRange, ok := dimension["beginRange"].(string); ok && beginRange != "0" {
+			continue
+		}
+
+		pricePerUnitMap, err := getMapForKey("pricePerUnit", dimension)
+		if err != nil {
+			return 0, false
+		}
+		priceStr, _ := pricePerUnitMap[currency].(string)
+		price, err := strconv.ParseFloat(priceStr, 64)
+		if err != nil || price == 0 {
+			return 0, false
+		}
+		return price, true
+	}
+	return 0, false
+}
+
+// newGetObjectStorageProductsInput assembles a GetProductsInput instance for querying the S3 prices of a product family
+func (e *Ec2Infoer) newGetObjectStorageProductsInput(regionId, productFamily string) *pricing.GetProductsInput {
+	return &pricing.GetProductsInput{
+		ServiceCode: aws.String("AmazonS3"),
+		Filters: []*pricing.Filter{
+			{
+				Type:  aws.String(pricing.FilterTypeTermMatch),
+				Field: aws.String("productFamily"),
+				Value: aws.String(productFamily),
+			},
+			{
+				Type:  aws.String(pricing.FilterTypeTermMatch),
+				Field: aws.String("location"),
+				Value: aws.String(e.pricingLocation(regionId)),
+			},
+		},
+	}
+}
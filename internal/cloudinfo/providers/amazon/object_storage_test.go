@@ -0,0 +1,82 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amazon
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/stretchr/testify/assert"
+	"logur.dev/logur"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/cloudinfoadapter"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func tieredPriceItem(attribute, value string, tiers map[string]string) aws.JSONValue {
+	dimensions := make(map[string]interface{})
+	for beginRange, price := range tiers {
+		dimensions["dimension-"+beginRange] = map[string]interface{}{
+			"beginRange":   beginRange,
+			"pricePerUnit": map[string]interface{}{"USD": price},
+		}
+	}
+	return aws.JSONValue{
+		"product": map[string]interface{}{
+			"attributes": map[string]interface{}{
+				attribute: value,
+			}},
+		"terms": map[string]interface{}{
+			"OnDemand": map[string]interface{}{
+				"term": map[string]interface{}{
+					"priceDimensions": dimensions,
+				}}},
+	}
+}
+
+func TestEc2Infoer_GetObjectStoragePrices(t *testing.T) {
+	pricingSvc := &dummyStoragePricing{priceLists: map[string][]aws.JSONValue{
+		s3StorageFamily: {
+			tieredPriceItem("volumeType", "Standard", map[string]string{"0": "0.023", "51200": "0.022", "512000": "0.021"}),
+			tieredPriceItem("volumeType", "Amazon Glacier", map[string]string{"0": "0.0036"}),
+			tieredPriceItem("volumeType", "Intelligent-Tiering Frequent Access", map[string]string{"0": "0.023"}),
+		},
+		s3RequestFamily: {
+			tieredPriceItem("group", "S3-API-Tier1", map[string]string{"0": "0.000005"}),
+			tieredPriceItem("group", "S3-API-Tier2", map[string]string{"0": "0.0000004"}),
+			tieredPriceItem("group", "S3-API-SIA-Tier1", map[string]string{"0": "0.00001"}),
+		},
+	}}
+	infoer := &Ec2Infoer{
+		pricingSvc: pricingSvc,
+		partition:  endpoints.AwsPartition(),
+		log:        cloudinfoadapter.NewLogger(&logur.TestLogger{}),
+	}
+
+	prices, err := infoer.GetObjectStoragePrices("eu-west-1")
+
+	assert.NoError(t, err)
+	if assert.Len(t, prices, 2, "only the known storage classes with a capacity price should be reported") {
+		assert.Equal(t, types.ObjectStoragePrice{Class: "GLACIER", PricePerGb: 0.0036}, prices[0])
+		assert.Equal(t, "STANDARD", prices[1].Class)
+		assert.Equal(t, 0.023, prices[1].PricePerGb, "the first tier should be reported")
+		assert.InDelta(t, 0.005, prices[1].PricePerThousandWrites, 1e-9)
+		assert.InDelta(t, 0.0004, prices[1].PricePerThousandReads, 1e-9)
+	}
+	for _, location := range pricingSvc.locations {
+		assert.Equal(t, "EU (Ireland)", location, "the prices should be queried for the region")
+	}
+}
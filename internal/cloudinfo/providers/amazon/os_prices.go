@@ -0,0 +1,76 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amazon
+
+import (
+	"strconv"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// pricingOperatingSystems maps the commercial operating systems of the prices to the ones of the pricing API
+var pricingOperatingSystems = map[string]string{
+	types.OsWindows: "Windows",
+	types.OsRHEL:    "RHEL",
+	types.OsSUSE:    "SUSE",
+}
+
+// addOsPrices sets the on demand prices of the virtual machines per operating system, including the license fees
+func (e *Ec2Infoer) addOsPrices(vms []types.VMInfo, region string) error {
+	osPrices := make(map[string]map[string]float64)
+	for os, pricingOs := range pricingOperatingSystems {
+		priceList, err := e.pricingSvc.GetPriceList(e.newGetOsProductsInput(region, pricingOs))
+		if err != nil {
+			return errors.WrapIfWithDetails(err, "failed to retrieve prices", "operatingSystem", pricingOs)
+		}
+
+		for _, item := range priceList {
+			pd, err := newPriceData(item)
+			if err != nil {
+				continue
+			}
+
+			instanceType, err := pd.getDataForKey("instanceType")
+			if err != nil {
+				continue
+			}
+			priceStr, err := pd.getOnDemandPrice(e.currency)
+			if err != nil {
+				continue
+			}
+			price, err := strconv.ParseFloat(priceStr, 64)
+			if err != nil || price == 0 {
+				continue
+			}
+
+			if osPrices[instanceType] == nil {
+				osPrices[instanceType] = make(map[string]float64)
+			}
+			osPrices[instanceType][os] = price
+		}
+	}
+
+	for i := range vms {
+		prices := map[string]float64{types.OsLinux: vms[i].OnDemandPrice}
+		for os, price := range osPrices[vms[i].Type] {
+			prices[os] = price
+		}
+		vms[i].OsPrices = prices
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amazon
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/pricing"
+	"github.com/stretchr/testify/assert"
+	"logur.dev/logur"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/cloudinfoadapter"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// dummyOsPricing mocks the instance price lists per operating system
+type dummyOsPricing struct {
+	priceLists map[string][]aws.JSONValue
+	err        error
+}
+
+func (d *dummyOsPricing) GetPriceList(input *pricing.GetProductsInput) ([]aws.JSONValue, error) {
+	for _, filter := range input.Filters {
+		if aws.StringValue(filter.Field) == "operatingSystem" {
+			return d.priceLists[aws.StringValue(filter.Value)], d.err
+		}
+	}
+	return nil, d.err
+}
+
+func osPriceItem(instanceType, price string) aws.JSONValue {
+	return aws.JSONValue{
+		"product": map[string]interface{}{
+			"attributes": map[string]interface{}{
+				"instanceType": instanceType,
+			}},
+		"terms": map[string]interface{}{
+			"OnDemand": map[string]interface{}{
+				"term": map[string]interface{}{
+					"priceDimensions": map[string]interface{}{
+						"dimension": map[string]interface{}{
+							"unit":         "Hrs",
+							"pricePerUnit": map[string]interface{}{"USD": price},
+						}}}}},
+	}
+}
+
+func TestEc2Infoer_addOsPrices(t *testing.T) {
+	tests := []struct {
+		name    string
+		pricing *dummyOsPricing
+		check   func(vms []types.VMInfo, err error)
+	}{
+		{
+			name: "the prices of the operating systems are set",
+			pricing: &dummyOsPricing{priceLists: map[string][]aws.JSONValue{
+				"Windows": {osPriceItem("m5.large", "0.188"), osPriceItem("c5.large", "0.177")},
+				"RHEL":    {osPriceItem("m5.large", "0.1248")},
+				"SUSE":    {osPriceItem("m5.large", "0")},
+			}},
+			check: func(vms []types.VMInfo, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, map[string]float64{
+					types.OsLinux:   0.096,
+					types.OsWindows: 0.188,
+					types.OsRHEL:    0.1248,
+				}, vms[0].OsPrices)
+				assert.Equal(t, map[string]float64{types.OsLinux: 0.085}, vms[1].OsPrices)
+			},
+		},
+		{
+			name:    "the error is returned",
+			pricing: &dummyOsPricing{err: errors.New("throttled")},
+			check: func(vms []types.VMInfo, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, vms[0].OsPrices)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			infoer := &Ec2Infoer{
+				pricingSvc: test.pricing,
+				partition:  endpoints.AwsPartition(),
+				log:        cloudinfoadapter.NewLogger(&logur.TestLogger{}),
+			}
+			vms := []types.VMInfo{{Type: "m5.large", OnDemandPrice: 0.096}, {Type: "t3.micro", OnDemandPrice: 0.085}}
+
+			err := infoer.addOsPrices(vms, "eu-west-1")
+			test.check(vms, err)
+		})
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amazon
+
+import (
+	"emperror.dev/errors"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+const (
+	// chinaPricingRegion the region serving the pricing api of the China partition
+	chinaPricingRegion = "cn-northwest-1"
+
+	// chinaCurrency the currency of the prices of the China partition
+	chinaCurrency = "CNY"
+)
+
+// newPartitionInfoer builds an infoer for an other partition, the GovCloud partition is priced by the
+// pricing api of the commercial partition unless a pricing region is configured
+func newPartitionInfoer(id string, config PartitionConfig, commercial *Ec2Infoer, userAgent string) (*Ec2Infoer, error) {
+	partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), config.Region)
+	if !ok || partition.ID() != id {
+		return nil, errors.NewWithDetails("the region is not in the aws partition", "partition", id, "region", config.Region)
+	}
+
+	econfig, err := configFromCredentials(config.Credentials)
+	if err != nil {
+		return nil, errors.WrapWithDetails(err, "creating ec2 aws config", "partition", id)
+	}
+
+	esess, err := session.NewSession(econfig)
+	if err != nil {
+		return nil, errors.WrapWithDetails(err, "creating ec2 aws session", "partition", id)
+	}
+
+	if userAgent != "" {
+		esess.Handlers.Build.PushBack(request.MakeAddToUserAgentFreeFormHandler(userAgent))
+	}
+
+	infoer := &Ec2Infoer{
+		pricingSvc: commercial.pricingSvc,
+		promQuery:  commercial.promQuery,
+		ec2Describer: func(region string) Ec2Describer {
+			return ec2.New(esess, aws.NewConfig().WithRegion(region))
+		},
+		partition:   partition,
+		rawPayloads: commercial.rawPayloads,
+		log:         commercial.log.WithFields(map[string]interface{}{"partition": id}),
+	}
+
+	if id == endpoints.AwsCnPartitionID {
+		infoer.currency = chinaCurrency
+		if config.Pricing.Region == "" {
+			config.Pricing.Region = chinaPricingRegion
+		}
+	}
+
+	if config.Pricing.Region != "" {
+		pconfig, err := configFromCredentials(config.GetPricingCredentials())
+		if err != nil {
+			return nil, errors.WrapWithDetails(err, "creating pricing aws config", "partition", id)
+		}
+
+		psess, err := session.NewSession(pconfig.WithRegion(config.Pricing.Region))
+		if err != nil {
+			return nil, errors.WrapWithDetails(err, "creating pricing aws session", "partition", id)
+		}
+
+		if userAgent != "" {
+			psess.Handlers.Build.PushBack(request.MakeAddToUserAgentFreeFormHandler(userAgent))
+		}
+
+		infoer.pricingSvc = NewPricingSource(psess)
+	}
+
+	return infoer, nil
+}
+
+// partitionInfoer returns the infoer of the other partition the region belongs to, nil for the regions of the own partition
+func (e *Ec2Infoer) partitionInfoer(region string) *Ec2Infoer {
+	for _, p := range e.partitions {
+		if _, ok := p.partition.Regions()[region]; ok {
+			return p
+		}
+	}
+
+	return nil
+}
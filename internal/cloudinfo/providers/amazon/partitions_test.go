@@ -0,0 +1,103 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amazon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"logur.dev/logur"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/cloudinfoadapter"
+)
+
+func TestNewAmazonInfoer_Partitions(t *testing.T) {
+	tests := []struct {
+		name   string
+		config Config
+		check  func(info *Ec2Infoer, err error)
+	}{
+		{
+			name: "GovCloud and China partitions",
+			config: Config{Region: "us-east-1", Partitions: map[string]PartitionConfig{
+				"aws-us-gov": {Region: "us-gov-west-1"},
+				"aws-cn":     {Region: "cn-north-1"},
+			}},
+			check: func(info *Ec2Infoer, err error) {
+				assert.NoError(t, err)
+				assert.Len(t, info.partitions, 2)
+
+				gov := info.partitionInfoer("us-gov-east-1")
+				assert.Equal(t, "aws-us-gov", gov.partition.ID())
+				assert.Equal(t, "", gov.currency)
+				assert.Equal(t, info.pricingSvc, gov.pricingSvc, "govcloud is priced by the commercial pricing api")
+
+				china := info.partitionInfoer("cn-northwest-1")
+				assert.Equal(t, "aws-cn", china.partition.ID())
+				assert.Equal(t, "CNY", china.currency)
+				assert.NotEqual(t, info.pricingSvc, china.pricingSvc)
+
+				assert.Nil(t, info.partitionInfoer("eu-west-1"))
+			},
+		},
+		{
+			name: "region outside of the partition",
+			config: Config{Region: "us-east-1", Partitions: map[string]PartitionConfig{
+				"aws-cn": {Region: "us-gov-west-1"},
+			}},
+			check: func(info *Ec2Infoer, err error) {
+				assert.EqualError(t, err, "the region is not in the aws partition")
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.check(NewAmazonInfoer(test.config, cloudinfoadapter.NewLogger(&logur.TestLogger{})))
+		})
+	}
+}
+
+func TestEc2Infoer_GetZones_Partition(t *testing.T) {
+	c := Config{Region: "us-east-1", Partitions: map[string]PartitionConfig{"aws-us-gov": {Region: "us-gov-west-1"}}}
+	cloudInfoer, err := NewAmazonInfoer(c, cloudinfoadapter.NewLogger(&logur.TestLogger{}))
+	if err != nil {
+		t.Fatalf("failed to create cloudinfoer; [%s]", err.Error())
+	}
+
+	var describedRegion string
+	cloudInfoer.partitions[0].ec2Describer = func(region string) Ec2Describer {
+		describedRegion = region
+		return &testStruct{}
+	}
+
+	zones, err := cloudInfoer.GetZones("us-gov-west-1")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"eu-central-1a", "eu-central-1b"}, zones)
+	assert.Equal(t, "us-gov-west-1", describedRegion)
+}
+
+func TestEc2Infoer_GetRegions_Partitions(t *testing.T) {
+	c := Config{Region: "us-east-1", Partitions: map[string]PartitionConfig{"aws-cn": {Region: "cn-north-1"}}}
+	cloudInfoer, err := NewAmazonInfoer(c, cloudinfoadapter.NewLogger(&logur.TestLogger{}))
+	if err != nil {
+		t.Fatalf("failed to create cloudinfoer; [%s]", err.Error())
+	}
+
+	regions, err := cloudInfoer.GetRegions("compute")
+	assert.NoError(t, err)
+	assert.Contains(t, regions, "us-east-1")
+	assert.Contains(t, regions, "cn-north-1")
+	assert.Contains(t, regions, "cn-northwest-1")
+}
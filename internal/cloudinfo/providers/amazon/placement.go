@@ -0,0 +1,63 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amazon
+
+import (
+	"strings"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+var (
+	// clusterPlacementPreviousGen holds the previous generation instance types supporting cluster placement groups
+	// source: https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/placement-groups.html#placement-groups-limitations-cluster
+	clusterPlacementPreviousGen = []string{"a1.", "c3.", "cc2.8xlarge", "cr1.8xlarge", "d2.", "g2.8xlarge", "i2.", "r3."}
+)
+
+// placementGroup returns the placement strategy supported by the instance type
+// current generation instance types support cluster placement groups, except the burstable and mac ones
+func placementGroup(instanceType string, currentGen bool) string {
+	it := strings.ToLower(instanceType)
+
+	if currentGen {
+		if isBurst(it) || strings.HasPrefix(it, "mac") {
+			return ""
+		}
+		return types.PlacementCluster
+	}
+
+	for _, prefix := range clusterPlacementPreviousGen {
+		if strings.HasPrefix(it, prefix) {
+			return types.PlacementCluster
+		}
+	}
+
+	return ""
+}
+
+// placementStrategies returns the placement strategies supported by the instance type
+// the spread and partition placement groups support all the instance types, except the mac ones running on dedicated hosts
+func placementStrategies(instanceType string, currentGen bool) []string {
+	if strings.HasPrefix(strings.ToLower(instanceType), "mac") {
+		return nil
+	}
+
+	var strategies []string
+	if placementGroup(instanceType, currentGen) == types.PlacementCluster {
+		strategies = append(strategies, types.PlacementCluster)
+	}
+
+	return append(strategies, types.PlacementSpread, types.PlacementPartition)
+}
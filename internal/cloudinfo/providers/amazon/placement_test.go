@@ -0,0 +1,63 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amazon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestPlacementGroup(t *testing.T) {
+	tests := []struct {
+		instanceType string
+		currentGen   bool
+		placement    string
+	}{
+		{instanceType: "c5n.18xlarge", currentGen: true, placement: types.PlacementCluster},
+		{instanceType: "t3.micro", currentGen: true, placement: ""},
+		{instanceType: "mac1.metal", currentGen: true, placement: ""},
+		{instanceType: "r3.large", currentGen: false, placement: types.PlacementCluster},
+		{instanceType: "cc2.8xlarge", currentGen: false, placement: types.PlacementCluster},
+		{instanceType: "m1.small", currentGen: false, placement: ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.instanceType, func(t *testing.T) {
+			assert.Equal(t, test.placement, placementGroup(test.instanceType, test.currentGen))
+		})
+	}
+}
+
+func TestPlacementStrategies(t *testing.T) {
+	tests := []struct {
+		instanceType string
+		currentGen   bool
+		strategies   []string
+	}{
+		{instanceType: "c5n.18xlarge", currentGen: true, strategies: []string{types.PlacementCluster, types.PlacementSpread, types.PlacementPartition}},
+		{instanceType: "t3.micro", currentGen: true, strategies: []string{types.PlacementSpread, types.PlacementPartition}},
+		{instanceType: "m1.small", currentGen: false, strategies: []string{types.PlacementSpread, types.PlacementPartition}},
+		{instanceType: "mac1.metal", currentGen: true, strategies: nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.instanceType, func(t *testing.T) {
+			assert.Equal(t, test.strategies, placementStrategies(test.instanceType, test.currentGen))
+		})
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amazon
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// reservedPaymentOptions orders the purchase options of the reserved instances
+var reservedPaymentOptions = map[string]int{
+	types.PaymentNoUpfront:      0,
+	types.PaymentPartialUpfront: 1,
+	types.PaymentAllUpfront:     2,
+}
+
+// getReservedPrices returns the prices of the standard reserved instance offerings of the price list item,
+// ordered by term and purchase option; the convertible offerings are left out
+func (pd *priceData) getReservedPrices(currency string) []types.ReservedPrice {
+	if currency == "" {
+		currency = "USD"
+	}
+
+	termsMap, err := getMapForKey("terms", pd.awsData)
+	if err != nil {
+		return nil
+	}
+	reservedMap, err := getMapForKey("Reserved", termsMap)
+	if err != nil {
+		return nil
+	}
+
+	var prices []types.ReservedPrice
+	for _, rawTerm := range reservedMap {
+		term, ok := rawTerm.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		attributes, err := getMapForKey("termAttributes", term)
+		if err != nil || attributes["OfferingClass"] != "standard" {
+			continue
+		}
+		length, _ := attributes["LeaseContractLength"].(string)
+		paymentOption, _ := attributes["PurchaseOption"].(string)
+		if _, ok := reservedPaymentOptions[paymentOption]; !ok || length == "" {
+			continue
+		}
+
+		priceDimensions, err := getMapForKey("priceDimensions", term)
+		if err != nil {
+			continue
+		}
+
+		var upfront, hourly float64
+		for _, rawDimension := range priceDimensions {
+			dimension, ok := rawDimension.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			pricePerUnit, err := getMapForKey("pricePerUnit", dimension)
+			if err != nil {
+				continue
+			}
+			priceStr, _ := pricePerUnit[currency].(string)
+			price, err := strconv.ParseFloat(priceStr, 64)
+			if err != nil {
+				continue
+			}
+
+			switch dimension["unit"] {
+			case "Quantity":
+				upfront = price
+			case "Hrs":
+				hourly = price
+			}
+		}
+
+		prices = append(prices, cloudinfo.NewReservedPrice(length, paymentOption, upfront, hourly))
+	}
+
+	sort.Slice(prices, func(i, j int) bool {
+		if prices[i].Term != prices[j].Term {
+			return prices[i].Term < prices[j].Term
+		}
+		return reservedPaymentOptions[prices[i].PaymentOption] < reservedPaymentOptions[prices[j].PaymentOption]
+	})
+
+	return prices
+}
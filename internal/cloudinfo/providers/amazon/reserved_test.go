@@ -0,0 +1,68 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amazon
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func reservedTerm(length, offeringClass, purchaseOption, upfront, hourly string) map[string]interface{} {
+	return map[string]interface{}{
+		"termAttributes": map[string]interface{}{
+			"LeaseContractLength": length,
+			"OfferingClass":       offeringClass,
+			"PurchaseOption":      purchaseOption,
+		},
+		"priceDimensions": map[string]interface{}{
+			"upfront": map[string]interface{}{"unit": "Quantity", "pricePerUnit": map[string]interface{}{"USD": upfront}},
+			"hourly":  map[string]interface{}{"unit": "Hrs", "pricePerUnit": map[string]interface{}{"USD": hourly}},
+		},
+	}
+}
+
+func TestPriceData_getReservedPrices(t *testing.T) {
+	pd, err := newPriceData(aws.JSONValue{
+		"product": map[string]interface{}{
+			"attributes": map[string]interface{}{"instanceType": "m5.large"},
+		},
+		"terms": map[string]interface{}{
+			"Reserved": map[string]interface{}{
+				"a": reservedTerm("3yr", "standard", "All Upfront", "1314", "0"),
+				"b": reservedTerm("1yr", "standard", "Partial Upfront", "438", "0.05"),
+				"c": reservedTerm("1yr", "standard", "No Upfront", "0", "0.1"),
+				"d": reservedTerm("1yr", "convertible", "No Upfront", "0", "0.12"),
+			},
+		},
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, []types.ReservedPrice{
+		{Term: "1yr", PaymentOption: types.PaymentNoUpfront, MonthlyPrice: 73, EffectiveHourlyPrice: 0.1},
+		{Term: "1yr", PaymentOption: types.PaymentPartialUpfront, UpfrontPrice: 438, MonthlyPrice: 36.5, EffectiveHourlyPrice: 0.1},
+		{Term: "3yr", PaymentOption: types.PaymentAllUpfront, UpfrontPrice: 1314, EffectiveHourlyPrice: 0.05},
+	}, pd.getReservedPrices(""), "the standard offerings should be ordered by term and purchase option")
+
+	pd, err = newPriceData(aws.JSONValue{
+		"product": map[string]interface{}{"attributes": map[string]interface{}{}},
+		"terms":   map[string]interface{}{"OnDemand": map[string]interface{}{}},
+	})
+	assert.NoError(t, err)
+	assert.Nil(t, pd.getReservedPrices(""), "the items without reserved terms should have no reserved prices")
+}
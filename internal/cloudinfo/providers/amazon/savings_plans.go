@@ -0,0 +1,140 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amazon
+
+import (
+	"sort"
+	"strconv"
+
+	"emperror.dev/errors"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/savingsplans"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+const (
+	// savingsPlansRegion the region serving the savings plans api
+	savingsPlansRegion = "us-east-1"
+
+	oneYearSeconds   = 365 * 24 * 60 * 60
+	threeYearSeconds = 3 * oneYearSeconds
+)
+
+// addSavingsPlansRates attaches the savings plans rates of the region to the virtual machines
+// instance types without savings plans rates are left untouched
+func (e *Ec2Infoer) addSavingsPlansRates(vms []types.VMInfo, region string) error {
+	rates, err := e.getSavingsPlansRates(region)
+	if err != nil {
+		return err
+	}
+
+	for i := range vms {
+		if spRates, ok := rates[vms[i].Type]; ok {
+			vms[i].SavingsPlans = spRates
+		}
+	}
+
+	return nil
+}
+
+// getSavingsPlansRates retrieves the Compute and EC2 Instance savings plans rates of the Linux, shared tenancy
+// instance types in the region
+func (e *Ec2Infoer) getSavingsPlansRates(region string) (map[string][]types.SavingsPlanPrice, error) {
+	offeringRates, err := e.savingsPlans.GetOfferingRates(&savingsplans.DescribeSavingsPlansOfferingRatesInput{
+		SavingsPlanTypes: aws.StringSlice([]string{savingsplans.SavingsPlanTypeCompute, savingsplans.SavingsPlanTypeEc2instance}),
+		Products:         aws.StringSlice([]string{savingsplans.SavingsPlanProductTypeEc2}),
+		ServiceCodes:     aws.StringSlice([]string{savingsplans.SavingsPlanRateServiceCodeAmazonEc2}),
+		Operations:       aws.StringSlice([]string{"RunInstances"}),
+		Filters: []*savingsplans.SavingsPlanOfferingRateFilterElement{
+			{
+				Name:   aws.String(savingsplans.SavingsPlanRateFilterAttributeRegion),
+				Values: aws.StringSlice([]string{region}),
+			},
+			{
+				Name:   aws.String(savingsplans.SavingsPlanRateFilterAttributeTenancy),
+				Values: aws.StringSlice([]string{"shared"}),
+			},
+			{
+				Name:   aws.String(savingsplans.SavingsPlanRateFilterAttributeProductDescription),
+				Values: aws.StringSlice([]string{"Linux/UNIX"}),
+			},
+		},
+		MaxResults: aws.Int64(1000),
+	})
+	if err != nil {
+		return nil, errors.WithDetails(err, "region", region)
+	}
+
+	rates := make(map[string][]types.SavingsPlanPrice)
+	for _, offeringRate := range offeringRates {
+		instanceType := offeringRateProperty(offeringRate, savingsplans.SavingsPlanRateFilterAttributeInstanceType)
+		if instanceType == "" || offeringRate.SavingsPlanOffering == nil {
+			continue
+		}
+
+		rate, err := strconv.ParseFloat(aws.StringValue(offeringRate.Rate), 64)
+		if err != nil {
+			continue
+		}
+
+		rates[instanceType] = append(rates[instanceType], types.SavingsPlanPrice{
+			PlanType:      aws.StringValue(offeringRate.SavingsPlanOffering.PlanType),
+			Term:          savingsPlanTerm(aws.Int64Value(offeringRate.SavingsPlanOffering.DurationSeconds)),
+			PaymentOption: aws.StringValue(offeringRate.SavingsPlanOffering.PaymentOption),
+			Rate:          rate,
+		})
+	}
+
+	for instanceType := range rates {
+		sortSavingsPlanRates(rates[instanceType])
+	}
+
+	return rates, nil
+}
+
+// sortSavingsPlanRates orders the savings plans rates by plan type, term and payment option
+func sortSavingsPlanRates(rates []types.SavingsPlanPrice) {
+	sort.Slice(rates, func(i, j int) bool {
+		if rates[i].PlanType != rates[j].PlanType {
+			return rates[i].PlanType < rates[j].PlanType
+		}
+		if rates[i].Term != rates[j].Term {
+			return rates[i].Term < rates[j].Term
+		}
+		return reservedPaymentOptions[rates[i].PaymentOption] < reservedPaymentOptions[rates[j].PaymentOption]
+	})
+}
+
+func offeringRateProperty(offeringRate *savingsplans.SavingsPlanOfferingRate, name string) string {
+	for _, property := range offeringRate.Properties {
+		if aws.StringValue(property.Name) == name {
+			return aws.StringValue(property.Value)
+		}
+	}
+	return ""
+}
+
+// savingsPlanTerm maps the duration of a savings plan to its commitment term
+func savingsPlanTerm(durationSeconds int64) string {
+	switch durationSeconds {
+	case oneYearSeconds:
+		return "1yr"
+	case threeYearSeconds:
+		return "3yr"
+	default:
+		return strconv.FormatInt(durationSeconds, 10) + "s"
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amazon
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/savingsplans"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// dummySavingsPlans mocks the savings plans offering rates
+type dummySavingsPlans struct {
+	rates []*savingsplans.SavingsPlanOfferingRate
+	err   error
+	input *savingsplans.DescribeSavingsPlansOfferingRatesInput
+}
+
+func (d *dummySavingsPlans) GetOfferingRates(input *savingsplans.DescribeSavingsPlansOfferingRatesInput) ([]*savingsplans.SavingsPlanOfferingRate, error) {
+	d.input = input
+	return d.rates, d.err
+}
+
+func offeringRate(instanceType, planType string, durationSeconds int64, paymentOption, rate string) *savingsplans.SavingsPlanOfferingRate {
+	return &savingsplans.SavingsPlanOfferingRate{
+		Rate: aws.String(rate),
+		Properties: []*savingsplans.SavingsPlanOfferingRateProperty{
+			{Name: aws.String(savingsplans.SavingsPlanRateFilterAttributeRegion), Value: aws.String("eu-west-1")},
+			{Name: aws.String(savingsplans.SavingsPlanRateFilterAttributeInstanceType), Value: aws.String(instanceType)},
+		},
+		SavingsPlanOffering: &savingsplans.ParentSavingsPlanOffering{
+			PlanType:        aws.String(planType),
+			DurationSeconds: aws.Int64(durationSeconds),
+			PaymentOption:   aws.String(paymentOption),
+		},
+	}
+}
+
+func TestEc2Infoer_addSavingsPlansRates(t *testing.T) {
+	tests := []struct {
+		name         string
+		savingsPlans *dummySavingsPlans
+		check        func(vms []types.VMInfo, err error)
+	}{
+		{
+			name: "the rates are attached to the instance types, ordered by plan type",
+			savingsPlans: &dummySavingsPlans{rates: []*savingsplans.SavingsPlanOfferingRate{
+				offeringRate("m5.large", "EC2Instance", threeYearSeconds, "All Upfront", "0.04"),
+				offeringRate("m5.large", "Compute", oneYearSeconds, "No Upfront", "0.07"),
+				offeringRate("c5.large", "Compute", 42, "Partial Upfront", "0.06"),
+				offeringRate("m5.large", "Compute", oneYearSeconds, "No Upfront", "invalid"),
+			}},
+			check: func(vms []types.VMInfo, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, []types.SavingsPlanPrice{
+					{PlanType: "Compute", Term: "1yr", PaymentOption: "No Upfront", Rate: 0.07},
+					{PlanType: "EC2Instance", Term: "3yr", PaymentOption: "All Upfront", Rate: 0.04},
+				}, vms[0].SavingsPlans)
+				assert.Equal(t, []types.SavingsPlanPrice{
+					{PlanType: "Compute", Term: "42s", PaymentOption: "Partial Upfront", Rate: 0.06},
+				}, vms[1].SavingsPlans)
+				assert.Nil(t, vms[2].SavingsPlans, "the instance type without rates should be left untouched")
+			},
+		},
+		{
+			name:         "the error is returned",
+			savingsPlans: &dummySavingsPlans{err: errors.New("access denied")},
+			check: func(vms []types.VMInfo, err error) {
+				assert.Error(t, err)
+				for _, vm := range vms {
+					assert.Nil(t, vm.SavingsPlans)
+				}
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			infoer := &Ec2Infoer{savingsPlans: test.savingsPlans}
+			vms := []types.VMInfo{{Type: "m5.large"}, {Type: "c5.large"}, {Type: "t3.micro"}}
+
+			test.check(vms, infoer.addSavingsPlansRates(vms, "eu-west-1"))
+
+			filters := make(map[string][]string)
+			for _, filter := range test.savingsPlans.input.Filters {
+				filters[aws.StringValue(filter.Name)] = aws.StringValueSlice(filter.Values)
+			}
+			assert.Equal(t, map[string][]string{
+				savingsplans.SavingsPlanRateFilterAttributeRegion:             {"eu-west-1"},
+				savingsplans.SavingsPlanRateFilterAttributeTenancy:            {"shared"},
+				savingsplans.SavingsPlanRateFilterAttributeProductDescription: {"Linux/UNIX"},
+			}, filters, "the rates should be queried for the region")
+			assert.Equal(t, []string{savingsplans.SavingsPlanTypeCompute, savingsplans.SavingsPlanTypeEc2instance},
+				aws.StringValueSlice(test.savingsPlans.input.SavingsPlanTypes), "the compute and instance plans should be queried")
+		})
+	}
+}
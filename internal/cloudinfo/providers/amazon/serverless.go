@@ -0,0 +1,129 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amazon
+
+import (
+	"sort"
+	"strings"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+	"github.com/banzaicloud/cloudinfo/internal/platform/log"
+)
+
+// GetServerlessPrices retrieves the per vCPU-second and per GB-second prices of the Fargate tasks in a region,
+// the Windows tasks are charged for their OS license per vCPU on top of the compute prices
+func (e *Ec2Infoer) GetServerlessPrices(region string) ([]types.ServerlessPrice, error) {
+	if p := e.partitionInfoer(region); p != nil {
+		return p.GetServerlessPrices(region)
+	}
+
+	logger := log.WithFields(e.log, map[string]interface{}{"region": region})
+	logger.Debug("getting Fargate prices from AWS API")
+
+	priceList, err := e.pricingSvc.GetPriceList(e.newGetFamilyProductsInput(region, "AmazonECS", "Compute"))
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to retrieve Fargate prices")
+	}
+
+	prices := make(map[types.ServerlessPrice]*types.ServerlessPrice)
+	for _, item := range priceList {
+		pd, err := newPriceData(item)
+		if err != nil {
+			continue
+		}
+
+		usageType, price, ok := usageTypePrice(pd, e.currency)
+		if !ok {
+			continue
+		}
+
+		key, resource, ok := fargateUsage(usageType)
+		if !ok {
+			continue
+		}
+
+		serverlessPrice, ok := prices[key]
+		if !ok {
+			serverlessPrice = &types.ServerlessPrice{Type: key.Type, Os: key.Os, Architecture: key.Architecture, Currency: e.currency}
+			prices[key] = serverlessPrice
+		}
+
+		// the Fargate usage is priced per hour
+		switch resource {
+		case "vCPU", "OS":
+			serverlessPrice.PricePerVcpuSecond += price / 3600
+		case "GB":
+			serverlessPrice.PricePerGbSecond = price / 3600
+		}
+	}
+
+	serverlessPrices := make([]types.ServerlessPrice, 0, len(prices))
+	for _, price := range prices {
+		if price.PricePerVcpuSecond > 0 && price.PricePerGbSecond > 0 {
+			serverlessPrices = append(serverlessPrices, *price)
+		}
+	}
+	sortServerlessPrices(serverlessPrices)
+
+	logger.Debug("found Fargate prices", map[string]interface{}{"numberOfPrices": len(serverlessPrices)})
+	return serverlessPrices, nil
+}
+
+// fargateUsage parses the usage type of a Fargate price, eg.: EUW1-SpotUsage-Fargate-ARM-vCPU-Hours:perCPU, into the
+// offering, OS and architecture of the tasks and the priced resource (vCPU, GB or the OS license)
+func fargateUsage(usageType string) (types.ServerlessPrice, string, bool) {
+	i := strings.Index(usageType, "Fargate-")
+	if i < 0 {
+		return types.ServerlessPrice{}, "", false
+	}
+
+	key := types.ServerlessPrice{Type: "fargate", Os: types.OsLinux, Architecture: types.ArchitectureAMD64}
+	if strings.Contains(usageType[:i], "SpotUsage") {
+		key.Type = "fargate-spot"
+	}
+
+	resource := ""
+	for _, part := range strings.Split(strings.SplitN(usageType[i+len("Fargate-"):], ":", 2)[0], "-") {
+		switch part {
+		case "ARM":
+			key.Architecture = types.ArchitectureARM64
+		case "Windows":
+			key.Os = types.OsWindows
+		case "vCPU", "GB", "OS":
+			resource = part
+		case "Hours":
+		default:
+			// the ephemeral storage and the rest of the Fargate usages are not compute prices
+			return types.ServerlessPrice{}, "", false
+		}
+	}
+
+	return key, resource, resource != ""
+}
+
+// sortServerlessPrices sorts the serverless prices by offering, OS and architecture
+func sortServerlessPrices(prices []types.ServerlessPrice) {
+	sort.Slice(prices, func(i, j int) bool {
+		if prices[i].Type != prices[j].Type {
+			return prices[i].Type < prices[j].Type
+		}
+		if prices[i].Os != prices[j].Os {
+			return prices[i].Os < prices[j].Os
+		}
+		return prices[i].Architecture < prices[j].Architecture
+	})
+}
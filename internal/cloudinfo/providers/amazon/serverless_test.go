@@ -0,0 +1,63 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amazon
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/stretchr/testify/assert"
+	"logur.dev/logur"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/cloudinfoadapter"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestEc2Infoer_GetServerlessPrices(t *testing.T) {
+	infoer := &Ec2Infoer{
+		pricingSvc: &dummyStoragePricing{priceLists: map[string][]aws.JSONValue{
+			"Compute": {
+				elbPriceItem("EUW1-Fargate-vCPU-Hours:perCPU", "hours", "0.0360"),
+				elbPriceItem("EUW1-Fargate-GB-Hours", "hours", "0.0036"),
+				elbPriceItem("EUW1-Fargate-ARM-vCPU-Hours:perCPU", "hours", "0.0288"),
+				elbPriceItem("EUW1-Fargate-ARM-GB-Hours", "hours", "0.0036"),
+				elbPriceItem("EUW1-Fargate-Windows-vCPU-Hours:perCPU", "hours", "0.0720"),
+				elbPriceItem("EUW1-Fargate-Windows-GB-Hours", "hours", "0.0072"),
+				elbPriceItem("EUW1-Fargate-Windows-OS-Hours:perCPU", "hours", "0.0360"),
+				elbPriceItem("EUW1-SpotUsage-Fargate-vCPU-Hours:perCPU", "hours", "0.0108"),
+				elbPriceItem("EUW1-SpotUsage-Fargate-GB-Hours", "hours", "0.0018"),
+				elbPriceItem("EUW1-Fargate-EphemeralStorage-GB-Hours", "GB-Hours", "0.0036"),
+			},
+		}},
+		partition: endpoints.AwsPartition(),
+		log:       cloudinfoadapter.NewLogger(&logur.TestLogger{}),
+	}
+
+	prices, err := infoer.GetServerlessPrices("eu-west-1")
+	assert.NoError(t, err)
+	expected := []types.ServerlessPrice{
+		{Type: "fargate", Os: "linux", Architecture: "amd64", PricePerVcpuSecond: 0.00001, PricePerGbSecond: 0.000001},
+		{Type: "fargate", Os: "linux", Architecture: "arm64", PricePerVcpuSecond: 0.000008, PricePerGbSecond: 0.000001},
+		{Type: "fargate", Os: "windows", Architecture: "amd64", PricePerVcpuSecond: 0.00003, PricePerGbSecond: 0.000002},
+		{Type: "fargate-spot", Os: "linux", Architecture: "amd64", PricePerVcpuSecond: 0.000003, PricePerGbSecond: 0.0000005},
+	}
+	assert.Len(t, prices, len(expected))
+	for i, price := range prices {
+		assert.Equal(t, []string{expected[i].Type, expected[i].Os, expected[i].Architecture}, []string{price.Type, price.Os, price.Architecture})
+		assert.InDelta(t, expected[i].PricePerVcpuSecond, price.PricePerVcpuSecond, 1e-12)
+		assert.InDelta(t, expected[i].PricePerGbSecond, price.PricePerGbSecond, 1e-12)
+	}
+}
@@ -0,0 +1,182 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amazon
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pricing"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+	"github.com/banzaicloud/cloudinfo/internal/platform/log"
+)
+
+const (
+	// ebsCapacityFamily the product family of the EBS volume capacity prices
+	ebsCapacityFamily = "Storage"
+	// ebsIopsFamily the product family of the EBS provisioned IOPS prices
+	ebsIopsFamily = "System Operation"
+	// ebsThroughputFamily the product family of the EBS provisioned throughput prices
+	ebsThroughputFamily = "Provisioned Throughput"
+	// ebsSnapshotFamily the product family of the EBS snapshot prices
+	ebsSnapshotFamily = "Storage Snapshot"
+)
+
+// GetStoragePrices retrieves the prices of the EBS volume types in a region
+func (e *Ec2Infoer) GetStoragePrices(region string) ([]types.StoragePrice, error) {
+	if p := e.partitionInfoer(region); p != nil {
+		return p.GetStoragePrices(region)
+	}
+
+	logger := log.WithFields(e.log, map[string]interface{}{"region": region})
+	logger.Debug("getting EBS prices from AWS API")
+
+	prices := make(map[string]*types.StoragePrice)
+	for _, family := range []string{ebsCapacityFamily, ebsIopsFamily, ebsThroughputFamily} {
+		priceList, err := e.pricingSvc.GetPriceList(e.newGetStorageProductsInput(region, family))
+		if err != nil {
+			return nil, errors.WrapIfWithDetails(err, "failed to retrieve EBS prices", "productFamily", family)
+		}
+
+		for _, item := range priceList {
+			pd, err := newPriceData(item)
+			if err != nil {
+				continue
+			}
+
+			volumeType, price, ok := ebsPrice(pd, e.currency)
+			if !ok {
+				continue
+			}
+
+			storagePrice, ok := prices[volumeType]
+			if !ok {
+				storagePrice = &types.StoragePrice{Type: volumeType, Currency: e.currency}
+				prices[volumeType] = storagePrice
+			}
+
+			switch family {
+			case ebsCapacityFamily:
+				storagePrice.PricePerGb = price
+			case ebsIopsFamily:
+				storagePrice.PricePerIops = price
+			case ebsThroughputFamily:
+				storagePrice.PricePerThroughput = price
+			}
+		}
+	}
+
+	snapshotPrice, err := e.getSnapshotPrice(region)
+	if err != nil {
+		return nil, err
+	}
+
+	storagePrices := make([]types.StoragePrice, 0, len(prices))
+	for _, price := range prices {
+		// the IOPS and throughput are only priced for the volume types with a capacity price
+		if price.PricePerGb > 0 {
+			price.PricePerSnapshotGb = snapshotPrice
+			storagePrices = append(storagePrices, *price)
+		}
+	}
+	sort.Slice(storagePrices, func(i, j int) bool { return storagePrices[i].Type < storagePrices[j].Type })
+
+	logger.Debug("found EBS prices", map[string]interface{}{"numberOfVolumeTypes": len(storagePrices)})
+	return storagePrices, nil
+}
+
+// getSnapshotPrice retrieves the monthly price of a GiB of the standard EBS snapshots in a region (the snapshots of all
+// the volume types are priced the same), zero if it's not known
+func (e *Ec2Infoer) getSnapshotPrice(region string) (float64, error) {
+	priceList, err := e.pricingSvc.GetPriceList(e.newGetStorageProductsInput(region, ebsSnapshotFamily))
+	if err != nil {
+		return 0, errors.WrapIfWithDetails(err, "failed to retrieve EBS prices", "productFamily", ebsSnapshotFamily)
+	}
+
+	for _, item := range priceList {
+		pd, err := newPriceData(item)
+		if err != nil {
+			continue
+		}
+
+		// the archive tier and the fast snapshot restore are priced separately
+		if usageType, price, ok := usageTypePrice(pd, e.currency); ok && strings.HasSuffix(usageType, "EBS:SnapshotUsage") {
+			return price, nil
+		}
+	}
+
+	return 0, nil
+}
+
+// ebsPrice extracts the volume type and the monthly price of an EBS price list item
+// the price is converted to the unit of the storage prices (GiB, IOPS and MiB/s per month)
+func ebsPrice(pd *priceData, currency string) (string, float64, bool) {
+	volumeType, err := pd.getDataForKey("volumeApiName")
+	if err != nil || volumeType == "" {
+		return "", 0, false
+	}
+
+	// only the first tier of the tiered IOPS prices (eg.: io2) is reported
+	if usageType, _ := pd.getDataForKey("usagetype"); strings.Contains(usageType, ".tier") {
+		return "", 0, false
+	}
+
+	priceStr, err := pd.getOnDemandPrice(currency)
+	if err != nil {
+		return "", 0, false
+	}
+	price, err := strconv.ParseFloat(priceStr, 64)
+	if err != nil || price == 0 {
+		return "", 0, false
+	}
+
+	unit, err := pd.getOnDemandUnit()
+	if err != nil {
+		return "", 0, false
+	}
+
+	switch strings.ToLower(unit) {
+	case "gb-mo", "iops-mo", "mibps-mo":
+		return volumeType, price, true
+	case "gibps-mo":
+		return volumeType, price / 1024, true
+	default:
+		// eg.: the I/O requests of the magnetic volumes
+		return "", 0, false
+	}
+}
+
+// newGetStorageProductsInput assembles a GetProductsInput instance for querying the EBS prices of a product family
+func (e *Ec2Infoer) newGetStorageProductsInput(regionId, productFamily string) *pricing.GetProductsInput {
+	return &pricing.GetProductsInput{
+		ServiceCode: aws.String("AmazonEC2"),
+		Filters: []*pricing.Filter{
+			{
+				Type:  aws.String(pricing.FilterTypeTermMatch),
+				Field: aws.String("productFamily"),
+				Value: aws.String(productFamily),
+			},
+			{
+				Type:  aws.String(pricing.FilterTypeTermMatch),
+				Field: aws.String("location"),
+				Value: aws.String(e.pricingLocation(regionId)),
+			},
+		},
+	}
+}
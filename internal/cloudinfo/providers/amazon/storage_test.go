@@ -0,0 +1,130 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amazon
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/pricing"
+	"github.com/stretchr/testify/assert"
+	"logur.dev/logur"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/cloudinfoadapter"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// dummyStoragePricing mocks the EBS price lists per product family
+type dummyStoragePricing struct {
+	priceLists map[string][]aws.JSONValue
+	err        error
+	locations  []string
+}
+
+func (d *dummyStoragePricing) GetPriceList(input *pricing.GetProductsInput) ([]aws.JSONValue, error) {
+	var family string
+	for _, filter := range input.Filters {
+		switch aws.StringValue(filter.Field) {
+		case "productFamily":
+			family = aws.StringValue(filter.Value)
+		case "location":
+			d.locations = append(d.locations, aws.StringValue(filter.Value))
+		}
+	}
+	return d.priceLists[family], d.err
+}
+
+func ebsPriceItem(volumeType, usageType, unit, price string) aws.JSONValue {
+	return aws.JSONValue{
+		"product": map[string]interface{}{
+			"attributes": map[string]interface{}{
+				"volumeApiName": volumeType,
+				"usagetype":     usageType,
+			}},
+		"terms": map[string]interface{}{
+			"OnDemand": map[string]interface{}{
+				"term": map[string]interface{}{
+					"priceDimensions": map[string]interface{}{
+						"dimension": map[string]interface{}{
+							"unit":         unit,
+							"pricePerUnit": map[string]interface{}{"USD": price},
+						}}}}},
+	}
+}
+
+func TestEc2Infoer_GetStoragePrices(t *testing.T) {
+	tests := []struct {
+		name    string
+		pricing *dummyStoragePricing
+		check   func(prices []types.StoragePrice, err error)
+	}{
+		{
+			name: "the capacity, IOPS and throughput prices are merged per volume type",
+			pricing: &dummyStoragePricing{priceLists: map[string][]aws.JSONValue{
+				ebsCapacityFamily: {
+					ebsPriceItem("gp3", "EU-EBS:VolumeUsage.gp3", "GB-Mo", "0.088"),
+					ebsPriceItem("io2", "EU-EBS:VolumeUsage.io2", "GB-Mo", "0.138"),
+					ebsPriceItem("standard", "EU-EBS:VolumeUsage", "GB-Mo", "0.055"),
+				},
+				ebsIopsFamily: {
+					ebsPriceItem("io2", "EU-EBS:VolumeP-IOPS.io2", "IOPS-Mo", "0.072"),
+					ebsPriceItem("io2", "EU-EBS:VolumeP-IOPS.io2.tier2", "IOPS-Mo", "0.0504"),
+					ebsPriceItem("gp3", "EU-EBS:VolumeP-IOPS.gp3", "IOPS-Mo", "0.0055"),
+					ebsPriceItem("standard", "EU-EBS:VolumeIOUsage", "IOs", "0.00000006"),
+				},
+				ebsThroughputFamily: {
+					ebsPriceItem("gp3", "EU-EBS:VolumeP-Throughput.gp3", "GiBps-mo", "45.056"),
+				},
+				ebsSnapshotFamily: {
+					ebsPriceItem("", "EU-EBS:SnapshotArchiveStorage", "GB-Mo", "0.0125"),
+					ebsPriceItem("", "EU-EBS:SnapshotUsage", "GB-Mo", "0.05"),
+				},
+			}},
+			check: func(prices []types.StoragePrice, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, []types.StoragePrice{
+					{Type: "gp3", PricePerGb: 0.088, PricePerIops: 0.0055, PricePerThroughput: 0.044, PricePerSnapshotGb: 0.05},
+					{Type: "io2", PricePerGb: 0.138, PricePerIops: 0.072, PricePerSnapshotGb: 0.05},
+					{Type: "standard", PricePerGb: 0.055, PricePerSnapshotGb: 0.05},
+				}, prices)
+			},
+		},
+		{
+			name:    "the error is returned",
+			pricing: &dummyStoragePricing{err: errors.New("throttled")},
+			check: func(prices []types.StoragePrice, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, prices)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			infoer := &Ec2Infoer{
+				pricingSvc: test.pricing,
+				partition:  endpoints.AwsPartition(),
+				log:        cloudinfoadapter.NewLogger(&logur.TestLogger{}),
+			}
+
+			test.check(infoer.GetStoragePrices("eu-west-1"))
+
+			for _, location := range test.pricing.locations {
+				assert.Equal(t, "EU (Ireland)", location, "the prices should be queried for the region")
+			}
+		})
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/pricing"
+	"github.com/aws/aws-sdk-go/service/savingsplans"
 )
 
 // PricingSource list of operations for retrieving pricing information
@@ -51,3 +52,39 @@ func (pd *pricingDetails) GetPriceList(input *pricing.GetProductsInput) ([]aws.J
 
 	return list, nil
 }
+
+// SavingsPlansSource list of operations for retrieving savings plans rates
+// Decouples the savings plans logic from the amazon api
+type SavingsPlansSource interface {
+	GetOfferingRates(input *savingsplans.DescribeSavingsPlansOfferingRatesInput) ([]*savingsplans.SavingsPlanOfferingRate, error)
+}
+
+// savingsPlansDetails wraps a savings plans client, and implements the SavingsPlansSource interface
+type savingsPlansDetails struct {
+	// embedded savings plans client
+	savingsplans.SavingsPlans
+}
+
+func NewSavingsPlansSource(s *session.Session, cfg ...*aws.Config) *savingsPlansDetails {
+	return &savingsPlansDetails{
+		*savingsplans.New(s, cfg...),
+	}
+}
+
+func (sd *savingsPlansDetails) GetOfferingRates(input *savingsplans.DescribeSavingsPlansOfferingRatesInput) ([]*savingsplans.SavingsPlanOfferingRate, error) {
+	rates := make([]*savingsplans.SavingsPlanOfferingRate, 0)
+
+	for {
+		output, err := sd.DescribeSavingsPlansOfferingRates(input)
+		if err != nil {
+			return nil, emperror.Wrap(err, "failed to retrieve savings plans offering rates")
+		}
+
+		rates = append(rates, output.SearchResults...)
+
+		if aws.StringValue(output.NextToken) == "" {
+			return rates, nil
+		}
+		input.NextToken = output.NextToken
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amazon
+
+import (
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// GetZoneInfo returns the availability zones in a region with their AZ IDs, which identify the same physical location
+// across the accounts unlike the zone names, and the instance types offered in them
+func (e *Ec2Infoer) GetZoneInfo(region string) ([]types.ZoneInfo, error) {
+	if p := e.partitionInfoer(region); p != nil {
+		return p.GetZoneInfo(region)
+	}
+
+	azs, err := e.ec2Describer(region).DescribeAvailabilityZones(&ec2.DescribeAvailabilityZonesInput{})
+	if err != nil {
+		return nil, err
+	}
+
+	offerings, err := e.instanceTypeOfferings(region)
+	if err != nil {
+		return nil, err
+	}
+
+	zones := make([]types.ZoneInfo, 0, len(azs.AvailabilityZones))
+	for _, az := range azs.AvailabilityZones {
+		if aws.StringValue(az.State) != ec2.AvailabilityZoneStateAvailable {
+			continue
+		}
+
+		zones = append(zones, types.ZoneInfo{
+			Name:          aws.StringValue(az.ZoneName),
+			Id:            aws.StringValue(az.ZoneId),
+			InstanceTypes: offerings[aws.StringValue(az.ZoneName)],
+		})
+	}
+
+	return zones, nil
+}
+
+// instanceTypeOfferings returns the instance types offered per availability zone in a region, ordered by name
+func (e *Ec2Infoer) instanceTypeOfferings(region string) (map[string][]string, error) {
+	offerings := make(map[string][]string)
+	err := e.ec2Describer(region).DescribeInstanceTypeOfferingsPages(&ec2.DescribeInstanceTypeOfferingsInput{
+		LocationType: aws.String(ec2.LocationTypeAvailabilityZone),
+	}, func(output *ec2.DescribeInstanceTypeOfferingsOutput, lastPage bool) bool {
+		for _, offering := range output.InstanceTypeOfferings {
+			zone := aws.StringValue(offering.Location)
+			offerings[zone] = append(offerings[zone], aws.StringValue(offering.InstanceType))
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for zone := range offerings {
+		sort.Strings(offerings[zone])
+	}
+
+	return offerings, nil
+}
+
+// addZoneOfferings restricts the zones of the virtual machines to the availability zones they are offered in
+func (e *Ec2Infoer) addZoneOfferings(vms []types.VMInfo, region string) error {
+	offerings, err := e.instanceTypeOfferings(region)
+	if err != nil {
+		return err
+	}
+
+	zones := make(map[string][]string)
+	for zone, instanceTypes := range offerings {
+		for _, instanceType := range instanceTypes {
+			zones[instanceType] = append(zones[instanceType], zone)
+		}
+	}
+
+	for i := range vms {
+		vms[i].Zones = zones[vms[i].Type]
+		sort.Strings(vms[i].Zones)
+	}
+
+	return nil
+}
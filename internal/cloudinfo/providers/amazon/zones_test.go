@@ -0,0 +1,79 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amazon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"logur.dev/logur"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/cloudinfoadapter"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestEc2Infoer_GetZoneInfo(t *testing.T) {
+	tests := []struct {
+		name  string
+		tcId  int
+		check func(zones []types.ZoneInfo, err error)
+	}{
+		{
+			name: "the zones are listed with their ids and offerings",
+			check: func(zones []types.ZoneInfo, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, []types.ZoneInfo{
+					{Name: "eu-central-1a", Id: "euc1-az2", InstanceTypes: []string{"c5.large", "m5.large"}},
+					{Name: "eu-central-1b", Id: "euc1-az3", InstanceTypes: []string{"m5.large"}},
+				}, zones)
+			},
+		},
+		{
+			name: "the failure to list the offerings is returned",
+			tcId: 12,
+			check: func(zones []types.ZoneInfo, err error) {
+				assert.EqualError(t, err, "could not get instance type offerings")
+				assert.Nil(t, zones)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			infoer := &Ec2Infoer{
+				ec2Describer: func(region string) Ec2Describer {
+					return &testStruct{TcId: test.tcId}
+				},
+				log: cloudinfoadapter.NewLogger(&logur.TestLogger{}),
+			}
+
+			test.check(infoer.GetZoneInfo("eu-central-1"))
+		})
+	}
+}
+
+func TestEc2Infoer_addZoneOfferings(t *testing.T) {
+	infoer := &Ec2Infoer{
+		ec2Describer: func(region string) Ec2Describer {
+			return &testStruct{}
+		},
+		log: cloudinfoadapter.NewLogger(&logur.TestLogger{}),
+	}
+	vms := []types.VMInfo{{Type: "m5.large"}, {Type: "c5.large"}, {Type: "p4d.24xlarge"}}
+
+	assert.NoError(t, infoer.addZoneOfferings(vms, "eu-central-1"))
+	assert.Equal(t, []string{"eu-central-1a", "eu-central-1b"}, vms[0].Zones)
+	assert.Equal(t, []string{"eu-central-1a"}, vms[1].Zones)
+	assert.Empty(t, vms[2].Zones, "the instance types not offered in the region should have no zones")
+}
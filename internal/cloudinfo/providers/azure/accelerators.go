@@ -0,0 +1,44 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// fpgaSizes holds the FPGAs of the FPGA accelerated virtual machine sizes (Xilinx Alveo U250 with 64 GiB memory),
+// the resource skus don't provide them
+// source: https://docs.microsoft.com/en-us/azure/virtual-machines/np-series
+var fpgaSizes = map[string]int{
+	"Standard_NP10s": 1,
+	"Standard_NP20s": 2,
+	"Standard_NP40s": 4,
+}
+
+// accelerators returns the FPGAs of the virtual machine size
+func accelerators(size string) []types.Accelerator {
+	count, ok := fpgaSizes[size]
+	if !ok {
+		return nil
+	}
+
+	return []types.Accelerator{{
+		Type:   types.AcceleratorFPGA,
+		Vendor: "xilinx",
+		Model:  "Alveo U250",
+		Count:  count,
+		Memory: float64(count) * 64,
+	}}
+}
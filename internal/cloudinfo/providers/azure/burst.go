@@ -0,0 +1,50 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"strings"
+)
+
+var (
+	// burstBaselines holds the baseline performance of the B-series virtual machines in percent of all their vCPUs
+	// source: https://docs.microsoft.com/en-us/azure/virtual-machines/sizes-b-series-burstable
+	burstBaselines = map[string]float64{
+		"standard_b1ls":  5,
+		"standard_b1s":   10,
+		"standard_b1ms":  20,
+		"standard_b2s":   40,
+		"standard_b2ms":  60,
+		"standard_b4ms":  90,
+		"standard_b8ms":  135,
+		"standard_b12ms": 202,
+		"standard_b16ms": 270,
+		"standard_b20ms": 337,
+	}
+)
+
+// isBurst decides whether the virtual machine belongs to the burstable B-series
+func isBurst(family string) bool {
+	return strings.HasPrefix(strings.ToLower(family), "standardb")
+}
+
+// burstBaseline returns the baseline cpu performance of a B-series virtual machine per vCPU, 0 if unknown
+func burstBaseline(vmType string, cpus float64) float64 {
+	baseline, ok := burstBaselines[strings.ToLower(vmType)]
+	if !ok || cpus == 0 {
+		return 0
+	}
+	return baseline / cpus
+}
@@ -20,6 +20,8 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"emperror.dev/emperror"
 	"emperror.dev/errors"
@@ -45,6 +47,7 @@ var (
 		"au": "australia",
 		"br": "brazil",
 		"ca": "canada",
+		"cn": "china",
 		"eu": "europe",
 		"fr": "france",
 		"in": "india",
@@ -65,8 +68,22 @@ var (
 	mtStandardL, _ = regexp.Compile(`^Standard_L\d+[_v\d]*[_Promo]*$`)
 	mtStandardM, _ = regexp.Compile(`^Standard_M\d+[m|t|l]*s[_v\d]*[_Promo]*$`)
 	mtStandardN, _ = regexp.Compile(`^Standard_N[C|D|V]\d+r?[_v\d]*[_Promo]*$`)
+
+	// rateCardOffers are the pay-as-you-go offers the rate card is queried for in the supported Azure clouds
+	rateCardOffers = map[string]rateCardOffer{
+		azure.PublicCloud.Name:       {offerDurableID: "MS-AZR-0003p", currency: "USD", regionInfo: "US"},
+		azure.USGovernmentCloud.Name: {offerDurableID: "MS-AZR-USGOV-0003P", currency: "USD", regionInfo: "US"},
+		azure.ChinaCloud.Name:        {offerDurableID: "MS-MC-AZR-0033P", currency: "CNY", regionInfo: "CN"},
+	}
 )
 
+// rateCardOffer is the offer, currency and billing region the rate card is queried with
+type rateCardOffer struct {
+	offerDurableID string
+	currency       string
+	regionInfo     string
+}
+
 // AzureInfoer encapsulates the data and operations needed to access external Azure resources
 type AzureInfoer struct {
 	subscriptionId      string
@@ -75,7 +92,24 @@ type AzureInfoer struct {
 	skusClient          ResourceSkuRetriever
 	providersClient     ProviderSource
 	containerSvcClient  VersionRetriever
+	rateCardOffer       rateCardOffer
+	retailPrices        *retailPricesClient
+	rawPayloads         bool
 	log                 cloudinfo.Logger
+
+	// storagePrices, objectStoragePrices, loadBalancerPrices, natGatewayPrices, publicIpPrices, databases, dedicatedHosts and
+	// serverlessPrices are the managed disk, blob storage, load balancer, NAT gateway, public IP, flexible server, dedicated host
+	// and container instance prices per region, retrieved from the rate card at rateCardPricesAt
+	storagePrices       map[string][]types.StoragePrice
+	objectStoragePrices map[string][]types.ObjectStoragePrice
+	loadBalancerPrices  map[string][]types.LoadBalancerPrice
+	natGatewayPrices    map[string][]types.NatGatewayPrice
+	publicIpPrices      map[string][]types.PublicIpPrice
+	databases           map[string][]types.DatabaseInfo
+	dedicatedHosts      map[string][]types.DedicatedHostInfo
+	serverlessPrices    map[string][]types.ServerlessPrice
+	rateCardPricesAt    time.Time
+	rateCardMu          sync.Mutex
 }
 
 // LocationRetriever collects regions
@@ -105,9 +139,26 @@ type ResourceSkuRetriever interface {
 
 // NewAzureInfoer creates a new instance of the Azure infoer.
 func NewAzureInfoer(config Config, logger cloudinfo.Logger) (*AzureInfoer, error) {
+	environmentName := config.Environment
+	if environmentName == "" {
+		environmentName = azure.PublicCloud.Name
+	}
+
+	env, err := azure.EnvironmentFromName(environmentName)
+	if err != nil {
+		return nil, errors.WrapIfWithDetails(err, "invalid azure environment", "environment", environmentName)
+	}
+
+	offer, ok := rateCardOffers[env.Name]
+	if !ok {
+		return nil, errors.NewWithDetails("unsupported azure environment", "environment", env.Name)
+	}
+
 	var authorizer autorest.Authorizer
 	if config.ClientID != "" && config.ClientSecret != "" && config.TenantID != "" {
 		credentialsConfig := auth.NewClientCredentialsConfig(config.ClientID, config.ClientSecret, config.TenantID)
+		credentialsConfig.AADEndpoint = env.ActiveDirectoryEndpoint
+		credentialsConfig.Resource = env.ResourceManagerEndpoint
 		a, err := credentialsConfig.Authorizer()
 		if err != nil {
 			return nil, emperror.Wrap(err, "failed to build authorizer")
@@ -117,10 +168,14 @@ func NewAzureInfoer(config Config, logger cloudinfo.Logger) (*AzureInfoer, error
 	}
 
 	if authorizer == nil {
-		a, err := auth.NewAuthorizerFromEnvironment()
-		authorizer = a
+		settings, err := auth.GetSettingsFromEnvironment()
+		if err == nil {
+			settings.Environment = env
+			settings.Values[auth.Resource] = env.ResourceManagerEndpoint
+			authorizer, err = settings.GetAuthorizer()
+		}
 		if err != nil { // Failed to create authorizer from environment, try from file
-			a, err := auth.NewAuthorizerFromFile(azure.PublicCloud.ResourceManagerEndpoint)
+			a, err := auth.NewAuthorizerFromFile(env.ResourceManagerEndpoint)
 			if err != nil {
 				return nil, errors.Wrap(err, "failed to get authorizer from both env and file")
 			}
@@ -129,21 +184,35 @@ func NewAzureInfoer(config Config, logger cloudinfo.Logger) (*AzureInfoer, error
 		}
 	}
 
-	sClient := subscriptions.NewClient()
+	sClient := subscriptions.NewClientWithBaseURI(env.ResourceManagerEndpoint)
 	sClient.Authorizer = authorizer
 
-	rcClient := commerce.NewRateCardClient(config.SubscriptionID)
+	rcClient := commerce.NewRateCardClientWithBaseURI(env.ResourceManagerEndpoint, config.SubscriptionID)
 	rcClient.Authorizer = authorizer
 
-	skusClient := skus.NewResourceSkusClient(config.SubscriptionID)
+	skusClient := skus.NewResourceSkusClientWithBaseURI(env.ResourceManagerEndpoint, config.SubscriptionID)
 	skusClient.Authorizer = authorizer
 
-	providersClient := resources.NewProvidersClient(config.SubscriptionID)
+	providersClient := resources.NewProvidersClientWithBaseURI(env.ResourceManagerEndpoint, config.SubscriptionID)
 	providersClient.Authorizer = authorizer
 
-	containerServiceClient := containerservice.NewContainerServicesClient(config.SubscriptionID)
+	containerServiceClient := containerservice.NewContainerServicesClientWithBaseURI(env.ResourceManagerEndpoint, config.SubscriptionID)
 	containerServiceClient.Authorizer = authorizer
 
+	if config.UserAgent != "" {
+		for _, client := range []*autorest.Client{&sClient.Client, &rcClient.Client, &skusClient.Client, &providersClient.Client, &containerServiceClient.Client} {
+			if err := client.AddToUserAgent(config.UserAgent); err != nil {
+				return nil, errors.Wrap(err, "failed to set user agent")
+			}
+		}
+	}
+
+	// the reservation prices are only published for the public cloud
+	var retailPrices *retailPricesClient
+	if env.Name == azure.PublicCloud.Name {
+		retailPrices = newRetailPricesClient(config.UserAgent)
+	}
+
 	return &AzureInfoer{
 		subscriptionId:      config.SubscriptionID,
 		subscriptionsClient: sClient,
@@ -151,6 +220,9 @@ func NewAzureInfoer(config Config, logger cloudinfo.Logger) (*AzureInfoer, error
 		rateCardClient:      rcClient,
 		providersClient:     providersClient,
 		containerSvcClient:  &containerServiceClient,
+		rateCardOffer:       offer,
+		retailPrices:        retailPrices,
+		rawPayloads:         config.RawPayloads,
 		log:                 logger,
 	}, nil
 }
@@ -208,8 +280,7 @@ func (a *AzureInfoer) Initialize() (map[string]map[string]types.Price, error) {
 		return nil, err
 	}
 
-	rateCardFilter := "OfferDurableId eq 'MS-AZR-0003p' and Currency eq 'USD' and Locale eq 'en-US' and RegionInfo eq 'US'"
-	result, err := a.rateCardClient.Get(context.TODO(), rateCardFilter)
+	result, err := a.rateCardClient.Get(context.TODO(), a.rateCardOffer.filter())
 	if err != nil {
 		return nil, err
 	}
@@ -217,44 +288,53 @@ func (a *AzureInfoer) Initialize() (map[string]map[string]types.Price, error) {
 	var missingRegions []string
 	for _, v := range *result.Meters {
 		if *v.MeterCategory == "Virtual Machines" && len(*v.MeterTags) == 0 && *v.MeterRegion != "" {
-			if !strings.Contains(*v.MeterSubCategory, "Windows") {
-				region, err := a.toRegionID(*v.MeterRegion, regions)
-				if err != nil {
-					missingRegions = appendIfMissing(missingRegions, *v.MeterRegion)
-					continue
-				}
+			// the windows meters hold the prices with the license included
+			windows := strings.Contains(*v.MeterSubCategory, "Windows")
+			lowPriority := strings.Contains(*v.MeterName, "Low Priority")
+			if windows && lowPriority {
+				continue
+			}
 
-				instanceTypes := a.machineType(*v.MeterName, *v.MeterSubCategory)
+			region, err := a.toRegionID(*v.MeterRegion, regions)
+			if err != nil {
+				missingRegions = appendIfMissing(missingRegions, *v.MeterRegion)
+				continue
+			}
 
-				var priceInUsd float64
+			instanceTypes := a.machineType(*v.MeterName, *v.MeterSubCategory)
 
-				if len(v.MeterRates) < 1 {
-					a.log.Debug("missing rate info", map[string]interface{}{"MeterSubCategory": *v.MeterSubCategory, "region": region})
-					continue
-				}
-				for _, rate := range v.MeterRates {
-					priceInUsd += *rate
-				}
-				if allPrices[region] == nil {
-					allPrices[region] = make(map[string]types.Price)
+			var priceInUsd float64
+
+			if len(v.MeterRates) < 1 {
+				a.log.Debug("missing rate info", map[string]interface{}{"MeterSubCategory": *v.MeterSubCategory, "region": region})
+				continue
+			}
+			for _, rate := range v.MeterRates {
+				priceInUsd += *rate
+			}
+			if allPrices[region] == nil {
+				allPrices[region] = make(map[string]types.Price)
+			}
+			for _, instanceType := range instanceTypes {
+				price := allPrices[region][instanceType]
+				switch {
+				case lowPriority:
+					spotPrice := make(types.SpotPriceInfo)
+					spotPrice[region] = priceInUsd
+					price.SpotPrice = spotPrice
+					metrics.ReportAzureSpotPrice(region, instanceType, priceInUsd)
+				case windows:
+					price.OsPrices = withOsPrice(price.OsPrices, types.OsWindows, priceInUsd)
+				default:
+					price.OnDemandPrice = priceInUsd
+					price.OsPrices = withOsPrice(price.OsPrices, types.OsLinux, priceInUsd)
 				}
-				for _, instanceType := range instanceTypes {
-					price := allPrices[region][instanceType]
-					if !strings.Contains(*v.MeterName, "Low Priority") {
-						price.OnDemandPrice = priceInUsd
-					} else {
-						spotPrice := make(types.SpotPriceInfo)
-						spotPrice[region] = priceInUsd
-						price.SpotPrice = spotPrice
-						metrics.ReportAzureSpotPrice(region, instanceType, priceInUsd)
-					}
 
-					allPrices[region][instanceType] = price
+				allPrices[region][instanceType] = price
 
-					mts := a.getMachineTypeVariants(instanceType)
-					for _, mt := range mts {
-						allPrices[region][mt] = price
-					}
+				mts := a.getMachineTypeVariants(instanceType)
+				for _, mt := range mts {
+					allPrices[region][mt] = price
 				}
 			}
 		}
@@ -265,6 +345,33 @@ func (a *AzureInfoer) Initialize() (map[string]map[string]types.Price, error) {
 	return allPrices, nil
 }
 
+// withOsPrice returns a copy of the operating system prices with the price of an operating system set, the prices
+// are shared by the variants of the machine types
+func withOsPrice(prices map[string]float64, os string, price float64) map[string]float64 {
+	osPrices := make(map[string]float64, len(prices)+1)
+	for k, v := range prices {
+		osPrices[k] = v
+	}
+	osPrices[os] = price
+
+	return osPrices
+}
+
+// filter returns the rate card filter of the offer
+func (o rateCardOffer) filter() string {
+	return fmt.Sprintf("OfferDurableId eq '%s' and Currency eq '%s' and Locale eq 'en-US' and RegionInfo eq '%s'",
+		o.offerDurableID, o.currency, o.regionInfo)
+}
+
+// priceCurrency returns the currency of the prices, empty for USD
+func (o rateCardOffer) priceCurrency() string {
+	if o.currency == "USD" {
+		return ""
+	}
+
+	return o.currency
+}
+
 func (a *AzureInfoer) machineType(meterName string, subCategory string) []string {
 	var instanceTypes = make([]string, 0)
 	name := strings.TrimSuffix(meterName, " Low Priority")
@@ -364,6 +471,8 @@ func (a *AzureInfoer) GetVirtualMachines(region string) ([]types.VMInfo, error)
 				if *sku.ResourceType == "virtualMachines" {
 					var memory float64
 					var cpu float64
+					var gpus float64
+					var architecture string
 					for _, capabilities := range *sku.Capabilities {
 						switch *capabilities.Name {
 						case "MemoryGB":
@@ -378,29 +487,67 @@ func (a *AzureInfoer) GetVirtualMachines(region string) ([]types.VMInfo, error)
 								logger.Error("couldn't parse cpu")
 								continue
 							}
+						case "GPUs":
+							gpus, err = strconv.ParseFloat(*capabilities.Value, 64)
+							if err != nil {
+								logger.Error("couldn't parse gpu")
+								continue
+							}
+						case "CpuArchitectureType":
+							// x64 or Arm64 (Ampere Altra and Cobalt)
+							architecture = cloudinfo.Architecture(*capabilities.Value)
 						}
 					}
+					localDisks := skuLocalDisks(*sku.Capabilities)
 					category, err := a.mapCategory(*sku.Family)
 					if err != nil {
 						logger.Debug(emperror.Wrap(err, "failed to get virtual machine category").Error(),
 							map[string]interface{}{"instanceType": *sku.Name})
 					}
 
-					virtualMachines = append(virtualMachines, types.VMInfo{
-						Category:   category,
-						Type:       *sku.Name,
-						Mem:        memory,
-						Cpus:       cpu,
-						NtwPerf:    "1 Gbit/s",
-						NtwPerfCat: types.NtwLow,
-						Zones:      *locationInfo.Zones,
-						Attributes: cloudinfo.Attributes(fmt.Sprint(cpu), fmt.Sprint(memory), types.NtwLow, category),
-					})
+					vm := types.VMInfo{
+						Category:      category,
+						Type:          *sku.Name,
+						Mem:           memory,
+						Cpus:          cpu,
+						Gpus:          gpus,
+						NtwPerf:       "1 Gbit/s",
+						NtwPerfCat:    types.NtwLow,
+						NtwPerfGbps:   1,
+						Zones:         *locationInfo.Zones,
+						Burst:         isBurst(*sku.Family),
+						BaselineCPU:   burstBaseline(*sku.Name, cpu),
+						CpuCredits:    cloudinfo.CpuCredits(burstBaseline(*sku.Name, cpu), cpu),
+						LocalDisks:    localDisks,
+						NetworkLimits: skuNetworkLimits(*sku.Capabilities),
+						// IPv6 addresses can be assigned to the network interfaces of all the sizes
+						AcceleratedNetworking: skuAcceleratedNetworking(*sku.Capabilities),
+						IPv6:                  true,
+						// all the sizes can be deployed to proximity placement groups
+						PlacementGroup:      types.PlacementCluster,
+						PlacementStrategies: []string{types.PlacementCluster},
+						Architecture:        architecture,
+						Accelerators:        accelerators(*sku.Name),
+						Currency:            a.rateCardOffer.priceCurrency(),
+						Attributes:          cloudinfo.Attributes(fmt.Sprint(cpu), fmt.Sprint(memory), types.NtwLow, category),
+					}
+					cloudinfo.SetGpu(&vm, gpuDescription(*sku.Family))
+					if a.rawPayloads {
+						vm.RawPayload = cloudinfo.RawPayload(sku)
+					}
+					virtualMachines = append(virtualMachines, vm)
 				}
 			}
 		}
 	}
 
+	if a.retailPrices != nil {
+		if err := a.addReservedPrices(virtualMachines, region); err != nil {
+			// reservation prices are optional, don't break the flow
+			logger.Warn("failed to retrieve reservation prices", map[string]interface{}{"error": err.Error()})
+		}
+	}
+
 	logger.Debug("found virtual machines", map[string]interface{}{"numberOfVms": len(virtualMachines)})
 	return virtualMachines, nil
 }
@@ -119,6 +119,26 @@ func (dps *test) Get(ctx context.Context, filter string) (result commerce.Resour
 					},
 					MeterTags: &[]string{},
 				},
+				{
+					MeterName:        strPointer("F2/F2s"),
+					MeterCategory:    strPointer("Virtual Machines"),
+					MeterSubCategory: strPointer("F/FS Series Windows"),
+					MeterRegion:      strPointer("EU West"),
+					MeterRates: map[string]*float64{
+						"0": floatPointer(0.424),
+					},
+					MeterTags: &[]string{},
+				},
+				{
+					MeterName:        strPointer("F2/F2s Low Priority"),
+					MeterCategory:    strPointer("Virtual Machines"),
+					MeterSubCategory: strPointer("F/FS Series Windows"),
+					MeterRegion:      strPointer("EU West"),
+					MeterRates: map[string]*float64{
+						"0": floatPointer(0.169),
+					},
+					MeterTags: &[]string{},
+				},
 				{
 					MeterName:        strPointer("F2/F2s"),
 					MeterCategory:    strPointer("Virtual Machines"),
@@ -152,6 +172,53 @@ func floatPointer(i float64) *float64 {
 	return &i
 }
 
+func TestNewAzureInfoer_Environment(t *testing.T) {
+	tests := []struct {
+		name        string
+		environment string
+		check       func(info *AzureInfoer, err error)
+	}{
+		{
+			name:        "public cloud by default",
+			environment: "",
+			check: func(info *AzureInfoer, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "OfferDurableId eq 'MS-AZR-0003p' and Currency eq 'USD' and Locale eq 'en-US' and RegionInfo eq 'US'", info.rateCardOffer.filter())
+				assert.Equal(t, "", info.rateCardOffer.priceCurrency())
+			},
+		},
+		{
+			name:        "china cloud",
+			environment: "AzureChinaCloud",
+			check: func(info *AzureInfoer, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "OfferDurableId eq 'MS-MC-AZR-0033P' and Currency eq 'CNY' and Locale eq 'en-US' and RegionInfo eq 'CN'", info.rateCardOffer.filter())
+				assert.Equal(t, "CNY", info.rateCardOffer.priceCurrency())
+			},
+		},
+		{
+			name:        "unsupported cloud",
+			environment: "AzureGermanCloud",
+			check: func(info *AzureInfoer, err error) {
+				assert.EqualError(t, err, "unsupported azure environment")
+			},
+		},
+		{
+			name:        "invalid cloud",
+			environment: "AzureMoonCloud",
+			check: func(info *AzureInfoer, err error) {
+				assert.Error(t, err)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config := Config{ClientID: "client", ClientSecret: "secret", TenantID: "tenant", Environment: test.environment}
+			test.check(NewAzureInfoer(config, cloudinfoadapter.NewLogger(&logur.TestLogger{})))
+		})
+	}
+}
+
 func TestAzureInfoer_toRegionID(t *testing.T) {
 	regionMap := map[string]string{
 		"japanwest":          "Japan West",
@@ -173,6 +240,8 @@ func TestAzureInfoer_toRegionID(t *testing.T) {
 		"koreacentral":       "Korea Central",
 		"koreanorthcentral":  "Korea North Central",
 		"koreanorthcentral2": "Korea North Central 2",
+		"chinaeast2":         "China East 2",
+		"usgovvirginia":      "USGov Virginia",
 		"francecentral":      "France Central",
 		"eastasia":           "East Asia",
 		"canadacentral":      "Canada Central",
@@ -306,6 +375,22 @@ func TestAzureInfoer_toRegionID(t *testing.T) {
 				assert.Nil(t, err, "error should be nil")
 			},
 		},
+		{
+			name:         "successful check china",
+			sourceRegion: "CN East 2",
+			check: func(regionId string, err error) {
+				assert.Equal(t, "chinaeast2", regionId, "invalid region ID returned")
+				assert.Nil(t, err, "error should be nil")
+			},
+		},
+		{
+			name:         "successful check us government",
+			sourceRegion: "US Gov Virginia",
+			check: func(regionId string, err error) {
+				assert.Equal(t, "usgovvirginia", regionId, "invalid region ID returned")
+				assert.Nil(t, err, "error should be nil")
+			},
+		},
 		{
 			name:         "check not supported region",
 			sourceRegion: "US Gov TX",
@@ -666,6 +751,8 @@ func TestAzureInfoer_Initialize(t *testing.T) {
 				}
 				assert.ElementsMatch(t, onDemandPrice, []float64{0.332, 0.332, 0.132, 0.132})
 				assert.ElementsMatch(t, spotPrice, []float64{0.077, 0.077})
+				assert.Equal(t, map[string]float64{types.OsLinux: 0.332, types.OsWindows: 0.424}, prices["westeurope"]["Standard_F2"].OsPrices)
+				assert.Equal(t, map[string]float64{types.OsLinux: 0.132}, prices["centralus"]["Standard_F2s"].OsPrices)
 				assert.Nil(t, err, "the error should be nil")
 			},
 		},
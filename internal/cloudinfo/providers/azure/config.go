@@ -20,4 +20,12 @@ type Config struct {
 	ClientID     string
 	ClientSecret string
 	TenantID     string
+
+	// Environment is the name of the Azure cloud, eg.: AzurePublicCloud, AzureUSGovernmentCloud or AzureChinaCloud
+	Environment string
+
+	UserAgent string
+
+	// RawPayloads enables retaining the raw provider responses of the products, set from the scrape configuration
+	RawPayloads bool `mapstructure:"-"`
 }
@@ -0,0 +1,129 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/commerce/mgmt/2015-06-01-preview/commerce"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// flexibleServerEngines maps the meter categories of the flexible servers to the database engines
+var flexibleServerEngines = map[string]string{
+	"Azure Database for MySQL":      types.DatabaseMySQL,
+	"Azure Database for PostgreSQL": types.DatabasePostgreSQL,
+}
+
+// flexibleServerSeries describes a compute series of the flexible servers priced per vCore
+type flexibleServerSeries struct {
+	// subCategory the meter sub category of the series, following the "Flexible Server" prefix
+	subCategory string
+	// classFormat the format of the instance class names with the number of vCores
+	classFormat string
+	// memPerCore the memory per vCore in GiB
+	memPerCore float64
+}
+
+// flexibleServerSeriesList the general purpose and memory optimized series of the flexible servers
+var flexibleServerSeriesList = []flexibleServerSeries{
+	{subCategory: "General Purpose - Ddsv4 Series", classFormat: "Standard_D%dds_v4", memPerCore: 4},
+	{subCategory: "Memory Optimized - Edsv4 Series", classFormat: "Standard_E%dds_v4", memPerCore: 8},
+}
+
+// flexibleServerCores the vCore counts of the instance classes available in both series
+var flexibleServerCores = []float64{2, 4, 8, 16, 32, 48, 64}
+
+// GetDatabases retrieves the flexible server instance classes of the MySQL and PostgreSQL engines and their prices in a region
+func (a *AzureInfoer) GetDatabases(region string) ([]types.DatabaseInfo, error) {
+	a.rateCardMu.Lock()
+	defer a.rateCardMu.Unlock()
+
+	if err := a.refreshRateCardPrices(); err != nil {
+		return nil, err
+	}
+
+	return a.databases[region], nil
+}
+
+// flexibleServerDatabases assembles the flexible server instance classes per region from the hourly vCore meters,
+// the zone redundant high availability doubles the price as the standby server is billed like the primary one
+func flexibleServerDatabases(meters []commerce.MeterInfo, toRegionID func(meterRegion string) (string, error), currency string) map[string][]types.DatabaseInfo {
+	databases := make(map[string][]types.DatabaseInfo)
+	for _, meter := range meters {
+		if meter.MeterCategory == nil || meter.MeterSubCategory == nil || meter.MeterName == nil || meter.MeterRegion == nil ||
+			*meter.MeterName != "vCore" {
+			continue
+		}
+
+		engine, ok := flexibleServerEngines[*meter.MeterCategory]
+		if !ok {
+			continue
+		}
+
+		rate, ok := meter.MeterRates["0"]
+		if !ok || rate == nil || *rate == 0 {
+			continue
+		}
+
+		region, err := toRegionID(*meter.MeterRegion)
+		if err != nil {
+			continue
+		}
+
+		for _, series := range flexibleServerSeriesList {
+			if *meter.MeterSubCategory != "Flexible Server "+series.subCategory {
+				continue
+			}
+
+			for _, cores := range flexibleServerCores {
+				database := types.DatabaseInfo{
+					InstanceClass: fmt.Sprintf(series.classFormat, int(cores)),
+					Engine:        engine,
+					Deployment:    types.DatabaseSingleZone,
+					Cpus:          cores,
+					Mem:           cores * series.memPerCore,
+					OnDemandPrice: cores * *rate,
+					Currency:      currency,
+				}
+				highAvailability := database
+				highAvailability.Deployment = types.DatabaseHighAvailability
+				highAvailability.OnDemandPrice = 2 * database.OnDemandPrice
+
+				databases[region] = append(databases[region], database, highAvailability)
+			}
+		}
+	}
+
+	for region := range databases {
+		sort.Slice(databases[region], func(i, j int) bool {
+			a, b := databases[region][i], databases[region][j]
+			if a.Engine != b.Engine {
+				return a.Engine < b.Engine
+			}
+			if a.Deployment != b.Deployment {
+				return a.Deployment > b.Deployment
+			}
+			if a.Cpus != b.Cpus {
+				return a.Cpus < b.Cpus
+			}
+			return a.Mem < b.Mem
+		})
+	}
+
+	return databases
+}
@@ -0,0 +1,64 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"testing"
+
+	"emperror.dev/errors"
+	"github.com/Azure/azure-sdk-for-go/services/preview/commerce/mgmt/2015-06-01-preview/commerce"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestFlexibleServerDatabases(t *testing.T) {
+	toRegionID := func(meterRegion string) (string, error) {
+		if meterRegion == "EU West" {
+			return "westeurope", nil
+		}
+		return "", errors.New("unknown region")
+	}
+	meter := func(category, subCategory, name string, rate float64) commerce.MeterInfo {
+		m := diskMeter(subCategory, name, "EU West", rate)
+		m.MeterCategory = strPointer(category)
+		return m
+	}
+
+	databases := flexibleServerDatabases([]commerce.MeterInfo{
+		meter("Azure Database for MySQL", "Flexible Server General Purpose - Ddsv4 Series", "vCore", 0.0845),
+		meter("Azure Database for MySQL", "Flexible Server General Purpose - Ddsv4 Series", "Storage Data Stored", 0.115),
+		meter("Azure Database for PostgreSQL", "Single Server General Purpose - Gen5", "vCore", 0.1755),
+		meter("SQL Database", "Flexible Server General Purpose - Ddsv4 Series", "vCore", 0.2),
+	}, toRegionID, "")
+
+	assert.Len(t, databases["westeurope"], 2*len(flexibleServerCores), "the single zone and high availability classes should be listed")
+	assert.Equal(t, types.DatabaseInfo{
+		InstanceClass: "Standard_D2ds_v4",
+		Engine:        types.DatabaseMySQL,
+		Deployment:    types.DatabaseSingleZone,
+		Cpus:          2,
+		Mem:           8,
+		OnDemandPrice: 2 * 0.0845,
+	}, databases["westeurope"][0])
+	assert.Equal(t, types.DatabaseInfo{
+		InstanceClass: "Standard_D2ds_v4",
+		Engine:        types.DatabaseMySQL,
+		Deployment:    types.DatabaseHighAvailability,
+		Cpus:          2,
+		Mem:           8,
+		OnDemandPrice: 4 * 0.0845,
+	}, databases["westeurope"][len(flexibleServerCores)])
+}
@@ -0,0 +1,79 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"regexp"
+	"sort"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/commerce/mgmt/2015-06-01-preview/commerce"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// dedicatedHostMeterName matches the meter names of the dedicated host SKUs with the virtual machine series and the
+// hardware generation, eg.: DSv3 Type1
+var dedicatedHostMeterName = regexp.MustCompile(`^(\w+) Type ?(\d+)$`)
+
+// GetDedicatedHosts retrieves the dedicated host SKUs and their prices in a region
+func (a *AzureInfoer) GetDedicatedHosts(region string) ([]types.DedicatedHostInfo, error) {
+	a.rateCardMu.Lock()
+	defer a.rateCardMu.Unlock()
+
+	if err := a.refreshRateCardPrices(); err != nil {
+		return nil, err
+	}
+
+	return a.dedicatedHosts[region], nil
+}
+
+// dedicatedHosts assembles the dedicated host SKUs per region from their hourly meters, eg.: DSv3-Type1
+func dedicatedHosts(meters []commerce.MeterInfo, toRegionID func(meterRegion string) (string, error), currency string) map[string][]types.DedicatedHostInfo {
+	hosts := make(map[string][]types.DedicatedHostInfo)
+	for _, meter := range meters {
+		if meter.MeterCategory == nil || *meter.MeterCategory != "Virtual Machines Dedicated Host" ||
+			meter.MeterName == nil || meter.MeterRegion == nil {
+			continue
+		}
+
+		match := dedicatedHostMeterName.FindStringSubmatch(*meter.MeterName)
+		if match == nil {
+			continue
+		}
+
+		rate, ok := meter.MeterRates["0"]
+		if !ok || rate == nil || *rate == 0 {
+			continue
+		}
+
+		region, err := toRegionID(*meter.MeterRegion)
+		if err != nil {
+			continue
+		}
+
+		hosts[region] = append(hosts[region], types.DedicatedHostInfo{
+			Type:          match[1] + "-Type" + match[2],
+			Family:        match[1],
+			OnDemandPrice: *rate,
+			Currency:      currency,
+		})
+	}
+
+	for region := range hosts {
+		sort.Slice(hosts[region], func(i, j int) bool { return hosts[region][i].Type < hosts[region][j].Type })
+	}
+
+	return hosts
+}
@@ -0,0 +1,53 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"testing"
+
+	"emperror.dev/errors"
+	"github.com/Azure/azure-sdk-for-go/services/preview/commerce/mgmt/2015-06-01-preview/commerce"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestDedicatedHosts(t *testing.T) {
+	toRegionID := func(meterRegion string) (string, error) {
+		if meterRegion == "EU West" {
+			return "westeurope", nil
+		}
+		return "", errors.New("unknown region")
+	}
+	meter := func(category, name, region string, rate float64) commerce.MeterInfo {
+		m := diskMeter("", name, region, rate)
+		m.MeterCategory = strPointer(category)
+		return m
+	}
+
+	hosts := dedicatedHosts([]commerce.MeterInfo{
+		meter("Virtual Machines Dedicated Host", "ESv3 Type2", "EU West", 5.544),
+		meter("Virtual Machines Dedicated Host", "DSv3 Type1", "EU West", 4.313),
+		meter("Virtual Machines Dedicated Host", "DSv3 Type1", "US Moon", 4.1),
+		meter("Virtual Machines", "D2s v3", "EU West", 0.096),
+	}, toRegionID, "")
+
+	assert.Equal(t, map[string][]types.DedicatedHostInfo{
+		"westeurope": {
+			{Type: "DSv3-Type1", Family: "DSv3", OnDemandPrice: 4.313},
+			{Type: "ESv3-Type2", Family: "ESv3", OnDemandPrice: 5.544},
+		},
+	}, hosts)
+}
@@ -0,0 +1,40 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"strings"
+)
+
+// gpuFamilies holds the descriptions of the GPUs of the GPU virtual machine families by (lowercase) family name,
+// the resource skus only provide their number
+// source: https://docs.microsoft.com/en-us/azure/virtual-machines/sizes-gpu
+var gpuFamilies = map[string]string{
+	"standardncfamily":          "NVIDIA Tesla K80",
+	"standardncsv2family":       "NVIDIA Tesla P100",
+	"standardncsv3family":       "NVIDIA Tesla V100",
+	"standardncast4_v3family":   "NVIDIA T4",
+	"standardncadsa100v4family": "NVIDIA A100 80 GB",
+	"standardndsfamily":         "NVIDIA Tesla P40",
+	"standardndsv2family":       "NVIDIA Tesla V100 32 GB",
+	"standardndasv4_a100family": "NVIDIA A100",
+	"standardnvfamily":          "NVIDIA Tesla M60",
+	"standardnvsv3family":       "NVIDIA Tesla M60",
+}
+
+// gpuDescription returns the description of the GPUs of the virtual machine family, empty if unknown
+func gpuDescription(family string) string {
+	return gpuFamilies[strings.ToLower(family)]
+}
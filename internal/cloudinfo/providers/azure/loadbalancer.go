@@ -0,0 +1,101 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/commerce/mgmt/2015-06-01-preview/commerce"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// loadBalancerMeters maps the meter categories and sub categories of the load balancers to the load balancer types
+var loadBalancerMeters = map[string]string{
+	"Load Balancer/Standard":          "standard",
+	"Application Gateway/Standard v2": "application-gateway-v2",
+}
+
+// GetLoadBalancerPrices retrieves the prices of the standard load balancer and the v2 application gateway in a region
+func (a *AzureInfoer) GetLoadBalancerPrices(region string) ([]types.LoadBalancerPrice, error) {
+	a.rateCardMu.Lock()
+	defer a.rateCardMu.Unlock()
+
+	if err := a.refreshRateCardPrices(); err != nil {
+		return nil, err
+	}
+
+	return a.loadBalancerPrices[region], nil
+}
+
+// loadBalancerPrices assembles the load balancer prices per region from the hourly rule, fixed cost, capacity unit
+// and the processed data meters
+func loadBalancerPrices(meters []commerce.MeterInfo, toRegionID func(meterRegion string) (string, error), currency string) map[string][]types.LoadBalancerPrice {
+	prices := make(map[string]map[string]*types.LoadBalancerPrice)
+	for _, meter := range meters {
+		if meter.MeterCategory == nil || meter.MeterSubCategory == nil || meter.MeterName == nil || meter.MeterRegion == nil {
+			continue
+		}
+
+		loadBalancerType, ok := loadBalancerMeters[*meter.MeterCategory+"/"+*meter.MeterSubCategory]
+		if !ok {
+			continue
+		}
+
+		rate, ok := meter.MeterRates["0"]
+		if !ok || rate == nil {
+			continue
+		}
+
+		region, err := toRegionID(*meter.MeterRegion)
+		if err != nil {
+			continue
+		}
+
+		if prices[region] == nil {
+			prices[region] = make(map[string]*types.LoadBalancerPrice)
+		}
+		loadBalancerPrice, ok := prices[region][loadBalancerType]
+		if !ok {
+			loadBalancerPrice = &types.LoadBalancerPrice{Type: loadBalancerType, Currency: currency}
+			prices[region][loadBalancerType] = loadBalancerPrice
+		}
+
+		// the first five rules of a standard load balancer are included in its hourly price
+		switch name := *meter.MeterName; {
+		case strings.Contains(name, "Included LB Rules"), strings.Contains(name, "Fixed Cost"):
+			loadBalancerPrice.PricePerHour = *rate
+		case strings.Contains(name, "Capacity Unit"):
+			loadBalancerPrice.PricePerCapacityUnit = *rate
+		case strings.Contains(name, "Data Processed"):
+			loadBalancerPrice.PricePerGb = *rate
+		}
+	}
+
+	regionPrices := make(map[string][]types.LoadBalancerPrice, len(prices))
+	for region, loadBalancerTypes := range prices {
+		for _, price := range loadBalancerTypes {
+			if price.PricePerHour > 0 {
+				regionPrices[region] = append(regionPrices[region], *price)
+			}
+		}
+		sort.Slice(regionPrices[region], func(i, j int) bool {
+			return regionPrices[region][i].Type < regionPrices[region][j].Type
+		})
+	}
+
+	return regionPrices
+}
@@ -0,0 +1,56 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"testing"
+
+	"emperror.dev/errors"
+	"github.com/Azure/azure-sdk-for-go/services/preview/commerce/mgmt/2015-06-01-preview/commerce"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestLoadBalancerPrices(t *testing.T) {
+	toRegionID := func(meterRegion string) (string, error) {
+		if meterRegion == "EU West" {
+			return "westeurope", nil
+		}
+		return "", errors.New("unknown region")
+	}
+
+	meter := func(category, subCategory, name string, rate float64) commerce.MeterInfo {
+		m := diskMeter(subCategory, name, "EU West", rate)
+		m.MeterCategory = strPointer(category)
+		return m
+	}
+
+	prices := loadBalancerPrices([]commerce.MeterInfo{
+		meter("Load Balancer", "Standard", "Standard Included LB Rules and Outbound Rules", 0.025),
+		meter("Load Balancer", "Standard", "Standard Overage LB Rules and Outbound Rules", 0.01),
+		meter("Load Balancer", "Standard", "Standard Data Processed", 0.005),
+		meter("Application Gateway", "Standard v2", "Standard Fixed Cost", 0.246),
+		meter("Application Gateway", "Standard v2", "Standard Capacity Units", 0.008),
+		meter("Application Gateway", "Standard", "Small Gateway", 0.025),
+	}, toRegionID, "")
+
+	assert.Equal(t, map[string][]types.LoadBalancerPrice{
+		"westeurope": {
+			{Type: "application-gateway-v2", PricePerHour: 0.246, PricePerCapacityUnit: 0.008},
+			{Type: "standard", PricePerHour: 0.025, PricePerGb: 0.005},
+		},
+	}, prices)
+}
@@ -0,0 +1,54 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2017-09-01/skus"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// skuLocalDisks returns the local disks of a virtual machine size from its capabilities: the temporary (resource) SSD
+// disk and the NVMe disks of the storage optimized sizes, the sizes are reported in MiB
+func skuLocalDisks(capabilities []skus.ResourceSkuCapabilities) []types.LocalDisk {
+	values := make(map[string]float64)
+	for _, capability := range capabilities {
+		if capability.Name == nil || capability.Value == nil {
+			continue
+		}
+		if value, err := strconv.ParseFloat(*capability.Value, 64); err == nil {
+			values[*capability.Name] = value
+		}
+	}
+
+	var disks []types.LocalDisk
+	if size := values["MaxResourceVolumeMB"]; size > 0 {
+		disks = append(disks, types.LocalDisk{Count: 1, Size: math.Round(size / 1024), Type: types.LocalDiskSSD})
+	}
+
+	if total := values["NvmeDiskSizeInMiB"]; total > 0 {
+		disk := types.LocalDisk{Count: 1, Size: math.Round(total / 1024), Type: types.LocalDiskNVMe}
+		if perDisk := values["NvmeSizePerDiskInMiB"]; perDisk > 0 {
+			disk.Count = int(math.Round(total / perDisk))
+			disk.Size = math.Round(perDisk / 1024)
+		}
+		disks = append(disks, disk)
+	}
+
+	return disks
+}
@@ -0,0 +1,43 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2017-09-01/skus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func capability(name, value string) skus.ResourceSkuCapabilities {
+	return skus.ResourceSkuCapabilities{Name: &name, Value: &value}
+}
+
+func TestSkuLocalDisks(t *testing.T) {
+	assert.Equal(t, []types.LocalDisk{
+		{Count: 1, Size: 80, Type: types.LocalDiskSSD},
+		{Count: 2, Size: 1788, Type: types.LocalDiskNVMe},
+	}, skuLocalDisks([]skus.ResourceSkuCapabilities{
+		capability("vCPUs", "16"),
+		capability("MaxResourceVolumeMB", "81920"),
+		capability("NvmeDiskSizeInMiB", "3662040"),
+		capability("NvmeSizePerDiskInMiB", "1831020"),
+	}))
+
+	assert.Empty(t, skuLocalDisks([]skus.ResourceSkuCapabilities{capability("MaxResourceVolumeMB", "0")}),
+		"the sizes without temporary disk should have no local disks")
+}
@@ -0,0 +1,131 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"sort"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/commerce/mgmt/2015-06-01-preview/commerce"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// publicIpMeters maps the names of the public IP address meters to the address kinds
+var publicIpMeters = map[string]string{
+	"Standard IPv4 Static Public IP": "standard-static",
+	"Basic IPv4 Static Public IP":    "basic-static",
+	"Basic IPv4 Dynamic Public IP":   "basic-dynamic",
+}
+
+// GetNatGatewayPrices retrieves the hourly and data processing prices of the NAT gateways in a region
+func (a *AzureInfoer) GetNatGatewayPrices(region string) ([]types.NatGatewayPrice, error) {
+	a.rateCardMu.Lock()
+	defer a.rateCardMu.Unlock()
+
+	if err := a.refreshRateCardPrices(); err != nil {
+		return nil, err
+	}
+
+	return a.natGatewayPrices[region], nil
+}
+
+// GetPublicIpPrices retrieves the hourly prices of the public IP addresses in a region
+func (a *AzureInfoer) GetPublicIpPrices(region string) ([]types.PublicIpPrice, error) {
+	a.rateCardMu.Lock()
+	defer a.rateCardMu.Unlock()
+
+	if err := a.refreshRateCardPrices(); err != nil {
+		return nil, err
+	}
+
+	return a.publicIpPrices[region], nil
+}
+
+// natGatewayPrices assembles the NAT gateway prices per region from the gateway hour and the processed data meters
+func natGatewayPrices(meters []commerce.MeterInfo, toRegionID func(meterRegion string) (string, error), currency string) map[string][]types.NatGatewayPrice {
+	prices := make(map[string]*types.NatGatewayPrice)
+	for _, meter := range meters {
+		if meter.MeterCategory == nil || *meter.MeterCategory != "NAT Gateway" || meter.MeterName == nil || meter.MeterRegion == nil {
+			continue
+		}
+
+		rate, ok := meter.MeterRates["0"]
+		if !ok || rate == nil {
+			continue
+		}
+
+		region, err := toRegionID(*meter.MeterRegion)
+		if err != nil {
+			continue
+		}
+
+		natGatewayPrice, ok := prices[region]
+		if !ok {
+			natGatewayPrice = &types.NatGatewayPrice{Type: "nat-gateway", Currency: currency}
+			prices[region] = natGatewayPrice
+		}
+
+		switch *meter.MeterName {
+		case "Standard Gateway":
+			natGatewayPrice.PricePerHour = *rate
+		case "Standard Data Processed":
+			natGatewayPrice.PricePerGb = *rate
+		}
+	}
+
+	regionPrices := make(map[string][]types.NatGatewayPrice, len(prices))
+	for region, price := range prices {
+		if price.PricePerHour > 0 {
+			regionPrices[region] = []types.NatGatewayPrice{*price}
+		}
+	}
+
+	return regionPrices
+}
+
+// publicIpPrices assembles the public IP address prices per region from the hourly IP address meters
+func publicIpPrices(meters []commerce.MeterInfo, toRegionID func(meterRegion string) (string, error), currency string) map[string][]types.PublicIpPrice {
+	regionPrices := make(map[string][]types.PublicIpPrice)
+	for _, meter := range meters {
+		if meter.MeterCategory == nil || *meter.MeterCategory != "Virtual Network" || meter.MeterName == nil || meter.MeterRegion == nil {
+			continue
+		}
+
+		kind, ok := publicIpMeters[*meter.MeterName]
+		if !ok {
+			continue
+		}
+
+		rate, ok := meter.MeterRates["0"]
+		if !ok || rate == nil {
+			continue
+		}
+
+		region, err := toRegionID(*meter.MeterRegion)
+		if err != nil {
+			continue
+		}
+
+		regionPrices[region] = append(regionPrices[region], types.PublicIpPrice{Type: kind, PricePerHour: *rate, Currency: currency})
+	}
+
+	for region := range regionPrices {
+		sort.Slice(regionPrices[region], func(i, j int) bool {
+			return regionPrices[region][i].Type < regionPrices[region][j].Type
+		})
+	}
+
+	return regionPrices
+}
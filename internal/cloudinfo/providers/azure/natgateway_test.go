@@ -0,0 +1,59 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"testing"
+
+	"emperror.dev/errors"
+	"github.com/Azure/azure-sdk-for-go/services/preview/commerce/mgmt/2015-06-01-preview/commerce"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestNatGatewayAndPublicIpPrices(t *testing.T) {
+	toRegionID := func(meterRegion string) (string, error) {
+		if meterRegion == "EU West" {
+			return "westeurope", nil
+		}
+		return "", errors.New("unknown region")
+	}
+
+	meter := func(category, name string, rate float64) commerce.MeterInfo {
+		m := diskMeter("", name, "EU West", rate)
+		m.MeterCategory = strPointer(category)
+		return m
+	}
+
+	meters := []commerce.MeterInfo{
+		meter("NAT Gateway", "Standard Gateway", 0.045),
+		meter("NAT Gateway", "Standard Data Processed", 0.045),
+		meter("Virtual Network", "Standard IPv4 Static Public IP", 0.005),
+		meter("Virtual Network", "Basic IPv4 Dynamic Public IP", 0.004),
+		meter("Virtual Network", "Standard IPv4 Public IP Prefix", 0.006),
+	}
+
+	assert.Equal(t, map[string][]types.NatGatewayPrice{
+		"westeurope": {{Type: "nat-gateway", PricePerHour: 0.045, PricePerGb: 0.045}},
+	}, natGatewayPrices(meters, toRegionID, ""))
+
+	assert.Equal(t, map[string][]types.PublicIpPrice{
+		"westeurope": {
+			{Type: "basic-dynamic", PricePerHour: 0.004},
+			{Type: "standard-static", PricePerHour: 0.005},
+		},
+	}, publicIpPrices(meters, toRegionID, ""))
+}
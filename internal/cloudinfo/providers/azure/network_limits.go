@@ -0,0 +1,56 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"strconv"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2017-09-01/skus"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// ipv4PerInterface the maximum number of private IP addresses of a network interface, the same for all the sizes
+// source: https://docs.microsoft.com/en-us/azure/azure-resource-manager/management/azure-subscription-service-limits#networking-limits
+const ipv4PerInterface = 256
+
+// skuNetworkLimits returns the network interface limits of a virtual machine size from its capabilities
+func skuNetworkLimits(capabilities []skus.ResourceSkuCapabilities) *types.NetworkLimits {
+	for _, capability := range capabilities {
+		if capability.Name == nil || capability.Value == nil || *capability.Name != "MaxNetworkInterfaces" {
+			continue
+		}
+		maxInterfaces, err := strconv.Atoi(*capability.Value)
+		if err != nil || maxInterfaces == 0 {
+			return nil
+		}
+
+		return &types.NetworkLimits{MaxInterfaces: maxInterfaces, IPv4PerInterface: ipv4PerInterface}
+	}
+
+	return nil
+}
+
+// skuAcceleratedNetworking checks whether a virtual machine size supports accelerated networking (SR-IOV)
+func skuAcceleratedNetworking(capabilities []skus.ResourceSkuCapabilities) bool {
+	for _, capability := range capabilities {
+		if capability.Name != nil && capability.Value != nil && *capability.Name == "AcceleratedNetworkingEnabled" {
+			enabled, _ := strconv.ParseBool(*capability.Value)
+			return enabled
+		}
+	}
+
+	return false
+}
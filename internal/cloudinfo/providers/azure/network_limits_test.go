@@ -0,0 +1,38 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2017-09-01/skus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestSkuNetworkLimits(t *testing.T) {
+	assert.Equal(t, &types.NetworkLimits{MaxInterfaces: 4, IPv4PerInterface: 256}, skuNetworkLimits([]skus.ResourceSkuCapabilities{
+		capability("vCPUs", "4"),
+		capability("MaxNetworkInterfaces", "4"),
+	}))
+	assert.Nil(t, skuNetworkLimits([]skus.ResourceSkuCapabilities{capability("vCPUs", "4")}))
+}
+
+func TestSkuAcceleratedNetworking(t *testing.T) {
+	assert.True(t, skuAcceleratedNetworking([]skus.ResourceSkuCapabilities{capability("AcceleratedNetworkingEnabled", "True")}))
+	assert.False(t, skuAcceleratedNetworking([]skus.ResourceSkuCapabilities{capability("AcceleratedNetworkingEnabled", "False")}))
+	assert.False(t, skuAcceleratedNetworking([]skus.ResourceSkuCapabilities{capability("vCPUs", "4")}))
+}
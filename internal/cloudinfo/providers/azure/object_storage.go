@@ -0,0 +1,109 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/commerce/mgmt/2015-06-01-preview/commerce"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// blobMeterSubCategory the meter sub category of the block blobs of the general purpose v2 storage accounts
+const blobMeterSubCategory = "General Block Blob v2"
+
+// blobAccessTiers the access tiers of the block blobs, the prefixes of the meter names
+var blobAccessTiers = []string{"Hot", "Cool", "Archive"}
+
+// GetObjectStoragePrices retrieves the prices of the locally redundant block blob access tiers in a region
+func (a *AzureInfoer) GetObjectStoragePrices(region string) ([]types.ObjectStoragePrice, error) {
+	a.rateCardMu.Lock()
+	defer a.rateCardMu.Unlock()
+
+	if err := a.refreshRateCardPrices(); err != nil {
+		return nil, err
+	}
+
+	return a.objectStoragePrices[region], nil
+}
+
+// blobPrices assembles the locally redundant block blob prices per region from the data stored and operation meters
+func blobPrices(meters []commerce.MeterInfo, toRegionID func(meterRegion string) (string, error), currency string) map[string][]types.ObjectStoragePrice {
+	prices := make(map[string]map[string]*types.ObjectStoragePrice)
+	for _, meter := range meters {
+		if meter.MeterCategory == nil || *meter.MeterCategory != "Storage" || meter.MeterSubCategory == nil ||
+			*meter.MeterSubCategory != blobMeterSubCategory || meter.MeterName == nil || meter.MeterRegion == nil {
+			continue
+		}
+
+		var accessTier string
+		for _, tier := range blobAccessTiers {
+			if strings.HasPrefix(*meter.MeterName, tier+" ") {
+				accessTier = tier
+				break
+			}
+		}
+		if accessTier == "" {
+			continue
+		}
+
+		// the first tier of the tiered data stored prices
+		rate, ok := meter.MeterRates["0"]
+		if !ok || rate == nil {
+			continue
+		}
+
+		region, err := toRegionID(*meter.MeterRegion)
+		if err != nil {
+			continue
+		}
+
+		if prices[region] == nil {
+			prices[region] = make(map[string]*types.ObjectStoragePrice)
+		}
+		blobPrice, ok := prices[region][accessTier]
+		if !ok {
+			blobPrice = &types.ObjectStoragePrice{Class: accessTier, Currency: currency}
+			prices[region][accessTier] = blobPrice
+		}
+
+		// the operations are priced per 10K operations
+		switch name := *meter.MeterName; {
+		case name == accessTier+" LRS Data Stored":
+			blobPrice.PricePerGb = *rate
+		case name == accessTier+" LRS Write Operations":
+			blobPrice.PricePerThousandWrites = *rate / 10
+		case name == accessTier+" Read Operations":
+			blobPrice.PricePerThousandReads = *rate / 10
+		}
+	}
+
+	regionPrices := make(map[string][]types.ObjectStoragePrice, len(prices))
+	for region, accessTiers := range prices {
+		for _, price := range accessTiers {
+			// the operations are only reported for the access tiers with a capacity price
+			if price.PricePerGb > 0 {
+				regionPrices[region] = append(regionPrices[region], *price)
+			}
+		}
+		sort.Slice(regionPrices[region], func(i, j int) bool {
+			return regionPrices[region][i].Class < regionPrices[region][j].Class
+		})
+	}
+
+	return regionPrices
+}
@@ -0,0 +1,61 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"testing"
+
+	"emperror.dev/errors"
+	"github.com/Azure/azure-sdk-for-go/services/preview/commerce/mgmt/2015-06-01-preview/commerce"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestBlobPrices(t *testing.T) {
+	toRegionID := func(meterRegion string) (string, error) {
+		if meterRegion == "EU West" {
+			return "westeurope", nil
+		}
+		return "", errors.New("unknown region")
+	}
+
+	// the rates per 10K operations are converted at runtime, like the scraped ones
+	perThousand := func(rate float64) float64 { return rate / 10 }
+
+	prices := blobPrices([]commerce.MeterInfo{
+		diskMeter(blobMeterSubCategory, "Hot LRS Data Stored", "EU West", 0.0196),
+		diskMeter(blobMeterSubCategory, "Hot LRS Write Operations", "EU West", 0.054),
+		diskMeter(blobMeterSubCategory, "Hot Read Operations", "EU West", 0.0044),
+		diskMeter(blobMeterSubCategory, "Hot GRS Data Stored", "EU West", 0.0392),
+		diskMeter(blobMeterSubCategory, "Archive LRS Data Stored", "EU West", 0.00099),
+		diskMeter(blobMeterSubCategory, "Cool LRS Write Operations", "EU West", 0.1),
+		diskMeter("Tables", "Hot LRS Data Stored", "EU West", 0.045),
+		diskMeter(blobMeterSubCategory, "Hot LRS Data Stored", "Unknown", 0.0196),
+	}, toRegionID, "EUR")
+
+	assert.Equal(t, map[string][]types.ObjectStoragePrice{
+		"westeurope": {
+			{Class: "Archive", PricePerGb: 0.00099, Currency: "EUR"},
+			{
+				Class:                  "Hot",
+				PricePerGb:             0.0196,
+				PricePerThousandWrites: perThousand(0.054),
+				PricePerThousandReads:  perThousand(0.0044),
+				Currency:               "EUR",
+			},
+		},
+	}, prices)
+}
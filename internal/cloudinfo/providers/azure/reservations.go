@@ -0,0 +1,163 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"time"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+const (
+	// retailPricesURL the endpoint of the Azure Retail Prices API, it only serves the prices of the public cloud
+	retailPricesURL = "https://prices.azure.com/api/retail/prices"
+
+	// retailPricesTimeout is the timeout of a single page request, so a hanging api doesn't block the scrape
+	retailPricesTimeout = 30 * time.Second
+)
+
+// reservationTerms maps the reservation terms of the Retail Prices API to the terms of the reserved prices
+var reservationTerms = map[string]string{
+	"1 Year":  "1yr",
+	"3 Years": "3yr",
+}
+
+// retailPrice is a price item of the Retail Prices API
+type retailPrice struct {
+	ArmSkuName      string  `json:"armSkuName"`
+	ReservationTerm string  `json:"reservationTerm"`
+	RetailPrice     float64 `json:"retailPrice"`
+	Type            string  `json:"type"`
+}
+
+// retailPricesPage is a page of the price items of the Retail Prices API
+type retailPricesPage struct {
+	Items        []retailPrice `json:"Items"`
+	NextPageLink string        `json:"NextPageLink"`
+}
+
+// retailPricesClient is a minimal client of the Retail Prices API
+type retailPricesClient struct {
+	httpClient *http.Client
+	baseURL    string
+	userAgent  string
+}
+
+func newRetailPricesClient(userAgent string) *retailPricesClient {
+	return &retailPricesClient{
+		httpClient: &http.Client{Timeout: retailPricesTimeout},
+		baseURL:    retailPricesURL,
+		userAgent:  userAgent,
+	}
+}
+
+// listReservationPrices lists the virtual machine reservation prices of a region in the currency
+func (c *retailPricesClient) listReservationPrices(ctx context.Context, region, currency string) ([]retailPrice, error) {
+	query := url.Values{}
+	query.Set("$filter", fmt.Sprintf("serviceName eq 'Virtual Machines' and armRegionName eq '%s' and priceType eq 'Reservation'", region))
+	if currency != "" {
+		query.Set("currencyCode", currency)
+	}
+
+	var prices []retailPrice
+	for next := c.baseURL + "?" + query.Encode(); next != ""; {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, next, nil)
+		if err != nil {
+			return nil, errors.WrapIf(err, "failed to create request")
+		}
+		if c.userAgent != "" {
+			req.Header.Set("User-Agent", c.userAgent)
+		}
+
+		page, err := c.getPage(req)
+		if err != nil {
+			return nil, errors.WithDetails(err, "region", region)
+		}
+
+		prices = append(prices, page.Items...)
+		next = page.NextPageLink
+	}
+
+	return prices, nil
+}
+
+// getPage retrieves and decodes a page of the price items
+func (c *retailPricesClient) getPage(req *http.Request) (*retailPricesPage, error) {
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to call the retail prices api")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.NewWithDetails("unexpected response from the retail prices api", "status", resp.StatusCode)
+	}
+
+	var page retailPricesPage
+	if err := json.NewDecoder(resp.Body).Decode(&page); err != nil {
+		return nil, errors.WrapIf(err, "failed to decode retail prices api response")
+	}
+
+	return &page, nil
+}
+
+// addReservedPrices attaches the reservation prices of the region to the virtual machines,
+// the reservations are paid upfront or monthly for the same total price
+func (a *AzureInfoer) addReservedPrices(vms []types.VMInfo, region string) error {
+	items, err := a.retailPrices.listReservationPrices(context.Background(), region, a.rateCardOffer.priceCurrency())
+	if err != nil {
+		return err
+	}
+
+	prices := reservedPrices(items)
+	for i := range vms {
+		vms[i].ReservedPrices = prices[vms[i].Type]
+	}
+
+	return nil
+}
+
+// reservedPrices assembles the reserved prices per virtual machine size from the reservation price items,
+// the price of an item is the total price of the term
+func reservedPrices(items []retailPrice) map[string][]types.ReservedPrice {
+	prices := make(map[string][]types.ReservedPrice)
+	for _, item := range items {
+		term, ok := reservationTerms[item.ReservationTerm]
+		if item.Type != "Reservation" || !ok || item.RetailPrice == 0 {
+			continue
+		}
+
+		allUpfront := cloudinfo.NewReservedPrice(term, types.PaymentAllUpfront, item.RetailPrice, 0)
+		monthly := cloudinfo.NewReservedPrice(term, types.PaymentNoUpfront, 0, allUpfront.EffectiveHourlyPrice)
+		prices[item.ArmSkuName] = append(prices[item.ArmSkuName], monthly, allUpfront)
+	}
+
+	for size := range prices {
+		sort.SliceStable(prices[size], func(i, j int) bool {
+			return prices[size][i].Term < prices[size][j].Term
+		})
+	}
+
+	return prices
+}
@@ -0,0 +1,69 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestRetailPricesClient_listReservationPrices(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page := retailPricesPage{}
+		if r.URL.Query().Get("page") == "" {
+			assert.Equal(t, "serviceName eq 'Virtual Machines' and armRegionName eq 'westeurope' and priceType eq 'Reservation'",
+				r.URL.Query().Get("$filter"))
+			assert.Equal(t, "EUR", r.URL.Query().Get("currencyCode"))
+
+			page.Items = []retailPrice{{ArmSkuName: "Standard_D2s_v3", ReservationTerm: "1 Year", RetailPrice: 438, Type: "Reservation"}}
+			page.NextPageLink = server.URL + "?page=2"
+		} else {
+			page.Items = []retailPrice{{ArmSkuName: "Standard_D2s_v3", ReservationTerm: "3 Years", RetailPrice: 876, Type: "Reservation"}}
+		}
+		_ = json.NewEncoder(w).Encode(page)
+	}))
+	defer server.Close()
+
+	client := &retailPricesClient{httpClient: server.Client(), baseURL: server.URL}
+
+	items, err := client.listReservationPrices(context.Background(), "westeurope", "EUR")
+	assert.NoError(t, err)
+	assert.Len(t, items, 2, "all the pages should be listed")
+}
+
+func TestReservedPrices(t *testing.T) {
+	prices := reservedPrices([]retailPrice{
+		{ArmSkuName: "Standard_D2s_v3", ReservationTerm: "3 Years", RetailPrice: 2628, Type: "Reservation"},
+		{ArmSkuName: "Standard_D2s_v3", ReservationTerm: "1 Year", RetailPrice: 876, Type: "Reservation"},
+		{ArmSkuName: "Standard_D2s_v3", ReservationTerm: "5 Years", RetailPrice: 3000, Type: "Reservation"},
+	})
+
+	assert.Equal(t, map[string][]types.ReservedPrice{
+		"Standard_D2s_v3": {
+			{Term: "1yr", PaymentOption: types.PaymentNoUpfront, MonthlyPrice: 73, EffectiveHourlyPrice: 0.1},
+			{Term: "1yr", PaymentOption: types.PaymentAllUpfront, UpfrontPrice: 876, EffectiveHourlyPrice: 0.1},
+			{Term: "3yr", PaymentOption: types.PaymentNoUpfront, MonthlyPrice: 73, EffectiveHourlyPrice: 0.1},
+			{Term: "3yr", PaymentOption: types.PaymentAllUpfront, UpfrontPrice: 2628, EffectiveHourlyPrice: 0.1},
+		},
+	}, prices, "the reservations should be paid monthly or upfront, ordered by term")
+}
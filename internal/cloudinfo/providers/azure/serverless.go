@@ -0,0 +1,139 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/commerce/mgmt/2015-06-01-preview/commerce"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// GetServerlessPrices retrieves the per vCPU-second and per GB-second prices of the container instances in a region
+func (a *AzureInfoer) GetServerlessPrices(region string) ([]types.ServerlessPrice, error) {
+	a.rateCardMu.Lock()
+	defer a.rateCardMu.Unlock()
+
+	if err := a.refreshRateCardPrices(); err != nil {
+		return nil, err
+	}
+
+	return a.serverlessPrices[region], nil
+}
+
+// containerInstancePrices assembles the container instance prices per region from the vCPU and memory duration meters,
+// eg.: "Standard vCPU Duration"; the Windows containers are charged for their software per vCPU on top of the Linux prices
+func containerInstancePrices(meters []commerce.MeterInfo, toRegionID func(meterRegion string) (string, error), currency string) map[string][]types.ServerlessPrice {
+	type durationPrices struct {
+		cpu, mem, windows float64
+	}
+
+	prices := make(map[string]map[string]*durationPrices)
+	for _, meter := range meters {
+		if meter.MeterCategory == nil || *meter.MeterCategory != "Container Instances" || meter.MeterName == nil ||
+			meter.MeterRegion == nil || meter.Unit == nil {
+			continue
+		}
+
+		rate, ok := meter.MeterRates["0"]
+		if !ok || rate == nil {
+			continue
+		}
+
+		price, ok := perSecond(*rate, *meter.Unit)
+		if !ok {
+			continue
+		}
+
+		region, err := toRegionID(*meter.MeterRegion)
+		if err != nil {
+			continue
+		}
+
+		kind := "container-instances"
+		if strings.HasPrefix(*meter.MeterName, "Spot ") {
+			kind = "container-instances-spot"
+		}
+
+		if prices[region] == nil {
+			prices[region] = make(map[string]*durationPrices)
+		}
+		if prices[region][kind] == nil {
+			prices[region][kind] = &durationPrices{}
+		}
+
+		switch name := *meter.MeterName; {
+		case strings.HasSuffix(name, "vCPU Duration"):
+			prices[region][kind].cpu = price
+		case strings.HasSuffix(name, "Memory Duration"):
+			prices[region][kind].mem = price
+		case strings.HasSuffix(name, "Windows Software Duration"):
+			prices[region][kind].windows = price
+		}
+	}
+
+	regionPrices := make(map[string][]types.ServerlessPrice, len(prices))
+	for region, kinds := range prices {
+		for kind, p := range kinds {
+			if p.cpu == 0 || p.mem == 0 {
+				continue
+			}
+
+			regionPrices[region] = append(regionPrices[region], types.ServerlessPrice{Type: kind, Os: types.OsLinux,
+				Architecture: types.ArchitectureAMD64, PricePerVcpuSecond: p.cpu, PricePerGbSecond: p.mem, Currency: currency})
+			if p.windows > 0 {
+				regionPrices[region] = append(regionPrices[region], types.ServerlessPrice{Type: kind, Os: types.OsWindows,
+					Architecture: types.ArchitectureAMD64, PricePerVcpuSecond: p.cpu + p.windows, PricePerGbSecond: p.mem, Currency: currency})
+			}
+		}
+	}
+
+	for region := range regionPrices {
+		sort.Slice(regionPrices[region], func(i, j int) bool {
+			if regionPrices[region][i].Type != regionPrices[region][j].Type {
+				return regionPrices[region][i].Type < regionPrices[region][j].Type
+			}
+			return regionPrices[region][i].Os < regionPrices[region][j].Os
+		})
+	}
+
+	return regionPrices
+}
+
+// perSecond converts the rate of a duration meter to a per second price, eg.: the unit of the rate is "1 Hour",
+// "100 Seconds" or "1 GB Second"
+func perSecond(rate float64, unit string) (float64, bool) {
+	fields := strings.Fields(unit)
+	if len(fields) < 2 {
+		return 0, false
+	}
+
+	quantity, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil || quantity <= 0 {
+		return 0, false
+	}
+
+	switch strings.TrimSuffix(fields[len(fields)-1], "s") {
+	case "Hour":
+		return rate / quantity / 3600, true
+	case "Second":
+		return rate / quantity, true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,62 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"testing"
+
+	"emperror.dev/errors"
+	"github.com/Azure/azure-sdk-for-go/services/preview/commerce/mgmt/2015-06-01-preview/commerce"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestContainerInstancePrices(t *testing.T) {
+	toRegionID := func(meterRegion string) (string, error) {
+		if meterRegion == "EU West" {
+			return "westeurope", nil
+		}
+		return "", errors.New("unknown region")
+	}
+	meter := func(name, unit string, rate float64) commerce.MeterInfo {
+		m := diskMeter("", name, "EU West", rate)
+		m.MeterCategory = strPointer("Container Instances")
+		m.Unit = strPointer(unit)
+		return m
+	}
+
+	prices := containerInstancePrices([]commerce.MeterInfo{
+		meter("Standard vCPU Duration", "1 Hour", 0.0486),
+		meter("Standard Memory Duration", "1 GB Hour", 0.0054),
+		meter("Standard Windows Software Duration", "100 Seconds", 0.0012),
+		meter("Spot vCPU Duration", "1 Hour", 0.0054),
+		meter("Spot Memory Duration", "1 GB Hour", 0.0006),
+		meter("Standard GPU K80 Duration", "1 Hour", 1.2),
+	}, toRegionID, "")
+
+	expected := []types.ServerlessPrice{
+		{Type: "container-instances", Os: "linux", Architecture: "amd64", PricePerVcpuSecond: 0.0000135, PricePerGbSecond: 0.0000015},
+		{Type: "container-instances", Os: "windows", Architecture: "amd64", PricePerVcpuSecond: 0.0000255, PricePerGbSecond: 0.0000015},
+		{Type: "container-instances-spot", Os: "linux", Architecture: "amd64", PricePerVcpuSecond: 0.0000015, PricePerGbSecond: 0.00000016666666666666666},
+	}
+	assert.Len(t, prices, 1)
+	assert.Len(t, prices["westeurope"], len(expected))
+	for i, price := range prices["westeurope"] {
+		assert.Equal(t, []string{expected[i].Type, expected[i].Os}, []string{price.Type, price.Os})
+		assert.InDelta(t, expected[i].PricePerVcpuSecond, price.PricePerVcpuSecond, 1e-12)
+		assert.InDelta(t, expected[i].PricePerGbSecond, price.PricePerGbSecond, 1e-12)
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"emperror.dev/errors"
+	"github.com/Azure/azure-sdk-for-go/services/preview/commerce/mgmt/2015-06-01-preview/commerce"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+const (
+	// rateCardPricesTTL the time the prices of all the regions retrieved from the rate card are reused for,
+	// so a scrape cycle downloads the rate card only once
+	rateCardPricesTTL = time.Hour
+
+	// hoursPerMonth the number of hours the hourly disk prices are converted to monthly prices with
+	hoursPerMonth = 730
+)
+
+// provisionedDisks maps the meter sub categories of the managed disks priced by provisioned capacity, IOPS and throughput
+// to the disk types; the other managed disks are priced per disk size tier
+var provisionedDisks = map[string]string{
+	"Ultra Disks":          "UltraSSD_LRS",
+	"Azure Premium SSD v2": "PremiumV2_LRS",
+}
+
+// snapshotSubCategory the meter sub category of the (incremental) managed disk snapshots stored on standard storage,
+// priced per GB per month
+const snapshotSubCategory = "Standard HDD Managed Disks"
+
+// GetStoragePrices retrieves the prices of the managed disk types priced by provisioned capacity in a region
+func (a *AzureInfoer) GetStoragePrices(region string) ([]types.StoragePrice, error) {
+	a.rateCardMu.Lock()
+	defer a.rateCardMu.Unlock()
+
+	if err := a.refreshRateCardPrices(); err != nil {
+		return nil, err
+	}
+
+	return a.storagePrices[region], nil
+}
+
+// refreshRateCardPrices downloads the rate card and assembles the managed disk, blob storage, load balancer, NAT gateway,
+// public IP, flexible server and dedicated host prices of all the regions if the cached ones are expired; the caller must hold rateCardMu
+func (a *AzureInfoer) refreshRateCardPrices() error {
+	if a.storagePrices != nil && time.Since(a.rateCardPricesAt) <= rateCardPricesTTL {
+		return nil
+	}
+
+	regions, err := a.GetRegions("compute")
+	if err != nil {
+		return err
+	}
+
+	result, err := a.rateCardClient.Get(context.TODO(), a.rateCardOffer.filter())
+	if err != nil {
+		return errors.WrapIf(err, "failed to retrieve rate card")
+	}
+	if result.Meters == nil {
+		return errors.New("no meters in the rate card")
+	}
+
+	toRegionID := func(meterRegion string) (string, error) {
+		return a.toRegionID(meterRegion, regions)
+	}
+	a.storagePrices = diskPrices(*result.Meters, toRegionID, a.rateCardOffer.priceCurrency())
+	a.objectStoragePrices = blobPrices(*result.Meters, toRegionID, a.rateCardOffer.priceCurrency())
+	a.loadBalancerPrices = loadBalancerPrices(*result.Meters, toRegionID, a.rateCardOffer.priceCurrency())
+	a.natGatewayPrices = natGatewayPrices(*result.Meters, toRegionID, a.rateCardOffer.priceCurrency())
+	a.publicIpPrices = publicIpPrices(*result.Meters, toRegionID, a.rateCardOffer.priceCurrency())
+	a.databases = flexibleServerDatabases(*result.Meters, toRegionID, a.rateCardOffer.priceCurrency())
+	a.dedicatedHosts = dedicatedHosts(*result.Meters, toRegionID, a.rateCardOffer.priceCurrency())
+	a.serverlessPrices = containerInstancePrices(*result.Meters, toRegionID, a.rateCardOffer.priceCurrency())
+	a.rateCardPricesAt = time.Now()
+
+	return nil
+}
+
+// diskPrices assembles the monthly managed disk prices per region from the hourly provisioned capacity, IOPS and throughput meters
+func diskPrices(meters []commerce.MeterInfo, toRegionID func(meterRegion string) (string, error), currency string) map[string][]types.StoragePrice {
+	prices := make(map[string]map[string]*types.StoragePrice)
+	snapshotPrices := make(map[string]float64)
+	for _, meter := range meters {
+		if meter.MeterCategory == nil || *meter.MeterCategory != "Storage" || meter.MeterSubCategory == nil ||
+			meter.MeterName == nil || meter.MeterRegion == nil {
+			continue
+		}
+
+		diskType, ok := provisionedDisks[*meter.MeterSubCategory]
+		isSnapshot := *meter.MeterSubCategory == snapshotSubCategory && strings.HasPrefix(*meter.MeterName, "LRS Snapshot")
+		if !ok && !isSnapshot {
+			continue
+		}
+
+		rate, ok := meter.MeterRates["0"]
+		if !ok || rate == nil {
+			continue
+		}
+
+		region, err := toRegionID(*meter.MeterRegion)
+		if err != nil {
+			continue
+		}
+
+		if isSnapshot {
+			snapshotPrices[region] = *rate
+			continue
+		}
+
+		if prices[region] == nil {
+			prices[region] = make(map[string]*types.StoragePrice)
+		}
+		storagePrice, ok := prices[region][diskType]
+		if !ok {
+			storagePrice = &types.StoragePrice{Type: diskType, Currency: currency}
+			prices[region][diskType] = storagePrice
+		}
+
+		monthly := *rate * hoursPerMonth
+		switch {
+		case strings.Contains(*meter.MeterName, "Provisioned Capacity"):
+			storagePrice.PricePerGb = monthly
+		case strings.Contains(*meter.MeterName, "Provisioned IOPS"):
+			storagePrice.PricePerIops = monthly
+		case strings.Contains(*meter.MeterName, "Provisioned Throughput"):
+			storagePrice.PricePerThroughput = monthly
+		}
+	}
+
+	regionPrices := make(map[string][]types.StoragePrice, len(prices))
+	for region, diskTypes := range prices {
+		for _, price := range diskTypes {
+			// the IOPS and throughput are only priced for the disk types with a capacity price
+			if price.PricePerGb > 0 {
+				price.PricePerSnapshotGb = snapshotPrices[region]
+				regionPrices[region] = append(regionPrices[region], *price)
+			}
+		}
+		sort.Slice(regionPrices[region], func(i, j int) bool {
+			return regionPrices[region][i].Type < regionPrices[region][j].Type
+		})
+	}
+
+	return regionPrices
+}
@@ -0,0 +1,73 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"testing"
+
+	"emperror.dev/errors"
+	"github.com/Azure/azure-sdk-for-go/services/preview/commerce/mgmt/2015-06-01-preview/commerce"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func diskMeter(subCategory, name, region string, rate float64) commerce.MeterInfo {
+	return commerce.MeterInfo{
+		MeterCategory:    strPointer("Storage"),
+		MeterSubCategory: strPointer(subCategory),
+		MeterName:        strPointer(name),
+		MeterRegion:      strPointer(region),
+		MeterRates:       map[string]*float64{"0": &rate},
+	}
+}
+
+func TestDiskPrices(t *testing.T) {
+	toRegionID := func(meterRegion string) (string, error) {
+		switch meterRegion {
+		case "US East":
+			return "eastus", nil
+		case "EU West":
+			return "westeurope", nil
+		default:
+			return "", errors.New("unknown region")
+		}
+	}
+
+	// the hourly rates are converted at runtime, like the scraped ones
+	monthly := func(rate float64) float64 { return rate * hoursPerMonth }
+
+	prices := diskPrices([]commerce.MeterInfo{
+		diskMeter("Ultra Disks", "Ultra LRS Provisioned Capacity", "US East", 0.0001),
+		diskMeter("Ultra Disks", "Ultra LRS Provisioned IOPS", "US East", 0.00005),
+		diskMeter("Ultra Disks", "Ultra LRS Provisioned Throughput (MBps)", "US East", 0.001),
+		diskMeter("Azure Premium SSD v2", "Premium LRS Provisioned Capacity", "EU West", 0.0002),
+		diskMeter("Premium SSD Managed Disks", "P10 LRS Disk", "US East", 19.71),
+		diskMeter("Ultra Disks", "Ultra LRS Provisioned Capacity", "Unknown", 0.0001),
+		diskMeter("Standard HDD Managed Disks", "LRS Snapshots", "US East", 0.05),
+		diskMeter("Standard HDD Managed Disks", "ZRS Snapshots", "US East", 0.0625),
+	}, toRegionID, "")
+
+	assert.Equal(t, map[string][]types.StoragePrice{
+		"eastus": {{
+			Type:               "UltraSSD_LRS",
+			PricePerGb:         monthly(0.0001),
+			PricePerIops:       monthly(0.00005),
+			PricePerThroughput: monthly(0.001),
+			PricePerSnapshotGb: 0.05,
+		}},
+		"westeurope": {{Type: "PremiumV2_LRS", PricePerGb: monthly(0.0002)}},
+	}, prices)
+}
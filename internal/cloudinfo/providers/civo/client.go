@@ -0,0 +1,131 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civo
+
+import (
+	"context"
+	"net/http"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/restapi"
+)
+
+const (
+	apiURL = "https://api.civo.com/v2"
+
+	// billedHours is the number of hours of a month the instances are billed for at most
+	billedHours = 730
+)
+
+// size is an instance size of the Civo API
+type size struct {
+	Name         string  `json:"name"`
+	Type         string  `json:"type"`
+	CPUCores     int     `json:"cpu_cores"`
+	GPUCount     int     `json:"gpu_count"`
+	GPUType      string  `json:"gpu_type"`
+	RAMMegabytes int     `json:"ram_mb"`
+	Selectable   bool    `json:"selectable"`
+	PriceMonthly float64 `json:"price_monthly"`
+	PriceHourly  float64 `json:"price_hourly"`
+}
+
+// region is a region of the Civo API
+type region struct {
+	Code          string `json:"code"`
+	Name          string `json:"name"`
+	OutOfCapacity bool   `json:"out_of_capacity"`
+}
+
+// kubernetesVersion is a k3s version of the Civo Kubernetes service
+type kubernetesVersion struct {
+	Version string `json:"version"`
+	Type    string `json:"type"`
+	Default bool   `json:"default"`
+}
+
+// client is a minimal client of the Civo API
+type client struct {
+	api     *restapi.Client
+	baseURL string
+	apiKey  string
+}
+
+func newClient(config Config) *client {
+	return &client{
+		api:     restapi.NewClient("civo", config.UserAgent),
+		baseURL: apiURL,
+		apiKey:  config.APIKey,
+	}
+}
+
+// get retrieves a resource of the api and decodes it into the given value
+func (c *client) get(ctx context.Context, path string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+path, nil)
+	if err != nil {
+		return errors.WrapIf(err, "failed to create request")
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.apiKey)
+
+	return c.api.Do(req, v)
+}
+
+// listSizes lists the selectable instance, kubernetes node and database sizes
+func (c *client) listSizes(ctx context.Context) ([]size, error) {
+	var sizes []size
+	if err := c.get(ctx, "/sizes", &sizes); err != nil {
+		return nil, err
+	}
+
+	selectable := sizes[:0]
+	for _, s := range sizes {
+		if s.Selectable {
+			selectable = append(selectable, s)
+		}
+	}
+
+	return selectable, nil
+}
+
+// listRegions lists the regions
+func (c *client) listRegions(ctx context.Context) ([]region, error) {
+	var regions []region
+	if err := c.get(ctx, "/regions", &regions); err != nil {
+		return nil, err
+	}
+
+	return regions, nil
+}
+
+// listKubernetesVersions lists the k3s versions of the Civo Kubernetes service
+func (c *client) listKubernetesVersions(ctx context.Context) ([]kubernetesVersion, error) {
+	var versions []kubernetesVersion
+	if err := c.get(ctx, "/kubernetes/versions", &versions); err != nil {
+		return nil, err
+	}
+
+	return versions, nil
+}
+
+// hourlyPrice returns the hourly price of the size, derived from the monthly price if not available
+func (s size) hourlyPrice() float64 {
+	if s.PriceHourly > 0 {
+		return s.PriceHourly
+	}
+
+	return s.PriceMonthly / billedHours
+}
@@ -0,0 +1,247 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+	"github.com/banzaicloud/cloudinfo/internal/platform/log"
+)
+
+const (
+	svcCompute    = "compute"
+	svcKubernetes = "kubernetes"
+)
+
+// sizeTypes are the types of the sizes offered by the services
+var sizeTypes = map[string]string{
+	svcCompute:    "instance",
+	svcKubernetes: "kubernetes",
+}
+
+// CivoInfoer encapsulates the data and operations needed to access external Civo resources.
+type CivoInfoer struct {
+	client *client
+
+	logger cloudinfo.Logger
+}
+
+// NewCivoInfoer creates a new instance of the Civo infoer.
+func NewCivoInfoer(config Config, logger cloudinfo.Logger) (*CivoInfoer, error) {
+	if config.APIKey == "" {
+		return nil, errors.New("civo api key is required")
+	}
+
+	return &CivoInfoer{
+		client: newClient(config),
+		logger: logger,
+	}, nil
+}
+
+// Initialize retrieves the prices of the instance and kubernetes node sizes in all regions, the prices are the same in every region
+func (i *CivoInfoer) Initialize() (map[string]map[string]types.Price, error) {
+	i.logger.Debug("initializing price info")
+
+	ctx := context.Background()
+
+	sizes, err := i.client.listSizes(ctx)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to list sizes")
+	}
+
+	regions, err := i.client.listRegions(ctx)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to list regions")
+	}
+
+	allPrices := make(map[string]map[string]types.Price, len(regions))
+	for _, r := range regions {
+		prices := make(map[string]types.Price)
+		for _, s := range sizes {
+			if s.Type == sizeTypes[svcCompute] || s.Type == sizeTypes[svcKubernetes] {
+				prices[s.Name] = types.Price{
+					OnDemandPrice: s.hourlyPrice(),
+				}
+			}
+		}
+		allPrices[regionID(r.Code)] = prices
+	}
+
+	i.logger.Debug("finished initializing price info")
+	return allPrices, nil
+}
+
+// GetVirtualMachines retrieves the instance sizes available in the region
+func (i *CivoInfoer) GetVirtualMachines(region string) ([]types.VMInfo, error) {
+	return i.getSizes(region, svcCompute)
+}
+
+// getSizes retrieves the sizes of the service available in the region
+func (i *CivoInfoer) getSizes(region, service string) ([]types.VMInfo, error) {
+	logger := log.WithFields(i.logger, map[string]interface{}{"region": region, "service": service})
+	logger.Debug("getting product info")
+
+	sizes, err := i.client.listSizes(context.Background())
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to list sizes")
+	}
+
+	var virtualMachines []types.VMInfo
+	for _, s := range sizes {
+		if s.Type != sizeTypes[service] {
+			continue
+		}
+
+		cpus := float64(s.CPUCores)
+		mem := float64(s.RAMMegabytes) / 1024
+		category := getCategory(s.Name, s.GPUCount)
+
+		virtualMachines = append(virtualMachines, types.VMInfo{
+			Category:      category,
+			Type:          s.Name,
+			OnDemandPrice: s.hourlyPrice(),
+			Currency:      "USD",
+			MonthlyPrice:  s.PriceMonthly,
+			Cpus:          cpus,
+			Mem:           mem,
+			Gpus:          float64(s.GPUCount),
+			NtwPerf:       "N/A",
+			NtwPerfCat:    types.NtwMedium,
+			Zones:         []string{},
+			Attributes:    cloudinfo.Attributes(fmt.Sprint(cpus), fmt.Sprint(mem), types.NtwMedium, category),
+		})
+	}
+
+	logger.Debug("found sizes", map[string]interface{}{"numberOfTypes": len(virtualMachines)})
+	return virtualMachines, nil
+}
+
+// GetProducts retrieves the available sizes of the service in the region
+func (i *CivoInfoer) GetProducts(vms []types.VMInfo, service, regionId string) ([]types.VMInfo, error) {
+	switch service {
+	case svcCompute:
+		if len(vms) > 0 {
+			return vms, nil
+		}
+
+		return i.GetVirtualMachines(regionId)
+	case svcKubernetes:
+		return i.getSizes(regionId, svcKubernetes)
+	default:
+		return nil, errors.NewWithDetails("invalid service", "service", service)
+	}
+}
+
+// GetZones returns the availability zones of the region, Civo has no zones
+func (*CivoInfoer) GetZones(region string) ([]string, error) {
+	return []string{}, nil
+}
+
+// GetRegions retrieves the regions of the service
+func (i *CivoInfoer) GetRegions(service string) (map[string]string, error) {
+	if _, ok := sizeTypes[service]; !ok {
+		return nil, errors.NewWithDetails("invalid service", "service", service)
+	}
+
+	regions, err := i.client.listRegions(context.Background())
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to list regions")
+	}
+
+	regionMap := make(map[string]string, len(regions))
+	for _, r := range regions {
+		regionMap[regionID(r.Code)] = r.Name
+	}
+
+	return regionMap, nil
+}
+
+// HasShortLivedPriceInfo signals that the Civo prices don't change frequently
+func (*CivoInfoer) HasShortLivedPriceInfo() bool {
+	return false
+}
+
+// GetCurrentPrices is not supported, Civo has no spot instances
+func (*CivoInfoer) GetCurrentPrices(region string) (map[string]types.Price, error) {
+	return nil, errors.New("GetCurrentPrices - not yet implemented")
+}
+
+// HasImages signals that images are not scraped for Civo
+func (*CivoInfoer) HasImages() bool {
+	return false
+}
+
+// GetServiceImages is not supported for Civo
+func (*CivoInfoer) GetServiceImages(service, region string) ([]types.Image, error) {
+	return nil, errors.New("GetServiceImages - not yet implemented")
+}
+
+// GetVersions retrieves the stable k3s versions of the Civo Kubernetes service
+func (i *CivoInfoer) GetVersions(service, region string) ([]types.LocationVersion, error) {
+	switch service {
+	case svcKubernetes:
+		kubernetesVersions, err := i.client.listKubernetesVersions(context.Background())
+		if err != nil {
+			return nil, errors.WrapIf(err, "failed to list kubernetes versions")
+		}
+
+		var versions []string
+		var defaultVersion string
+		for _, v := range kubernetesVersions {
+			if v.Type != "stable" {
+				continue
+			}
+
+			versions = append(versions, v.Version)
+			if v.Default {
+				defaultVersion = v.Version
+			}
+		}
+
+		return []types.LocationVersion{types.NewLocationVersion(region, versions, defaultVersion)}, nil
+	default:
+		return []types.LocationVersion{}, nil
+	}
+}
+
+// GetServiceProducts is not supported for Civo
+func (*CivoInfoer) GetServiceProducts(region, service string) ([]types.ProductDetails, error) {
+	return nil, errors.New("GetServiceProducts - not yet implemented")
+}
+
+// regionID returns the identifier of the region from its code, eg.: lon1 for LON1
+func regionID(code string) string {
+	return strings.ToLower(code)
+}
+
+// getCategory maps the size to an instance type category by its family, eg.: g4c (cpu optimized) of g4c.kube.large
+func getCategory(name string, gpus int) string {
+	switch {
+	case gpus > 0:
+		return types.CategoryGpu
+	case strings.HasPrefix(name, "g4c."):
+		return types.CategoryCompute
+	case strings.HasPrefix(name, "g4m."):
+		return types.CategoryMemory
+	default:
+		return types.CategoryGeneral
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package civo
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/cloudinfoadapter"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func newTestServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+
+		switch r.URL.Path {
+		case "/sizes":
+			fmt.Fprint(w, `[
+				{"name": "g3.xsmall", "type": "instance", "cpu_cores": 1, "ram_mb": 1024, "selectable": true, "price_monthly": 5, "price_hourly": 0.01},
+				{"name": "g4c.kube.large", "type": "kubernetes", "cpu_cores": 8, "ram_mb": 16384, "selectable": true, "price_monthly": 146},
+				{"name": "g3.db.small", "type": "database", "cpu_cores": 2, "ram_mb": 4096, "selectable": true, "price_monthly": 40},
+				{"name": "g2.small", "type": "instance", "cpu_cores": 1, "ram_mb": 2048, "selectable": false, "price_monthly": 10}]`)
+		case "/regions":
+			fmt.Fprint(w, `[{"code": "LON1", "name": "London 1"}, {"code": "NYC1", "name": "New York 1"}]`)
+		case "/kubernetes/versions":
+			fmt.Fprint(w, `[
+				{"version": "1.28.7-k3s1", "type": "stable", "default": false},
+				{"version": "1.27.1-k3s1", "type": "stable", "default": true},
+				{"version": "1.22.2-k3s1", "type": "deprecated"}]`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func newTestInfoer(t *testing.T, server *httptest.Server) *CivoInfoer {
+	infoer, err := NewCivoInfoer(Config{APIKey: "secret"}, cloudinfoadapter.NewNoopLogger())
+	assert.NoError(t, err)
+	infoer.client.baseURL = server.URL
+
+	return infoer
+}
+
+func TestCivoInfoer_Initialize(t *testing.T) {
+	server := newTestServer(t)
+	defer server.Close()
+
+	prices, err := newTestInfoer(t, server).Initialize()
+	assert.NoError(t, err)
+
+	expected := map[string]types.Price{
+		"g3.xsmall":      {OnDemandPrice: 0.01},
+		"g4c.kube.large": {OnDemandPrice: 146.0 / billedHours},
+	}
+	assert.Equal(t, map[string]map[string]types.Price{"lon1": expected, "nyc1": expected}, prices)
+}
+
+func TestCivoInfoer_GetRegions(t *testing.T) {
+	server := newTestServer(t)
+	defer server.Close()
+
+	regions, err := newTestInfoer(t, server).GetRegions("kubernetes")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"lon1": "London 1", "nyc1": "New York 1"}, regions)
+}
+
+func TestCivoInfoer_GetProducts(t *testing.T) {
+	server := newTestServer(t)
+	defer server.Close()
+
+	vms, err := newTestInfoer(t, server).GetProducts(nil, "kubernetes", "lon1")
+	assert.NoError(t, err)
+	assert.Equal(t, []types.VMInfo{
+		{
+			Category:      types.CategoryCompute,
+			Type:          "g4c.kube.large",
+			OnDemandPrice: 146.0 / billedHours,
+			Currency:      "USD",
+			MonthlyPrice:  146,
+			Cpus:          8,
+			Mem:           16,
+			NtwPerf:       "N/A",
+			NtwPerfCat:    types.NtwMedium,
+			Zones:         []string{},
+			Attributes:    map[string]string{"cpu": "8", "memory": "16", "networkPerfCategory": types.NtwMedium, "instanceTypeCategory": types.CategoryCompute},
+		},
+	}, vms)
+}
+
+func TestCivoInfoer_GetVersions(t *testing.T) {
+	server := newTestServer(t)
+	defer server.Close()
+
+	versions, err := newTestInfoer(t, server).GetVersions("kubernetes", "lon1")
+	assert.NoError(t, err)
+	assert.Equal(t, []types.LocationVersion{{Location: "lon1", Versions: []string{"1.28.7-k3s1", "1.27.1-k3s1"}, Default: "1.27.1-k3s1"}}, versions)
+}
@@ -0,0 +1,192 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package custom
+
+import (
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+
+	"emperror.dev/errors"
+	"gopkg.in/yaml.v2"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// catalog describes the regions, zones and instance types of the provider:
+//
+//   currency: EUR
+//   regions:
+//     - id: dc1
+//       name: Datacenter 1
+//       zones: [rack-a, rack-b]
+//       instanceTypes:
+//         - type: esx.large
+//           category: General purpose
+//           cpus: 16
+//           memory: 64
+//           price: 0.5
+//           networkPerformance: 10 Gbit/s
+//           networkCategory: high
+type catalog struct {
+	Currency string   `yaml:"currency"`
+	Regions  []region `yaml:"regions"`
+}
+
+// region is a region of the catalog
+type region struct {
+	ID            string         `yaml:"id"`
+	Name          string         `yaml:"name"`
+	Zones         []string       `yaml:"zones"`
+	InstanceTypes []instanceType `yaml:"instanceTypes"`
+}
+
+// instanceType is an instance type of a region of the catalog, the memory is in GiB and the price is hourly
+type instanceType struct {
+	Type               string      `yaml:"type"`
+	Category           string      `yaml:"category"`
+	CPUs               float64     `yaml:"cpus"`
+	Memory             float64     `yaml:"memory"`
+	GPUs               float64     `yaml:"gpus"`
+	GPUVendor          string      `yaml:"gpuVendor"`
+	GPUModel           string      `yaml:"gpuModel"`
+	GPUMemory          float64     `yaml:"gpuMemory"`
+	LocalDisks         []localDisk `yaml:"localDisks"`
+	Price              float64     `yaml:"price"`
+	NetworkPerformance string      `yaml:"networkPerformance"`
+	NetworkCategory    string      `yaml:"networkCategory"`
+	Burst              bool        `yaml:"burst"`
+	BareMetal          bool        `yaml:"bareMetal"`
+	Architecture       string      `yaml:"architecture"`
+}
+
+// localDisk is a group of identical local disks of an instance type, the size is in GB
+type localDisk struct {
+	Count int     `yaml:"count"`
+	Size  float64 `yaml:"size"`
+	Type  string  `yaml:"type"`
+}
+
+var (
+	categories = []string{
+		types.CategoryGeneral, types.CategoryCompute, types.CategoryMemory, types.CategoryGpu, types.CategoryStorage,
+	}
+	networkCategories = []string{types.NtwLow, types.NtwMedium, types.NtwHight, types.NtwExtra}
+	localDiskTypes    = []string{types.LocalDiskNVMe, types.LocalDiskSSD, types.LocalDiskHDD}
+)
+
+// validate checks that the regions and instance types are identified and unique, and their categories are known
+func (c catalog) validate() error {
+	regions := make(map[string]bool, len(c.Regions))
+	for _, r := range c.Regions {
+		if r.ID == "" {
+			return errors.New("region id is required")
+		}
+
+		if regions[r.ID] {
+			return errors.NewWithDetails("duplicate region", "region", r.ID)
+		}
+		regions[r.ID] = true
+
+		instanceTypes := make(map[string]bool, len(r.InstanceTypes))
+		for _, t := range r.InstanceTypes {
+			if t.Type == "" {
+				return errors.NewWithDetails("instance type is required", "region", r.ID)
+			}
+
+			if instanceTypes[t.Type] {
+				return errors.NewWithDetails("duplicate instance type", "region", r.ID, "instanceType", t.Type)
+			}
+			instanceTypes[t.Type] = true
+
+			if t.Category != "" && !cloudinfo.Contains(categories, t.Category) {
+				return errors.NewWithDetails("invalid category", "instanceType", t.Type, "category", t.Category)
+			}
+
+			if t.NetworkCategory != "" && !cloudinfo.Contains(networkCategories, t.NetworkCategory) {
+				return errors.NewWithDetails("invalid network category", "instanceType", t.Type, "networkCategory", t.NetworkCategory)
+			}
+
+			if t.Architecture != "" && !cloudinfo.IsArchitecture(t.Architecture) {
+				return errors.NewWithDetails("invalid architecture", "instanceType", t.Type, "architecture", t.Architecture)
+			}
+
+			for _, disk := range t.LocalDisks {
+				if !cloudinfo.Contains(localDiskTypes, disk.Type) {
+					return errors.NewWithDetails("invalid local disk type", "instanceType", t.Type, "localDiskType", disk.Type)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// region returns the region of the catalog with the id
+func (c catalog) region(id string) (region, bool) {
+	for _, r := range c.Regions {
+		if r.ID == id {
+			return r, true
+		}
+	}
+
+	return region{}, false
+}
+
+// catalogFile is a catalog file reloaded whenever it's modified
+type catalogFile struct {
+	path string
+
+	catalog catalog
+	modTime time.Time
+	mu      sync.Mutex
+}
+
+// get returns the catalog, reloading the file if it has been modified since it was last loaded;
+// the catalog fails to load until an invalid modification is fixed
+func (f *catalogFile) get() (catalog, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	info, err := os.Stat(f.path)
+	if err != nil {
+		return catalog{}, errors.WrapIfWithDetails(err, "failed to stat catalog", "path", f.path)
+	}
+
+	if info.ModTime().Equal(f.modTime) {
+		return f.catalog, nil
+	}
+
+	data, err := ioutil.ReadFile(f.path)
+	if err != nil {
+		return catalog{}, errors.WrapIfWithDetails(err, "failed to read catalog", "path", f.path)
+	}
+
+	var c catalog
+	if err := yaml.UnmarshalStrict(data, &c); err != nil {
+		return catalog{}, errors.WrapIfWithDetails(err, "failed to parse catalog", "path", f.path)
+	}
+
+	if err := c.validate(); err != nil {
+		return catalog{}, errors.WrapIfWithDetails(err, "invalid catalog", "path", f.path)
+	}
+
+	f.catalog = c
+	f.modTime = info.ModTime()
+
+	return f.catalog, nil
+}
@@ -0,0 +1,223 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package custom
+
+import (
+	"fmt"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+	"github.com/banzaicloud/cloudinfo/internal/platform/log"
+)
+
+const svcCompute = "compute"
+
+// CustomInfoer serves the regions, zones and instance types of a user supplied catalog file.
+type CustomInfoer struct {
+	catalog *catalogFile
+
+	logger cloudinfo.Logger
+}
+
+// NewCustomInfoer creates a new instance of the custom infoer, failing if the catalog can't be loaded.
+func NewCustomInfoer(config Config, logger cloudinfo.Logger) (*CustomInfoer, error) {
+	if config.Catalog == "" {
+		return nil, errors.New("custom catalog file is required")
+	}
+
+	catalog := &catalogFile{path: config.Catalog}
+	if _, err := catalog.get(); err != nil {
+		return nil, err
+	}
+
+	return &CustomInfoer{
+		catalog: catalog,
+		logger:  logger,
+	}, nil
+}
+
+// Initialize returns the prices of the instance types in all regions of the catalog
+func (i *CustomInfoer) Initialize() (map[string]map[string]types.Price, error) {
+	i.logger.Debug("initializing price info")
+
+	c, err := i.catalog.get()
+	if err != nil {
+		return nil, err
+	}
+
+	allPrices := make(map[string]map[string]types.Price, len(c.Regions))
+	for _, r := range c.Regions {
+		allPrices[r.ID] = make(map[string]types.Price, len(r.InstanceTypes))
+		for _, t := range r.InstanceTypes {
+			allPrices[r.ID][t.Type] = types.Price{
+				OnDemandPrice: t.Price,
+			}
+		}
+	}
+
+	i.logger.Debug("finished initializing price info")
+	return allPrices, nil
+}
+
+// GetVirtualMachines returns the instance types of the region of the catalog
+func (i *CustomInfoer) GetVirtualMachines(region string) ([]types.VMInfo, error) {
+	logger := log.WithFields(i.logger, map[string]interface{}{"region": region})
+	logger.Debug("getting product info")
+
+	c, err := i.catalog.get()
+	if err != nil {
+		return nil, err
+	}
+
+	r, ok := c.region(region)
+	if !ok {
+		return nil, errors.NewWithDetails("region not found in the catalog", "region", region)
+	}
+
+	virtualMachines := make([]types.VMInfo, 0, len(r.InstanceTypes))
+	for _, t := range r.InstanceTypes {
+		category := t.Category
+		if category == "" {
+			category = types.CategoryGeneral
+		}
+
+		ntwPerf, ntwPerfCat := t.NetworkPerformance, t.NetworkCategory
+		if ntwPerf == "" {
+			ntwPerf = "N/A"
+		}
+		if ntwPerfCat == "" {
+			ntwPerfCat = types.NtwMedium
+		}
+
+		zones := r.Zones
+		if zones == nil {
+			zones = []string{}
+		}
+
+		var localDisks []types.LocalDisk
+		for _, disk := range t.LocalDisks {
+			localDisks = append(localDisks, types.LocalDisk{Count: disk.Count, Size: disk.Size, Type: disk.Type})
+		}
+
+		virtualMachines = append(virtualMachines, types.VMInfo{
+			Category:      category,
+			Type:          t.Type,
+			OnDemandPrice: t.Price,
+			Currency:      c.Currency,
+			Cpus:          t.CPUs,
+			Mem:           t.Memory,
+			Gpus:          t.GPUs,
+			GpuVendor:     t.GPUVendor,
+			GpuModel:      t.GPUModel,
+			GpuMem:        t.GPUMemory,
+			NtwPerf:       ntwPerf,
+			NtwPerfCat:    ntwPerfCat,
+			Zones:         zones,
+			Burst:         t.Burst,
+			BareMetal:     t.BareMetal,
+			LocalDisks:    localDisks,
+			Architecture:  t.Architecture,
+			Attributes:    cloudinfo.Attributes(fmt.Sprint(t.CPUs), fmt.Sprint(t.Memory), ntwPerfCat, category),
+		})
+	}
+
+	logger.Debug("found instance types", map[string]interface{}{"numberOfTypes": len(virtualMachines)})
+	return virtualMachines, nil
+}
+
+// GetProducts returns the instance types of the service in the region, the catalog is re-read
+// so that its modifications are picked up by the next scrape
+func (i *CustomInfoer) GetProducts(vms []types.VMInfo, service, regionId string) ([]types.VMInfo, error) {
+	switch service {
+	case svcCompute:
+		return i.GetVirtualMachines(regionId)
+	default:
+		return nil, errors.NewWithDetails("invalid service", "service", service)
+	}
+}
+
+// GetZones returns the zones of the region of the catalog
+func (i *CustomInfoer) GetZones(region string) ([]string, error) {
+	c, err := i.catalog.get()
+	if err != nil {
+		return nil, err
+	}
+
+	r, ok := c.region(region)
+	if !ok {
+		return nil, errors.NewWithDetails("region not found in the catalog", "region", region)
+	}
+
+	if r.Zones == nil {
+		return []string{}, nil
+	}
+
+	return r.Zones, nil
+}
+
+// GetRegions returns the regions of the catalog
+func (i *CustomInfoer) GetRegions(service string) (map[string]string, error) {
+	if service != svcCompute {
+		return nil, errors.NewWithDetails("invalid service", "service", service)
+	}
+
+	c, err := i.catalog.get()
+	if err != nil {
+		return nil, err
+	}
+
+	regions := make(map[string]string, len(c.Regions))
+	for _, r := range c.Regions {
+		name := r.Name
+		if name == "" {
+			name = r.ID
+		}
+		regions[r.ID] = name
+	}
+
+	return regions, nil
+}
+
+// HasShortLivedPriceInfo signals that the catalog prices don't change frequently
+func (*CustomInfoer) HasShortLivedPriceInfo() bool {
+	return false
+}
+
+// GetCurrentPrices is not supported, the catalog has no short lived prices
+func (*CustomInfoer) GetCurrentPrices(region string) (map[string]types.Price, error) {
+	return nil, errors.New("GetCurrentPrices - not yet implemented")
+}
+
+// HasImages signals that the catalog has no images
+func (*CustomInfoer) HasImages() bool {
+	return false
+}
+
+// GetServiceImages is not supported for the custom provider
+func (*CustomInfoer) GetServiceImages(service, region string) ([]types.Image, error) {
+	return nil, errors.New("GetServiceImages - not yet implemented")
+}
+
+// GetVersions returns no versions, the catalog has no Kubernetes versions
+func (*CustomInfoer) GetVersions(service, region string) ([]types.LocationVersion, error) {
+	return []types.LocationVersion{}, nil
+}
+
+// GetServiceProducts is not supported for the custom provider
+func (*CustomInfoer) GetServiceProducts(region, service string) ([]types.ProductDetails, error) {
+	return nil, errors.New("GetServiceProducts - not yet implemented")
+}
@@ -0,0 +1,136 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package custom
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/cloudinfoadapter"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+const testCatalog = `
+currency: EUR
+regions:
+  - id: dc1
+    name: Datacenter 1
+    zones: [rack-a, rack-b]
+    instanceTypes:
+      - type: esx.large
+        cpus: 16
+        memory: 64
+        price: 0.5
+      - type: esx.gpu
+        category: GPU instance
+        cpus: 32
+        memory: 256
+        gpus: 4
+        price: 4
+        networkPerformance: 25 Gbit/s
+        networkCategory: extra
+        bareMetal: true
+  - id: dc2
+    instanceTypes: []
+`
+
+// writeCatalog writes the catalog file, moving its modification time forward to be noticed by the reload
+func writeCatalog(t *testing.T, path, content string, modTime time.Time) {
+	require.NoError(t, ioutil.WriteFile(path, []byte(content), 0600))
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+}
+
+func TestCustomInfoer(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "catalog.yaml")
+	modTime := time.Now()
+	writeCatalog(t, path, testCatalog, modTime)
+
+	infoer, err := NewCustomInfoer(Config{Catalog: path}, cloudinfoadapter.NewNoopLogger())
+	require.NoError(t, err)
+
+	regions, err := infoer.GetRegions(svcCompute)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"dc1": "Datacenter 1", "dc2": "dc2"}, regions)
+
+	zones, err := infoer.GetZones("dc1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"rack-a", "rack-b"}, zones)
+
+	vms, err := infoer.GetProducts(nil, svcCompute, "dc1")
+	require.NoError(t, err)
+	if assert.Len(t, vms, 2) {
+		assert.Equal(t, types.CategoryGeneral, vms[0].Category, "the category should default to general purpose")
+		assert.Equal(t, types.NtwMedium, vms[0].NtwPerfCat)
+		assert.Equal(t, "EUR", vms[0].Currency)
+		assert.Equal(t, []string{"rack-a", "rack-b"}, vms[0].Zones)
+
+		assert.Equal(t, types.CategoryGpu, vms[1].Category)
+		assert.Equal(t, 4.0, vms[1].Gpus)
+		assert.Equal(t, types.NtwExtra, vms[1].NtwPerfCat)
+		assert.True(t, vms[1].BareMetal)
+	}
+
+	_, err = infoer.GetVirtualMachines("dc3")
+	assert.Error(t, err, "an unknown region should fail")
+
+	writeCatalog(t, path, "regions:\n  - id: dc3\n", modTime.Add(time.Minute))
+
+	regions, err = infoer.GetRegions(svcCompute)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"dc3": "dc3"}, regions, "the modified catalog should be reloaded")
+
+	writeCatalog(t, path, "regions:\n  - id: dc3\n  - id: dc3\n", modTime.Add(2*time.Minute))
+
+	_, err = infoer.GetRegions(svcCompute)
+	assert.Error(t, err, "an invalid catalog should fail")
+}
+
+func TestCatalogFile_get(t *testing.T) {
+	tests := map[string]string{
+		"missing region id":    "regions:\n  - name: dc1\n",
+		"duplicate type":       "regions:\n  - id: dc1\n    instanceTypes:\n      - type: a\n      - type: a\n",
+		"invalid category":     "regions:\n  - id: dc1\n    instanceTypes:\n      - type: a\n        category: fast\n",
+		"invalid network":      "regions:\n  - id: dc1\n    instanceTypes:\n      - type: a\n        networkCategory: fast\n",
+		"invalid local disk":   "regions:\n  - id: dc1\n    instanceTypes:\n      - type: a\n        localDisks:\n          - type: tape\n",
+		"invalid architecture": "regions:\n  - id: dc1\n    instanceTypes:\n      - type: a\n        architecture: x86_64\n",
+		"unknown field":        "regions:\n  - id: dc1\n    cpus: 1\n",
+		"missing type":         "regions:\n  - id: dc1\n    instanceTypes:\n      - cpus: 1\n",
+		"not a catalog (json)": `{"regions": {"id": "dc1"}}`,
+	}
+
+	for name, content := range tests {
+		content := content
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "catalog.yaml")
+			writeCatalog(t, path, content, time.Now())
+
+			_, err := (&catalogFile{path: path}).get()
+			assert.Error(t, err)
+		})
+	}
+
+	path := filepath.Join(t.TempDir(), "catalog.json")
+	writeCatalog(t, path, `{"currency": "USD", "regions": [{"id": "dc1", "instanceTypes": [{"type": "a", "price": 0.1}]}]}`, time.Now())
+
+	c, err := (&catalogFile{path: path}).get()
+	require.NoError(t, err)
+	assert.Equal(t, 0.1, c.Regions[0].InstanceTypes[0].Price, "json catalogs should be supported")
+}
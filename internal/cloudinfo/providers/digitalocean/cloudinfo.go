@@ -42,7 +42,14 @@ func NewDigitaloceanInfoer(config Config, logger cloudinfo.Logger) (*Digitalocea
 		AccessToken: config.AccessToken,
 	})
 	oauthClient := oauth2.NewClient(context.Background(), tokenSource)
-	client := godo.NewClient(oauthClient)
+	var opts []godo.ClientOpt
+	if config.UserAgent != "" {
+		opts = append(opts, godo.SetUserAgent(config.UserAgent))
+	}
+	client, err := godo.New(oauthClient, opts...)
+	if err != nil {
+		return nil, emperror.Wrap(err, "failed to create digitalocean client")
+	}
 
 	return &DigitaloceanInfoer{
 		client: client,
@@ -16,4 +16,6 @@ package digitalocean
 
 type Config struct {
 	AccessToken string
+
+	UserAgent string
 }
@@ -0,0 +1,275 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package equinixmetal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/restapi"
+)
+
+const (
+	apiURL = "https://api.equinix.com/metal/v1"
+)
+
+// plan is a server plan of the Equinix Metal API
+type plan struct {
+	Slug              string                        `json:"slug"`
+	Line              string                        `json:"line"`
+	Legacy            bool                          `json:"legacy"`
+	DeploymentTypes   []string                      `json:"deployment_types"`
+	Specs             specs                         `json:"specs"`
+	Pricing           price                         `json:"pricing"`
+	AvailableInMetros []metroPrice                  `json:"available_in_metros"`
+	ReservationPrices map[string]map[string]payment `json:"reservation_pricing"`
+}
+
+// specs are the hardware specs of a plan
+type specs struct {
+	CPUs   []component `json:"cpus"`
+	GPUs   []component `json:"gpu"`
+	NICs   []component `json:"nics"`
+	Drives []drive     `json:"drives"`
+
+	Memory struct {
+		Total string `json:"total"`
+	} `json:"memory"`
+}
+
+// component is a hardware component of a plan, eg.: a processor or a network interface
+type component struct {
+	Count int    `json:"count"`
+	Type  string `json:"type"`
+}
+
+// drive is a group of identical drives of a plan
+type drive struct {
+	Count    int    `json:"count"`
+	Size     string `json:"size"`
+	Type     string `json:"type"`
+	Category string `json:"category"`
+}
+
+// price is the hourly price of a plan
+type price struct {
+	Hour float64 `json:"hour"`
+}
+
+// payment is the monthly price of a reservation
+type payment struct {
+	Month float64 `json:"month"`
+}
+
+// metroPrice is a metro the plan is available in with the hourly price of the plan in the metro
+type metroPrice struct {
+	Code  string `json:"code"`
+	Price *price `json:"price"`
+}
+
+// metro is a metro (region) of the Equinix Metal API
+type metro struct {
+	Code    string `json:"code"`
+	Name    string `json:"name"`
+	Country string `json:"country"`
+}
+
+// facility is a data center of a metro
+type facility struct {
+	Code  string `json:"code"`
+	Metro *metro `json:"metro"`
+}
+
+// client is a minimal client of the Equinix Metal API
+type client struct {
+	api       *restapi.Client
+	baseURL   string
+	authToken string
+}
+
+func newClient(config Config) *client {
+	return &client{
+		api:       restapi.NewClient("equinix metal", config.UserAgent),
+		baseURL:   apiURL,
+		authToken: config.AuthToken,
+	}
+}
+
+// get retrieves a resource of the api and decodes it into the given value
+func (c *client) get(ctx context.Context, path string, query url.Values, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s%s?%s", c.baseURL, path, query.Encode()), nil)
+	if err != nil {
+		return errors.WrapIf(err, "failed to create request")
+	}
+
+	req.Header.Set("X-Auth-Token", c.authToken)
+
+	return c.api.Do(req, v)
+}
+
+// listPlans lists the plans with the metros they are available in
+func (c *client) listPlans(ctx context.Context) ([]plan, error) {
+	var resp struct {
+		Plans []plan `json:"plans"`
+	}
+
+	if err := c.get(ctx, "/plans", url.Values{"include": {"available_in_metros"}}, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Plans, nil
+}
+
+// listMetros lists the metros
+func (c *client) listMetros(ctx context.Context) ([]metro, error) {
+	var resp struct {
+		Metros []metro `json:"metros"`
+	}
+
+	if err := c.get(ctx, "/locations/metros", url.Values{}, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Metros, nil
+}
+
+// listFacilities lists the facilities with their metros
+func (c *client) listFacilities(ctx context.Context) ([]facility, error) {
+	var resp struct {
+		Facilities []facility `json:"facilities"`
+	}
+
+	if err := c.get(ctx, "/facilities", url.Values{"include": {"metro"}}, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Facilities, nil
+}
+
+// reservationTerms maps the reservation terms of the api to the terms of the reserved prices
+var reservationTerms = map[string]string{
+	"one_month":  "1mo",
+	"one_year":   "1yr",
+	"three_year": "3yr",
+}
+
+var coresRegexp = regexp.MustCompile(`(\d+)-Core`)
+
+// cores returns the number of physical cores of the plan, counting a processor of unknown core count as one
+func (s specs) cores() int {
+	var cores int
+	for _, cpu := range s.CPUs {
+		perProcessor := 1
+		if match := coresRegexp.FindStringSubmatch(cpu.Type); match != nil {
+			perProcessor, _ = strconv.Atoi(match[1])
+		}
+
+		cores += cpu.Count * perProcessor
+	}
+
+	return cores
+}
+
+// memory parses the total memory of the plan (eg.: 64GB, 1TB) in GiB, unparseable values are zero
+func (s specs) memory() float64 {
+	return parseSize(s.Memory.Total)
+}
+
+// localDisks returns the data drives of the plan, the boot drives are left out
+func (s specs) localDisks() []types.LocalDisk {
+	var disks []types.LocalDisk
+	for _, d := range s.Drives {
+		if strings.EqualFold(d.Category, "boot") || d.Count == 0 {
+			continue
+		}
+
+		diskType := types.LocalDiskSSD
+		switch strings.ToUpper(d.Type) {
+		case "NVME":
+			diskType = types.LocalDiskNVMe
+		case "HDD":
+			diskType = types.LocalDiskHDD
+		}
+
+		disks = append(disks, types.LocalDisk{Count: d.Count, Size: parseSize(d.Size), Type: diskType})
+	}
+
+	return disks
+}
+
+// parseSize parses a size in GB, eg.: 480GB, 3.8TB
+func parseSize(size string) float64 {
+	size = strings.ToUpper(strings.TrimSpace(size))
+
+	multiplier := 1.0
+	switch {
+	case strings.HasSuffix(size, "TB"):
+		multiplier = 1024
+		size = strings.TrimSuffix(size, "TB")
+	case strings.HasSuffix(size, "GB"):
+		size = strings.TrimSuffix(size, "GB")
+	}
+
+	value, _ := strconv.ParseFloat(size, 64)
+	return value * multiplier
+}
+
+// gpus returns the number of gpus of the plan
+func (s specs) gpus() int {
+	var gpus int
+	for _, gpu := range s.GPUs {
+		gpus += gpu.Count
+	}
+
+	return gpus
+}
+
+// gpuModel returns the model of the gpus of the plan, eg.: Tesla V100
+func (s specs) gpuModel() string {
+	for _, gpu := range s.GPUs {
+		if gpu.Type != "" {
+			return gpu.Type
+		}
+	}
+
+	return ""
+}
+
+// nics returns the number of network interfaces and their total bandwidth in Gbps
+func (s specs) nics() (int, int) {
+	var count, bandwidth int
+	for _, nic := range s.NICs {
+		gbps, _ := strconv.Atoi(strings.TrimSuffix(nic.Type, "Gbps"))
+
+		count += nic.Count
+		bandwidth += nic.Count * gbps
+	}
+
+	return count, bandwidth
+}
+
+// arch returns the cpu architecture part of the plan slug, eg.: arm64 of c3.large.arm64, x86 of m3.large.x86
+func (p plan) arch() string {
+	return p.Slug[strings.LastIndex(p.Slug, ".")+1:]
+}
@@ -0,0 +1,298 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package equinixmetal
+
+import (
+	"context"
+	"fmt"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+	"github.com/banzaicloud/cloudinfo/internal/platform/log"
+)
+
+const svcCompute = "compute"
+
+// EquinixMetalInfoer encapsulates the data and operations needed to access external Equinix Metal resources.
+type EquinixMetalInfoer struct {
+	client *client
+
+	logger cloudinfo.Logger
+}
+
+// NewEquinixMetalInfoer creates a new instance of the Equinix Metal infoer.
+func NewEquinixMetalInfoer(config Config, logger cloudinfo.Logger) (*EquinixMetalInfoer, error) {
+	if config.AuthToken == "" {
+		return nil, errors.New("equinix metal auth token is required")
+	}
+
+	return &EquinixMetalInfoer{
+		client: newClient(config),
+		logger: logger,
+	}, nil
+}
+
+// Initialize retrieves the prices of the plans in all metros
+func (i *EquinixMetalInfoer) Initialize() (map[string]map[string]types.Price, error) {
+	i.logger.Debug("initializing price info")
+
+	vmsByMetro, err := i.virtualMachines(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	allPrices := make(map[string]map[string]types.Price, len(vmsByMetro))
+	for metro, vms := range vmsByMetro {
+		allPrices[metro] = make(map[string]types.Price, len(vms))
+		for _, vm := range vms {
+			allPrices[metro][vm.Type] = types.Price{
+				OnDemandPrice: vm.OnDemandPrice,
+			}
+		}
+	}
+
+	i.logger.Debug("finished initializing price info")
+	return allPrices, nil
+}
+
+// virtualMachines retrieves the current on demand plans by metro, the plans without a price in the metro are skipped
+func (i *EquinixMetalInfoer) virtualMachines(ctx context.Context) (map[string][]types.VMInfo, error) {
+	plans, err := i.client.listPlans(ctx)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to list plans")
+	}
+
+	facilities, err := i.facilities(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	vmsByMetro := make(map[string][]types.VMInfo)
+	for _, p := range plans {
+		if p.Legacy || p.Line != "baremetal" || !cloudinfo.Contains(p.DeploymentTypes, "on_demand") {
+			continue
+		}
+
+		cores, mem, gpus := p.Specs.cores(), p.Specs.memory(), p.Specs.gpus()
+		nics, bandwidth := p.Specs.nics()
+		ntwPerf, ntwPerfCat := networkPerformance(bandwidth)
+		category := getCategory(p.Slug, gpus)
+
+		for _, m := range p.AvailableInMetros {
+			hourly := p.Pricing.Hour
+			if m.Price != nil {
+				hourly = m.Price.Hour
+			}
+
+			if hourly == 0 {
+				i.logger.Debug("no price found for plan", map[string]interface{}{"plan": p.Slug, "metro": m.Code})
+				continue
+			}
+
+			zones := facilities[m.Code]
+			if zones == nil {
+				zones = []string{}
+			}
+
+			vm := types.VMInfo{
+				Category:       category,
+				Type:           p.Slug,
+				OnDemandPrice:  hourly,
+				Cpus:           float64(cores),
+				Mem:            mem,
+				Gpus:           float64(gpus),
+				NtwPerf:        ntwPerf,
+				NtwPerfCat:     ntwPerfCat,
+				Zones:          zones,
+				BareMetal:      true,
+				NICs:           nics,
+				ReservedPrices: p.reservedPrices(m.Code),
+				LocalDisks:     p.Specs.localDisks(),
+				Architecture:   cloudinfo.Architecture(p.arch()),
+				Attributes:     cloudinfo.Attributes(fmt.Sprint(cores), fmt.Sprint(mem), ntwPerfCat, category),
+			}
+			cloudinfo.SetGpu(&vm, p.Specs.gpuModel())
+			vmsByMetro[m.Code] = append(vmsByMetro[m.Code], vm)
+		}
+	}
+
+	return vmsByMetro, nil
+}
+
+// facilities retrieves the facility codes by metro
+func (i *EquinixMetalInfoer) facilities(ctx context.Context) (map[string][]string, error) {
+	facilities, err := i.client.listFacilities(ctx)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to list facilities")
+	}
+
+	facilitiesByMetro := make(map[string][]string)
+	for _, f := range facilities {
+		if f.Metro == nil {
+			continue
+		}
+
+		facilitiesByMetro[f.Metro.Code] = append(facilitiesByMetro[f.Metro.Code], f.Code)
+	}
+
+	return facilitiesByMetro, nil
+}
+
+// reservedPrices returns the reserved prices of the plan in the metro, ordered by term
+func (p plan) reservedPrices(metro string) []types.ReservedPrice {
+	var prices []types.ReservedPrice
+	for _, term := range []string{"one_month", "one_year", "three_year"} {
+		payment, ok := p.ReservationPrices[metro][term]
+		if !ok || payment.Month == 0 {
+			continue
+		}
+
+		prices = append(prices, types.ReservedPrice{
+			Term:                 reservationTerms[term],
+			PaymentOption:        types.PaymentNoUpfront,
+			MonthlyPrice:         payment.Month,
+			EffectiveHourlyPrice: payment.Month / cloudinfo.HoursPerMonth,
+		})
+	}
+
+	return prices
+}
+
+// GetVirtualMachines retrieves the plans available in the metro
+func (i *EquinixMetalInfoer) GetVirtualMachines(region string) ([]types.VMInfo, error) {
+	logger := log.WithFields(i.logger, map[string]interface{}{"region": region})
+	logger.Debug("getting product info")
+
+	vmsByMetro, err := i.virtualMachines(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Debug("found plans", map[string]interface{}{"numberOfTypes": len(vmsByMetro[region])})
+	return vmsByMetro[region], nil
+}
+
+// GetProducts retrieves the available plans of the service in the metro
+func (i *EquinixMetalInfoer) GetProducts(vms []types.VMInfo, service, regionId string) ([]types.VMInfo, error) {
+	switch service {
+	case svcCompute:
+		if len(vms) > 0 {
+			return vms, nil
+		}
+
+		return i.GetVirtualMachines(regionId)
+	default:
+		return nil, errors.NewWithDetails("invalid service", "service", service)
+	}
+}
+
+// GetZones retrieves the facilities of the metro
+func (i *EquinixMetalInfoer) GetZones(region string) ([]string, error) {
+	facilities, err := i.facilities(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	if facilities[region] == nil {
+		return []string{}, nil
+	}
+
+	return facilities[region], nil
+}
+
+// GetRegions retrieves the metros of the service
+func (i *EquinixMetalInfoer) GetRegions(service string) (map[string]string, error) {
+	if service != svcCompute {
+		return nil, errors.NewWithDetails("invalid service", "service", service)
+	}
+
+	metros, err := i.client.listMetros(context.Background())
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to list metros")
+	}
+
+	regions := make(map[string]string, len(metros))
+	for _, m := range metros {
+		regions[m.Code] = m.Name
+	}
+
+	return regions, nil
+}
+
+// HasShortLivedPriceInfo signals that the Equinix Metal on demand prices don't change frequently
+func (*EquinixMetalInfoer) HasShortLivedPriceInfo() bool {
+	return false
+}
+
+// GetCurrentPrices is not supported, the spot market prices are not scraped
+func (*EquinixMetalInfoer) GetCurrentPrices(region string) (map[string]types.Price, error) {
+	return nil, errors.New("GetCurrentPrices - not yet implemented")
+}
+
+// HasImages signals that images are not scraped for Equinix Metal
+func (*EquinixMetalInfoer) HasImages() bool {
+	return false
+}
+
+// GetServiceImages is not supported for Equinix Metal
+func (*EquinixMetalInfoer) GetServiceImages(service, region string) ([]types.Image, error) {
+	return nil, errors.New("GetServiceImages - not yet implemented")
+}
+
+// GetVersions returns no versions, Equinix Metal has no managed Kubernetes service
+func (*EquinixMetalInfoer) GetVersions(service, region string) ([]types.LocationVersion, error) {
+	return []types.LocationVersion{}, nil
+}
+
+// GetServiceProducts is not supported for Equinix Metal
+func (*EquinixMetalInfoer) GetServiceProducts(region, service string) ([]types.ProductDetails, error) {
+	return nil, errors.New("GetServiceProducts - not yet implemented")
+}
+
+// getCategory maps the class of the plan (the first letter of the slug, eg.: c3.small.x86) to an instance type category
+func getCategory(slug string, gpus int) string {
+	switch {
+	case gpus > 0:
+		return types.CategoryGpu
+	case slug == "":
+		return types.CategoryGeneral
+	}
+
+	switch slug[0] {
+	case 'c':
+		return types.CategoryCompute
+	case 'm':
+		return types.CategoryMemory
+	case 's':
+		return types.CategoryStorage
+	default:
+		return types.CategoryGeneral
+	}
+}
+
+// networkPerformance categorizes the total bandwidth (Gbps) of the network interfaces
+func networkPerformance(gbps int) (string, string) {
+	ntwPerf := fmt.Sprintf("%d Gbit/s", gbps)
+	switch {
+	case gbps < 10:
+		return ntwPerf, types.NtwMedium
+	case gbps < 25:
+		return ntwPerf, types.NtwHight
+	default:
+		return ntwPerf, types.NtwExtra
+	}
+}
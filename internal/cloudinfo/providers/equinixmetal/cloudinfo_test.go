@@ -0,0 +1,148 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package equinixmetal
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/cloudinfoadapter"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestEquinixMetalInfoer_GetVirtualMachines(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "token", r.Header.Get("X-Auth-Token"))
+
+		switch r.URL.Path {
+		case "/plans":
+			assert.Equal(t, "available_in_metros", r.URL.Query().Get("include"))
+
+			fmt.Fprint(w, `{"plans": [
+				{"slug": "c3.small.x86", "line": "baremetal", "deployment_types": ["on_demand", "spot_market"],
+					"specs": {"cpus": [{"count": 1, "type": "Intel Xeon E-2278G 8-Core Processor @ 3.40GHz"}],
+						"memory": {"total": "32GB"}, "nics": [{"count": 2, "type": "10Gbps"}]},
+					"pricing": {"hour": 0.5},
+					"available_in_metros": [{"code": "da", "price": {"hour": 0.75}}, {"code": "am"}],
+					"reservation_pricing": {"da": {"one_year": {"month": 300}, "one_month": {"month": 400}}}},
+				{"slug": "m3.large.x86", "line": "baremetal", "deployment_types": ["on_demand"],
+					"specs": {"cpus": [{"count": 2, "type": "AMD EPYC 7502P 32-Core Processor @ 2.5GHz"}],
+						"memory": {"total": "1TB"}, "nics": [{"count": 2, "type": "25Gbps"}]},
+					"available_in_metros": [{"code": "da", "price": {"hour": 3.1}}]},
+				{"slug": "t1.small.x86", "line": "baremetal", "legacy": true, "deployment_types": ["on_demand"],
+					"pricing": {"hour": 0.07}, "available_in_metros": [{"code": "da"}]},
+				{"slug": "storage.standard", "line": "storage", "pricing": {"hour": 0.01}, "available_in_metros": [{"code": "da"}]}]}`)
+		case "/facilities":
+			fmt.Fprint(w, `{"facilities": [{"code": "da11", "metro": {"code": "da"}}, {"code": "da6", "metro": {"code": "da"}}, {"code": "am6", "metro": {"code": "am"}}]}`)
+		case "/locations/metros":
+			fmt.Fprint(w, `{"metros": [{"code": "da", "name": "Dallas", "country": "US"}, {"code": "am", "name": "Amsterdam", "country": "NL"}]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	infoer, err := NewEquinixMetalInfoer(Config{AuthToken: "token"}, cloudinfoadapter.NewNoopLogger())
+	assert.NoError(t, err)
+	infoer.client.baseURL = server.URL
+
+	vms, err := infoer.GetVirtualMachines("da")
+	assert.NoError(t, err)
+	if assert.Len(t, vms, 2, "the legacy and non bare metal plans should be skipped") {
+		assert.Equal(t, types.VMInfo{
+			Category:      types.CategoryCompute,
+			Type:          "c3.small.x86",
+			OnDemandPrice: 0.75,
+			Cpus:          8,
+			Mem:           32,
+			NtwPerf:       "20 Gbit/s",
+			NtwPerfCat:    types.NtwHight,
+			Zones:         []string{"da11", "da6"},
+			BareMetal:     true,
+			NICs:          2,
+			Architecture:  types.ArchitectureAMD64,
+			ReservedPrices: []types.ReservedPrice{
+				{Term: "1mo", PaymentOption: types.PaymentNoUpfront, MonthlyPrice: 400, EffectiveHourlyPrice: 400.0 / 730},
+				{Term: "1yr", PaymentOption: types.PaymentNoUpfront, MonthlyPrice: 300, EffectiveHourlyPrice: 300.0 / 730},
+			},
+			Attributes: vms[0].Attributes,
+		}, vms[0])
+
+		assert.Equal(t, "m3.large.x86", vms[1].Type)
+		assert.Equal(t, types.CategoryMemory, vms[1].Category)
+		assert.Equal(t, 64.0, vms[1].Cpus)
+		assert.Equal(t, 1024.0, vms[1].Mem)
+		assert.Equal(t, types.NtwExtra, vms[1].NtwPerfCat)
+		assert.Empty(t, vms[1].ReservedPrices)
+	}
+
+	vms, err = infoer.GetVirtualMachines("am")
+	assert.NoError(t, err)
+	if assert.Len(t, vms, 1) {
+		assert.Equal(t, 0.5, vms[0].OnDemandPrice, "the plan price should be used without a metro price")
+		assert.Equal(t, []string{"am6"}, vms[0].Zones)
+	}
+
+	regions, err := infoer.GetRegions(svcCompute)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"da": "Dallas", "am": "Amsterdam"}, regions)
+
+	zones, err := infoer.GetZones("da")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"da11", "da6"}, zones)
+}
+
+func TestSpecs_localDisks(t *testing.T) {
+	s := specs{Drives: []drive{
+		{Count: 2, Size: "480GB", Type: "SSD", Category: "boot"},
+		{Count: 2, Size: "3.8TB", Type: "NVME", Category: "storage"},
+		{Count: 12, Size: "8TB", Type: "HDD", Category: "storage"},
+	}}
+
+	assert.Equal(t, []types.LocalDisk{
+		{Count: 2, Size: 3.8 * 1024, Type: types.LocalDiskNVMe},
+		{Count: 12, Size: 8 * 1024, Type: types.LocalDiskHDD},
+	}, s.localDisks(), "the boot drives should be left out")
+}
+
+func TestPlan_arch(t *testing.T) {
+	assert.Equal(t, "arm64", plan{Slug: "c3.large.arm64"}.arch())
+	assert.Equal(t, "x86", plan{Slug: "m3.large.x86"}.arch())
+}
+
+func TestSpecs_memory(t *testing.T) {
+	tests := []struct {
+		total  string
+		memory float64
+	}{
+		{total: "32GB", memory: 32},
+		{total: "1TB", memory: 1024},
+		{total: "1.5TB", memory: 1536},
+		{total: "unknown", memory: 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.total, func(t *testing.T) {
+			var s specs
+			s.Memory.Total = test.total
+
+			assert.Equal(t, test.memory, s.memory())
+		})
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exoscale
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/restapi"
+)
+
+const (
+	// apiEndpoint is the endpoint of the api of a zone
+	apiEndpoint = "https://api-%s.exoscale.com/v2"
+	pricingURL  = "https://portal.exoscale.com/api/pricing/opencompute"
+
+	// apiZone is the zone the global resources (instance types, zones) are listed in
+	apiZone = "ch-gva-2"
+
+	// signatureValidity is how long a signed request is accepted by the api
+	signatureValidity = 10 * time.Minute
+)
+
+// instanceType is an instance type of the Exoscale API
+type instanceType struct {
+	ID         string   `json:"id"`
+	Family     string   `json:"family"`
+	Size       string   `json:"size"`
+	CPUs       int      `json:"cpus"`
+	GPUs       int      `json:"gpus"`
+	Memory     int64    `json:"memory"`
+	Authorized bool     `json:"authorized"`
+	Zones      []string `json:"zones"`
+}
+
+// name is the name of the instance type as shown by the Exoscale tools (eg.: standard.medium)
+func (t instanceType) name() string {
+	return t.Family + "." + t.Size
+}
+
+// priceKey is the key of the hourly price of the instance type in the pricing
+func (t instanceType) priceKey() string {
+	if t.Family == "standard" {
+		return "running_" + t.Size
+	}
+
+	return "running_" + t.Family + "-" + t.Size
+}
+
+// client is a minimal client of the Exoscale API
+type client struct {
+	api         *restapi.Client
+	apiEndpoint string
+	pricingURL  string
+	apiKey      string
+	apiSecret   string
+
+	now func() time.Time
+}
+
+func newClient(config Config) *client {
+	return &client{
+		api:         restapi.NewClient("exoscale", config.UserAgent),
+		apiEndpoint: apiEndpoint,
+		pricingURL:  pricingURL,
+		apiKey:      config.APIKey,
+		apiSecret:   config.APISecret,
+		now:         time.Now,
+	}
+}
+
+// get retrieves a resource of the api of the zone and decodes it into the given value
+func (c *client) get(ctx context.Context, zone, path string, v interface{}) error {
+	endpoint := fmt.Sprintf(c.apiEndpoint, zone)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint+path, nil)
+	if err != nil {
+		return errors.WrapIf(err, "failed to create request")
+	}
+
+	req.Header.Set("Authorization", c.signature(http.MethodGet, req.URL.Path))
+
+	return c.api.Do(req, v)
+}
+
+// signature computes the EXO2-HMAC-SHA256 authorization header of a request without body and query parameters
+func (c *client) signature(method, path string) string {
+	expires := strconv.FormatInt(c.now().Add(signatureValidity).Unix(), 10)
+
+	// the message is the request line, the body, the signed query parameters and headers (none) and the expiration
+	message := strings.Join([]string{method + " " + path, "", "", "", expires}, "\n")
+
+	mac := hmac.New(sha256.New, []byte(c.apiSecret))
+	_, _ = mac.Write([]byte(message))
+
+	return fmt.Sprintf("EXO2-HMAC-SHA256 credential=%s,expires=%s,signature=%s",
+		c.apiKey, expires, base64.StdEncoding.EncodeToString(mac.Sum(nil)))
+}
+
+// listInstanceTypes lists the instance types with the zones they are available in
+func (c *client) listInstanceTypes(ctx context.Context) ([]instanceType, error) {
+	var resp struct {
+		InstanceTypes []instanceType `json:"instance-types"`
+	}
+
+	if err := c.get(ctx, apiZone, "/instance-type", &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.InstanceTypes, nil
+}
+
+// listZones lists the names of the zones
+func (c *client) listZones(ctx context.Context) ([]string, error) {
+	var resp struct {
+		Zones []struct {
+			Name string `json:"name"`
+		} `json:"zones"`
+	}
+
+	if err := c.get(ctx, apiZone, "/zone", &resp); err != nil {
+		return nil, err
+	}
+
+	zones := make([]string, 0, len(resp.Zones))
+	for _, z := range resp.Zones {
+		zones = append(zones, z.Name)
+	}
+
+	return zones, nil
+}
+
+// listKubernetesVersions lists the Kubernetes versions supported by SKS in the zone
+func (c *client) listKubernetesVersions(ctx context.Context, zone string) ([]string, error) {
+	var resp struct {
+		Versions []string `json:"sks-cluster-versions"`
+	}
+
+	if err := c.get(ctx, zone, "/sks-cluster-version", &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Versions, nil
+}
+
+// getPrices retrieves the hourly prices in the currency by price key, the prices are the same in all zones
+func (c *client) getPrices(ctx context.Context, currency string) (map[string]float64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.pricingURL, nil)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to create request")
+	}
+
+	// the prices are decimal strings by currency (lower case)
+	var resp map[string]map[string]string
+	if err := c.api.Do(req, &resp); err != nil {
+		return nil, err
+	}
+
+	currencyPrices, ok := resp[strings.ToLower(currency)]
+	if !ok {
+		return nil, errors.NewWithDetails("no prices found in the currency", "currency", currency)
+	}
+
+	prices := make(map[string]float64, len(currencyPrices))
+	for key, value := range currencyPrices {
+		price, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			continue
+		}
+
+		prices[key] = price
+	}
+
+	return prices, nil
+}
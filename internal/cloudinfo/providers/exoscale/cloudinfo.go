@@ -0,0 +1,251 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exoscale
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+	"github.com/banzaicloud/cloudinfo/internal/platform/log"
+)
+
+const (
+	svcCompute = "compute"
+	svcSks     = "sks"
+)
+
+// zoneNames are the names of the Exoscale zones, the zones are named after the airport code of their city
+var zoneNames = map[string]string{
+	"at-vie-1": "Vienna 1",
+	"at-vie-2": "Vienna 2",
+	"bg-sof-1": "Sofia",
+	"ch-dk-2":  "Zurich",
+	"ch-gva-2": "Geneva",
+	"de-fra-1": "Frankfurt",
+	"de-muc-1": "Munich",
+}
+
+// ExoscaleInfoer encapsulates the data and operations needed to access external Exoscale resources.
+type ExoscaleInfoer struct {
+	client   *client
+	currency string
+
+	logger cloudinfo.Logger
+}
+
+// NewExoscaleInfoer creates a new instance of the Exoscale infoer.
+func NewExoscaleInfoer(config Config, logger cloudinfo.Logger) (*ExoscaleInfoer, error) {
+	if config.APIKey == "" || config.APISecret == "" {
+		return nil, errors.New("exoscale api key and secret are required")
+	}
+
+	currency := strings.ToUpper(config.Currency)
+	if currency == "" {
+		currency = "USD"
+	}
+
+	return &ExoscaleInfoer{
+		client:   newClient(config),
+		currency: currency,
+		logger:   logger,
+	}, nil
+}
+
+// Initialize retrieves the prices of the instance types in all zones
+func (i *ExoscaleInfoer) Initialize() (map[string]map[string]types.Price, error) {
+	i.logger.Debug("initializing price info")
+
+	vmsByZone, err := i.virtualMachines(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	allPrices := make(map[string]map[string]types.Price, len(vmsByZone))
+	for zone, vms := range vmsByZone {
+		allPrices[zone] = make(map[string]types.Price, len(vms))
+		for _, vm := range vms {
+			allPrices[zone][vm.Type] = types.Price{
+				OnDemandPrice: vm.OnDemandPrice,
+			}
+		}
+	}
+
+	i.logger.Debug("finished initializing price info")
+	return allPrices, nil
+}
+
+// virtualMachines retrieves the authorized instance types by zone, the instance types without a price are skipped
+func (i *ExoscaleInfoer) virtualMachines(ctx context.Context) (map[string][]types.VMInfo, error) {
+	instanceTypes, err := i.client.listInstanceTypes(ctx)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to list instance types")
+	}
+
+	prices, err := i.client.getPrices(ctx, i.currency)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to retrieve prices")
+	}
+
+	vmsByZone := make(map[string][]types.VMInfo)
+	for _, t := range instanceTypes {
+		if !t.Authorized {
+			continue
+		}
+
+		price, ok := prices[t.priceKey()]
+		if !ok {
+			i.logger.Debug("no price found for instance type", map[string]interface{}{"instanceType": t.name()})
+			continue
+		}
+
+		category := getCategory(t.Family)
+		mem := float64(t.Memory) / (1 << 30)
+
+		for _, zone := range t.Zones {
+			vmsByZone[zone] = append(vmsByZone[zone], types.VMInfo{
+				Category:      category,
+				Type:          t.name(),
+				OnDemandPrice: price,
+				Currency:      i.currency,
+				Cpus:          float64(t.CPUs),
+				Mem:           mem,
+				Gpus:          float64(t.GPUs),
+				NtwPerf:       "N/A",
+				NtwPerfCat:    types.NtwMedium,
+				Zones:         []string{},
+				Attributes:    cloudinfo.Attributes(fmt.Sprint(t.CPUs), fmt.Sprint(mem), types.NtwMedium, category),
+			})
+		}
+	}
+
+	return vmsByZone, nil
+}
+
+// GetVirtualMachines retrieves the instance types available in the zone
+func (i *ExoscaleInfoer) GetVirtualMachines(region string) ([]types.VMInfo, error) {
+	logger := log.WithFields(i.logger, map[string]interface{}{"region": region})
+	logger.Debug("getting product info")
+
+	vmsByZone, err := i.virtualMachines(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Debug("found instance types", map[string]interface{}{"numberOfTypes": len(vmsByZone[region])})
+	return vmsByZone[region], nil
+}
+
+// GetProducts retrieves the available virtual machines of the service in the zone
+func (i *ExoscaleInfoer) GetProducts(vms []types.VMInfo, service, regionId string) ([]types.VMInfo, error) {
+	switch service {
+	case svcCompute, svcSks:
+		if len(vms) > 0 {
+			return vms, nil
+		}
+
+		return i.GetVirtualMachines(regionId)
+	default:
+		return nil, errors.NewWithDetails("invalid service", "service", service)
+	}
+}
+
+// GetZones returns the availability zones of the region, the Exoscale zones are regions without availability zones
+func (*ExoscaleInfoer) GetZones(region string) ([]string, error) {
+	return []string{}, nil
+}
+
+// GetRegions retrieves the zones of the service
+func (i *ExoscaleInfoer) GetRegions(service string) (map[string]string, error) {
+	if service != svcCompute && service != svcSks {
+		return nil, errors.NewWithDetails("invalid service", "service", service)
+	}
+
+	zones, err := i.client.listZones(context.Background())
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to list zones")
+	}
+
+	regions := make(map[string]string, len(zones))
+	for _, zone := range zones {
+		name, ok := zoneNames[zone]
+		if !ok {
+			name = zone
+		}
+		regions[zone] = name
+	}
+
+	return regions, nil
+}
+
+// HasShortLivedPriceInfo signals that the Exoscale prices don't change frequently
+func (*ExoscaleInfoer) HasShortLivedPriceInfo() bool {
+	return false
+}
+
+// GetCurrentPrices is not supported, Exoscale has no short lived prices
+func (*ExoscaleInfoer) GetCurrentPrices(region string) (map[string]types.Price, error) {
+	return nil, errors.New("GetCurrentPrices - not yet implemented")
+}
+
+// HasImages signals that images are not scraped for Exoscale
+func (*ExoscaleInfoer) HasImages() bool {
+	return false
+}
+
+// GetServiceImages is not supported for Exoscale
+func (*ExoscaleInfoer) GetServiceImages(service, region string) ([]types.Image, error) {
+	return nil, errors.New("GetServiceImages - not yet implemented")
+}
+
+// GetVersions retrieves the Kubernetes versions supported by SKS in the zone
+func (i *ExoscaleInfoer) GetVersions(service, region string) ([]types.LocationVersion, error) {
+	switch service {
+	case svcSks:
+		versions, err := i.client.listKubernetesVersions(context.Background(), region)
+		if err != nil {
+			return nil, errors.WrapIfWithDetails(err, "failed to list kubernetes versions", "region", region)
+		}
+
+		return []types.LocationVersion{types.NewLocationVersion(region, versions, "")}, nil
+	default:
+		return []types.LocationVersion{}, nil
+	}
+}
+
+// GetServiceProducts is not supported for Exoscale
+func (*ExoscaleInfoer) GetServiceProducts(region, service string) ([]types.ProductDetails, error) {
+	return nil, errors.New("GetServiceProducts - not yet implemented")
+}
+
+// getCategory maps the family of the instance type to an instance type category
+func getCategory(family string) string {
+	switch {
+	case strings.HasPrefix(family, "gpu"):
+		return types.CategoryGpu
+	case family == "cpu":
+		return types.CategoryCompute
+	case family == "memory":
+		return types.CategoryMemory
+	case family == "storage":
+		return types.CategoryStorage
+	default:
+		return types.CategoryGeneral
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exoscale
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/cloudinfoadapter"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func newTestInfoer(t *testing.T) (*ExoscaleInfoer, func()) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/pricing" {
+			assert.True(t, strings.HasPrefix(r.Header.Get("Authorization"), "EXO2-HMAC-SHA256 credential=key,"))
+		}
+
+		switch r.URL.Path {
+		case "/ch-gva-2/v2/instance-type":
+			fmt.Fprint(w, `{"instance-types": [
+				{"family": "standard", "size": "medium", "cpus": 2, "memory": 4294967296, "authorized": true, "zones": ["ch-gva-2", "de-fra-1"]},
+				{"family": "cpu", "size": "large", "cpus": 4, "memory": 8589934592, "authorized": true, "zones": ["ch-gva-2"]},
+				{"family": "gpu2", "size": "small", "cpus": 12, "gpus": 1, "memory": 60129542144, "authorized": false, "zones": ["ch-gva-2"]},
+				{"family": "colossus", "size": "huge", "cpus": 32, "memory": 240518168576, "authorized": true, "zones": ["ch-gva-2"]}]}`)
+		case "/ch-gva-2/v2/zone":
+			fmt.Fprint(w, `{"zones": [{"name": "ch-gva-2"}, {"name": "de-fra-1"}, {"name": "xx-new-1"}]}`)
+		case "/de-fra-1/v2/sks-cluster-version":
+			fmt.Fprint(w, `{"sks-cluster-versions": ["1.22.2", "1.21.5"]}`)
+		case "/pricing":
+			fmt.Fprint(w, `{
+				"chf": {"running_medium": "0.03", "running_cpu-large": "0.11"},
+				"usd": {"running_medium": "0.03250", "running_cpu-large": "0.12", "running_gpu2-small": "1.5"}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	infoer, err := NewExoscaleInfoer(Config{APIKey: "key", APISecret: "secret"}, cloudinfoadapter.NewNoopLogger())
+	assert.NoError(t, err)
+	infoer.client.apiEndpoint = server.URL + "/%s/v2"
+	infoer.client.pricingURL = server.URL + "/pricing"
+
+	return infoer, server.Close
+}
+
+func TestExoscaleInfoer_GetVirtualMachines(t *testing.T) {
+	infoer, closeServer := newTestInfoer(t)
+	defer closeServer()
+
+	vms, err := infoer.GetVirtualMachines("ch-gva-2")
+	assert.NoError(t, err)
+	if assert.Len(t, vms, 2, "the unauthorized and unpriced instance types should be skipped") {
+		assert.Equal(t, "standard.medium", vms[0].Type)
+		assert.Equal(t, types.CategoryGeneral, vms[0].Category)
+		assert.Equal(t, 0.0325, vms[0].OnDemandPrice)
+		assert.Equal(t, 4.0, vms[0].Mem)
+		assert.Equal(t, "USD", vms[0].Currency)
+
+		assert.Equal(t, "cpu.large", vms[1].Type)
+		assert.Equal(t, types.CategoryCompute, vms[1].Category)
+		assert.Equal(t, 0.12, vms[1].OnDemandPrice)
+	}
+
+	vms, err = infoer.GetVirtualMachines("de-fra-1")
+	assert.NoError(t, err)
+	assert.Len(t, vms, 1)
+
+	infoer.currency = "CHF"
+
+	vms, err = infoer.GetVirtualMachines("ch-gva-2")
+	assert.NoError(t, err)
+	if assert.Len(t, vms, 2) {
+		assert.Equal(t, 0.03, vms[0].OnDemandPrice)
+		assert.Equal(t, "CHF", vms[0].Currency)
+	}
+
+	infoer.currency = "GBP"
+
+	_, err = infoer.GetVirtualMachines("ch-gva-2")
+	assert.Error(t, err, "an unknown currency should fail")
+}
+
+func TestExoscaleInfoer_GetRegions(t *testing.T) {
+	infoer, closeServer := newTestInfoer(t)
+	defer closeServer()
+
+	regions, err := infoer.GetRegions(svcSks)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"ch-gva-2": "Geneva", "de-fra-1": "Frankfurt", "xx-new-1": "xx-new-1"}, regions)
+
+	_, err = infoer.GetRegions("unknown")
+	assert.Error(t, err)
+}
+
+func TestExoscaleInfoer_GetVersions(t *testing.T) {
+	infoer, closeServer := newTestInfoer(t)
+	defer closeServer()
+
+	versions, err := infoer.GetVersions(svcSks, "de-fra-1")
+	assert.NoError(t, err)
+	assert.Equal(t, []types.LocationVersion{types.NewLocationVersion("de-fra-1", []string{"1.22.2", "1.21.5"}, "")}, versions)
+}
+
+func TestClient_signature(t *testing.T) {
+	c := newClient(Config{APIKey: "key", APISecret: "secret"})
+	c.now = func() time.Time { return time.Unix(1600000000, 0) }
+
+	mac := hmac.New(sha256.New, []byte("secret"))
+	_, _ = mac.Write([]byte("GET /v2/zone\n\n\n\n1600000600"))
+
+	assert.Equal(t,
+		"EXO2-HMAC-SHA256 credential=key,expires=1600000600,signature="+base64.StdEncoding.EncodeToString(mac.Sum(nil)),
+		c.signature("GET", "/v2/zone"))
+}
+
+func TestNewExoscaleInfoer(t *testing.T) {
+	_, err := NewExoscaleInfoer(Config{APIKey: "key"}, cloudinfoadapter.NewNoopLogger())
+	assert.Error(t, err, "the api secret should be required")
+}
@@ -0,0 +1,81 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package google
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"google.golang.org/api/compute/v1"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+var (
+	// tpuMachineTypePattern matches the TPU machine types with the number of attached chips, eg.: ct5lp-hightpu-4t
+	tpuMachineTypePattern = regexp.MustCompile(`^(ct[a-z0-9]+)-[a-z]*tpu-(\d+)t$`)
+
+	// tpuMemory holds the high bandwidth memory of a TPU chip in GiB by machine series
+	// source: https://cloud.google.com/tpu/docs/system-architecture-tpu-vm
+	tpuMemory = map[string]float64{
+		"ct4p":  32,
+		"ct5lp": 16,
+		"ct5p":  95,
+		"ct6e":  32,
+	}
+)
+
+// isTPU checks whether the guest accelerator type is a TPU, eg.: tpu-v5-lite-podslice
+func isTPU(acceleratorType string) bool {
+	return strings.HasPrefix(acceleratorType, "tpu")
+}
+
+// accelerators returns the TPUs attached to the machine type
+func accelerators(mt *compute.MachineType) []types.Accelerator {
+	var series string
+	var count int
+	if match := tpuMachineTypePattern.FindStringSubmatch(mt.Name); match != nil {
+		series = match[1]
+		count, _ = strconv.Atoi(match[2])
+	}
+
+	var accelerators []types.Accelerator
+	for _, accelerator := range mt.Accelerators {
+		if !isTPU(accelerator.GuestAcceleratorType) {
+			continue
+		}
+		accelerators = append(accelerators, types.Accelerator{
+			Type:   types.AcceleratorTPU,
+			Vendor: "google",
+			Model:  accelerator.GuestAcceleratorType,
+			Count:  int(accelerator.GuestAcceleratorCount),
+			Memory: float64(accelerator.GuestAcceleratorCount) * tpuMemory[series],
+		})
+	}
+
+	// the machine type name holds the number of chips if the api doesn't list the attached TPUs
+	if len(accelerators) == 0 && count > 0 {
+		accelerators = append(accelerators, types.Accelerator{
+			Type:   types.AcceleratorTPU,
+			Vendor: "google",
+			Model:  series,
+			Count:  count,
+			Memory: float64(count) * tpuMemory[series],
+		})
+	}
+
+	return accelerators
+}
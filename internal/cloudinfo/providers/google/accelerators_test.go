@@ -0,0 +1,64 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package google
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/api/compute/v1"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestAccelerators(t *testing.T) {
+	tests := []struct {
+		name        string
+		machineType *compute.MachineType
+		expected    []types.Accelerator
+	}{
+		{
+			name:        "no accelerators",
+			machineType: &compute.MachineType{Name: "n2-standard-4"},
+		},
+		{
+			name: "gpus are not listed",
+			machineType: &compute.MachineType{Name: "a2-highgpu-1g", Accelerators: []*compute.MachineTypeAccelerators{
+				{GuestAcceleratorType: "nvidia-tesla-a100", GuestAcceleratorCount: 1},
+			}},
+		},
+		{
+			name: "tpus listed by the api",
+			machineType: &compute.MachineType{Name: "ct5lp-hightpu-4t", Accelerators: []*compute.MachineTypeAccelerators{
+				{GuestAcceleratorType: "tpu-v5-lite-podslice", GuestAcceleratorCount: 4},
+			}},
+			expected: []types.Accelerator{
+				{Type: types.AcceleratorTPU, Vendor: "google", Model: "tpu-v5-lite-podslice", Count: 4, Memory: 64},
+			},
+		},
+		{
+			name:        "tpus parsed from the machine type name",
+			machineType: &compute.MachineType{Name: "ct5p-hightpu-4t"},
+			expected: []types.Accelerator{
+				{Type: types.AcceleratorTPU, Vendor: "google", Model: "ct5p", Count: 4, Memory: 380},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, accelerators(test.machineType))
+		})
+	}
+}
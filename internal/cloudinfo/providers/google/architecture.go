@@ -0,0 +1,39 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package google
+
+import (
+	"strings"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+var (
+	// armSeries holds the machine series with Arm processors (Ampere Altra and Google Axion)
+	// source: https://cloud.google.com/compute/docs/instances/arm-on-compute
+	armSeries = []string{"c4a", "t2a"}
+)
+
+// architecture returns the cpu architecture of the machine type
+func architecture(machineType string) string {
+	series := strings.Split(machineType, "-")[0]
+	for _, s := range armSeries {
+		if s == series {
+			return types.ArchitectureARM64
+		}
+	}
+
+	return types.ArchitectureAMD64
+}
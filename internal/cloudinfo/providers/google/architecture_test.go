@@ -0,0 +1,41 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package google
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestArchitecture(t *testing.T) {
+	tests := []struct {
+		machineType  string
+		architecture string
+	}{
+		{machineType: "t2a-standard-1", architecture: types.ArchitectureARM64},
+		{machineType: "c4a-highmem-8", architecture: types.ArchitectureARM64},
+		{machineType: "t2d-standard-1", architecture: types.ArchitectureAMD64},
+		{machineType: "c4-standard-8", architecture: types.ArchitectureAMD64},
+	}
+
+	for _, test := range tests {
+		t.Run(test.machineType, func(t *testing.T) {
+			assert.Equal(t, test.architecture, architecture(test.machineType))
+		})
+	}
+}
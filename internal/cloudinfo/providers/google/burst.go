@@ -0,0 +1,32 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package google
+
+var (
+	// sharedCoreBaselines holds the baseline performance of the shared-core machine types in percent of a vCPU
+	// source: https://cloud.google.com/compute/docs/general-purpose-machines#sharedcore
+	sharedCoreBaselines = map[string]float64{
+		"f1-micro":  20,
+		"g1-small":  50,
+		"e2-micro":  12.5,
+		"e2-small":  25,
+		"e2-medium": 50,
+	}
+)
+
+// burstBaseline returns the baseline cpu performance of a shared-core machine type, 0 if unknown
+func burstBaseline(machineType string) float64 {
+	return sharedCoreBaselines[machineType]
+}
@@ -20,6 +20,8 @@ import (
 	"fmt"
 	"io/ioutil"
 	"strings"
+	"sync"
+	"time"
 
 	"emperror.dev/emperror"
 	"emperror.dev/errors"
@@ -64,7 +66,35 @@ type GceInfoer struct {
 	computeSvc   *compute.Service
 	containerSvc *container.Service
 	projectId    string
+	rawPayloads  bool
 	log          cloudinfo.Logger
+
+	// storagePrices are the persistent disk prices per region, listed at storagePricesAt
+	storagePrices   map[string][]types.StoragePrice
+	storagePricesAt time.Time
+	// objectStoragePrices are the Cloud Storage prices per region, listed at objectStoragePricesAt
+	objectStoragePrices   map[string][]types.ObjectStoragePrice
+	objectStoragePricesAt time.Time
+	storageMu             sync.Mutex
+
+	// networkPrices, loadBalancerPrices, natGatewayPrices and publicIpPrices are the network traffic, load balancer,
+	// NAT gateway and public IP prices per region, listed at networkPricesAt
+	networkPrices      map[string][]types.NetworkPrice
+	loadBalancerPrices map[string][]types.LoadBalancerPrice
+	natGatewayPrices   map[string][]types.NatGatewayPrice
+	publicIpPrices     map[string][]types.PublicIpPrice
+	networkPricesAt    time.Time
+	networkMu          sync.Mutex
+
+	// databases are the Cloud SQL instance shapes and their prices per region, listed at databasesAt
+	databases   map[string][]types.DatabaseInfo
+	databasesAt time.Time
+	databaseMu  sync.Mutex
+
+	// serverlessPrices are the Cloud Run prices per region, listed at serverlessPricesAt
+	serverlessPrices   map[string][]types.ServerlessPrice
+	serverlessPricesAt time.Time
+	serverlessMu       sync.Mutex
 }
 
 // NewGoogleInfoer creates a new instance of the Google infoer.
@@ -74,6 +104,10 @@ func NewGoogleInfoer(config Config, logger cloudinfo.Logger) (*GceInfoer, error)
 		option.WithScopes(compute.ComputeReadonlyScope, container.CloudPlatformScope),
 	}
 
+	if config.UserAgent != "" {
+		clientOpts = append(clientOpts, option.WithUserAgent(config.UserAgent))
+	}
+
 	if config.Credentials != "" {
 		decoded, err := base64.StdEncoding.DecodeString(config.Credentials)
 		if err != nil {
@@ -108,6 +142,7 @@ func NewGoogleInfoer(config Config, logger cloudinfo.Logger) (*GceInfoer, error)
 		computeSvc:   computeSvc,
 		containerSvc: containerSvc,
 		projectId:    project,
+		rawPayloads:  config.RawPayloads,
 		log:          logger,
 	}, nil
 }
@@ -176,7 +211,7 @@ func (g *GceInfoer) Initialize() (map[string]map[string]types.Price, error) {
 		return nil, err
 	}
 
-	pricePerRegion, err := g.getPrice()
+	pricePerRegion, commitments, err := g.getPrice()
 	if err != nil {
 		return nil, err
 	}
@@ -212,6 +247,8 @@ func (g *GceInfoer) Initialize() (map[string]map[string]types.Price, error) {
 							metrics.ReportGoogleSpotPrice(region, z, mt.Name, spotPrice[z])
 						}
 						prices.SpotPrice = spotPrice
+						prices.EffectiveMonthlyPrice = effectiveMonthlyPrice(mt.Name, prices.OnDemandPrice)
+						prices.ReservedPrices = commitments.reservedPrices(region, mt.Name, float64(mt.GuestCpus), float64(mt.MemoryMb)/1024, mt.IsSharedCpu)
 
 						allPrices[region][mt.Name] = prices
 					}
@@ -228,22 +265,41 @@ func (g *GceInfoer) Initialize() (map[string]map[string]types.Price, error) {
 	return allPrices, nil
 }
 
-func (g *GceInfoer) getPrice() (map[string]map[string]map[string]float64, error) {
+// computeEngineService returns the name of the Compute Engine service in the billing catalog
+func (g *GceInfoer) computeEngineService() (string, error) {
+	return g.billingService("Compute Engine")
+}
+
+// billingService returns the name of the service with the display name in the billing catalog
+func (g *GceInfoer) billingService(displayName string) (string, error) {
 	svcList, err := g.cbSvc.Services.List().Fields("services/displayName", "services/name").Do()
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
-	var compEngId string
+	var svcId string
 	for _, svc := range svcList.Services {
-		if svc.DisplayName == "Compute Engine" {
-			compEngId = svc.Name
+		if svc.DisplayName == displayName {
+			svcId = svc.Name
 		}
 	}
 
+	return svcId, nil
+}
+
+// getPrice retrieves the on demand and preemptible prices of the vCPUs and the memory per region, along with
+// the committed use discount prices
+func (g *GceInfoer) getPrice() (map[string]map[string]map[string]float64, commitmentPrices, error) {
+	compEngId, err := g.computeEngineService()
+	if err != nil {
+		return nil, nil, err
+	}
+
 	price := make(map[string]map[string]map[string]float64)
+	commitments := make(commitmentPrices)
 	err = g.cbSvc.Services.Skus.List(compEngId).Pages(context.Background(), func(response *cloudbilling.ListSkusResponse) error {
 		for _, sku := range response.Skus {
+			commitments.add(sku)
 			if sku.Category.ResourceGroup == "G1Small" || sku.Category.ResourceGroup == "F1Micro" {
 				priceInUsd, err := g.priceInUsd(sku.PricingInfo)
 				if err != nil {
@@ -284,9 +340,9 @@ func (g *GceInfoer) getPrice() (map[string]map[string]map[string]float64, error)
 		return nil
 	})
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	return price, nil
+	return price, commitments, nil
 }
 
 func (g *GceInfoer) priceInUsd(pricingInfos []*cloudbilling.PricingInfo) (float64, error) {
@@ -321,9 +377,20 @@ func (g *GceInfoer) GetVirtualMachines(region string) ([]types.VMInfo, error) {
 	if err != nil {
 		return nil, err
 	}
-	err = g.computeSvc.MachineTypes.List(g.projectId, zones[0]).Pages(context.TODO(), func(allMts *compute.MachineTypeList) error {
-		for _, mt := range allMts.Items {
-			if _, ok := vmsMap[mt.Name]; !ok {
+	// the machine types are listed per zone, as not all of them are offered in every zone of the region
+	for _, zone := range zones {
+		err = g.computeSvc.MachineTypes.List(g.projectId, zone).Pages(context.TODO(), func(allMts *compute.MachineTypeList) error {
+			for _, mt := range allMts.Items {
+				if !usable(mt.Deprecated) {
+					continue
+				}
+
+				if vm, ok := vmsMap[mt.Name]; ok {
+					vm.Zones = append(vm.Zones, zone)
+					vmsMap[mt.Name] = vm
+					continue
+				}
+
 				switch {
 				case mt.GuestCpus < 1:
 					// minimum 1 Gbps network performance for each virtual machine
@@ -341,22 +408,47 @@ func (g *GceInfoer) GetVirtualMachines(region string) ([]types.VMInfo, error) {
 					logger.Debug(emperror.Wrap(err, "failed to get network performance category").Error(),
 						map[string]interface{}{"instanceType": mt.Name})
 				}
-				vmsMap[mt.Name] = types.VMInfo{
-					Category:   g.getCategory(mt.Name),
-					Type:       mt.Name,
-					Cpus:       float64(mt.GuestCpus),
-					Mem:        float64(mt.MemoryMb) / 1024,
-					NtwPerf:    fmt.Sprintf("%d Gbit/s", ntwPerf),
-					NtwPerfCat: ntwPerfCat,
-					Zones:      zones,
-					Attributes: cloudinfo.Attributes(fmt.Sprint(mt.GuestCpus), fmt.Sprint(float64(mt.MemoryMb)/1024), ntwPerfCat, g.getCategory(mt.Name)),
+				vm := types.VMInfo{
+					Category:            g.getCategory(mt.Name),
+					Type:                mt.Name,
+					Cpus:                float64(mt.GuestCpus),
+					Mem:                 float64(mt.MemoryMb) / 1024,
+					NtwPerf:             fmt.Sprintf("%d Gbit/s", ntwPerf),
+					NtwPerfCat:          ntwPerfCat,
+					NtwPerfGbps:         float64(ntwPerf),
+					Zones:               []string{zone},
+					Burst:               mt.IsSharedCpu,
+					BaselineCPU:         burstBaseline(mt.Name),
+					PlacementGroup:      placementGroup(mt.Name),
+					PlacementStrategies: placementStrategies(mt.Name),
+					Lifecycle:           lifecycle(mt.Deprecated),
+					Architecture:        architecture(mt.Name),
+					BareMetal:           strings.HasSuffix(mt.Name, "-metal"),
+					Attributes:          cloudinfo.Attributes(fmt.Sprint(mt.GuestCpus), fmt.Sprint(float64(mt.MemoryMb)/1024), ntwPerfCat, g.getCategory(mt.Name)),
 				}
+				// the accelerator optimized machine types come with attached GPUs, eg.: a2-highgpu-1g
+				var gpuType string
+				for _, accelerator := range mt.Accelerators {
+					if isTPU(accelerator.GuestAcceleratorType) {
+						continue
+					}
+					if gpuType == "" {
+						gpuType = accelerator.GuestAcceleratorType
+					}
+					vm.Gpus += float64(accelerator.GuestAcceleratorCount)
+				}
+				cloudinfo.SetGpu(&vm, gpuType)
+				vm.Accelerators = accelerators(mt)
+				if g.rawPayloads {
+					vm.RawPayload = cloudinfo.RawPayload(mt)
+				}
+				vmsMap[mt.Name] = vm
 			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
 		}
-		return nil
-	})
-	if err != nil {
-		return nil, err
 	}
 	var vms []types.VMInfo
 	for _, vm := range vmsMap {
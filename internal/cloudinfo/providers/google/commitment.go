@@ -0,0 +1,136 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package google
+
+import (
+	"strings"
+
+	"google.golang.org/api/cloudbilling/v1"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// commitmentTerms maps the usage types of the committed use discount SKUs to the terms of the commitments, in order
+var commitmentTerms = []struct {
+	usageType string
+	term      string
+}{
+	{usageType: "Commit1Yr", term: "1yr"},
+	{usageType: "Commit3Yr", term: "3yr"},
+}
+
+// commitmentFamilies maps the resource prefixes of the committed use discount SKUs to machine families,
+// the rest of the prefixes are the upper case machine families, eg.: N2, N2D AMD
+var commitmentFamilies = map[string]string{
+	"":                  "n1",
+	"Memory-optimized":  "m1",
+	"Compute optimized": "c2",
+}
+
+// machineFamilyAliases maps the machine families sharing the committed use discount SKUs of another family
+var machineFamilyAliases = map[string]string{
+	"m2": "m1",
+}
+
+// commitmentKey identifies the committed use discount price of a resource of a machine family
+type commitmentKey struct {
+	family    string
+	resource  string
+	usageType string
+}
+
+// commitmentPrices holds the hourly committed use discount prices of the vCPUs and the memory per region
+type commitmentPrices map[string]map[commitmentKey]float64
+
+// add records the price of a committed use discount SKU, the rest of the SKUs are ignored
+func (c commitmentPrices) add(sku *cloudbilling.Sku) {
+	if sku.Category == nil || sku.Category.ResourceFamily != "Compute" || len(sku.PricingInfo) != 1 {
+		return
+	}
+	if sku.Category.ResourceGroup != "CPU" && sku.Category.ResourceGroup != "RAM" {
+		return
+	}
+	if !strings.HasPrefix(sku.Category.UsageType, "Commit") {
+		return
+	}
+
+	family, ok := commitmentFamily(sku.Description)
+	if !ok {
+		return
+	}
+
+	key := commitmentKey{family: family, resource: types.CPU, usageType: sku.Category.UsageType}
+	if sku.Category.ResourceGroup == "RAM" {
+		key.resource = types.Memory
+	}
+	for _, region := range sku.ServiceRegions {
+		if c[region] == nil {
+			c[region] = make(map[commitmentKey]float64)
+		}
+		c[region][key] = skuPrice(sku.PricingInfo[0])
+	}
+}
+
+// commitmentFamily parses the machine family from the description of a committed use discount SKU,
+// eg.: Commitment v1: N2 Cpu in Americas for 1 Year
+func commitmentFamily(description string) (string, bool) {
+	if !strings.HasPrefix(description, "Commitment") || strings.Contains(description, "Sole Tenancy") {
+		return "", false
+	}
+
+	resource := description[strings.Index(description, ":")+1:]
+	if i := strings.Index(resource, " in "); i >= 0 {
+		resource = resource[:i]
+	}
+	fields := strings.Fields(resource)
+	if len(fields) == 0 || (fields[len(fields)-1] != "Cpu" && fields[len(fields)-1] != "Ram") {
+		return "", false
+	}
+
+	prefix := strings.Join(fields[:len(fields)-1], " ")
+	if family, ok := commitmentFamilies[prefix]; ok {
+		return family, true
+	}
+
+	return strings.ToLower(fields[0]), true
+}
+
+// reservedPrices returns the committed use discount prices of a machine type in the region, priced from its vCPUs and
+// memory; the shared core machine types can't be committed to
+func (c commitmentPrices) reservedPrices(region, machineType string, cpus, memGb float64, sharedCpu bool) []types.ReservedPrice {
+	if sharedCpu {
+		return nil
+	}
+
+	family := strings.SplitN(machineType, "-", 2)[0]
+	if alias, ok := machineFamilyAliases[family]; ok {
+		family = alias
+	}
+
+	var prices []types.ReservedPrice
+	for _, term := range commitmentTerms {
+		cpuPrice := c[region][commitmentKey{family: family, resource: types.CPU, usageType: term.usageType}]
+		memPrice := c[region][commitmentKey{family: family, resource: types.Memory, usageType: term.usageType}]
+		if cpuPrice == 0 || memPrice == 0 {
+			continue
+		}
+
+		// the commitments are billed monthly, without upfront payment
+		prices = append(prices, cloudinfo.NewReservedPrice(term.term, types.PaymentNoUpfront, 0, cpuPrice*cpus+memPrice*memGb))
+	}
+
+	return prices
+}
@@ -0,0 +1,84 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package google
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/api/cloudbilling/v1"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func commitmentSku(description, resourceGroup, usageType string, nanos int64, regions ...string) *cloudbilling.Sku {
+	return &cloudbilling.Sku{
+		Description:    description,
+		Category:       &cloudbilling.Category{ResourceFamily: "Compute", ResourceGroup: resourceGroup, UsageType: usageType},
+		ServiceRegions: regions,
+		PricingInfo: []*cloudbilling.PricingInfo{{
+			PricingExpression: &cloudbilling.PricingExpression{TieredRates: []*cloudbilling.TierRate{
+				{UnitPrice: &cloudbilling.Money{Nanos: nanos}},
+			}},
+		}},
+	}
+}
+
+func TestCommitmentFamily(t *testing.T) {
+	tests := map[string]string{
+		"Commitment v1: Cpu in Americas for 1 Year":              "n1",
+		"Commitment v1: N2 Ram in Belgium for 3 Year":            "n2",
+		"Commitment v1: N2D AMD Cpu in Iowa for 1 Year":          "n2d",
+		"Commitment v1: Memory-optimized Cpu in Iowa for 3 Year": "m1",
+	}
+	for description, expected := range tests {
+		family, ok := commitmentFamily(description)
+		assert.True(t, ok, description)
+		assert.Equal(t, expected, family, description)
+	}
+
+	_, ok := commitmentFamily("Commitment v1: Sole Tenancy Premium for Cpu in Iowa for 1 Year")
+	assert.False(t, ok, "the sole tenancy premium should be ignored")
+	_, ok = commitmentFamily("N2 Instance Core running in Iowa")
+	assert.False(t, ok, "the on demand prices should be ignored")
+}
+
+func TestCommitmentPrices_reservedPrices(t *testing.T) {
+	commitments := make(commitmentPrices)
+	for _, sku := range []*cloudbilling.Sku{
+		commitmentSku("Commitment v1: N2 Cpu in Belgium for 1 Year", "CPU", "Commit1Yr", 19940000, "europe-west1"),
+		commitmentSku("Commitment v1: N2 Ram in Belgium for 1 Year", "RAM", "Commit1Yr", 2670000, "europe-west1"),
+		commitmentSku("Commitment v1: N2 Cpu in Belgium for 3 Year", "CPU", "Commit3Yr", 14240000, "europe-west1"),
+		commitmentSku("Commitment v1: N2 Ram in Belgium for 3 Year", "RAM", "Commit3Yr", 1910000, "europe-west1"),
+		// the E2 commitments are not priced without the RAM price
+		commitmentSku("Commitment v1: E2 Cpu in Belgium for 1 Year", "CPU", "Commit1Yr", 13880000, "europe-west1"),
+		commitmentSku("N2 Instance Core running in Belgium", "CPU", "OnDemand", 34730000, "europe-west1"),
+	} {
+		commitments.add(sku)
+	}
+
+	prices := commitments.reservedPrices("europe-west1", "n2-standard-2", 2, 8, false)
+	if assert.Len(t, prices, 2) {
+		assert.Equal(t, "1yr", prices[0].Term)
+		assert.Equal(t, types.PaymentNoUpfront, prices[0].PaymentOption)
+		assert.InDelta(t, 2*0.01994+8*0.00267, prices[0].EffectiveHourlyPrice, 1e-9)
+		assert.Equal(t, "3yr", prices[1].Term)
+		assert.InDelta(t, 2*0.01424+8*0.00191, prices[1].EffectiveHourlyPrice, 1e-9)
+	}
+
+	assert.Empty(t, commitments.reservedPrices("europe-west1", "e2-standard-2", 2, 8, false))
+	assert.Empty(t, commitments.reservedPrices("europe-west1", "n2-standard-2", 2, 8, true), "shared core types can't be committed to")
+	assert.Empty(t, commitments.reservedPrices("us-east1", "n2-standard-2", 2, 8, false))
+}
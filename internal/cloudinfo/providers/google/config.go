@@ -19,4 +19,9 @@ type Config struct {
 	CredentialsFile string
 
 	Project string
+
+	UserAgent string
+
+	// RawPayloads enables retaining the raw provider responses of the products, set from the scrape configuration
+	RawPayloads bool `mapstructure:"-"`
 }
@@ -0,0 +1,186 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package google
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"emperror.dev/errors"
+	"google.golang.org/api/cloudbilling/v1"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// databasesTTL the time the Cloud SQL prices of all the regions are reused for,
+// so a scrape cycle lists the SKUs only once
+const databasesTTL = time.Hour
+
+// cloudSqlEngines maps the description prefixes of the Cloud SQL SKUs to the database engines,
+// SQL Server is left out as its license is priced per edition
+var cloudSqlEngines = map[string]string{
+	"Cloud SQL for MySQL: ":      types.DatabaseMySQL,
+	"Cloud SQL for PostgreSQL: ": types.DatabasePostgreSQL,
+}
+
+// cloudSqlDeployments maps the availability types in the descriptions of the Cloud SQL SKUs to the database deployments
+var cloudSqlDeployments = map[string]string{
+	"Zonal":    types.DatabaseSingleZone,
+	"Regional": types.DatabaseHighAvailability,
+}
+
+// cloudSqlCpus the vCPU counts of the custom machine shapes the prices are calculated for
+var cloudSqlCpus = []float64{1, 2, 4, 8, 16, 32, 64, 96}
+
+// cloudSqlMemPerCpu the memory per vCPU of the standard and high memory custom machine shapes in GiB
+var cloudSqlMemPerCpu = []float64{3.75, 6.5}
+
+// cloudSqlPrice the vCPU and memory prices of an engine and deployment in a region
+type cloudSqlPrice struct {
+	engine     string
+	deployment string
+	cpuPrice   float64
+	memPrice   float64
+}
+
+// GetDatabases retrieves the Cloud SQL custom machine shapes and their prices in a region
+func (g *GceInfoer) GetDatabases(region string) ([]types.DatabaseInfo, error) {
+	g.databaseMu.Lock()
+	defer g.databaseMu.Unlock()
+
+	if g.databases == nil || time.Since(g.databasesAt) > databasesTTL {
+		skus, err := g.listCloudSqlSkus()
+		if err != nil {
+			return nil, err
+		}
+
+		g.databases = cloudSqlDatabases(skus)
+		g.databasesAt = time.Now()
+	}
+
+	return g.databases[region], nil
+}
+
+// listCloudSqlSkus lists the SKUs of the Cloud SQL service
+func (g *GceInfoer) listCloudSqlSkus() ([]*cloudbilling.Sku, error) {
+	cloudSqlId, err := g.billingService("Cloud SQL")
+	if err != nil {
+		return nil, err
+	}
+
+	var skus []*cloudbilling.Sku
+	err = g.cbSvc.Services.Skus.List(cloudSqlId).Pages(context.Background(), func(response *cloudbilling.ListSkusResponse) error {
+		skus = append(skus, response.Skus...)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to list cloud sql skus")
+	}
+
+	return skus, nil
+}
+
+// cloudSqlDatabases assembles the prices of the custom machine shapes per region from the on demand vCPU and RAM SKUs,
+// eg.: "Cloud SQL for MySQL: Zonal - vCPU in Belgium"
+func cloudSqlDatabases(skus []*cloudbilling.Sku) map[string][]types.DatabaseInfo {
+	prices := make(map[string]map[string]*cloudSqlPrice)
+	for _, sku := range skus {
+		if sku.Category == nil || sku.Category.UsageType != "OnDemand" || len(sku.PricingInfo) != 1 {
+			continue
+		}
+
+		for prefix, engine := range cloudSqlEngines {
+			if !strings.HasPrefix(sku.Description, prefix) {
+				continue
+			}
+
+			availability, resource := cloudSqlResource(strings.TrimPrefix(sku.Description, prefix))
+			deployment, ok := cloudSqlDeployments[availability]
+			if !ok || (resource != "vCPU" && resource != "RAM") {
+				continue
+			}
+
+			price := skuPrice(sku.PricingInfo[0])
+			for _, region := range sku.ServiceRegions {
+				if prices[region] == nil {
+					prices[region] = make(map[string]*cloudSqlPrice)
+				}
+				key := engine + "/" + deployment
+				if prices[region][key] == nil {
+					prices[region][key] = &cloudSqlPrice{engine: engine, deployment: deployment}
+				}
+
+				if resource == "vCPU" {
+					prices[region][key].cpuPrice = price
+				} else {
+					prices[region][key].memPrice = price
+				}
+			}
+		}
+	}
+
+	databases := make(map[string][]types.DatabaseInfo, len(prices))
+	for region, regionPrices := range prices {
+		for _, price := range regionPrices {
+			if price.cpuPrice == 0 || price.memPrice == 0 {
+				continue
+			}
+
+			for _, memPerCpu := range cloudSqlMemPerCpu {
+				for _, cpus := range cloudSqlCpus {
+					mem := cpus * memPerCpu
+					databases[region] = append(databases[region], types.DatabaseInfo{
+						InstanceClass: fmt.Sprintf("db-custom-%d-%d", int(cpus), int(mem*1024)),
+						Engine:        price.engine,
+						Deployment:    price.deployment,
+						Cpus:          cpus,
+						Mem:           mem,
+						OnDemandPrice: cpus*price.cpuPrice + mem*price.memPrice,
+					})
+				}
+			}
+		}
+
+		sort.Slice(databases[region], func(i, j int) bool {
+			a, b := databases[region][i], databases[region][j]
+			if a.Engine != b.Engine {
+				return a.Engine < b.Engine
+			}
+			if a.Deployment != b.Deployment {
+				return a.Deployment > b.Deployment
+			}
+			if a.Cpus != b.Cpus {
+				return a.Cpus < b.Cpus
+			}
+			return a.Mem < b.Mem
+		})
+	}
+
+	return databases
+}
+
+// cloudSqlResource splits the rest of a Cloud SQL SKU description to the availability type and the priced resource,
+// eg.: "Regional - RAM in Iowa" to "Regional" and "RAM"
+func cloudSqlResource(description string) (string, string) {
+	parts := strings.SplitN(description, " - ", 2)
+	if len(parts) != 2 {
+		return "", ""
+	}
+
+	return parts[0], strings.SplitN(parts[1], " in ", 2)[0]
+}
@@ -0,0 +1,60 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package google
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/api/cloudbilling/v1"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func cloudSqlSku(description string, nanos int64, regions ...string) *cloudbilling.Sku {
+	return &cloudbilling.Sku{
+		Description:    description,
+		Category:       &cloudbilling.Category{ResourceFamily: "ApplicationServices", UsageType: "OnDemand"},
+		ServiceRegions: regions,
+		PricingInfo: []*cloudbilling.PricingInfo{{
+			PricingExpression: &cloudbilling.PricingExpression{TieredRates: []*cloudbilling.TierRate{
+				{UnitPrice: &cloudbilling.Money{Nanos: nanos}},
+			}},
+		}},
+	}
+}
+
+func TestCloudSqlDatabases(t *testing.T) {
+	databases := cloudSqlDatabases([]*cloudbilling.Sku{
+		cloudSqlSku("Cloud SQL for MySQL: Zonal - vCPU in Belgium", 41300000, "europe-west1"),
+		cloudSqlSku("Cloud SQL for MySQL: Zonal - RAM in Belgium", 7000000, "europe-west1"),
+		cloudSqlSku("Cloud SQL for MySQL: Regional - vCPU in Belgium", 82600000, "europe-west1"),
+		cloudSqlSku("Cloud SQL for MySQL: Regional - RAM in Belgium", 14000000, "europe-west1"),
+		cloudSqlSku("Cloud SQL for MySQL: Zonal - Micro instance in Belgium", 10500000, "europe-west1"),
+		// the shapes are not priced without the RAM price
+		cloudSqlSku("Cloud SQL for PostgreSQL: Zonal - vCPU in Belgium", 41300000, "europe-west1"),
+		cloudSqlSku("Cloud SQL for SQL Server: Zonal - vCPU in Belgium", 41300000, "europe-west1"),
+	})
+
+	assert.Len(t, databases["europe-west1"], 2*len(cloudSqlCpus)*len(cloudSqlMemPerCpu),
+		"the standard and high memory shapes should be priced for both deployments of the engine")
+	smallest := databases["europe-west1"][0]
+	assert.Equal(t, "db-custom-1-3840", smallest.InstanceClass)
+	assert.Equal(t, types.DatabaseMySQL, smallest.Engine)
+	assert.Equal(t, types.DatabaseSingleZone, smallest.Deployment)
+	assert.Equal(t, 3.75, smallest.Mem)
+	assert.InDelta(t, 0.0413+3.75*0.007, smallest.OnDemandPrice, 1e-9, "the vCPU and the RAM prices should be summed")
+	assert.Equal(t, types.DatabaseHighAvailability, databases["europe-west1"][len(databases["europe-west1"])-1].Deployment)
+}
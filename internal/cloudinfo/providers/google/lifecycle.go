@@ -0,0 +1,46 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package google
+
+import (
+	"google.golang.org/api/compute/v1"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// lifecycle returns the lifecycle stage of a machine type from its deprecation status,
+// the machine types without a deprecation status are generally available
+func lifecycle(deprecation *compute.DeprecationStatus) string {
+	if deprecation == nil {
+		return types.LifecycleGA
+	}
+
+	switch deprecation.State {
+	case "DEPRECATED", "OBSOLETE", "DELETED":
+		return types.LifecycleRetiring
+	default:
+		// scheduled deprecations are published with the ACTIVE state and the date of the deprecation
+		if deprecation.Deprecated != "" || deprecation.Obsolete != "" || deprecation.Deleted != "" {
+			return types.LifecycleRetiring
+		}
+
+		return types.LifecycleGA
+	}
+}
+
+// usable checks whether new instances can be created with a machine type of the given deprecation status
+func usable(deprecation *compute.DeprecationStatus) bool {
+	return deprecation == nil || (deprecation.State != "OBSOLETE" && deprecation.State != "DELETED")
+}
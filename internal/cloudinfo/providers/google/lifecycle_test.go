@@ -0,0 +1,51 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package google
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/api/compute/v1"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestLifecycle(t *testing.T) {
+	tests := []struct {
+		name        string
+		deprecation *compute.DeprecationStatus
+		lifecycle   string
+		usable      bool
+	}{
+		{name: "no deprecation status", lifecycle: types.LifecycleGA, usable: true},
+		{name: "active", deprecation: &compute.DeprecationStatus{State: "ACTIVE"}, lifecycle: types.LifecycleGA, usable: true},
+		{
+			name:        "deprecation scheduled",
+			deprecation: &compute.DeprecationStatus{State: "ACTIVE", Deprecated: "2026-12-01T00:00:00Z"},
+			lifecycle:   types.LifecycleRetiring,
+			usable:      true,
+		},
+		{name: "deprecated", deprecation: &compute.DeprecationStatus{State: "DEPRECATED"}, lifecycle: types.LifecycleRetiring, usable: true},
+		{name: "obsolete", deprecation: &compute.DeprecationStatus{State: "OBSOLETE"}, lifecycle: types.LifecycleRetiring, usable: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.lifecycle, lifecycle(test.deprecation))
+			assert.Equal(t, test.usable, usable(test.deprecation))
+		})
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package google
+
+import (
+	"strings"
+
+	"google.golang.org/api/cloudbilling/v1"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// forwardingRuleLoadBalancer the type of the load balancers priced by forwarding rules and processed data
+const forwardingRuleLoadBalancer = "forwarding-rule"
+
+// GetLoadBalancerPrices retrieves the prices of the load balancer forwarding rules in a region
+// the hourly price is the minimum service charge covering the first five forwarding rules
+func (g *GceInfoer) GetLoadBalancerPrices(region string) ([]types.LoadBalancerPrice, error) {
+	g.networkMu.Lock()
+	defer g.networkMu.Unlock()
+
+	if err := g.refreshNetworkPrices(); err != nil {
+		return nil, err
+	}
+
+	return g.loadBalancerPrices[region], nil
+}
+
+// loadBalancerPrices assembles the load balancer prices per region from the on demand load balancing SKUs
+func loadBalancerPrices(skus []*cloudbilling.Sku) map[string][]types.LoadBalancerPrice {
+	prices := make(map[string]*types.LoadBalancerPrice)
+	for _, sku := range skus {
+		if sku.Category.ResourceGroup != "LoadBalancing" || sku.Category.UsageType != "OnDemand" || len(sku.PricingInfo) != 1 {
+			continue
+		}
+
+		price := skuPrice(sku.PricingInfo[0])
+		for _, region := range sku.ServiceRegions {
+			loadBalancerPrice, ok := prices[region]
+			if !ok {
+				loadBalancerPrice = &types.LoadBalancerPrice{Type: forwardingRuleLoadBalancer}
+				prices[region] = loadBalancerPrice
+			}
+
+			switch {
+			case strings.Contains(sku.Description, "Forwarding Rule Minimum Service Charge"):
+				loadBalancerPrice.PricePerHour = price
+			case strings.Contains(sku.Description, "Data Processing Charge"):
+				loadBalancerPrice.PricePerGb = price
+			}
+		}
+	}
+
+	regionPrices := make(map[string][]types.LoadBalancerPrice, len(prices))
+	for region, price := range prices {
+		if price.PricePerHour > 0 {
+			regionPrices[region] = []types.LoadBalancerPrice{*price}
+		}
+	}
+
+	return regionPrices
+}
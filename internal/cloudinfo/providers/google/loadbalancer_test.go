@@ -0,0 +1,44 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package google
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/api/cloudbilling/v1"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestLoadBalancerPrices(t *testing.T) {
+	loadBalancingSku := func(description string, nanos int64, regions ...string) *cloudbilling.Sku {
+		sku := networkSku("LoadBalancing", []int64{nanos}, regions...)
+		sku.Description = description
+		return sku
+	}
+
+	prices := loadBalancerPrices([]*cloudbilling.Sku{
+		loadBalancingSku("Network Load Balancing: Forwarding Rule Minimum Service Charge in Belgium", 25000000, "europe-west1"),
+		loadBalancingSku("Network Load Balancing: Forwarding Rule Additional Service Charge in Belgium", 10000000, "europe-west1"),
+		loadBalancingSku("Network Load Balancing: Data Processing Charge in Belgium", 8000000, "europe-west1"),
+		loadBalancingSku("Network Load Balancing: Data Processing Charge in Iowa", 8000000, "us-central1"),
+		networkSku("InterzoneEgress", []int64{10000000}, "europe-west1"),
+	})
+
+	assert.Equal(t, map[string][]types.LoadBalancerPrice{
+		"europe-west1": {{Type: forwardingRuleLoadBalancer, PricePerHour: 25000000 / 1e9, PricePerGb: 8000000 / 1e9}},
+	}, prices, "the regions without an hourly price should be omitted")
+}
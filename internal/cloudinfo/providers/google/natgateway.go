@@ -0,0 +1,118 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package google
+
+import (
+	"sort"
+	"strings"
+
+	"google.golang.org/api/cloudbilling/v1"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// publicIpSkus maps the description prefixes of the external IP address SKUs to the address kinds
+var publicIpSkus = map[string]string{
+	"External IP Charge on a Standard VM": "in-use",
+	"Static Ip Charge":                    "idle",
+}
+
+// GetNatGatewayPrices retrieves the uptime and data processing prices of the Cloud NAT gateways in a region
+func (g *GceInfoer) GetNatGatewayPrices(region string) ([]types.NatGatewayPrice, error) {
+	g.networkMu.Lock()
+	defer g.networkMu.Unlock()
+
+	if err := g.refreshNetworkPrices(); err != nil {
+		return nil, err
+	}
+
+	return g.natGatewayPrices[region], nil
+}
+
+// GetPublicIpPrices retrieves the prices of the external IP addresses in a region
+func (g *GceInfoer) GetPublicIpPrices(region string) ([]types.PublicIpPrice, error) {
+	g.networkMu.Lock()
+	defer g.networkMu.Unlock()
+
+	if err := g.refreshNetworkPrices(); err != nil {
+		return nil, err
+	}
+
+	return g.publicIpPrices[region], nil
+}
+
+// natGatewayPrices assembles the Cloud NAT prices per region from the on demand NAT gateway SKUs
+func natGatewayPrices(skus []*cloudbilling.Sku) map[string][]types.NatGatewayPrice {
+	prices := make(map[string]*types.NatGatewayPrice)
+	for _, sku := range skus {
+		if sku.Category.UsageType != "OnDemand" || len(sku.PricingInfo) != 1 || !strings.Contains(sku.Description, "NAT Gateway") {
+			continue
+		}
+
+		price := skuPrice(sku.PricingInfo[0])
+		for _, region := range sku.ServiceRegions {
+			natGatewayPrice, ok := prices[region]
+			if !ok {
+				natGatewayPrice = &types.NatGatewayPrice{Type: "cloud-nat"}
+				prices[region] = natGatewayPrice
+			}
+
+			switch description := strings.ToLower(sku.Description); {
+			case strings.Contains(description, "uptime"):
+				natGatewayPrice.PricePerHour = price
+			case strings.Contains(description, "data processing"):
+				natGatewayPrice.PricePerGb = price
+			}
+		}
+	}
+
+	regionPrices := make(map[string][]types.NatGatewayPrice, len(prices))
+	for region, price := range prices {
+		if price.PricePerHour > 0 {
+			regionPrices[region] = []types.NatGatewayPrice{*price}
+		}
+	}
+
+	return regionPrices
+}
+
+// publicIpPrices assembles the external IP address prices per region from the on demand IP address SKUs
+func publicIpPrices(skus []*cloudbilling.Sku) map[string][]types.PublicIpPrice {
+	regionPrices := make(map[string][]types.PublicIpPrice)
+	for _, sku := range skus {
+		if sku.Category.UsageType != "OnDemand" || len(sku.PricingInfo) != 1 {
+			continue
+		}
+
+		for prefix, kind := range publicIpSkus {
+			if !strings.HasPrefix(sku.Description, prefix) {
+				continue
+			}
+
+			price := skuPrice(sku.PricingInfo[0])
+			for _, region := range sku.ServiceRegions {
+				regionPrices[region] = append(regionPrices[region], types.PublicIpPrice{Type: kind, PricePerHour: price})
+			}
+		}
+	}
+
+	for region := range regionPrices {
+		sort.Slice(regionPrices[region], func(i, j int) bool {
+			return regionPrices[region][i].Type < regionPrices[region][j].Type
+		})
+	}
+
+	return regionPrices
+}
@@ -0,0 +1,57 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package google
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/api/cloudbilling/v1"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func describedNetworkSku(description string, nanos int64, regions ...string) *cloudbilling.Sku {
+	sku := networkSku("", []int64{nanos}, regions...)
+	sku.Description = description
+	return sku
+}
+
+func TestNatGatewayPrices(t *testing.T) {
+	prices := natGatewayPrices([]*cloudbilling.Sku{
+		describedNetworkSku("Networking Cloud NAT Gateway Uptime", 1400000, "europe-west1"),
+		describedNetworkSku("Networking Cloud NAT Gateway Data Processing", 45000000, "europe-west1"),
+		describedNetworkSku("Networking Cloud NAT Gateway Data Processing", 45000000, "us-central1"),
+	})
+
+	assert.Equal(t, map[string][]types.NatGatewayPrice{
+		"europe-west1": {{Type: "cloud-nat", PricePerHour: 1400000 / 1e9, PricePerGb: 45000000 / 1e9}},
+	}, prices, "the regions without an uptime price should be omitted")
+}
+
+func TestPublicIpPrices(t *testing.T) {
+	prices := publicIpPrices([]*cloudbilling.Sku{
+		describedNetworkSku("External IP Charge on a Standard VM", 5000000, "europe-west1"),
+		describedNetworkSku("Static Ip Charge", 10000000, "europe-west1"),
+		describedNetworkSku("External IP Charge on a Spot Preemptible VM", 2500000, "europe-west1"),
+	})
+
+	assert.Equal(t, map[string][]types.PublicIpPrice{
+		"europe-west1": {
+			{Type: "idle", PricePerHour: 10000000 / 1e9},
+			{Type: "in-use", PricePerHour: 5000000 / 1e9},
+		},
+	}, prices)
+}
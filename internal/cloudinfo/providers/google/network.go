@@ -0,0 +1,128 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package google
+
+import (
+	"sort"
+	"time"
+
+	"google.golang.org/api/cloudbilling/v1"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// networkPricesTTL the time the network prices of all the regions are reused for,
+// so a network scrape cycle lists the SKUs only once
+const networkPricesTTL = time.Hour
+
+// networkResourceGroups maps the resource groups of the network egress SKUs to the network traffic types
+var networkResourceGroups = map[string]string{
+	"PremiumInternetEgress": types.TrafficInternetEgress,
+	"InterzoneEgress":       types.TrafficInterZone,
+	"InterregionEgress":     types.TrafficInterRegion,
+}
+
+// GetNetworkPrices retrieves the premium tier network egress prices in a region
+func (g *GceInfoer) GetNetworkPrices(region string) ([]types.NetworkPrice, error) {
+	g.networkMu.Lock()
+	defer g.networkMu.Unlock()
+
+	if err := g.refreshNetworkPrices(); err != nil {
+		return nil, err
+	}
+
+	return g.networkPrices[region], nil
+}
+
+// refreshNetworkPrices lists the network SKUs and assembles the network egress, load balancer, NAT gateway and public IP
+// prices of all the regions if the cached ones are expired; the caller must hold networkMu
+func (g *GceInfoer) refreshNetworkPrices() error {
+	if g.networkPrices != nil && time.Since(g.networkPricesAt) <= networkPricesTTL {
+		return nil
+	}
+
+	skus, err := g.listComputeSkus("Network")
+	if err != nil {
+		return err
+	}
+
+	g.networkPrices = networkPrices(skus)
+	g.loadBalancerPrices = loadBalancerPrices(skus)
+	g.natGatewayPrices = natGatewayPrices(skus)
+	g.publicIpPrices = publicIpPrices(skus)
+	g.networkPricesAt = time.Now()
+
+	return nil
+}
+
+// networkPrices assembles the network egress prices per region from the on demand egress SKUs
+// the egress is priced per destination, the highest of the prices is reported
+func networkPrices(skus []*cloudbilling.Sku) map[string][]types.NetworkPrice {
+	prices := make(map[string]map[string]float64)
+	for _, sku := range skus {
+		if sku.Category.UsageType != "OnDemand" || len(sku.PricingInfo) != 1 {
+			continue
+		}
+
+		trafficType, ok := networkResourceGroups[sku.Category.ResourceGroup]
+		if !ok {
+			continue
+		}
+
+		price := firstPaidTierPrice(sku.PricingInfo[0])
+		if price == 0 {
+			continue
+		}
+
+		for _, region := range sku.ServiceRegions {
+			if prices[region] == nil {
+				prices[region] = make(map[string]float64)
+			}
+			if price > prices[region][trafficType] {
+				prices[region][trafficType] = price
+			}
+		}
+	}
+
+	regionPrices := make(map[string][]types.NetworkPrice, len(prices))
+	for region, trafficTypes := range prices {
+		for trafficType, price := range trafficTypes {
+			regionPrices[region] = append(regionPrices[region], types.NetworkPrice{Type: trafficType, PricePerGb: price})
+		}
+		sort.Slice(regionPrices[region], func(i, j int) bool {
+			return regionPrices[region][i].Type < regionPrices[region][j].Type
+		})
+	}
+
+	return regionPrices
+}
+
+// firstPaidTierPrice returns the unit price of the lowest tier of a SKU that is not free
+func firstPaidTierPrice(pricingInfo *cloudbilling.PricingInfo) float64 {
+	if pricingInfo.PricingExpression == nil {
+		return 0
+	}
+
+	for _, rate := range pricingInfo.PricingExpression.TieredRates {
+		if rate.UnitPrice == nil {
+			continue
+		}
+		if price := float64(rate.UnitPrice.Units) + float64(rate.UnitPrice.Nanos)/1e9; price > 0 {
+			return price
+		}
+	}
+
+	return 0
+}
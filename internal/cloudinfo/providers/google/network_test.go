@@ -0,0 +1,57 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package google
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/api/cloudbilling/v1"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func networkSku(resourceGroup string, nanos []int64, regions ...string) *cloudbilling.Sku {
+	rates := make([]*cloudbilling.TierRate, 0, len(nanos))
+	for _, n := range nanos {
+		rates = append(rates, &cloudbilling.TierRate{UnitPrice: &cloudbilling.Money{Nanos: n}})
+	}
+	return &cloudbilling.Sku{
+		Category:       &cloudbilling.Category{ResourceFamily: "Network", ResourceGroup: resourceGroup, UsageType: "OnDemand"},
+		ServiceRegions: regions,
+		PricingInfo: []*cloudbilling.PricingInfo{{
+			PricingExpression: &cloudbilling.PricingExpression{TieredRates: rates},
+		}},
+	}
+}
+
+func TestNetworkPrices(t *testing.T) {
+	prices := networkPrices([]*cloudbilling.Sku{
+		networkSku("PremiumInternetEgress", []int64{0, 120000000, 110000000}, "europe-west1"),
+		networkSku("PremiumInternetEgress", []int64{230000000}, "europe-west1"),
+		networkSku("InterzoneEgress", []int64{10000000}, "europe-west1", "us-central1"),
+		networkSku("InterregionEgress", []int64{20000000}, "europe-west1"),
+		networkSku("StandardInternetEgress", []int64{85000000}, "europe-west1"),
+	})
+
+	assert.Equal(t, map[string][]types.NetworkPrice{
+		"europe-west1": {
+			{Type: types.TrafficInterRegion, PricePerGb: 20000000 / 1e9},
+			{Type: types.TrafficInterZone, PricePerGb: 10000000 / 1e9},
+			{Type: types.TrafficInternetEgress, PricePerGb: 230000000 / 1e9},
+		},
+		"us-central1": {{Type: types.TrafficInterZone, PricePerGb: 10000000 / 1e9}},
+	}, prices, "the first paid tier and the highest destination price should be reported")
+}
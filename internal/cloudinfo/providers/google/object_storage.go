@@ -0,0 +1,133 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package google
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"emperror.dev/errors"
+	"google.golang.org/api/cloudbilling/v1"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// storageClasses maps the resource groups of the regional Cloud Storage SKUs to the storage classes
+var storageClasses = map[string]string{
+	"RegionalStorage": "STANDARD",
+	"NearlineStorage": "NEARLINE",
+	"ColdlineStorage": "COLDLINE",
+	"ArchiveStorage":  "ARCHIVE",
+	"RegionalOps":     "STANDARD",
+	"NearlineOps":     "NEARLINE",
+	"ColdlineOps":     "COLDLINE",
+	"ArchiveOps":      "ARCHIVE",
+}
+
+// GetObjectStoragePrices retrieves the prices of the Cloud Storage classes in a region
+func (g *GceInfoer) GetObjectStoragePrices(region string) ([]types.ObjectStoragePrice, error) {
+	g.storageMu.Lock()
+	defer g.storageMu.Unlock()
+
+	if g.objectStoragePrices == nil || time.Since(g.objectStoragePricesAt) > storagePricesTTL {
+		skus, err := g.listObjectStorageSkus()
+		if err != nil {
+			return nil, err
+		}
+
+		g.objectStoragePrices = objectStoragePrices(skus)
+		g.objectStoragePricesAt = time.Now()
+	}
+
+	return g.objectStoragePrices[region], nil
+}
+
+// listObjectStorageSkus lists the SKUs of the Cloud Storage service
+func (g *GceInfoer) listObjectStorageSkus() ([]*cloudbilling.Sku, error) {
+	storageId, err := g.billingService("Cloud Storage")
+	if err != nil {
+		return nil, err
+	}
+
+	var skus []*cloudbilling.Sku
+	err = g.cbSvc.Services.Skus.List(storageId).Pages(context.Background(), func(response *cloudbilling.ListSkusResponse) error {
+		skus = append(skus, response.Skus...)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to list cloud storage skus")
+	}
+
+	return skus, nil
+}
+
+// objectStoragePrices assembles the Cloud Storage prices per region from the on demand storage and operation SKUs
+// the class A operations (eg.: inserting or listing objects) are reported as writes, the class B operations as reads
+func objectStoragePrices(skus []*cloudbilling.Sku) map[string][]types.ObjectStoragePrice {
+	prices := make(map[string]map[string]*types.ObjectStoragePrice)
+	for _, sku := range skus {
+		if sku.Category == nil || sku.Category.UsageType != "OnDemand" || len(sku.PricingInfo) != 1 {
+			continue
+		}
+
+		class, ok := storageClasses[sku.Category.ResourceGroup]
+		if !ok {
+			continue
+		}
+
+		price := skuPrice(sku.PricingInfo[0])
+		if expression := sku.PricingInfo[0].PricingExpression; expression != nil && expression.UsageUnit == "count" {
+			// the operations are priced per operation
+			price *= 1000
+		}
+
+		for _, region := range sku.ServiceRegions {
+			if prices[region] == nil {
+				prices[region] = make(map[string]*types.ObjectStoragePrice)
+			}
+			objectStoragePrice, ok := prices[region][class]
+			if !ok {
+				objectStoragePrice = &types.ObjectStoragePrice{Class: class}
+				prices[region][class] = objectStoragePrice
+			}
+
+			switch {
+			case strings.HasSuffix(sku.Category.ResourceGroup, "Storage"):
+				objectStoragePrice.PricePerGb = price
+			case strings.Contains(sku.Description, "Class A"):
+				objectStoragePrice.PricePerThousandWrites = price
+			case strings.Contains(sku.Description, "Class B"):
+				objectStoragePrice.PricePerThousandReads = price
+			}
+		}
+	}
+
+	regionPrices := make(map[string][]types.ObjectStoragePrice, len(prices))
+	for region, classes := range prices {
+		for _, price := range classes {
+			// the operations are only reported for the storage classes with a capacity price
+			if price.PricePerGb > 0 {
+				regionPrices[region] = append(regionPrices[region], *price)
+			}
+		}
+		sort.Slice(regionPrices[region], func(i, j int) bool {
+			return regionPrices[region][i].Class < regionPrices[region][j].Class
+		})
+	}
+
+	return regionPrices
+}
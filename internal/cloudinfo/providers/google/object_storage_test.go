@@ -0,0 +1,60 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package google
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/api/cloudbilling/v1"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func cloudStorageSku(description, resourceGroup, usageUnit string, nanos int64, regions ...string) *cloudbilling.Sku {
+	return &cloudbilling.Sku{
+		Description:    description,
+		Category:       &cloudbilling.Category{ResourceFamily: "Storage", ResourceGroup: resourceGroup, UsageType: "OnDemand"},
+		ServiceRegions: regions,
+		PricingInfo: []*cloudbilling.PricingInfo{{
+			PricingExpression: &cloudbilling.PricingExpression{
+				UsageUnit:   usageUnit,
+				TieredRates: []*cloudbilling.TierRate{{UnitPrice: &cloudbilling.Money{Nanos: nanos}}},
+			},
+		}},
+	}
+}
+
+func TestObjectStoragePrices(t *testing.T) {
+	prices := objectStoragePrices([]*cloudbilling.Sku{
+		cloudStorageSku("Standard Storage Belgium", "RegionalStorage", "GiBy.mo", 20000000, "europe-west1"),
+		cloudStorageSku("Regional Standard Class A Operations", "RegionalOps", "count", 5000, "europe-west1"),
+		cloudStorageSku("Regional Standard Class B Operations", "RegionalOps", "count", 400, "europe-west1"),
+		cloudStorageSku("Archive Storage Belgium", "ArchiveStorage", "GiBy.mo", 1200000, "europe-west1"),
+		cloudStorageSku("Nearline Storage Class A Operations", "NearlineOps", "count", 10000, "europe-west1"),
+		cloudStorageSku("Multi-Region Standard Storage US", "MultiRegionalStorage", "GiBy.mo", 26000000, "us"),
+	})
+
+	if assert.Len(t, prices["europe-west1"], 2, "only the storage classes with a capacity price should be reported") {
+		assert.Equal(t, types.ObjectStoragePrice{Class: "ARCHIVE", PricePerGb: 1200000 / 1e9}, prices["europe-west1"][0])
+
+		standard := prices["europe-west1"][1]
+		assert.Equal(t, "STANDARD", standard.Class)
+		assert.Equal(t, 20000000/1e9, standard.PricePerGb)
+		assert.InDelta(t, 0.005, standard.PricePerThousandWrites, 1e-9)
+		assert.InDelta(t, 0.0004, standard.PricePerThousandReads, 1e-9)
+	}
+	assert.NotContains(t, prices, "us", "the multi-regional storage should not be reported")
+}
@@ -0,0 +1,48 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package google
+
+import (
+	"strings"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+var (
+	// compactPlacementSeries holds the machine series supporting compact placement policies
+	// source: https://cloud.google.com/compute/docs/instances/define-instance-placement
+	compactPlacementSeries = []string{"a2", "a3", "c2", "c2d", "c3", "c3d", "c4", "c4a", "c4d", "g2", "h3", "n2", "n2d", "z3"}
+)
+
+// placementGroup returns the placement strategy supported by the machine type
+func placementGroup(machineType string) string {
+	series := strings.Split(machineType, "-")[0]
+	for _, s := range compactPlacementSeries {
+		if s == series {
+			return types.PlacementCluster
+		}
+	}
+
+	return ""
+}
+
+// placementStrategies returns the placement strategies supported by the machine type, spread placement policies apply to all
+func placementStrategies(machineType string) []string {
+	if placementGroup(machineType) == types.PlacementCluster {
+		return []string{types.PlacementCluster, types.PlacementSpread}
+	}
+
+	return []string{types.PlacementSpread}
+}
@@ -0,0 +1,48 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package google
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestPlacementGroup(t *testing.T) {
+	tests := []struct {
+		machineType string
+		placement   string
+	}{
+		{machineType: "c2-standard-60", placement: types.PlacementCluster},
+		{machineType: "c3d-highcpu-360", placement: types.PlacementCluster},
+		{machineType: "a3-highgpu-8g", placement: types.PlacementCluster},
+		{machineType: "n2d-standard-2", placement: types.PlacementCluster},
+		{machineType: "n1-standard-1", placement: ""},
+		{machineType: "e2-medium", placement: ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.machineType, func(t *testing.T) {
+			assert.Equal(t, test.placement, placementGroup(test.machineType))
+		})
+	}
+}
+
+func TestPlacementStrategies(t *testing.T) {
+	assert.Equal(t, []string{types.PlacementCluster, types.PlacementSpread}, placementStrategies("c2-standard-60"))
+	assert.Equal(t, []string{types.PlacementSpread}, placementStrategies("e2-medium"))
+}
@@ -0,0 +1,115 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package google
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"emperror.dev/errors"
+	"google.golang.org/api/cloudbilling/v1"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// serverlessPricesTTL the time the Cloud Run prices of all the regions are reused for,
+// so a scrape cycle lists the SKUs only once
+const serverlessPricesTTL = time.Hour
+
+// GetServerlessPrices retrieves the per vCPU-second, per GB-second and per request prices of the Cloud Run services
+// in a region, the prices of the CPU allocated only during the request processing are reported
+func (g *GceInfoer) GetServerlessPrices(region string) ([]types.ServerlessPrice, error) {
+	g.serverlessMu.Lock()
+	defer g.serverlessMu.Unlock()
+
+	if g.serverlessPrices == nil || time.Since(g.serverlessPricesAt) > serverlessPricesTTL {
+		skus, err := g.listCloudRunSkus()
+		if err != nil {
+			return nil, err
+		}
+
+		g.serverlessPrices = cloudRunPrices(skus)
+		g.serverlessPricesAt = time.Now()
+	}
+
+	return g.serverlessPrices[region], nil
+}
+
+// listCloudRunSkus lists the SKUs of the Cloud Run service
+func (g *GceInfoer) listCloudRunSkus() ([]*cloudbilling.Sku, error) {
+	cloudRunId, err := g.billingService("Cloud Run")
+	if err != nil {
+		return nil, err
+	}
+
+	var skus []*cloudbilling.Sku
+	err = g.cbSvc.Services.Skus.List(cloudRunId).Pages(context.Background(), func(response *cloudbilling.ListSkusResponse) error {
+		skus = append(skus, response.Skus...)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to list cloud run skus")
+	}
+
+	return skus, nil
+}
+
+// cloudRunPrices assembles the Cloud Run prices per region from the on demand CPU, memory and request SKUs,
+// eg.: "CPU Allocation Time (tier 2)"; the free tier of the SKUs and the always allocated CPU are skipped
+func cloudRunPrices(skus []*cloudbilling.Sku) map[string][]types.ServerlessPrice {
+	prices := make(map[string]*types.ServerlessPrice)
+	for _, sku := range skus {
+		if sku.Category == nil || sku.Category.UsageType != "OnDemand" || len(sku.PricingInfo) != 1 ||
+			strings.Contains(strings.ToLower(sku.Description), "always") {
+			continue
+		}
+
+		var set func(price *types.ServerlessPrice, unitPrice float64)
+		switch {
+		case strings.HasPrefix(sku.Description, "CPU Allocation Time"):
+			set = func(price *types.ServerlessPrice, unitPrice float64) { price.PricePerVcpuSecond = unitPrice }
+		case strings.HasPrefix(sku.Description, "Memory Allocation Time"):
+			set = func(price *types.ServerlessPrice, unitPrice float64) { price.PricePerGbSecond = unitPrice }
+		case strings.HasPrefix(sku.Description, "Requests"):
+			set = func(price *types.ServerlessPrice, unitPrice float64) { price.PricePerMillionRequests = unitPrice * 1e6 }
+		default:
+			continue
+		}
+
+		unitPrice := firstPaidTierPrice(sku.PricingInfo[0])
+		if unitPrice == 0 {
+			continue
+		}
+
+		for _, region := range sku.ServiceRegions {
+			price, ok := prices[region]
+			if !ok {
+				price = &types.ServerlessPrice{Type: "cloud-run", Os: types.OsLinux, Architecture: types.ArchitectureAMD64}
+				prices[region] = price
+			}
+			set(price, unitPrice)
+		}
+	}
+
+	regionPrices := make(map[string][]types.ServerlessPrice, len(prices))
+	for region, price := range prices {
+		if price.PricePerVcpuSecond > 0 && price.PricePerGbSecond > 0 {
+			regionPrices[region] = []types.ServerlessPrice{*price}
+		}
+	}
+
+	return regionPrices
+}
@@ -0,0 +1,52 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package google
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/api/cloudbilling/v1"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func cloudRunSku(description string, nanos []int64, regions ...string) *cloudbilling.Sku {
+	sku := networkSku("", nanos, regions...)
+	sku.Description = description
+	return sku
+}
+
+func TestCloudRunPrices(t *testing.T) {
+	prices := cloudRunPrices([]*cloudbilling.Sku{
+		cloudRunSku("CPU Allocation Time", []int64{0, 24000}, "europe-west1", "us-central1"),
+		cloudRunSku("Memory Allocation Time", []int64{0, 2500}, "europe-west1", "us-central1"),
+		cloudRunSku("Requests", []int64{0, 400}, "europe-west1", "us-central1"),
+		cloudRunSku("CPU Allocation Time (tier 2)", []int64{0, 33600}, "asia-east2"),
+		cloudRunSku("Memory Allocation Time (tier 2)", []int64{0, 3500}, "asia-east2"),
+		cloudRunSku("Idle Min-Instance CPU Allocation Time", []int64{2500}, "europe-west1"),
+		cloudRunSku("CPU Allocation Time (tier 2)", []int64{0, 33600}, "europe-north2"),
+	})
+
+	perRequest := 400 / 1e9
+	assert.Equal(t, map[string][]types.ServerlessPrice{
+		"europe-west1": {{Type: "cloud-run", Os: "linux", Architecture: "amd64", PricePerVcpuSecond: 24000 / 1e9,
+			PricePerGbSecond: 2500 / 1e9, PricePerMillionRequests: perRequest * 1e6}},
+		"us-central1": {{Type: "cloud-run", Os: "linux", Architecture: "amd64", PricePerVcpuSecond: 24000 / 1e9,
+			PricePerGbSecond: 2500 / 1e9, PricePerMillionRequests: perRequest * 1e6}},
+		"asia-east2": {{Type: "cloud-run", Os: "linux", Architecture: "amd64", PricePerVcpuSecond: 33600 / 1e9,
+			PricePerGbSecond: 3500 / 1e9}},
+	}, prices, "the regions without a memory price should be omitted")
+}
@@ -0,0 +1,161 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package google
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"emperror.dev/errors"
+	"google.golang.org/api/cloudbilling/v1"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// storagePricesTTL the time the persistent disk prices of all the regions are reused for,
+// so a storage scrape cycle lists the SKUs only once
+const storagePricesTTL = time.Hour
+
+// diskSkus maps the description prefixes of the zonal persistent disk SKUs to the disk types and the priced dimension
+var diskSkus = []struct {
+	prefix    string
+	diskType  string
+	dimension string
+}{
+	{prefix: "Storage PD Capacity", diskType: "pd-standard", dimension: "capacity"},
+	{prefix: "Balanced PD Capacity", diskType: "pd-balanced", dimension: "capacity"},
+	{prefix: "SSD backed PD Capacity", diskType: "pd-ssd", dimension: "capacity"},
+	{prefix: "Extreme PD Capacity", diskType: "pd-extreme", dimension: "capacity"},
+	{prefix: "Extreme PD IOPS", diskType: "pd-extreme", dimension: "iops"},
+}
+
+// diskSnapshotSku the description prefix of the standard persistent disk snapshot SKUs, the snapshots of all the disk
+// types are priced the same
+const diskSnapshotSku = "Storage PD Snapshot"
+
+// GetStoragePrices retrieves the prices of the persistent disk types in a region
+func (g *GceInfoer) GetStoragePrices(region string) ([]types.StoragePrice, error) {
+	g.storageMu.Lock()
+	defer g.storageMu.Unlock()
+
+	if g.storagePrices == nil || time.Since(g.storagePricesAt) > storagePricesTTL {
+		skus, err := g.listComputeSkus("Storage")
+		if err != nil {
+			return nil, err
+		}
+
+		g.storagePrices = diskPrices(skus)
+		g.storagePricesAt = time.Now()
+	}
+
+	return g.storagePrices[region], nil
+}
+
+// listComputeSkus lists the SKUs of a resource family (eg.: Storage, Network) of the Compute Engine service
+func (g *GceInfoer) listComputeSkus(resourceFamily string) ([]*cloudbilling.Sku, error) {
+	compEngId, err := g.computeEngineService()
+	if err != nil {
+		return nil, err
+	}
+
+	var skus []*cloudbilling.Sku
+	err = g.cbSvc.Services.Skus.List(compEngId).Pages(context.Background(), func(response *cloudbilling.ListSkusResponse) error {
+		for _, sku := range response.Skus {
+			if sku.Category != nil && sku.Category.ResourceFamily == resourceFamily {
+				skus = append(skus, sku)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.WrapIfWithDetails(err, "failed to list compute engine skus", "resourceFamily", resourceFamily)
+	}
+
+	return skus, nil
+}
+
+// diskPrices assembles the persistent disk prices per region from the on demand storage SKUs
+func diskPrices(skus []*cloudbilling.Sku) map[string][]types.StoragePrice {
+	prices := make(map[string]map[string]*types.StoragePrice)
+	snapshotPrices := make(map[string]float64)
+	for _, sku := range skus {
+		if sku.Category.UsageType != "OnDemand" || len(sku.PricingInfo) != 1 {
+			continue
+		}
+
+		if strings.HasPrefix(sku.Description, diskSnapshotSku) {
+			for _, region := range sku.ServiceRegions {
+				snapshotPrices[region] = skuPrice(sku.PricingInfo[0])
+			}
+			continue
+		}
+
+		for _, diskSku := range diskSkus {
+			if !strings.HasPrefix(sku.Description, diskSku.prefix) {
+				continue
+			}
+
+			price := skuPrice(sku.PricingInfo[0])
+			for _, region := range sku.ServiceRegions {
+				if prices[region] == nil {
+					prices[region] = make(map[string]*types.StoragePrice)
+				}
+				storagePrice, ok := prices[region][diskSku.diskType]
+				if !ok {
+					storagePrice = &types.StoragePrice{Type: diskSku.diskType}
+					prices[region][diskSku.diskType] = storagePrice
+				}
+
+				switch diskSku.dimension {
+				case "capacity":
+					storagePrice.PricePerGb = price
+				case "iops":
+					storagePrice.PricePerIops = price
+				}
+			}
+			break
+		}
+	}
+
+	regionPrices := make(map[string][]types.StoragePrice, len(prices))
+	for region, diskTypes := range prices {
+		for _, price := range diskTypes {
+			price.PricePerSnapshotGb = snapshotPrices[region]
+			regionPrices[region] = append(regionPrices[region], *price)
+		}
+		sort.Slice(regionPrices[region], func(i, j int) bool {
+			return regionPrices[region][i].Type < regionPrices[region][j].Type
+		})
+	}
+
+	return regionPrices
+}
+
+// skuPrice returns the unit price of the highest tier of a SKU (the lower tiers may be free)
+func skuPrice(pricingInfo *cloudbilling.PricingInfo) float64 {
+	if pricingInfo.PricingExpression == nil || len(pricingInfo.PricingExpression.TieredRates) == 0 {
+		return 0
+	}
+
+	rates := pricingInfo.PricingExpression.TieredRates
+	unitPrice := rates[len(rates)-1].UnitPrice
+	if unitPrice == nil {
+		return 0
+	}
+
+	return float64(unitPrice.Units) + float64(unitPrice.Nanos)/1e9
+}
@@ -0,0 +1,58 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package google
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/api/cloudbilling/v1"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func storageSku(description, usageType string, nanos int64, regions ...string) *cloudbilling.Sku {
+	return &cloudbilling.Sku{
+		Description:    description,
+		Category:       &cloudbilling.Category{ResourceFamily: "Storage", UsageType: usageType},
+		ServiceRegions: regions,
+		PricingInfo: []*cloudbilling.PricingInfo{{
+			PricingExpression: &cloudbilling.PricingExpression{
+				TieredRates: []*cloudbilling.TierRate{{UnitPrice: &cloudbilling.Money{Nanos: nanos}}},
+			},
+		}},
+	}
+}
+
+func TestDiskPrices(t *testing.T) {
+	prices := diskPrices([]*cloudbilling.Sku{
+		storageSku("Storage PD Capacity", "OnDemand", 40000000, "us-central1", "europe-west1"),
+		storageSku("SSD backed PD Capacity in Frankfurt", "OnDemand", 187000000, "europe-west3"),
+		storageSku("Extreme PD Capacity", "OnDemand", 125000000, "us-central1"),
+		storageSku("Extreme PD IOPS", "OnDemand", 65000000, "us-central1"),
+		storageSku("Regional SSD backed PD Capacity", "OnDemand", 340000000, "us-central1"),
+		storageSku("Storage PD Snapshot", "OnDemand", 26000000, "us-central1"),
+		storageSku("Balanced PD Capacity", "Commit1Yr", 50000000, "us-central1"),
+	})
+
+	assert.Equal(t, map[string][]types.StoragePrice{
+		"us-central1": {
+			{Type: "pd-extreme", PricePerGb: 0.125, PricePerIops: 0.065, PricePerSnapshotGb: 0.026},
+			{Type: "pd-standard", PricePerGb: 0.04, PricePerSnapshotGb: 0.026},
+		},
+		"europe-west1": {{Type: "pd-standard", PricePerGb: 0.04}},
+		"europe-west3": {{Type: "pd-ssd", PricePerGb: 0.187}},
+	}, prices)
+}
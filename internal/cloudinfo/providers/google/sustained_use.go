@@ -0,0 +1,51 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package google
+
+import (
+	"math"
+	"strings"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo"
+)
+
+// sustainedUseTiers the fractions of the on demand price charged for each quarter of the month a machine type is used in,
+// per machine family; the families without sustained use discounts (eg.: E2, A2) are charged the on demand price
+var sustainedUseTiers = map[string][]float64{
+	"n1":  {1, 0.8, 0.6, 0.4},
+	"f1":  {1, 0.8, 0.6, 0.4},
+	"g1":  {1, 0.8, 0.6, 0.4},
+	"n2":  {1, 0.8678, 0.7356, 0.6034},
+	"n2d": {1, 0.8678, 0.7356, 0.6034},
+	"c2":  {1, 0.8678, 0.7356, 0.6034},
+	"m1":  {1, 0.8678, 0.7356, 0.6034},
+	"m2":  {1, 0.8678, 0.7356, 0.6034},
+}
+
+// effectiveMonthlyPrice returns the price of running the machine type for a whole month,
+// with the sustained use discounts applied
+func effectiveMonthlyPrice(machineType string, onDemandPrice float64) float64 {
+	tiers, ok := sustainedUseTiers[strings.SplitN(machineType, "-", 2)[0]]
+	if !ok {
+		tiers = []float64{1}
+	}
+
+	var fraction float64
+	for _, tier := range tiers {
+		fraction += tier / float64(len(tiers))
+	}
+
+	return math.Round(onDemandPrice*cloudinfo.HoursPerMonth*fraction*10000) / 10000
+}
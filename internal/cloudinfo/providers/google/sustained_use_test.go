@@ -0,0 +1,27 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package google
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEffectiveMonthlyPrice(t *testing.T) {
+	assert.InDelta(t, 0.7*0.1*730, effectiveMonthlyPrice("n1-standard-2", 0.1), 0.0001, "the N1 machine types should get 30% off for a whole month")
+	assert.InDelta(t, 0.8017*0.1*730, effectiveMonthlyPrice("n2-standard-2", 0.1), 0.0001, "the N2 machine types should get about 20% off for a whole month")
+	assert.InDelta(t, 0.1*730, effectiveMonthlyPrice("e2-standard-2", 0.1), 0.0001, "the E2 machine types should not get sustained use discounts")
+}
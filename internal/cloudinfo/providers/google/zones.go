@@ -0,0 +1,59 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package google
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	"google.golang.org/api/compute/v1"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// GetZoneInfo returns the zones in a region with their numeric ids and the machine types offered in them
+func (g *GceInfoer) GetZoneInfo(region string) ([]types.ZoneInfo, error) {
+	zones := make([]types.ZoneInfo, 0)
+	err := g.computeSvc.Zones.List(g.projectId).Pages(context.TODO(), func(zoneList *compute.ZoneList) error {
+		for _, z := range zoneList.Items {
+			s := strings.Split(z.Region, "/")
+			if s[len(s)-1] == region && z.Name != "" {
+				zones = append(zones, types.ZoneInfo{Name: z.Name, Id: strconv.FormatUint(z.Id, 10)})
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range zones {
+		err := g.computeSvc.MachineTypes.List(g.projectId, zones[i].Name).Pages(context.TODO(), func(allMts *compute.MachineTypeList) error {
+			for _, mt := range allMts.Items {
+				zones[i].InstanceTypes = append(zones[i].InstanceTypes, mt.Name)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		sort.Strings(zones[i].InstanceTypes)
+	}
+
+	return zones, nil
+}
@@ -0,0 +1,180 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hetzner
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/restapi"
+)
+
+const (
+	apiURL = "https://api.hetzner.cloud/v1"
+)
+
+// serverType is a server type of the Hetzner Cloud API
+type serverType struct {
+	Name         string          `json:"name"`
+	Description  string          `json:"description"`
+	Cores        int             `json:"cores"`
+	Memory       float64         `json:"memory"`
+	Disk         int             `json:"disk"`
+	CPUType      string          `json:"cpu_type"`
+	Architecture string          `json:"architecture"`
+	Deprecated   bool            `json:"deprecated"`
+	Prices       []locationPrice `json:"prices"`
+}
+
+// locationPrice is the price of a resource in a location
+type locationPrice struct {
+	Location     string `json:"location"`
+	PriceHourly  amount `json:"price_hourly"`
+	PriceMonthly amount `json:"price_monthly"`
+}
+
+// amount is a price without (net) and with (gross) VAT, as decimal strings
+type amount struct {
+	Net   string `json:"net"`
+	Gross string `json:"gross"`
+}
+
+// location is a location (data center) of the Hetzner Cloud API
+type location struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Country     string `json:"country"`
+	City        string `json:"city"`
+	NetworkZone string `json:"network_zone"`
+}
+
+// pricing is the (partial) pricing of the Hetzner Cloud API
+type pricing struct {
+	Currency   string `json:"currency"`
+	PrimaryIPs []struct {
+		Type   string          `json:"type"`
+		Prices []locationPrice `json:"prices"`
+	} `json:"primary_ips"`
+}
+
+// meta is the pagination metadata of the Hetzner Cloud API listings
+type meta struct {
+	Pagination struct {
+		NextPage *int `json:"next_page"`
+	} `json:"pagination"`
+}
+
+// client is a minimal client of the Hetzner Cloud API
+type client struct {
+	api     *restapi.Client
+	baseURL string
+	token   string
+}
+
+func newClient(config Config) *client {
+	return &client{
+		api:     restapi.NewClient("hetzner", config.UserAgent),
+		baseURL: apiURL,
+		token:   config.Token,
+	}
+}
+
+// get retrieves a resource of the api and decodes it into the given value
+func (c *client) get(ctx context.Context, path string, query url.Values, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s%s?%s", c.baseURL, path, query.Encode()), nil)
+	if err != nil {
+		return errors.WrapIf(err, "failed to create request")
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.token)
+
+	return c.api.Do(req, v)
+}
+
+// listServerTypes lists the server types
+func (c *client) listServerTypes(ctx context.Context) ([]serverType, error) {
+	var serverTypes []serverType
+	for page := 1; ; {
+		var resp struct {
+			ServerTypes []serverType `json:"server_types"`
+			Meta        meta         `json:"meta"`
+		}
+
+		query := url.Values{"page": {strconv.Itoa(page)}, "per_page": {"50"}}
+		if err := c.get(ctx, "/server_types", query, &resp); err != nil {
+			return nil, err
+		}
+
+		serverTypes = append(serverTypes, resp.ServerTypes...)
+
+		if resp.Meta.Pagination.NextPage == nil {
+			return serverTypes, nil
+		}
+		page = *resp.Meta.Pagination.NextPage
+	}
+}
+
+// listLocations lists the locations
+func (c *client) listLocations(ctx context.Context) ([]location, error) {
+	var resp struct {
+		Locations []location `json:"locations"`
+	}
+
+	if err := c.get(ctx, "/locations", url.Values{}, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Locations, nil
+}
+
+// getPricing retrieves the pricing
+func (c *client) getPricing(ctx context.Context) (pricing, error) {
+	var resp struct {
+		Pricing pricing `json:"pricing"`
+	}
+
+	if err := c.get(ctx, "/pricing", url.Values{}, &resp); err != nil {
+		return pricing{}, err
+	}
+
+	return resp.Pricing, nil
+}
+
+// ipv4Prices returns the hourly and monthly prices of a primary IPv4 address by location
+func (p pricing) ipv4Prices() map[string]locationPrice {
+	prices := make(map[string]locationPrice)
+	for _, ip := range p.PrimaryIPs {
+		if ip.Type != "ipv4" {
+			continue
+		}
+
+		for _, price := range ip.Prices {
+			prices[price.Location] = price
+		}
+	}
+
+	return prices
+}
+
+// net parses the net amount, unparseable amounts are zero
+func (a amount) net() float64 {
+	value, _ := strconv.ParseFloat(a.Net, 64)
+	return value
+}
@@ -0,0 +1,212 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hetzner
+
+import (
+	"context"
+	"fmt"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+	"github.com/banzaicloud/cloudinfo/internal/platform/log"
+)
+
+const svcCompute = "compute"
+
+// HetznerInfoer encapsulates the data and operations needed to access external Hetzner Cloud resources.
+type HetznerInfoer struct {
+	client        *client
+	ipv4Surcharge bool
+
+	logger cloudinfo.Logger
+}
+
+// NewHetznerInfoer creates a new instance of the Hetzner Cloud infoer.
+func NewHetznerInfoer(config Config, logger cloudinfo.Logger) (*HetznerInfoer, error) {
+	if config.Token == "" {
+		return nil, errors.New("hetzner cloud api token is required")
+	}
+
+	return &HetznerInfoer{
+		client:        newClient(config),
+		ipv4Surcharge: config.IPv4Surcharge,
+		logger:        logger,
+	}, nil
+}
+
+// Initialize retrieves the prices of the server types in all locations
+func (i *HetznerInfoer) Initialize() (map[string]map[string]types.Price, error) {
+	i.logger.Debug("initializing price info")
+
+	vmsByLocation, err := i.virtualMachines(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	allPrices := make(map[string]map[string]types.Price, len(vmsByLocation))
+	for location, vms := range vmsByLocation {
+		allPrices[location] = make(map[string]types.Price, len(vms))
+		for _, vm := range vms {
+			allPrices[location][vm.Type] = types.Price{
+				OnDemandPrice: vm.OnDemandPrice,
+			}
+		}
+	}
+
+	i.logger.Debug("finished initializing price info")
+	return allPrices, nil
+}
+
+// virtualMachines retrieves the available server types by location, priced with the IPv4 surcharge if enabled
+// the prices are in the currency of the account (EUR)
+func (i *HetznerInfoer) virtualMachines(ctx context.Context) (map[string][]types.VMInfo, error) {
+	serverTypes, err := i.client.listServerTypes(ctx)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to list server types")
+	}
+
+	pricing, err := i.client.getPricing(ctx)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to retrieve pricing")
+	}
+
+	var ipv4Prices map[string]locationPrice
+	if i.ipv4Surcharge {
+		ipv4Prices = pricing.ipv4Prices()
+	}
+
+	vmsByLocation := make(map[string][]types.VMInfo)
+	for _, t := range serverTypes {
+		if t.Deprecated {
+			continue
+		}
+
+		for _, p := range t.Prices {
+			hourly, monthly := p.PriceHourly.net(), p.PriceMonthly.net()
+			if ipv4, ok := ipv4Prices[p.Location]; ok {
+				hourly += ipv4.PriceHourly.net()
+				monthly += ipv4.PriceMonthly.net()
+			}
+
+			category := getCategory(t.CPUType)
+			vmsByLocation[p.Location] = append(vmsByLocation[p.Location], types.VMInfo{
+				Category:      category,
+				Type:          t.Name,
+				OnDemandPrice: hourly,
+				MonthlyPrice:  monthly,
+				Currency:      pricing.Currency,
+				Cpus:          float64(t.Cores),
+				Mem:           t.Memory,
+				NtwPerf:       "N/A",
+				NtwPerfCat:    types.NtwMedium,
+				Zones:         []string{},
+				Burst:         t.CPUType == "shared",
+				Architecture:  cloudinfo.Architecture(t.Architecture),
+				Attributes:    cloudinfo.Attributes(fmt.Sprint(t.Cores), fmt.Sprint(t.Memory), types.NtwMedium, category),
+			})
+		}
+	}
+
+	return vmsByLocation, nil
+}
+
+// GetVirtualMachines retrieves the server types available in the location
+func (i *HetznerInfoer) GetVirtualMachines(region string) ([]types.VMInfo, error) {
+	logger := log.WithFields(i.logger, map[string]interface{}{"region": region})
+	logger.Debug("getting product info")
+
+	vmsByLocation, err := i.virtualMachines(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Debug("found server types", map[string]interface{}{"numberOfTypes": len(vmsByLocation[region])})
+	return vmsByLocation[region], nil
+}
+
+// GetProducts retrieves the available virtual machines of the service in the location
+func (i *HetznerInfoer) GetProducts(vms []types.VMInfo, service, regionId string) ([]types.VMInfo, error) {
+	switch service {
+	case svcCompute:
+		return i.GetVirtualMachines(regionId)
+	default:
+		return nil, errors.NewWithDetails("invalid service", "service", service)
+	}
+}
+
+// GetZones returns the availability zones of the location, Hetzner Cloud has no zones
+func (*HetznerInfoer) GetZones(region string) ([]string, error) {
+	return []string{}, nil
+}
+
+// GetRegions retrieves the locations of the service
+func (i *HetznerInfoer) GetRegions(service string) (map[string]string, error) {
+	if service != svcCompute {
+		return nil, errors.NewWithDetails("invalid service", "service", service)
+	}
+
+	locations, err := i.client.listLocations(context.Background())
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to list locations")
+	}
+
+	regions := make(map[string]string, len(locations))
+	for _, l := range locations {
+		regions[l.Name] = l.Description
+	}
+
+	return regions, nil
+}
+
+// HasShortLivedPriceInfo signals that the Hetzner Cloud prices don't change frequently
+func (*HetznerInfoer) HasShortLivedPriceInfo() bool {
+	return false
+}
+
+// GetCurrentPrices is not supported, Hetzner Cloud has no short lived prices
+func (*HetznerInfoer) GetCurrentPrices(region string) (map[string]types.Price, error) {
+	return nil, errors.New("GetCurrentPrices - not yet implemented")
+}
+
+// HasImages signals that images are not scraped for Hetzner Cloud
+func (*HetznerInfoer) HasImages() bool {
+	return false
+}
+
+// GetServiceImages is not supported for Hetzner Cloud
+func (*HetznerInfoer) GetServiceImages(service, region string) ([]types.Image, error) {
+	return nil, errors.New("GetServiceImages - not yet implemented")
+}
+
+// GetVersions returns no versions, Hetzner Cloud has no managed Kubernetes service
+func (*HetznerInfoer) GetVersions(service, region string) ([]types.LocationVersion, error) {
+	return []types.LocationVersion{}, nil
+}
+
+// GetServiceProducts is not supported for Hetzner Cloud
+func (*HetznerInfoer) GetServiceProducts(region, service string) ([]types.ProductDetails, error) {
+	return nil, errors.New("GetServiceProducts - not yet implemented")
+}
+
+// getCategory maps the cpu type of the server type to an instance type category
+func getCategory(cpuType string) string {
+	if cpuType == "dedicated" {
+		return types.CategoryCompute
+	}
+
+	return types.CategoryGeneral
+}
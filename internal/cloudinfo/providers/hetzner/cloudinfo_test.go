@@ -0,0 +1,61 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hetzner
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/cloudinfoadapter"
+)
+
+func TestHetznerInfoer_GetVirtualMachines(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/server_types":
+			fmt.Fprint(w, `{"server_types": [
+				{"name": "cx22", "cores": 2, "memory": 4, "cpu_type": "shared", "prices": [
+					{"location": "fsn1", "price_hourly": {"net": "0.0060"}, "price_monthly": {"net": "3.79"}},
+					{"location": "ash", "price_hourly": {"net": "0.0080"}, "price_monthly": {"net": "4.99"}}]},
+				{"name": "cx11", "cores": 1, "memory": 2, "cpu_type": "shared", "deprecated": true, "prices": [
+					{"location": "fsn1", "price_hourly": {"net": "0.0050"}, "price_monthly": {"net": "3.29"}}]}],
+				"meta": {"pagination": {"next_page": null}}}`)
+		case "/pricing":
+			fmt.Fprint(w, `{"pricing": {"currency": "EUR", "primary_ips": [{"type": "ipv4", "prices": [
+				{"location": "fsn1", "price_hourly": {"net": "0.0010"}, "price_monthly": {"net": "0.50"}}]}]}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	infoer, err := NewHetznerInfoer(Config{Token: "token", IPv4Surcharge: true}, cloudinfoadapter.NewNoopLogger())
+	assert.NoError(t, err)
+	infoer.client.baseURL = server.URL
+
+	vms, err := infoer.GetVirtualMachines("fsn1")
+	assert.NoError(t, err)
+	if assert.Len(t, vms, 1, "deprecated server types should be skipped") {
+		assert.Equal(t, "cx22", vms[0].Type)
+		assert.InDelta(t, 0.007, vms[0].OnDemandPrice, 1e-9, "the ipv4 surcharge should be added")
+		assert.InDelta(t, 4.29, vms[0].MonthlyPrice, 1e-9)
+		assert.Equal(t, "EUR", vms[0].Currency)
+		assert.True(t, vms[0].Burst)
+	}
+}
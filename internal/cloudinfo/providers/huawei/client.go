@@ -0,0 +1,272 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package huawei
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/restapi"
+)
+
+const (
+	iamURL      = "https://iam.myhuaweicloud.com/v3"
+	ecsEndpoint = "https://ecs.%s.myhuaweicloud.com"
+
+	// ratingBatchSize is the maximum number of products rated in a single request
+	ratingBatchSize = 100
+)
+
+// flavor is an ECS flavor, the vcpus are a decimal string and the ram is in MiB
+type flavor struct {
+	Name       string            `json:"name"`
+	VCPUs      string            `json:"vcpus"`
+	RAM        int               `json:"ram"`
+	ExtraSpecs map[string]string `json:"os_extra_specs"`
+}
+
+// region is a region of the IAM API
+type region struct {
+	ID      string `json:"id"`
+	Locales struct {
+		EnUS string `json:"en-us"`
+	} `json:"locales"`
+}
+
+// client is a minimal client of the Huawei Cloud IAM, ECS and BSS APIs
+type client struct {
+	api         *restapi.Client
+	iamURL      string
+	ecsEndpoint string
+	bssEndpoint string
+	accessKey   string
+	secretKey   string
+
+	projectIDs   map[string]string
+	projectIDsMu sync.Mutex
+}
+
+func newClient(config Config) *client {
+	return &client{
+		api:         restapi.NewClient("huawei cloud", config.UserAgent),
+		iamURL:      iamURL,
+		ecsEndpoint: ecsEndpoint,
+		bssEndpoint: config.BSSEndpoint,
+		accessKey:   config.AccessKey,
+		secretKey:   config.SecretKey,
+		projectIDs:  make(map[string]string),
+	}
+}
+
+// do sends a signed request with the json encoded body (if any) and decodes the response into the given value
+func (c *client) do(ctx context.Context, method, target string, body, v interface{}) error {
+	var payload []byte
+	if body != nil {
+		var err error
+		if payload, err = json.Marshal(body); err != nil {
+			return errors.WrapIf(err, "failed to encode request")
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, target, bytes.NewReader(payload))
+	if err != nil {
+		return errors.WrapIf(err, "failed to create request")
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	sign(req, payload, c.accessKey, c.secretKey, time.Now())
+
+	return c.api.Do(req, v)
+}
+
+// listRegions lists the regions
+func (c *client) listRegions(ctx context.Context) ([]region, error) {
+	var resp struct {
+		Regions []region `json:"regions"`
+	}
+
+	if err := c.do(ctx, http.MethodGet, c.iamURL+"/regions", nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Regions, nil
+}
+
+// projectID returns the id of the project of the region, the ECS API is scoped to projects
+func (c *client) projectID(ctx context.Context, region string) (string, error) {
+	c.projectIDsMu.Lock()
+	defer c.projectIDsMu.Unlock()
+
+	if id, ok := c.projectIDs[region]; ok {
+		return id, nil
+	}
+
+	var resp struct {
+		Projects []struct {
+			ID string `json:"id"`
+		} `json:"projects"`
+	}
+
+	if err := c.do(ctx, http.MethodGet, c.iamURL+"/projects?"+url.Values{"name": {region}}.Encode(), nil, &resp); err != nil {
+		return "", err
+	}
+
+	if len(resp.Projects) == 0 {
+		return "", errors.NewWithDetails("no project found in the region", "region", region)
+	}
+
+	c.projectIDs[region] = resp.Projects[0].ID
+
+	return resp.Projects[0].ID, nil
+}
+
+// ecsURL returns the url of the path of the ECS API of the project of the region
+func (c *client) ecsURL(ctx context.Context, region, version, path string) (string, error) {
+	projectID, err := c.projectID(ctx, region)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s/%s/%s%s", fmt.Sprintf(c.ecsEndpoint, region), version, projectID, path), nil
+}
+
+// listFlavors lists the flavors of the region
+func (c *client) listFlavors(ctx context.Context, region string) ([]flavor, error) {
+	target, err := c.ecsURL(ctx, region, "v1", "/cloudservers/flavors")
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		Flavors []flavor `json:"flavors"`
+	}
+
+	if err := c.do(ctx, http.MethodGet, target, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Flavors, nil
+}
+
+// listAvailabilityZones lists the available availability zones of the region
+func (c *client) listAvailabilityZones(ctx context.Context, region string) ([]string, error) {
+	target, err := c.ecsURL(ctx, region, "v2.1", "/os-availability-zone")
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		AvailabilityZones []struct {
+			ZoneName  string `json:"zoneName"`
+			ZoneState struct {
+				Available bool `json:"available"`
+			} `json:"zoneState"`
+		} `json:"availabilityZoneInfo"`
+	}
+
+	if err := c.do(ctx, http.MethodGet, target, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	zones := make([]string, 0, len(resp.AvailabilityZones))
+	for _, az := range resp.AvailabilityZones {
+		if az.ZoneState.Available {
+			zones = append(zones, az.ZoneName)
+		}
+	}
+
+	return zones, nil
+}
+
+// productInfo is a product rated by the billing center
+type productInfo struct {
+	ID               string `json:"id"`
+	CloudServiceType string `json:"cloud_service_type"`
+	ResourceType     string `json:"resource_type"`
+	ResourceSpec     string `json:"resource_spec"`
+	Region           string `json:"region"`
+	UsageFactor      string `json:"usage_factor"`
+	UsageValue       int    `json:"usage_value"`
+	UsageMeasureID   int    `json:"usage_measure_id"`
+	SubscriptionNum  int    `json:"subscription_num"`
+}
+
+// rateFlavors retrieves the hourly on demand prices of the flavors (running linux) in the region and their currency
+func (c *client) rateFlavors(ctx context.Context, region string, flavors []string) (map[string]float64, string, error) {
+	projectID, err := c.projectID(ctx, region)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var currency string
+	prices := make(map[string]float64, len(flavors))
+	for start := 0; start < len(flavors); start += ratingBatchSize {
+		end := start + ratingBatchSize
+		if end > len(flavors) {
+			end = len(flavors)
+		}
+
+		products := make([]productInfo, 0, end-start)
+		for idx, name := range flavors[start:end] {
+			products = append(products, productInfo{
+				ID:               strconv.Itoa(start + idx),
+				CloudServiceType: "hws.service.type.ec2",
+				ResourceType:     "hws.resource.type.vm",
+				ResourceSpec:     name + ".linux",
+				Region:           region,
+				UsageFactor:      "Duration",
+				UsageValue:       1,
+				UsageMeasureID:   4, // hour
+				SubscriptionNum:  1,
+			})
+		}
+
+		var resp struct {
+			Currency string `json:"currency"`
+			Results  []struct {
+				ID     string  `json:"id"`
+				Amount float64 `json:"amount"`
+			} `json:"product_rating_results"`
+		}
+
+		body := map[string]interface{}{"project_id": projectID, "product_infos": products}
+		if err := c.do(ctx, http.MethodPost, c.bssEndpoint+"/v2/bills/ratings/on-demand-resources", body, &resp); err != nil {
+			return nil, "", err
+		}
+
+		currency = resp.Currency
+		for _, result := range resp.Results {
+			idx, err := strconv.Atoi(result.ID)
+			if err != nil || idx < 0 || idx >= len(flavors) {
+				continue
+			}
+
+			prices[flavors[idx]] = result.Amount
+		}
+	}
+
+	return prices, currency, nil
+}
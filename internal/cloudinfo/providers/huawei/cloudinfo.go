@@ -0,0 +1,274 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package huawei
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+	"github.com/banzaicloud/cloudinfo/internal/platform/log"
+)
+
+const svcCompute = "compute"
+
+// HuaweiInfoer encapsulates the data and operations needed to access external Huawei Cloud resources.
+type HuaweiInfoer struct {
+	client *client
+
+	logger cloudinfo.Logger
+}
+
+// NewHuaweiInfoer creates a new instance of the Huawei Cloud infoer.
+func NewHuaweiInfoer(config Config, logger cloudinfo.Logger) (*HuaweiInfoer, error) {
+	if config.AccessKey == "" || config.SecretKey == "" {
+		return nil, errors.New("huawei cloud access key and secret key are required")
+	}
+
+	return &HuaweiInfoer{
+		client: newClient(config),
+		logger: logger,
+	}, nil
+}
+
+// Initialize retrieves the prices of the flavors in all regions
+func (i *HuaweiInfoer) Initialize() (map[string]map[string]types.Price, error) {
+	i.logger.Debug("initializing price info")
+
+	regions, err := i.GetRegions(svcCompute)
+	if err != nil {
+		return nil, err
+	}
+
+	allPrices := make(map[string]map[string]types.Price, len(regions))
+	for region := range regions {
+		vms, err := i.GetVirtualMachines(region)
+		if err != nil {
+			return nil, err
+		}
+
+		allPrices[region] = make(map[string]types.Price, len(vms))
+		for _, vm := range vms {
+			allPrices[region][vm.Type] = types.Price{
+				OnDemandPrice: vm.OnDemandPrice,
+			}
+		}
+	}
+
+	i.logger.Debug("finished initializing price info")
+	return allPrices, nil
+}
+
+// GetVirtualMachines retrieves the flavors on sale in the region with their on demand prices
+func (i *HuaweiInfoer) GetVirtualMachines(region string) ([]types.VMInfo, error) {
+	logger := log.WithFields(i.logger, map[string]interface{}{"region": region})
+	logger.Debug("getting product info")
+
+	ctx := context.Background()
+
+	flavors, err := i.client.listFlavors(ctx, region)
+	if err != nil {
+		return nil, errors.WrapIfWithDetails(err, "failed to list flavors", "region", region)
+	}
+
+	zones, err := i.GetZones(region)
+	if err != nil {
+		return nil, err
+	}
+
+	var onSale []flavor
+	for _, f := range flavors {
+		if f.ExtraSpecs["cond:operation:status"] != "abandon" {
+			onSale = append(onSale, f)
+		}
+	}
+
+	names := make([]string, 0, len(onSale))
+	for _, f := range onSale {
+		names = append(names, f.Name)
+	}
+
+	prices, currency, err := i.client.rateFlavors(ctx, region, names)
+	if err != nil {
+		return nil, errors.WrapIfWithDetails(err, "failed to rate flavors", "region", region)
+	}
+
+	var virtualMachines []types.VMInfo
+	for _, f := range onSale {
+		price, ok := prices[f.Name]
+		if !ok {
+			logger.Debug("no price found for flavor", map[string]interface{}{"flavor": f.Name})
+			continue
+		}
+
+		cpus, _ := strconv.ParseFloat(f.VCPUs, 64)
+		mem := float64(f.RAM) / 1024
+		gpus := countGPUs(f.ExtraSpecs["info:gpu:name"])
+		category := getCategory(f.Name, gpus)
+
+		vm := types.VMInfo{
+			Category:      category,
+			Type:          f.Name,
+			OnDemandPrice: price,
+			Currency:      currency,
+			Cpus:          cpus,
+			Mem:           mem,
+			Gpus:          gpus,
+			NtwPerf:       "N/A",
+			NtwPerfCat:    types.NtwMedium,
+			Zones:         flavorZones(f.ExtraSpecs["cond:operation:az"], zones),
+			Burst:         strings.HasPrefix(f.Name, "t"),
+			Architecture:  cloudinfo.Architecture(f.ExtraSpecs["ecs:instance_architecture"]),
+			Attributes:    cloudinfo.Attributes(fmt.Sprint(cpus), fmt.Sprint(mem), types.NtwMedium, category),
+		}
+		cloudinfo.SetGpu(&vm, f.ExtraSpecs["info:gpu:name"])
+		virtualMachines = append(virtualMachines, vm)
+	}
+
+	logger.Debug("found flavors", map[string]interface{}{"numberOfTypes": len(virtualMachines)})
+	return virtualMachines, nil
+}
+
+// GetProducts retrieves the available flavors of the service in the region
+func (i *HuaweiInfoer) GetProducts(vms []types.VMInfo, service, regionId string) ([]types.VMInfo, error) {
+	switch service {
+	case svcCompute:
+		if len(vms) > 0 {
+			return vms, nil
+		}
+
+		return i.GetVirtualMachines(regionId)
+	default:
+		return nil, errors.NewWithDetails("invalid service", "service", service)
+	}
+}
+
+// GetZones retrieves the available availability zones of the region
+func (i *HuaweiInfoer) GetZones(region string) ([]string, error) {
+	zones, err := i.client.listAvailabilityZones(context.Background(), region)
+	if err != nil {
+		return nil, errors.WrapIfWithDetails(err, "failed to list availability zones", "region", region)
+	}
+
+	return zones, nil
+}
+
+// GetRegions retrieves the regions of the service
+func (i *HuaweiInfoer) GetRegions(service string) (map[string]string, error) {
+	if service != svcCompute {
+		return nil, errors.NewWithDetails("invalid service", "service", service)
+	}
+
+	regions, err := i.client.listRegions(context.Background())
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to list regions")
+	}
+
+	regionMap := make(map[string]string, len(regions))
+	for _, r := range regions {
+		name := r.Locales.EnUS
+		if name == "" {
+			name = r.ID
+		}
+		regionMap[r.ID] = name
+	}
+
+	return regionMap, nil
+}
+
+// HasShortLivedPriceInfo signals that the Huawei Cloud prices don't change frequently
+func (*HuaweiInfoer) HasShortLivedPriceInfo() bool {
+	return false
+}
+
+// GetCurrentPrices is not supported, the spot prices are not scraped
+func (*HuaweiInfoer) GetCurrentPrices(region string) (map[string]types.Price, error) {
+	return nil, errors.New("GetCurrentPrices - not yet implemented")
+}
+
+// HasImages signals that images are not scraped for Huawei Cloud
+func (*HuaweiInfoer) HasImages() bool {
+	return false
+}
+
+// GetServiceImages is not supported for Huawei Cloud
+func (*HuaweiInfoer) GetServiceImages(service, region string) ([]types.Image, error) {
+	return nil, errors.New("GetServiceImages - not yet implemented")
+}
+
+// GetVersions returns no versions, the CCE versions are not scraped
+func (*HuaweiInfoer) GetVersions(service, region string) ([]types.LocationVersion, error) {
+	return []types.LocationVersion{}, nil
+}
+
+// GetServiceProducts is not supported for Huawei Cloud
+func (*HuaweiInfoer) GetServiceProducts(region, service string) ([]types.ProductDetails, error) {
+	return nil, errors.New("GetServiceProducts - not yet implemented")
+}
+
+// flavorZones parses the availability zones the flavor is sold in (eg.: ap-southeast-1a(normal),ap-southeast-1b(sellout)),
+// a flavor without zone restrictions is sold in all zones of the region
+func flavorZones(operationAZ string, regionZones []string) []string {
+	if operationAZ == "" {
+		return regionZones
+	}
+
+	zones := []string{}
+	for _, az := range strings.Split(operationAZ, ",") {
+		idx := strings.Index(az, "(")
+		if idx < 0 {
+			continue
+		}
+
+		switch status := strings.TrimSuffix(az[idx+1:], ")"); status {
+		case "normal", "promotion", "obt":
+			zones = append(zones, strings.TrimSpace(az[:idx]))
+		}
+	}
+
+	return zones
+}
+
+// countGPUs parses the gpu count of a flavor from its gpu description (eg.: 1 * NVIDIA V100 / 16 GB)
+func countGPUs(gpuName string) float64 {
+	count, err := strconv.ParseFloat(strings.TrimSpace(strings.SplitN(gpuName, "*", 2)[0]), 64)
+	if err != nil {
+		return 0
+	}
+
+	return count
+}
+
+// getCategory maps the family of the flavor (eg.: c6 of c6.large.2, k for kunpeng) to an instance type category
+func getCategory(name string, gpus float64) string {
+	family := strings.TrimPrefix(name, "k")
+	switch {
+	case gpus > 0, strings.HasPrefix(family, "p"), strings.HasPrefix(family, "g"):
+		return types.CategoryGpu
+	case strings.HasPrefix(family, "c"):
+		return types.CategoryCompute
+	case strings.HasPrefix(family, "m"), strings.HasPrefix(family, "e"), strings.HasPrefix(family, "x"):
+		return types.CategoryMemory
+	case strings.HasPrefix(family, "d"), strings.HasPrefix(family, "i"):
+		return types.CategoryStorage
+	default:
+		return types.CategoryGeneral
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package huawei
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/cloudinfoadapter"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func newTestServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.True(t, strings.HasPrefix(r.Header.Get("Authorization"), "SDK-HMAC-SHA256 Access=ak, "))
+		assert.NotEmpty(t, r.Header.Get("X-Sdk-Date"))
+
+		switch r.URL.Path {
+		case "/iam/regions":
+			fmt.Fprint(w, `{"regions": [{"id": "ap-southeast-1", "locales": {"en-us": "AP-Hong Kong"}}]}`)
+		case "/iam/projects":
+			assert.Equal(t, "ap-southeast-1", r.URL.Query().Get("name"))
+			fmt.Fprint(w, `{"projects": [{"id": "p1"}]}`)
+		case "/ap-southeast-1/v1/p1/cloudservers/flavors":
+			fmt.Fprint(w, `{"flavors": [
+				{"name": "s6.large.2", "vcpus": "2", "ram": 4096, "os_extra_specs": {"cond:operation:az": "ap-southeast-1a(normal),ap-southeast-1b(sellout)"}},
+				{"name": "kc1.xlarge.2", "vcpus": "4", "ram": 8192, "os_extra_specs": {"ecs:instance_architecture": "arm64"}},
+				{"name": "p2v.2xlarge.8", "vcpus": "8", "ram": 65536, "os_extra_specs": {"info:gpu:name": "1 * NVIDIA V100 / 16 GB"}},
+				{"name": "s3.small.1", "vcpus": "1", "ram": 1024, "os_extra_specs": {"cond:operation:status": "abandon"}}]}`)
+		case "/ap-southeast-1/v2.1/p1/os-availability-zone":
+			fmt.Fprint(w, `{"availabilityZoneInfo": [
+				{"zoneName": "ap-southeast-1a", "zoneState": {"available": true}},
+				{"zoneName": "ap-southeast-1b", "zoneState": {"available": true}},
+				{"zoneName": "ap-southeast-1c", "zoneState": {"available": false}}]}`)
+		case "/v2/bills/ratings/on-demand-resources":
+			var req struct {
+				ProjectID    string        `json:"project_id"`
+				ProductInfos []productInfo `json:"product_infos"`
+			}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			assert.Equal(t, "p1", req.ProjectID)
+			assert.Len(t, req.ProductInfos, 3)
+
+			fmt.Fprint(w, `{"currency": "USD", "product_rating_results": [
+				{"id": "0", "amount": 0.05},
+				{"id": "1", "amount": 0.1},
+				{"id": "2", "amount": 2.5}]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func newTestInfoer(t *testing.T, server *httptest.Server) *HuaweiInfoer {
+	infoer, err := NewHuaweiInfoer(Config{AccessKey: "ak", SecretKey: "sk"}, cloudinfoadapter.NewNoopLogger())
+	assert.NoError(t, err)
+	infoer.client.iamURL = server.URL + "/iam"
+	infoer.client.ecsEndpoint = server.URL + "/%s"
+	infoer.client.bssEndpoint = server.URL
+
+	return infoer
+}
+
+func TestNewHuaweiInfoer(t *testing.T) {
+	_, err := NewHuaweiInfoer(Config{AccessKey: "ak"}, cloudinfoadapter.NewNoopLogger())
+	assert.Error(t, err)
+}
+
+func TestHuaweiInfoer_GetRegions(t *testing.T) {
+	server := newTestServer(t)
+	defer server.Close()
+
+	regions, err := newTestInfoer(t, server).GetRegions("compute")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"ap-southeast-1": "AP-Hong Kong"}, regions)
+}
+
+func TestHuaweiInfoer_GetVirtualMachines(t *testing.T) {
+	server := newTestServer(t)
+	defer server.Close()
+
+	vms, err := newTestInfoer(t, server).GetVirtualMachines("ap-southeast-1")
+	assert.NoError(t, err)
+	assert.Equal(t, []types.VMInfo{
+		{
+			Category:      types.CategoryGeneral,
+			Type:          "s6.large.2",
+			OnDemandPrice: 0.05,
+			Currency:      "USD",
+			Cpus:          2,
+			Mem:           4,
+			NtwPerf:       "N/A",
+			NtwPerfCat:    types.NtwMedium,
+			Zones:         []string{"ap-southeast-1a"},
+			Architecture:  types.ArchitectureAMD64,
+			Attributes:    map[string]string{"cpu": "2", "memory": "4", "networkPerfCategory": types.NtwMedium, "instanceTypeCategory": types.CategoryGeneral},
+		},
+		{
+			Category:      types.CategoryCompute,
+			Type:          "kc1.xlarge.2",
+			OnDemandPrice: 0.1,
+			Currency:      "USD",
+			Cpus:          4,
+			Mem:           8,
+			NtwPerf:       "N/A",
+			NtwPerfCat:    types.NtwMedium,
+			Zones:         []string{"ap-southeast-1a", "ap-southeast-1b"},
+			Architecture:  types.ArchitectureARM64,
+			Attributes:    map[string]string{"cpu": "4", "memory": "8", "networkPerfCategory": types.NtwMedium, "instanceTypeCategory": types.CategoryCompute},
+		},
+		{
+			Category:      types.CategoryGpu,
+			Type:          "p2v.2xlarge.8",
+			OnDemandPrice: 2.5,
+			Currency:      "USD",
+			Cpus:          8,
+			Mem:           64,
+			Gpus:          1,
+			GpuVendor:     "nvidia",
+			GpuModel:      "Tesla V100",
+			GpuMem:        16,
+			NtwPerf:       "N/A",
+			NtwPerfCat:    types.NtwMedium,
+			Zones:         []string{"ap-southeast-1a", "ap-southeast-1b"},
+			Architecture:  types.ArchitectureAMD64,
+			Attributes:    map[string]string{"cpu": "8", "memory": "64", "networkPerfCategory": types.NtwMedium, "instanceTypeCategory": types.CategoryGpu},
+		},
+	}, vms)
+}
+
+func TestSign(t *testing.T) {
+	req, err := http.NewRequest(http.MethodGet, "https://iam.myhuaweicloud.com/v3/projects?name=ap-southeast-1", nil)
+	assert.NoError(t, err)
+
+	sign(req, nil, "ak", "sk", time.Date(2021, 9, 1, 10, 0, 0, 0, time.UTC))
+
+	assert.Equal(t, "20210901T100000Z", req.Header.Get("X-Sdk-Date"))
+	assert.True(t, strings.HasPrefix(req.Header.Get("Authorization"), "SDK-HMAC-SHA256 Access=ak, SignedHeaders=host;x-sdk-date, Signature="))
+}
+
+func TestFlavorZones(t *testing.T) {
+	regionZones := []string{"az1", "az2"}
+
+	assert.Equal(t, regionZones, flavorZones("", regionZones))
+	assert.Equal(t, []string{"az1", "az2"}, flavorZones("az1(normal),az2(promotion),az3(abandon)", regionZones))
+	assert.Equal(t, []string{}, flavorZones("az1(sellout)", regionZones))
+}
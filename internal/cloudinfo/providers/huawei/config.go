@@ -0,0 +1,28 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package huawei
+
+// Config holds the configuration of the Huawei Cloud provider
+type Config struct {
+	// AccessKey and SecretKey are the access key (AK/SK) of an IAM user
+	AccessKey string
+	SecretKey string
+
+	// BSSEndpoint is the endpoint of the billing center, pricing the flavors
+	// (https://bss-intl.myhuaweicloud.com on the international site, https://bss.myhuaweicloud.com on the chinese site)
+	BSSEndpoint string
+
+	UserAgent string
+}
@@ -0,0 +1,112 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package huawei
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	signingAlgorithm  = "SDK-HMAC-SHA256"
+	signingDateFormat = "20060102T150405Z"
+)
+
+// sign signs the request with the access key (APIG SDK-HMAC-SHA256), the body must be the request body
+func sign(req *http.Request, body []byte, accessKey, secretKey string, now time.Time) {
+	date := now.UTC().Format(signingDateFormat)
+	req.Header.Set("X-Sdk-Date", date)
+
+	signedHeaders := []string{"host", "x-sdk-date"}
+	headers := map[string]string{"host": req.URL.Host, "x-sdk-date": date}
+	if req.Header.Get("Content-Type") != "" {
+		signedHeaders = append(signedHeaders, "content-type")
+		headers["content-type"] = req.Header.Get("Content-Type")
+	}
+	sort.Strings(signedHeaders)
+
+	var canonicalHeaders strings.Builder
+	for _, h := range signedHeaders {
+		canonicalHeaders.WriteString(h + ":" + strings.TrimSpace(headers[h]) + "\n")
+	}
+
+	// the canonical uri always ends with a slash
+	uri := req.URL.EscapedPath()
+	if !strings.HasSuffix(uri, "/") {
+		uri += "/"
+	}
+
+	bodyHash := sha256.Sum256(body)
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		uri,
+		canonicalQuery(req),
+		canonicalHeaders.String(),
+		strings.Join(signedHeaders, ";"),
+		hex.EncodeToString(bodyHash[:]),
+	}, "\n")
+
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{signingAlgorithm, date, hex.EncodeToString(requestHash[:])}, "\n")
+
+	mac := hmac.New(sha256.New, []byte(secretKey))
+	_, _ = mac.Write([]byte(stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Access=%s, SignedHeaders=%s, Signature=%s",
+		signingAlgorithm, accessKey, strings.Join(signedHeaders, ";"), hex.EncodeToString(mac.Sum(nil))))
+}
+
+// canonicalQuery returns the query parameters sorted by name and value
+func canonicalQuery(req *http.Request) string {
+	query := req.URL.Query()
+
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var params []string
+	for _, key := range keys {
+		values := query[key]
+		sort.Strings(values)
+		for _, value := range values {
+			params = append(params, escape(key)+"="+escape(value))
+		}
+	}
+
+	return strings.Join(params, "&")
+}
+
+// escape percent-encodes everything but the unreserved characters
+func escape(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') || c == '-' || c == '_' || c == '.' || c == '~' {
+			b.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+
+	return b.String()
+}
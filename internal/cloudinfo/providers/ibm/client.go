@@ -0,0 +1,264 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/restapi"
+)
+
+const (
+	iamURL           = "https://iam.cloud.ibm.com/identity/token"
+	globalCatalogURL = "https://globalcatalog.cloud.ibm.com/api/v1"
+	vpcEndpoint      = "https://%s.iaas.cloud.ibm.com/v1"
+	versionsURL      = "https://containers.cloud.ibm.com/global/v1/versions"
+
+	// vpcAPIVersion is the version (date) of the VPC API the client is written against
+	vpcAPIVersion = "2021-06-01"
+)
+
+// vpcRegion is a region of the VPC API
+type vpcRegion struct {
+	Name     string `json:"name"`
+	Endpoint string `json:"endpoint"`
+	Status   string `json:"status"`
+}
+
+// zone is an availability zone of the VPC API
+type zone struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+}
+
+// profileValue is a (fixed) value of an instance profile
+type profileValue struct {
+	Type  string  `json:"type"`
+	Value float64 `json:"value"`
+}
+
+// instanceProfile is an instance profile of the VPC API
+type instanceProfile struct {
+	Name      string       `json:"name"`
+	Family    string       `json:"family"`
+	VCPUCount profileValue `json:"vcpu_count"`
+	Memory    profileValue `json:"memory"`
+	Bandwidth profileValue `json:"bandwidth"`
+	GPUCount  profileValue `json:"gpu_count"`
+}
+
+// catalogPricing is the (partial) pricing of a global catalog entry
+type catalogPricing struct {
+	Metrics []struct {
+		MetricID string `json:"metric_id"`
+		PartRef  string `json:"part_ref"`
+		Amounts  []struct {
+			Country  string `json:"country"`
+			Currency string `json:"currency"`
+			Prices   []struct {
+				QuantityTier int     `json:"quantity_tier"`
+				Price        float64 `json:"price"`
+			} `json:"prices"`
+		} `json:"amounts"`
+	} `json:"metrics"`
+}
+
+// client is a minimal client of the IBM Cloud VPC and global catalog APIs
+type client struct {
+	api         *restapi.Client
+	iamURL      string
+	catalogURL  string
+	vpcEndpoint string
+	versionsURL string
+	apiKey      string
+
+	token       string
+	tokenExpiry time.Time
+	tokenMu     sync.Mutex
+}
+
+func newClient(config Config) *client {
+	return &client{
+		api:         restapi.NewClient("ibm cloud", config.UserAgent),
+		iamURL:      iamURL,
+		catalogURL:  globalCatalogURL,
+		vpcEndpoint: vpcEndpoint,
+		versionsURL: versionsURL,
+		apiKey:      config.APIKey,
+	}
+}
+
+// accessToken returns a valid IAM access token, exchanging the api key for a new one if needed
+func (c *client) accessToken(ctx context.Context) (string, error) {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	if c.token != "" && time.Now().Before(c.tokenExpiry) {
+		return c.token, nil
+	}
+
+	form := url.Values{
+		"grant_type": {"urn:ibm:params:oauth:grant-type:apikey"},
+		"apikey":     {c.apiKey},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.iamURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", errors.WrapIf(err, "failed to create request")
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	var resp struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+	}
+	if err := c.api.Do(req, &resp); err != nil {
+		return "", errors.WrapIf(err, "failed to retrieve iam token")
+	}
+
+	c.token = resp.AccessToken
+	// renew the token a minute before it expires
+	c.tokenExpiry = time.Now().Add(time.Duration(resp.ExpiresIn)*time.Second - time.Minute)
+
+	return c.token, nil
+}
+
+// get retrieves an authenticated resource and decodes it into the given value
+func (c *client) get(ctx context.Context, target string, v interface{}) error {
+	token, err := c.accessToken(ctx)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return errors.WrapIf(err, "failed to create request")
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	return c.api.Do(req, v)
+}
+
+// vpcURL builds the url of a VPC API resource of the region
+func (c *client) vpcURL(region, path string) string {
+	return fmt.Sprintf("%s%s?version=%s&generation=2", fmt.Sprintf(c.vpcEndpoint, region), path, vpcAPIVersion)
+}
+
+// listRegions lists the VPC regions
+func (c *client) listRegions(ctx context.Context, region string) ([]vpcRegion, error) {
+	var resp struct {
+		Regions []vpcRegion `json:"regions"`
+	}
+
+	err := c.get(ctx, c.vpcURL(region, "/regions"), &resp)
+
+	return resp.Regions, err
+}
+
+// listZones lists the availability zones of the region
+func (c *client) listZones(ctx context.Context, region string) ([]zone, error) {
+	var resp struct {
+		Zones []zone `json:"zones"`
+	}
+
+	err := c.get(ctx, c.vpcURL(region, fmt.Sprintf("/regions/%s/zones", region)), &resp)
+
+	return resp.Zones, err
+}
+
+// listInstanceProfiles lists the instance profiles available in the region
+func (c *client) listInstanceProfiles(ctx context.Context, region string) ([]instanceProfile, error) {
+	var resp struct {
+		Profiles []instanceProfile `json:"profiles"`
+	}
+
+	err := c.get(ctx, c.vpcURL(region, "/instance/profiles"), &resp)
+
+	return resp.Profiles, err
+}
+
+// getProfilePrices retrieves the hourly prices of the instance profiles from the global catalog entry;
+// the pricing metrics of the entry are matched to the profiles by their ids containing the profile name
+func (c *client) getProfilePrices(ctx context.Context, entry, country string, profiles []instanceProfile) (map[string]float64, error) {
+	var pricing catalogPricing
+	if err := c.get(ctx, fmt.Sprintf("%s/%s/pricing", c.catalogURL, url.PathEscape(entry)), &pricing); err != nil {
+		return nil, err
+	}
+
+	prices := make(map[string]float64, len(profiles))
+	for _, metric := range pricing.Metrics {
+		metricID := strings.ToLower(metric.MetricID)
+
+		for _, profile := range profiles {
+			if !containsProfile(metricID, strings.ToLower(profile.Name)) {
+				continue
+			}
+
+			for _, amount := range metric.Amounts {
+				if amount.Country == country && len(amount.Prices) > 0 {
+					prices[profile.Name] = amount.Prices[0].Price
+				}
+			}
+		}
+	}
+
+	return prices, nil
+}
+
+// containsProfile checks whether the metric id contains the profile name delimited by non alphanumeric characters
+func containsProfile(metricID, profile string) bool {
+	for offset := 0; ; {
+		idx := strings.Index(metricID[offset:], profile)
+		if idx < 0 {
+			return false
+		}
+
+		start, end := offset+idx, offset+idx+len(profile)
+		if (start == 0 || !isAlphanumeric(metricID[start-1])) && (end == len(metricID) || !isAlphanumeric(metricID[end])) {
+			return true
+		}
+		offset = start + 1
+	}
+}
+
+func isAlphanumeric(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+// kubernetesVersion is a Kubernetes version supported by IKS
+type kubernetesVersion struct {
+	Major int `json:"major"`
+	Minor int `json:"minor"`
+	Patch int `json:"patch"`
+}
+
+// listKubernetesVersions lists the Kubernetes versions supported by IKS
+func (c *client) listKubernetesVersions(ctx context.Context) ([]kubernetesVersion, error) {
+	var resp struct {
+		Kubernetes []kubernetesVersion `json:"kubernetes"`
+	}
+
+	err := c.get(ctx, c.versionsURL, &resp)
+
+	return resp.Kubernetes, err
+}
@@ -0,0 +1,196 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/cloudinfoadapter"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// newTestServer serves the iam, vpc and global catalog apis, counting the token exchanges
+func newTestServer(t *testing.T, tokenRequests *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/identity/token" {
+			*tokenRequests++
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "application/x-www-form-urlencoded", r.Header.Get("Content-Type"))
+			assert.NoError(t, r.ParseForm())
+			assert.Equal(t, "urn:ibm:params:oauth:grant-type:apikey", r.PostForm.Get("grant_type"))
+			assert.Equal(t, "secret", r.PostForm.Get("apikey"))
+
+			fmt.Fprintf(w, `{"access_token": "token-%d", "expires_in": 3600}`, *tokenRequests)
+			return
+		}
+
+		assert.Equal(t, fmt.Sprintf("Bearer token-%d", *tokenRequests), r.Header.Get("Authorization"))
+
+		switch r.URL.Path {
+		case "/us-south/v1/instance/profiles":
+			assert.Equal(t, vpcAPIVersion, r.URL.Query().Get("version"))
+			assert.Equal(t, "2", r.URL.Query().Get("generation"))
+
+			fmt.Fprint(w, `{"profiles": [
+				{"name": "bx2-2x8", "family": "balanced", "vcpu_count": {"value": 2}, "memory": {"value": 8}, "bandwidth": {"value": 4000}},
+				{"name": "cx2-2x4", "family": "compute", "vcpu_count": {"value": 2}, "memory": {"value": 4}, "bandwidth": {"value": 4000}},
+				{"name": "mx2-2x16", "family": "memory", "vcpu_count": {"value": 2}, "memory": {"value": 16}, "bandwidth": {"value": 4000}}]}`)
+		case "/us-south/v1/regions/us-south/zones":
+			fmt.Fprint(w, `{"zones": [{"name": "us-south-1", "status": "available"}, {"name": "us-south-2", "status": "available"}]}`)
+		case "/catalog/is.instance/pricing":
+			fmt.Fprint(w, `{"metrics": [
+				{"metric_id": "part-is-instance-bx2-2x8-hours", "amounts": [
+					{"country": "DEU", "currency": "EUR", "prices": [{"quantity_tier": 1, "price": 0.09}]},
+					{"country": "USA", "currency": "USD", "prices": [{"quantity_tier": 1, "price": 0.096}, {"quantity_tier": 2, "price": 0.08}]}]},
+				{"metric_id": "part-is-instance-bx2-2x80-hours", "amounts": [
+					{"country": "USA", "currency": "USD", "prices": [{"quantity_tier": 1, "price": 0.9}]}]},
+				{"metric_id": "part-is-instance-cx2-2x4-hours", "amounts": [
+					{"country": "USA", "currency": "USD", "prices": [{"quantity_tier": 1, "price": 0.083}]}]}]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func newTestClient(server *httptest.Server) *client {
+	c := newClient(Config{APIKey: "secret"})
+	c.iamURL = server.URL + "/identity/token"
+	c.catalogURL = server.URL + "/catalog"
+	c.vpcEndpoint = server.URL + "/%s/v1"
+
+	return c
+}
+
+func TestClient_accessToken(t *testing.T) {
+	var tokenRequests int
+	server := newTestServer(t, &tokenRequests)
+	defer server.Close()
+
+	c := newTestClient(server)
+
+	token, err := c.accessToken(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "token-1", token)
+
+	token, err = c.accessToken(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "token-1", token, "the token should be reused until it expires")
+	assert.Equal(t, 1, tokenRequests)
+
+	c.tokenExpiry = time.Now().Add(-time.Second)
+
+	token, err = c.accessToken(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "token-2", token, "an expired token should be renewed")
+	assert.Equal(t, 2, tokenRequests)
+
+	unauthorized := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer unauthorized.Close()
+
+	c.iamURL = unauthorized.URL
+	c.token = ""
+
+	_, err = c.accessToken(context.Background())
+	assert.Error(t, err, "a failed token exchange should fail")
+}
+
+func TestClient_listInstanceProfiles(t *testing.T) {
+	var tokenRequests int
+	server := newTestServer(t, &tokenRequests)
+	defer server.Close()
+
+	profiles, err := newTestClient(server).listInstanceProfiles(context.Background(), "us-south")
+	assert.NoError(t, err)
+	if assert.Len(t, profiles, 3) {
+		assert.Equal(t, instanceProfile{
+			Name:      "bx2-2x8",
+			Family:    "balanced",
+			VCPUCount: profileValue{Value: 2},
+			Memory:    profileValue{Value: 8},
+			Bandwidth: profileValue{Value: 4000},
+		}, profiles[0])
+	}
+
+	_, err = newTestClient(server).listInstanceProfiles(context.Background(), "eu-de")
+	assert.Error(t, err)
+}
+
+func TestClient_getProfilePrices(t *testing.T) {
+	var tokenRequests int
+	server := newTestServer(t, &tokenRequests)
+	defer server.Close()
+
+	profiles := []instanceProfile{{Name: "bx2-2x8"}, {Name: "cx2-2x4"}, {Name: "mx2-2x16"}}
+
+	prices, err := newTestClient(server).getProfilePrices(context.Background(), "is.instance", "USA", profiles)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]float64{"bx2-2x8": 0.096, "cx2-2x4": 0.083}, prices)
+
+	prices, err = newTestClient(server).getProfilePrices(context.Background(), "is.instance", "DEU", profiles)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]float64{"bx2-2x8": 0.09}, prices, "only the prices of the country should be used")
+}
+
+func TestIBMInfoer_GetVirtualMachines(t *testing.T) {
+	var tokenRequests int
+	server := newTestServer(t, &tokenRequests)
+	defer server.Close()
+
+	infoer, err := NewIBMInfoer(Config{APIKey: "secret", CatalogEntry: "is.instance", Country: "USA"}, cloudinfoadapter.NewNoopLogger())
+	assert.NoError(t, err)
+	infoer.client = newTestClient(server)
+
+	vms, err := infoer.GetVirtualMachines("us-south")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, tokenRequests, "the token should be shared by the api calls")
+	if assert.Len(t, vms, 2, "the profiles without a price should be skipped") {
+		assert.Equal(t, "bx2-2x8", vms[0].Type)
+		assert.Equal(t, 0.096, vms[0].OnDemandPrice)
+		assert.Equal(t, 8.0, vms[0].Mem)
+		assert.Equal(t, []string{"us-south-1", "us-south-2"}, vms[0].Zones)
+
+		assert.Equal(t, "cx2-2x4", vms[1].Type)
+		assert.Equal(t, types.CategoryCompute, vms[1].Category)
+	}
+}
+
+func TestContainsProfile(t *testing.T) {
+	tests := []struct {
+		metricID string
+		profile  string
+		contains bool
+	}{
+		{metricID: "part-is-instance-bx2-2x8-hours", profile: "bx2-2x8", contains: true},
+		{metricID: "bx2-2x8", profile: "bx2-2x8", contains: true},
+		{metricID: "part-is-instance-bx2-2x80-hours", profile: "bx2-2x8", contains: false},
+		{metricID: "part-is-instance-bx2-2x80-bx2-2x8", profile: "bx2-2x8", contains: true},
+		{metricID: "part-is-instance-cx2-2x4-hours", profile: "bx2-2x8", contains: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.metricID, func(t *testing.T) {
+			assert.Equal(t, test.contains, containsProfile(test.metricID, test.profile))
+		})
+	}
+}
@@ -0,0 +1,256 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+	"github.com/banzaicloud/cloudinfo/internal/platform/log"
+)
+
+const (
+	svcCompute = "compute"
+	svcIks     = "iks"
+)
+
+// IBMInfoer encapsulates the data and operations needed to access external IBM Cloud resources.
+type IBMInfoer struct {
+	client       *client
+	region       string
+	catalogEntry string
+	country      string
+
+	logger cloudinfo.Logger
+}
+
+// NewIBMInfoer creates a new instance of the IBM Cloud infoer.
+func NewIBMInfoer(config Config, logger cloudinfo.Logger) (*IBMInfoer, error) {
+	if config.APIKey == "" {
+		return nil, errors.New("ibm cloud api key is required")
+	}
+
+	return &IBMInfoer{
+		client:       newClient(config),
+		region:       config.Region,
+		catalogEntry: config.CatalogEntry,
+		country:      config.Country,
+		logger:       logger,
+	}, nil
+}
+
+// Initialize retrieves the prices of the instance profiles in all regions
+func (i *IBMInfoer) Initialize() (map[string]map[string]types.Price, error) {
+	i.logger.Debug("initializing price info")
+
+	regions, err := i.GetRegions(svcCompute)
+	if err != nil {
+		return nil, err
+	}
+
+	allPrices := make(map[string]map[string]types.Price, len(regions))
+	for region := range regions {
+		vms, err := i.GetVirtualMachines(region)
+		if err != nil {
+			return nil, err
+		}
+
+		allPrices[region] = make(map[string]types.Price, len(vms))
+		for _, vm := range vms {
+			allPrices[region][vm.Type] = types.Price{
+				OnDemandPrice: vm.OnDemandPrice,
+			}
+		}
+	}
+
+	i.logger.Debug("finished initializing price info")
+	return allPrices, nil
+}
+
+// GetVirtualMachines retrieves the priced instance profiles available in the region
+func (i *IBMInfoer) GetVirtualMachines(region string) ([]types.VMInfo, error) {
+	logger := log.WithFields(i.logger, map[string]interface{}{"region": region})
+	logger.Debug("getting product info")
+
+	profiles, err := i.client.listInstanceProfiles(context.Background(), region)
+	if err != nil {
+		return nil, errors.WrapIfWithDetails(err, "failed to list instance profiles", "region", region)
+	}
+
+	prices, err := i.client.getProfilePrices(context.Background(), i.catalogEntry, i.country, profiles)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to retrieve instance profile prices")
+	}
+
+	zones, err := i.GetZones(region)
+	if err != nil {
+		return nil, err
+	}
+
+	var virtualMachines []types.VMInfo
+	for _, p := range profiles {
+		price, ok := prices[p.Name]
+		if !ok {
+			logger.Debug("no price found for instance profile", map[string]interface{}{"profile": p.Name})
+			continue
+		}
+
+		category := getCategory(p.Family)
+		ntwPerf, ntwPerfCat := networkPerformance(p.Bandwidth.Value)
+
+		virtualMachines = append(virtualMachines, types.VMInfo{
+			Category:      category,
+			Type:          p.Name,
+			OnDemandPrice: price,
+			Cpus:          p.VCPUCount.Value,
+			Mem:           p.Memory.Value,
+			Gpus:          p.GPUCount.Value,
+			NtwPerf:       ntwPerf,
+			NtwPerfCat:    ntwPerfCat,
+			Zones:         zones,
+			Attributes:    cloudinfo.Attributes(fmt.Sprint(p.VCPUCount.Value), fmt.Sprint(p.Memory.Value), ntwPerfCat, category),
+		})
+	}
+
+	logger.Debug("found instance profiles", map[string]interface{}{"numberOfTypes": len(virtualMachines)})
+	return virtualMachines, nil
+}
+
+// GetProducts retrieves the available virtual machines of the service in the region
+func (i *IBMInfoer) GetProducts(vms []types.VMInfo, service, regionId string) ([]types.VMInfo, error) {
+	switch service {
+	case svcCompute, svcIks:
+		return i.GetVirtualMachines(regionId)
+	default:
+		return nil, errors.NewWithDetails("invalid service", "service", service)
+	}
+}
+
+// GetZones retrieves the available zones of the region
+func (i *IBMInfoer) GetZones(region string) ([]string, error) {
+	zones, err := i.client.listZones(context.Background(), region)
+	if err != nil {
+		return nil, errors.WrapIfWithDetails(err, "failed to list zones", "region", region)
+	}
+
+	zoneNames := make([]string, 0, len(zones))
+	for _, z := range zones {
+		if z.Status == "available" {
+			zoneNames = append(zoneNames, z.Name)
+		}
+	}
+
+	return zoneNames, nil
+}
+
+// GetRegions retrieves the available VPC regions
+func (i *IBMInfoer) GetRegions(service string) (map[string]string, error) {
+	if service != svcCompute && service != svcIks {
+		return nil, errors.NewWithDetails("invalid service", "service", service)
+	}
+
+	regions, err := i.client.listRegions(context.Background(), i.region)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to list regions")
+	}
+
+	regionMap := make(map[string]string, len(regions))
+	for _, r := range regions {
+		if r.Status == "available" {
+			regionMap[r.Name] = r.Name
+		}
+	}
+
+	return regionMap, nil
+}
+
+// HasShortLivedPriceInfo signals that the IBM Cloud prices don't change frequently
+func (*IBMInfoer) HasShortLivedPriceInfo() bool {
+	return false
+}
+
+// GetCurrentPrices is not supported, IBM Cloud has no short lived prices
+func (*IBMInfoer) GetCurrentPrices(region string) (map[string]types.Price, error) {
+	return nil, errors.New("GetCurrentPrices - not yet implemented")
+}
+
+// HasImages signals that images are not scraped for IBM Cloud
+func (*IBMInfoer) HasImages() bool {
+	return false
+}
+
+// GetServiceImages is not supported for IBM Cloud
+func (*IBMInfoer) GetServiceImages(service, region string) ([]types.Image, error) {
+	return nil, errors.New("GetServiceImages - not yet implemented")
+}
+
+// GetVersions retrieves the Kubernetes versions supported by IKS
+func (i *IBMInfoer) GetVersions(service, region string) ([]types.LocationVersion, error) {
+	switch service {
+	case svcIks:
+		kubernetesVersions, err := i.client.listKubernetesVersions(context.Background())
+		if err != nil {
+			return nil, errors.WrapIf(err, "failed to list kubernetes versions")
+		}
+
+		versions := make([]string, 0, len(kubernetesVersions))
+		for _, v := range kubernetesVersions {
+			versions = append(versions, fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch))
+		}
+
+		return []types.LocationVersion{types.NewLocationVersion(region, versions, "")}, nil
+	default:
+		return []types.LocationVersion{}, nil
+	}
+}
+
+// GetServiceProducts is not supported for IBM Cloud
+func (*IBMInfoer) GetServiceProducts(region, service string) ([]types.ProductDetails, error) {
+	return nil, errors.New("GetServiceProducts - not yet implemented")
+}
+
+// getCategory maps the instance profile family to an instance type category
+func getCategory(family string) string {
+	switch {
+	case family == "compute":
+		return types.CategoryCompute
+	case strings.Contains(family, "memory"):
+		return types.CategoryMemory
+	case strings.Contains(family, "gpu"):
+		return types.CategoryGpu
+	default:
+		return types.CategoryGeneral
+	}
+}
+
+// networkPerformance maps the bandwidth (Mbps) to the network performance and its category
+func networkPerformance(mbps float64) (string, string) {
+	ntwPerf := fmt.Sprintf("%.0f Mbit/s", mbps)
+	switch {
+	case mbps < 4000:
+		return ntwPerf, types.NtwLow
+	case mbps < 16000:
+		return ntwPerf, types.NtwMedium
+	case mbps < 32000:
+		return ntwPerf, types.NtwHight
+	default:
+		return ntwPerf, types.NtwExtra
+	}
+}
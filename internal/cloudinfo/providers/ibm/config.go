@@ -0,0 +1,32 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ibm
+
+// Config holds the configuration of the IBM Cloud provider
+type Config struct {
+	// APIKey is an IBM Cloud API key, exchanged for IAM tokens
+	APIKey string
+
+	// Region is the VPC region used to list the available regions
+	Region string
+
+	// CatalogEntry is the id of the global catalog entry holding the pricing of the VPC instance profiles
+	CatalogEntry string
+
+	// Country is the country (ISO 3166-1 alpha-3) of the catalog prices
+	Country string
+
+	UserAgent string
+}
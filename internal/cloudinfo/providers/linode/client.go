@@ -0,0 +1,187 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linode
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/restapi"
+)
+
+const (
+	apiURL = "https://api.linode.com/v4"
+)
+
+// linodeType is an instance plan of the Linode API
+type linodeType struct {
+	ID         string  `json:"id"`
+	Label      string  `json:"label"`
+	Class      string  `json:"class"`
+	VCPUs      int     `json:"vcpus"`
+	Memory     int     `json:"memory"`
+	Disk       int     `json:"disk"`
+	GPUs       int     `json:"gpus"`
+	NetworkOut int     `json:"network_out"`
+	Price      price   `json:"price"`
+	Regional   []price `json:"region_prices"`
+}
+
+// price is the price of an instance plan, region specific prices have the region id set
+type price struct {
+	Region  string  `json:"id"`
+	Hourly  float64 `json:"hourly"`
+	Monthly float64 `json:"monthly"`
+}
+
+// region is a region of the Linode API
+type region struct {
+	ID           string   `json:"id"`
+	Label        string   `json:"label"`
+	Country      string   `json:"country"`
+	Capabilities []string `json:"capabilities"`
+	Status       string   `json:"status"`
+}
+
+// lkeVersion is a Kubernetes version supported by LKE
+type lkeVersion struct {
+	ID string `json:"id"`
+}
+
+// page is a page of a paginated Linode API listing
+type page struct {
+	Data  json.RawMessage `json:"data"`
+	Page  int             `json:"page"`
+	Pages int             `json:"pages"`
+}
+
+// client is a minimal client of the Linode API v4
+type client struct {
+	api     *restapi.Client
+	baseURL string
+	token   string
+}
+
+func newClient(config Config) *client {
+	return &client{
+		api:     restapi.NewClient("linode", config.UserAgent),
+		baseURL: apiURL,
+		token:   config.AccessToken,
+	}
+}
+
+// list retrieves all the pages of a listing, passing the data of the pages to the given function
+func (c *client) list(ctx context.Context, path string, fn func(data json.RawMessage) error) error {
+	for pageNum := 1; ; pageNum++ {
+		query := url.Values{}
+		query.Set("page", strconv.Itoa(pageNum))
+		query.Set("page_size", "500")
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s%s?%s", c.baseURL, path, query.Encode()), nil)
+		if err != nil {
+			return errors.WrapIf(err, "failed to create request")
+		}
+
+		if c.token != "" {
+			req.Header.Set("Authorization", "Bearer "+c.token)
+		}
+
+		var p page
+		if err := c.api.Do(req, &p); err != nil {
+			return err
+		}
+
+		if err := fn(p.Data); err != nil {
+			return errors.WrapIfWithDetails(err, "failed to decode linode api response", "path", path)
+		}
+
+		if p.Page >= p.Pages {
+			return nil
+		}
+	}
+}
+
+// listTypes lists the instance plans
+func (c *client) listTypes(ctx context.Context) ([]linodeType, error) {
+	var linodeTypes []linodeType
+	err := c.list(ctx, "/linode/types", func(data json.RawMessage) error {
+		var items []linodeType
+		if err := json.Unmarshal(data, &items); err != nil {
+			return err
+		}
+		linodeTypes = append(linodeTypes, items...)
+		return nil
+	})
+
+	return linodeTypes, err
+}
+
+// listRegions lists the regions
+func (c *client) listRegions(ctx context.Context) ([]region, error) {
+	var regions []region
+	err := c.list(ctx, "/regions", func(data json.RawMessage) error {
+		var items []region
+		if err := json.Unmarshal(data, &items); err != nil {
+			return err
+		}
+		regions = append(regions, items...)
+		return nil
+	})
+
+	return regions, err
+}
+
+// listLKEVersions lists the Kubernetes versions supported by LKE
+func (c *client) listLKEVersions(ctx context.Context) ([]lkeVersion, error) {
+	var versions []lkeVersion
+	err := c.list(ctx, "/lke/versions", func(data json.RawMessage) error {
+		var items []lkeVersion
+		if err := json.Unmarshal(data, &items); err != nil {
+			return err
+		}
+		versions = append(versions, items...)
+		return nil
+	})
+
+	return versions, err
+}
+
+// hourlyPrice returns the hourly price of the instance plan in the given region
+func (t linodeType) hourlyPrice(regionID string) float64 {
+	for _, p := range t.Regional {
+		if p.Region == regionID {
+			return p.Hourly
+		}
+	}
+
+	return t.Price.Hourly
+}
+
+// monthlyPrice returns the monthly price of the instance plan in the given region
+func (t linodeType) monthlyPrice(regionID string) float64 {
+	for _, p := range t.Regional {
+		if p.Region == regionID {
+			return p.Monthly
+		}
+	}
+
+	return t.Price.Monthly
+}
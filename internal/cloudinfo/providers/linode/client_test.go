@@ -0,0 +1,55 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linode
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_listTypes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/linode/types", r.URL.Path)
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+
+		switch r.URL.Query().Get("page") {
+		case "1":
+			fmt.Fprint(w, `{"data": [{"id": "g6-nanode-1", "class": "nanode", "vcpus": 1, "memory": 1024,
+				"price": {"hourly": 0.0075, "monthly": 5}, "region_prices": [{"id": "id-cgk", "hourly": 0.009, "monthly": 6}]}],
+				"page": 1, "pages": 2}`)
+		default:
+			fmt.Fprint(w, `{"data": [{"id": "g6-dedicated-2", "class": "dedicated", "vcpus": 2, "memory": 4096,
+				"price": {"hourly": 0.054, "monthly": 36}}], "page": 2, "pages": 2}`)
+		}
+	}))
+	defer server.Close()
+
+	c := newClient(Config{AccessToken: "token"})
+	c.baseURL = server.URL
+
+	linodeTypes, err := c.listTypes(context.Background())
+	assert.NoError(t, err)
+	if assert.Len(t, linodeTypes, 2, "all the pages should be listed") {
+		assert.Equal(t, 0.0075, linodeTypes[0].hourlyPrice("us-east"))
+		assert.Equal(t, 0.009, linodeTypes[0].hourlyPrice("id-cgk"), "the regional price should be used")
+		assert.Equal(t, 6.0, linodeTypes[0].monthlyPrice("id-cgk"))
+		assert.Equal(t, "g6-dedicated-2", linodeTypes[1].ID)
+	}
+}
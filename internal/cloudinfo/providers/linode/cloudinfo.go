@@ -0,0 +1,234 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linode
+
+import (
+	"context"
+	"fmt"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+	"github.com/banzaicloud/cloudinfo/internal/platform/log"
+)
+
+const (
+	svcCompute = "compute"
+	svcLke     = "lke"
+
+	// capabilityKubernetes is the capability of the regions supporting LKE
+	capabilityKubernetes = "Kubernetes"
+)
+
+// LinodeInfoer encapsulates the data and operations needed to access external Linode resources.
+type LinodeInfoer struct {
+	client *client
+
+	logger cloudinfo.Logger
+}
+
+// NewLinodeInfoer creates a new instance of the Linode infoer.
+func NewLinodeInfoer(config Config, logger cloudinfo.Logger) (*LinodeInfoer, error) {
+	return &LinodeInfoer{
+		client: newClient(config),
+		logger: logger,
+	}, nil
+}
+
+// Initialize retrieves the prices of the instance plans in all regions
+func (i *LinodeInfoer) Initialize() (map[string]map[string]types.Price, error) {
+	i.logger.Debug("initializing price info")
+
+	linodeTypes, err := i.client.listTypes(context.Background())
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to list instance types")
+	}
+
+	regions, err := i.client.listRegions(context.Background())
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to list regions")
+	}
+
+	allPrices := make(map[string]map[string]types.Price, len(regions))
+	for _, r := range regions {
+		allPrices[r.ID] = make(map[string]types.Price, len(linodeTypes))
+		for _, t := range linodeTypes {
+			allPrices[r.ID][t.ID] = types.Price{
+				OnDemandPrice: t.hourlyPrice(r.ID),
+			}
+		}
+	}
+
+	i.logger.Debug("finished initializing price info")
+	return allPrices, nil
+}
+
+// GetVirtualMachines retrieves the instance plans available in the region
+func (i *LinodeInfoer) GetVirtualMachines(region string) ([]types.VMInfo, error) {
+	logger := log.WithFields(i.logger, map[string]interface{}{"region": region})
+	logger.Debug("getting product info")
+
+	linodeTypes, err := i.client.listTypes(context.Background())
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to list instance types")
+	}
+
+	virtualMachines := make([]types.VMInfo, 0, len(linodeTypes))
+	for _, t := range linodeTypes {
+		category := getCategory(t.Class)
+		ntwPerf, ntwPerfCat := networkPerformance(t.NetworkOut)
+		mem := float64(t.Memory) / 1024
+
+		virtualMachines = append(virtualMachines, types.VMInfo{
+			Category:      category,
+			Type:          t.ID,
+			OnDemandPrice: t.hourlyPrice(region),
+			MonthlyPrice:  t.monthlyPrice(region),
+			Cpus:          float64(t.VCPUs),
+			Mem:           mem,
+			Gpus:          float64(t.GPUs),
+			NtwPerf:       ntwPerf,
+			NtwPerfCat:    ntwPerfCat,
+			Zones:         []string{},
+			Burst:         isShared(t.Class),
+			Attributes:    cloudinfo.Attributes(fmt.Sprint(t.VCPUs), fmt.Sprint(mem), ntwPerfCat, category),
+		})
+	}
+
+	logger.Debug("found instance types", map[string]interface{}{"numberOfTypes": len(virtualMachines)})
+	return virtualMachines, nil
+}
+
+// GetProducts retrieves the available virtual machines of the service in the region
+func (i *LinodeInfoer) GetProducts(vms []types.VMInfo, service, regionId string) ([]types.VMInfo, error) {
+	switch service {
+	case svcCompute, svcLke:
+		return i.GetVirtualMachines(regionId)
+	default:
+		return nil, errors.NewWithDetails("invalid service", "service", service)
+	}
+}
+
+// GetZones returns the availability zones of the region, Linode has no zones
+func (*LinodeInfoer) GetZones(region string) ([]string, error) {
+	return []string{}, nil
+}
+
+// GetRegions retrieves the regions of the service
+func (i *LinodeInfoer) GetRegions(service string) (map[string]string, error) {
+	if service != svcCompute && service != svcLke {
+		return nil, errors.NewWithDetails("invalid service", "service", service)
+	}
+
+	regions, err := i.client.listRegions(context.Background())
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to list regions")
+	}
+
+	regionMap := make(map[string]string, len(regions))
+	for _, r := range regions {
+		if r.Status != "ok" {
+			continue
+		}
+
+		if service == svcLke && !cloudinfo.Contains(r.Capabilities, capabilityKubernetes) {
+			continue
+		}
+
+		regionMap[r.ID] = r.Label
+	}
+
+	return regionMap, nil
+}
+
+// HasShortLivedPriceInfo signals that the Linode prices don't change frequently
+func (*LinodeInfoer) HasShortLivedPriceInfo() bool {
+	return false
+}
+
+// GetCurrentPrices is not supported, Linode has no short lived prices
+func (*LinodeInfoer) GetCurrentPrices(region string) (map[string]types.Price, error) {
+	return nil, errors.New("GetCurrentPrices - not yet implemented")
+}
+
+// HasImages signals that images are not scraped for Linode
+func (*LinodeInfoer) HasImages() bool {
+	return false
+}
+
+// GetServiceImages is not supported for Linode
+func (*LinodeInfoer) GetServiceImages(service, region string) ([]types.Image, error) {
+	return nil, errors.New("GetServiceImages - not yet implemented")
+}
+
+// GetVersions retrieves the Kubernetes versions supported by LKE
+func (i *LinodeInfoer) GetVersions(service, region string) ([]types.LocationVersion, error) {
+	switch service {
+	case svcLke:
+		lkeVersions, err := i.client.listLKEVersions(context.Background())
+		if err != nil {
+			return nil, errors.WrapIf(err, "failed to list kubernetes versions")
+		}
+
+		versions := make([]string, 0, len(lkeVersions))
+		for _, v := range lkeVersions {
+			versions = append(versions, v.ID)
+		}
+
+		return []types.LocationVersion{types.NewLocationVersion(region, versions, "")}, nil
+	default:
+		return []types.LocationVersion{}, nil
+	}
+}
+
+// GetServiceProducts is not supported for Linode
+func (*LinodeInfoer) GetServiceProducts(region, service string) ([]types.ProductDetails, error) {
+	return nil, errors.New("GetServiceProducts - not yet implemented")
+}
+
+// getCategory maps the class of the instance plan to an instance type category
+func getCategory(class string) string {
+	switch class {
+	case "dedicated", "premium":
+		return types.CategoryCompute
+	case "highmem":
+		return types.CategoryMemory
+	case "gpu":
+		return types.CategoryGpu
+	default:
+		return types.CategoryGeneral
+	}
+}
+
+// isShared signals whether the instance plan has shared (burstable) cpus
+func isShared(class string) bool {
+	return class == "nanode" || class == "standard"
+}
+
+// networkPerformance maps the outbound bandwidth (Mbps) to the network performance and its category
+func networkPerformance(mbps int) (string, string) {
+	ntwPerf := fmt.Sprintf("%d Mbit/s", mbps)
+	switch {
+	case mbps < 2000:
+		return ntwPerf, types.NtwLow
+	case mbps < 8000:
+		return ntwPerf, types.NtwMedium
+	case mbps < 20000:
+		return ntwPerf, types.NtwHight
+	default:
+		return ntwPerf, types.NtwExtra
+	}
+}
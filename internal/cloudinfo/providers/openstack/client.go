@@ -0,0 +1,265 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openstack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/restapi"
+)
+
+const (
+	// computeAPIVersion is the compute microversion returning the extra specs of the flavors
+	computeAPIVersion = "compute 2.61"
+)
+
+// flavor is a flavor of the Nova compute API
+type flavor struct {
+	Name       string            `json:"name"`
+	VCPUs      int               `json:"vcpus"`
+	RAM        int               `json:"ram"`
+	Disabled   bool              `json:"OS-FLV-DISABLED:disabled"`
+	ExtraSpecs map[string]string `json:"extra_specs"`
+}
+
+// link is a pagination link of the Nova compute API
+type link struct {
+	Rel  string `json:"rel"`
+	Href string `json:"href"`
+}
+
+// endpoint is an endpoint of a service in the Keystone catalog
+type endpoint struct {
+	Interface string `json:"interface"`
+	RegionID  string `json:"region_id"`
+	URL       string `json:"url"`
+}
+
+// client is a minimal client of the Keystone and Nova APIs
+type client struct {
+	api    *restapi.Client
+	config Config
+
+	token       string
+	tokenExpiry time.Time
+	endpoints   map[string]string
+	tokenMu     sync.Mutex
+}
+
+func newClient(config Config) *client {
+	return &client{
+		api:    restapi.NewClient("openstack", config.UserAgent),
+		config: config,
+	}
+}
+
+// authRequest builds the body of the token request from the credentials of the configuration
+func (c *client) authRequest() interface{} {
+	type domain struct {
+		Name string `json:"name"`
+	}
+
+	if c.config.ApplicationCredentialID != "" {
+		return map[string]interface{}{
+			"auth": map[string]interface{}{
+				"identity": map[string]interface{}{
+					"methods": []string{"application_credential"},
+					"application_credential": map[string]string{
+						"id":     c.config.ApplicationCredentialID,
+						"secret": c.config.ApplicationCredentialSecret,
+					},
+				},
+			},
+		}
+	}
+
+	return map[string]interface{}{
+		"auth": map[string]interface{}{
+			"identity": map[string]interface{}{
+				"methods": []string{"password"},
+				"password": map[string]interface{}{
+					"user": map[string]interface{}{
+						"name":     c.config.Username,
+						"password": c.config.Password,
+						"domain":   domain{Name: c.config.UserDomainName},
+					},
+				},
+			},
+			"scope": map[string]interface{}{
+				"project": map[string]interface{}{
+					"name":   c.config.ProjectName,
+					"domain": domain{Name: c.config.ProjectDomainName},
+				},
+			},
+		},
+	}
+}
+
+// authenticate returns a valid token and the compute endpoints by region, issuing a new token if needed
+func (c *client) authenticate(ctx context.Context) (string, map[string]string, error) {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	if c.token != "" && time.Now().Before(c.tokenExpiry) {
+		return c.token, c.endpoints, nil
+	}
+
+	body, err := json.Marshal(c.authRequest())
+	if err != nil {
+		return "", nil, errors.WrapIf(err, "failed to encode token request")
+	}
+
+	authURL := strings.TrimSuffix(strings.TrimSuffix(c.config.AuthURL, "/"), "/v3")
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, authURL+"/v3/auth/tokens", bytes.NewReader(body))
+	if err != nil {
+		return "", nil, errors.WrapIf(err, "failed to create request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	var resp struct {
+		Token struct {
+			ExpiresAt time.Time `json:"expires_at"`
+			Catalog   []struct {
+				Type      string     `json:"type"`
+				Endpoints []endpoint `json:"endpoints"`
+			} `json:"catalog"`
+		} `json:"token"`
+	}
+
+	header, err := c.api.DoStatus(req, http.StatusCreated, &resp)
+	if err != nil {
+		return "", nil, errors.WrapIf(err, "failed to issue keystone token")
+	}
+
+	endpoints := make(map[string]string)
+	for _, service := range resp.Token.Catalog {
+		if service.Type != "compute" {
+			continue
+		}
+
+		for _, e := range service.Endpoints {
+			if e.Interface == c.config.Interface {
+				endpoints[e.RegionID] = strings.TrimSuffix(e.URL, "/")
+			}
+		}
+	}
+
+	c.token = header.Get("X-Subject-Token")
+	c.endpoints = endpoints
+	// renew the token a minute before it expires
+	c.tokenExpiry = resp.Token.ExpiresAt.Add(-time.Minute)
+
+	return c.token, c.endpoints, nil
+}
+
+// regions returns the regions with a compute endpoint
+func (c *client) regions(ctx context.Context) ([]string, error) {
+	_, endpoints, err := c.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	regions := make([]string, 0, len(endpoints))
+	for region := range endpoints {
+		regions = append(regions, region)
+	}
+
+	return regions, nil
+}
+
+// getCompute retrieves a resource of the compute api of the region (or an absolute url) and decodes it into the given value
+func (c *client) getCompute(ctx context.Context, region, path string, v interface{}) error {
+	token, endpoints, err := c.authenticate(ctx)
+	if err != nil {
+		return err
+	}
+
+	target := path
+	if !strings.HasPrefix(path, "http") {
+		endpoint, ok := endpoints[region]
+		if !ok {
+			return errors.NewWithDetails("no compute endpoint found in the region", "region", region)
+		}
+		target = endpoint + path
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return errors.WrapIf(err, "failed to create request")
+	}
+	req.Header.Set("X-Auth-Token", token)
+	req.Header.Set("OpenStack-API-Version", computeAPIVersion)
+
+	return c.api.Do(req, v)
+}
+
+// listFlavors lists the flavors of the region with their extra specs, following the pagination links
+func (c *client) listFlavors(ctx context.Context, region string) ([]flavor, error) {
+	var flavors []flavor
+	for path := "/flavors/detail"; path != ""; {
+		var resp struct {
+			Flavors []flavor `json:"flavors"`
+			Links   []link   `json:"flavors_links"`
+		}
+
+		if err := c.getCompute(ctx, region, path, &resp); err != nil {
+			return nil, err
+		}
+
+		flavors = append(flavors, resp.Flavors...)
+
+		path = ""
+		for _, l := range resp.Links {
+			if l.Rel == "next" {
+				path = l.Href
+			}
+		}
+	}
+
+	return flavors, nil
+}
+
+// listAvailabilityZones lists the available availability zones of the region
+func (c *client) listAvailabilityZones(ctx context.Context, region string) ([]string, error) {
+	var resp struct {
+		AvailabilityZones []struct {
+			ZoneName  string `json:"zoneName"`
+			ZoneState struct {
+				Available bool `json:"available"`
+			} `json:"zoneState"`
+		} `json:"availabilityZoneInfo"`
+	}
+
+	if err := c.getCompute(ctx, region, "/os-availability-zone", &resp); err != nil {
+		return nil, err
+	}
+
+	zones := make([]string, 0, len(resp.AvailabilityZones))
+	for _, az := range resp.AvailabilityZones {
+		if az.ZoneState.Available {
+			zones = append(zones, az.ZoneName)
+		}
+	}
+
+	return zones, nil
+}
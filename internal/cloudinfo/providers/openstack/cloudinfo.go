@@ -0,0 +1,243 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+	"github.com/banzaicloud/cloudinfo/internal/platform/log"
+)
+
+const svcCompute = "compute"
+
+// OpenStackInfoer encapsulates the data and operations needed to access external OpenStack resources.
+type OpenStackInfoer struct {
+	client     *client
+	priceSheet string
+
+	logger cloudinfo.Logger
+}
+
+// NewOpenStackInfoer creates a new instance of the OpenStack infoer.
+func NewOpenStackInfoer(config Config, logger cloudinfo.Logger) (*OpenStackInfoer, error) {
+	if config.AuthURL == "" {
+		return nil, errors.New("openstack auth url is required")
+	}
+
+	if config.PriceSheet == "" {
+		return nil, errors.New("openstack price sheet is required")
+	}
+
+	return &OpenStackInfoer{
+		client:     newClient(config),
+		priceSheet: config.PriceSheet,
+		logger:     logger,
+	}, nil
+}
+
+// Initialize retrieves the prices of the flavors in all regions
+func (i *OpenStackInfoer) Initialize() (map[string]map[string]types.Price, error) {
+	i.logger.Debug("initializing price info")
+
+	regions, err := i.client.regions(context.Background())
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to list regions")
+	}
+
+	allPrices := make(map[string]map[string]types.Price, len(regions))
+	for _, region := range regions {
+		vms, err := i.GetVirtualMachines(region)
+		if err != nil {
+			return nil, err
+		}
+
+		allPrices[region] = make(map[string]types.Price, len(vms))
+		for _, vm := range vms {
+			allPrices[region][vm.Type] = types.Price{
+				OnDemandPrice: vm.OnDemandPrice,
+			}
+		}
+	}
+
+	i.logger.Debug("finished initializing price info")
+	return allPrices, nil
+}
+
+// GetVirtualMachines retrieves the enabled flavors of the region priced from the price sheet,
+// the price sheet is read on every call so that price changes are picked up by the next scrape
+func (i *OpenStackInfoer) GetVirtualMachines(region string) ([]types.VMInfo, error) {
+	logger := log.WithFields(i.logger, map[string]interface{}{"region": region})
+	logger.Debug("getting product info")
+
+	sheet, err := readPriceSheet(i.priceSheet)
+	if err != nil {
+		return nil, err
+	}
+
+	flavors, err := i.client.listFlavors(context.Background(), region)
+	if err != nil {
+		return nil, errors.WrapIfWithDetails(err, "failed to list flavors", "region", region)
+	}
+
+	zones, err := i.GetZones(region)
+	if err != nil {
+		return nil, err
+	}
+
+	var virtualMachines []types.VMInfo
+	for _, f := range flavors {
+		if f.Disabled {
+			continue
+		}
+
+		cpus, mem, gpus := float64(f.VCPUs), float64(f.RAM)/1024, float64(countGPUs(f.ExtraSpecs))
+
+		price, ok := sheet.price(region, f.Name, cpus, mem, gpus)
+		if !ok {
+			logger.Debug("no price found for flavor", map[string]interface{}{"flavor": f.Name})
+			continue
+		}
+
+		category := getCategory(cpus, mem, gpus)
+		virtualMachines = append(virtualMachines, types.VMInfo{
+			Category:      category,
+			Type:          f.Name,
+			OnDemandPrice: price,
+			Currency:      sheet.Currency,
+			Cpus:          cpus,
+			Mem:           mem,
+			Gpus:          gpus,
+			NtwPerf:       "N/A",
+			NtwPerfCat:    types.NtwMedium,
+			Zones:         zones,
+			Attributes:    cloudinfo.Attributes(fmt.Sprint(cpus), fmt.Sprint(mem), types.NtwMedium, category),
+		})
+	}
+
+	logger.Debug("found flavors", map[string]interface{}{"numberOfTypes": len(virtualMachines)})
+	return virtualMachines, nil
+}
+
+// GetProducts retrieves the available flavors of the service in the region
+func (i *OpenStackInfoer) GetProducts(vms []types.VMInfo, service, regionId string) ([]types.VMInfo, error) {
+	switch service {
+	case svcCompute:
+		if len(vms) > 0 {
+			return vms, nil
+		}
+
+		return i.GetVirtualMachines(regionId)
+	default:
+		return nil, errors.NewWithDetails("invalid service", "service", service)
+	}
+}
+
+// GetZones retrieves the available availability zones of the region
+func (i *OpenStackInfoer) GetZones(region string) ([]string, error) {
+	zones, err := i.client.listAvailabilityZones(context.Background(), region)
+	if err != nil {
+		return nil, errors.WrapIfWithDetails(err, "failed to list availability zones", "region", region)
+	}
+
+	return zones, nil
+}
+
+// GetRegions retrieves the regions with a compute endpoint
+func (i *OpenStackInfoer) GetRegions(service string) (map[string]string, error) {
+	if service != svcCompute {
+		return nil, errors.NewWithDetails("invalid service", "service", service)
+	}
+
+	regions, err := i.client.regions(context.Background())
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to list regions")
+	}
+
+	regionMap := make(map[string]string, len(regions))
+	for _, region := range regions {
+		regionMap[region] = region
+	}
+
+	return regionMap, nil
+}
+
+// HasShortLivedPriceInfo signals that the price sheet prices don't change frequently
+func (*OpenStackInfoer) HasShortLivedPriceInfo() bool {
+	return false
+}
+
+// GetCurrentPrices is not supported, the price sheet has no short lived prices
+func (*OpenStackInfoer) GetCurrentPrices(region string) (map[string]types.Price, error) {
+	return nil, errors.New("GetCurrentPrices - not yet implemented")
+}
+
+// HasImages signals that images are not scraped for OpenStack
+func (*OpenStackInfoer) HasImages() bool {
+	return false
+}
+
+// GetServiceImages is not supported for OpenStack
+func (*OpenStackInfoer) GetServiceImages(service, region string) ([]types.Image, error) {
+	return nil, errors.New("GetServiceImages - not yet implemented")
+}
+
+// GetVersions returns no versions, the Magnum cluster templates are not scraped
+func (*OpenStackInfoer) GetVersions(service, region string) ([]types.LocationVersion, error) {
+	return []types.LocationVersion{}, nil
+}
+
+// GetServiceProducts is not supported for OpenStack
+func (*OpenStackInfoer) GetServiceProducts(region, service string) ([]types.ProductDetails, error) {
+	return nil, errors.New("GetServiceProducts - not yet implemented")
+}
+
+// countGPUs counts the gpus of a flavor from its extra specs, either passed through pci devices
+// (pci_passthrough:alias = a100:2,t4:1) or virtual gpus (resources:VGPU = 1)
+func countGPUs(extraSpecs map[string]string) int {
+	var gpus int
+	for _, alias := range strings.Split(extraSpecs["pci_passthrough:alias"], ",") {
+		if idx := strings.LastIndex(alias, ":"); idx >= 0 {
+			count, _ := strconv.Atoi(alias[idx+1:])
+			gpus += count
+		}
+	}
+
+	vgpus, _ := strconv.Atoi(extraSpecs["resources:VGPU"])
+
+	return gpus + vgpus
+}
+
+// getCategory categorizes a flavor by its gpus and memory (GiB) per vcpu
+func getCategory(cpus, mem, gpus float64) string {
+	switch {
+	case gpus > 0:
+		return types.CategoryGpu
+	case cpus == 0:
+		return types.CategoryGeneral
+	case mem/cpus >= 8:
+		return types.CategoryMemory
+	case mem/cpus <= 2:
+		return types.CategoryCompute
+	default:
+		return types.CategoryGeneral
+	}
+}
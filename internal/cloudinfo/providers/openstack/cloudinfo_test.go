@@ -0,0 +1,178 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openstack
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/cloudinfoadapter"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+const testPriceSheet = `
+currency: EUR
+vcpu: 0.01
+memory: 0.005
+flavors:
+  m1.small: 0.02
+  m1.large: 0.08
+regions:
+  RegionTwo:
+    m1.small: 0.025
+`
+
+func newTestServer(t *testing.T, tokenRequests *int) *httptest.Server {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/identity/v3/auth/tokens" {
+			*tokenRequests++
+
+			var body struct {
+				Auth struct {
+					Identity struct {
+						Methods  []string `json:"methods"`
+						Password struct {
+							User struct {
+								Name     string `json:"name"`
+								Password string `json:"password"`
+							} `json:"user"`
+						} `json:"password"`
+					} `json:"identity"`
+				} `json:"auth"`
+			}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, []string{"password"}, body.Auth.Identity.Methods)
+			assert.Equal(t, "admin", body.Auth.Identity.Password.User.Name)
+
+			w.Header().Set("X-Subject-Token", "token")
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, `{"token": {"expires_at": %q, "catalog": [
+				{"type": "identity", "endpoints": [{"interface": "public", "region_id": "RegionOne", "url": "%[2]s/identity"}]},
+				{"type": "compute", "endpoints": [
+					{"interface": "public", "region_id": "RegionOne", "url": "%[2]s/compute/"},
+					{"interface": "internal", "region_id": "RegionOne", "url": "http://nova.internal"},
+					{"interface": "public", "region_id": "RegionTwo", "url": "%[2]s/compute-two"}]}]}}`,
+				time.Now().Add(time.Hour).Format(time.RFC3339), server.URL)
+			return
+		}
+
+		assert.Equal(t, "token", r.Header.Get("X-Auth-Token"))
+		assert.Equal(t, computeAPIVersion, r.Header.Get("OpenStack-API-Version"))
+
+		switch r.URL.Path {
+		case "/compute/flavors/detail":
+			if r.URL.Query().Get("marker") == "" {
+				fmt.Fprintf(w, `{"flavors": [
+					{"name": "m1.small", "vcpus": 1, "ram": 2048},
+					{"name": "m1.old", "vcpus": 1, "ram": 512, "OS-FLV-DISABLED:disabled": true}],
+					"flavors_links": [{"rel": "next", "href": "%s/compute/flavors/detail?marker=m1.old"}]}`, server.URL)
+				return
+			}
+
+			fmt.Fprint(w, `{"flavors": [
+				{"name": "c1.xlarge", "vcpus": 8, "ram": 16384},
+				{"name": "g1.large", "vcpus": 8, "ram": 65536, "extra_specs": {"pci_passthrough:alias": "a100:2"}}]}`)
+		case "/compute/os-availability-zone":
+			fmt.Fprint(w, `{"availabilityZoneInfo": [
+				{"zoneName": "nova", "zoneState": {"available": true}},
+				{"zoneName": "az-down", "zoneState": {"available": false}}]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	return server
+}
+
+func newTestInfoer(t *testing.T, server *httptest.Server) *OpenStackInfoer {
+	priceSheet := filepath.Join(t.TempDir(), "prices.yaml")
+	require.NoError(t, ioutil.WriteFile(priceSheet, []byte(testPriceSheet), 0600))
+
+	infoer, err := NewOpenStackInfoer(Config{
+		AuthURL:    server.URL + "/identity/v3/",
+		Username:   "admin",
+		Password:   "secret",
+		Interface:  "public",
+		PriceSheet: priceSheet,
+	}, cloudinfoadapter.NewNoopLogger())
+	require.NoError(t, err)
+
+	return infoer
+}
+
+func TestOpenStackInfoer_GetVirtualMachines(t *testing.T) {
+	var tokenRequests int
+	server := newTestServer(t, &tokenRequests)
+	defer server.Close()
+
+	infoer := newTestInfoer(t, server)
+
+	vms, err := infoer.GetVirtualMachines("RegionOne")
+	require.NoError(t, err)
+	assert.Equal(t, 1, tokenRequests, "the token should be reused")
+
+	if assert.Len(t, vms, 3, "the disabled flavors should be skipped") {
+		assert.Equal(t, "m1.small", vms[0].Type)
+		assert.Equal(t, 0.02, vms[0].OnDemandPrice)
+		assert.Equal(t, 2.0, vms[0].Mem)
+		assert.Equal(t, "EUR", vms[0].Currency)
+		assert.Equal(t, []string{"nova"}, vms[0].Zones)
+		assert.Equal(t, types.CategoryCompute, vms[0].Category)
+
+		assert.Equal(t, "c1.xlarge", vms[1].Type)
+		assert.InDelta(t, 0.16, vms[1].OnDemandPrice, 1e-9, "the unit prices should be used without a flavor price")
+
+		assert.Equal(t, "g1.large", vms[2].Type)
+		assert.Equal(t, 2.0, vms[2].Gpus)
+		assert.Equal(t, types.CategoryGpu, vms[2].Category)
+	}
+
+	regions, err := infoer.GetRegions(svcCompute)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"RegionOne": "RegionOne", "RegionTwo": "RegionTwo"}, regions)
+}
+
+func TestPriceSheet_price(t *testing.T) {
+	var sheet priceSheet
+	require.NoError(t, yaml.Unmarshal([]byte(testPriceSheet), &sheet))
+
+	price, ok := sheet.price("RegionTwo", "m1.small", 1, 2, 0)
+	assert.True(t, ok)
+	assert.Equal(t, 0.025, price, "the region price should override the flavor price")
+
+	price, ok = sheet.price("RegionOne", "m1.small", 1, 2, 0)
+	assert.True(t, ok)
+	assert.Equal(t, 0.02, price)
+
+	_, ok = priceSheet{Flavors: map[string]float64{"m1.small": 0.02}}.price("RegionOne", "m1.tiny", 1, 1, 0)
+	assert.False(t, ok, "flavors without a price should not be priced without unit prices")
+}
+
+func TestCountGPUs(t *testing.T) {
+	assert.Equal(t, 0, countGPUs(nil))
+	assert.Equal(t, 3, countGPUs(map[string]string{"pci_passthrough:alias": "a100:2,t4:1"}))
+	assert.Equal(t, 1, countGPUs(map[string]string{"resources:VGPU": "1"}))
+}
@@ -0,0 +1,42 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openstack
+
+// Config holds the configuration of the OpenStack provider
+type Config struct {
+	// AuthURL is the url of the Keystone identity service (eg.: https://keystone.example.com:5000/v3)
+	AuthURL string
+
+	// Username, Password and UserDomainName authenticate a user, unless an application credential is set
+	Username       string
+	Password       string
+	UserDomainName string
+
+	// ProjectName and ProjectDomainName scope the token to a project
+	ProjectName       string
+	ProjectDomainName string
+
+	// ApplicationCredentialID and ApplicationCredentialSecret authenticate with an application credential
+	ApplicationCredentialID     string
+	ApplicationCredentialSecret string
+
+	// Interface is the interface of the compute endpoints (public, internal or admin)
+	Interface string
+
+	// PriceSheet is the path of the YAML or JSON file holding the prices of the flavors
+	PriceSheet string
+
+	UserAgent string
+}
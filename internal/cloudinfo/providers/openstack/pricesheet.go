@@ -0,0 +1,76 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openstack
+
+import (
+	"io/ioutil"
+
+	"emperror.dev/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// priceSheet holds the hourly prices of the flavors, read from a YAML or JSON file:
+//
+//   currency: EUR
+//   # unit prices of the flavors without a price
+//   vcpu: 0.01
+//   memory: 0.005
+//   gpu: 0.5
+//   flavors:
+//     m1.small: 0.02
+//   # region specific prices, overriding the flavor prices
+//   regions:
+//     RegionOne:
+//       m1.small: 0.025
+type priceSheet struct {
+	Currency string                        `yaml:"currency"`
+	VCPU     float64                       `yaml:"vcpu"`
+	Memory   float64                       `yaml:"memory"`
+	GPU      float64                       `yaml:"gpu"`
+	Flavors  map[string]float64            `yaml:"flavors"`
+	Regions  map[string]map[string]float64 `yaml:"regions"`
+}
+
+// readPriceSheet reads the price sheet file, JSON files are parsed as YAML
+func readPriceSheet(path string) (priceSheet, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return priceSheet{}, errors.WrapIfWithDetails(err, "failed to read price sheet", "path", path)
+	}
+
+	var sheet priceSheet
+	if err := yaml.Unmarshal(data, &sheet); err != nil {
+		return priceSheet{}, errors.WrapIfWithDetails(err, "failed to parse price sheet", "path", path)
+	}
+
+	return sheet, nil
+}
+
+// price returns the hourly price of the flavor in the region, falling back to the unit prices
+func (s priceSheet) price(region, flavor string, vcpus, memory, gpus float64) (float64, bool) {
+	if price, ok := s.Regions[region][flavor]; ok {
+		return price, true
+	}
+
+	if price, ok := s.Flavors[flavor]; ok {
+		return price, true
+	}
+
+	if s.VCPU == 0 && s.Memory == 0 && s.GPU == 0 {
+		return 0, false
+	}
+
+	return s.VCPU*vcpus + s.Memory*memory + s.GPU*gpus, true
+}
@@ -25,21 +25,23 @@ import (
 
 // OCI is for managing OCI API calls
 type OCI struct {
-	config  common.ConfigurationProvider
-	logger  *logrus.Logger
-	Tenancy identity.Tenancy
+	config    common.ConfigurationProvider
+	logger    *logrus.Logger
+	userAgent string
+	Tenancy   identity.Tenancy
 }
 
 // NewOCI creates a new OCI and gets and caches tenancy info
-func NewOCI(cfgProvider common.ConfigurationProvider) (oci *OCI, err error) {
+func NewOCI(cfgProvider common.ConfigurationProvider, userAgent string) (oci *OCI, err error) {
 
 	if err != nil {
 		return
 	}
 
 	oci = &OCI{
-		config: cfgProvider,
-		logger: logrus.New(),
+		config:    cfgProvider,
+		logger:    logrus.New(),
+		userAgent: userAgent,
 	}
 
 	_, err = oci.GetTenancy()
@@ -78,6 +80,13 @@ func (oci *OCI) ChangeRegion(regionName string) (err error) {
 	return
 }
 
+// setUserAgent appends the configured user agent to the user agent of the client
+func (oci *OCI) setUserAgent(client *common.BaseClient) {
+	if oci.userAgent != "" {
+		client.UserAgent = oci.userAgent + " " + client.UserAgent
+	}
+}
+
 // SetLogger sets a logrus logger
 func (oci *OCI) SetLogger(logger *logrus.Logger) {
 
@@ -38,6 +38,8 @@ func (oci *OCI) NewIdentityClient() (client *Identity, err error) {
 		return client, err
 	}
 
+	oci.setUserAgent(&oClient.BaseClient)
+
 	client.client = &oClient
 	client.oci = oci
 
@@ -16,6 +16,8 @@ package oracle
 
 import (
 	"fmt"
+	"strings"
+	"sync"
 
 	"emperror.dev/emperror"
 	"emperror.dev/errors"
@@ -34,7 +36,11 @@ type Infoer struct {
 	client         *client.OCI
 	shapeSpecs     map[string]ShapeSpecs
 	cloudInfoCache map[string]ITRACloudInfo
+	userAgent      string
 	log            cloudinfo.Logger
+
+	// cacheMu guards the cloud info cache, shared by the product and the storage scraping
+	cacheMu sync.Mutex
 }
 
 // ShapeSpecs representation the specs of a certain type of virtual machine
@@ -72,6 +78,10 @@ var shapeSpecs = map[string]ShapeSpecs{
 	"VM.DenseIO1.16":   {PartNumber: "B88316", Mem: 120, Cpus: 16, NtwPerf: "4.8 Gbps"},
 	"VM.DenseIO2.16":   {PartNumber: "B88516", Mem: 240, Cpus: 16, NtwPerf: "16.4 Gbps"},
 	"VM.DenseIO2.24":   {PartNumber: "B88516", Mem: 320, Cpus: 24, NtwPerf: "24.6 Gbps"},
+	// bare metal shapes
+	"BM.Standard2.52":   {PartNumber: "B88514", Mem: 768, Cpus: 52, NtwPerf: "2x25 Gbps"},
+	"BM.Standard.E2.64": {PartNumber: "B90425", Mem: 512, Cpus: 64, NtwPerf: "2x25 Gbps"},
+	"BM.DenseIO2.52":    {PartNumber: "B88516", Mem: 768, Cpus: 52, NtwPerf: "2x25 Gbps"},
 }
 
 // NewOracleInfoer creates a new instance of the Oracle infoer.
@@ -110,13 +120,14 @@ func NewOracleInfoer(config Config, logger cloudinfo.Logger) (*Infoer, error) {
 
 	provider, _ := common.ComposingConfigurationProvider(providers)
 
-	oci, err := client.NewOCI(provider)
+	oci, err := client.NewOCI(provider, config.UserAgent)
 	if err != nil {
 		return nil, err
 	}
 
 	return &Infoer{
 		client:     oci,
+		userAgent:  config.UserAgent,
 		shapeSpecs: shapeSpecs,
 		log:        logger,
 	}, nil
@@ -186,9 +197,11 @@ func (i *Infoer) GetVirtualMachines(region string) ([]types.VMInfo, error) {
 			OnDemandPrice: price,
 			NtwPerf:       s.NtwPerf,
 			NtwPerfCat:    ntwPerfCat,
+			NtwPerfGbps:   cloudinfo.NetworkPerfGbps(s.NtwPerf),
 			Cpus:          s.Cpus,
 			Mem:           s.Mem,
 			Zones:         zones,
+			BareMetal:     strings.HasPrefix(shape, "BM."),
 			Attributes:    cloudinfo.Attributes(fmt.Sprint(s.Cpus), fmt.Sprint(s.Mem), ntwPerfCat, types.CategoryMemory),
 		})
 	}
@@ -241,9 +254,11 @@ func (i *Infoer) GetProducts(vms []types.VMInfo, service, regionId string) ([]ty
 			OnDemandPrice: price,
 			NtwPerf:       s.NtwPerf,
 			NtwPerfCat:    ntwPerfCat,
+			NtwPerfGbps:   cloudinfo.NetworkPerfGbps(s.NtwPerf),
 			Cpus:          s.Cpus,
 			Mem:           s.Mem,
 			Zones:         zones,
+			BareMetal:     strings.HasPrefix(shape, "BM."),
 			Attributes:    cloudinfo.Attributes(fmt.Sprint(s.Cpus), fmt.Sprint(s.Mem), ntwPerfCat, types.CategoryMemory),
 		})
 	}
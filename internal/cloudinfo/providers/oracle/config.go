@@ -24,4 +24,6 @@ type Config struct {
 
 	ConfigFilePath string
 	Profile        string
+
+	UserAgent string
 }
@@ -47,6 +47,9 @@ type ITRAResponse struct {
 
 // GetCloudInfoFromITRA gets product information from ITRA api by part number
 func (i *Infoer) GetCloudInfoFromITRA(partNumber string) (info ITRACloudInfo, err error) {
+	i.cacheMu.Lock()
+	defer i.cacheMu.Unlock()
+
 	if i.cloudInfoCache == nil {
 		i.cloudInfoCache = make(map[string]ITRACloudInfo)
 	}
@@ -59,7 +62,15 @@ func (i *Infoer) GetCloudInfoFromITRA(partNumber string) (info ITRACloudInfo, er
 	i.log.Debug("getting product info", map[string]interface{}{"PN": partNumber})
 
 	url := fmt.Sprintf("https://itra.oraclecloud.com/itas/.anon/myservices/api/v1/products?partNumber=%s", partNumber)
-	resp, err := http.Get(url)
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return
+	}
+	if i.userAgent != "" {
+		req.Header.Set("User-Agent", i.userAgent)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
 		return
 	}
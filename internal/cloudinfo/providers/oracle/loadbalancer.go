@@ -0,0 +1,48 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oracle
+
+import (
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+const (
+	// loadBalancerBasePartNumber the part number of the flexible load balancer instances, priced per hour
+	loadBalancerBasePartNumber = "B93030"
+	// loadBalancerBandwidthPartNumber the part number of the flexible load balancer bandwidth, priced per Mbps per hour
+	loadBalancerBandwidthPartNumber = "B93031"
+)
+
+// GetLoadBalancerPrices retrieves the prices of the flexible load balancer shape, the prices are the same in all regions
+// the capacity unit of the flexible shape is a Mbps of bandwidth
+func (i *Infoer) GetLoadBalancerPrices(region string) ([]types.LoadBalancerPrice, error) {
+	base, err := i.GetCloudInfoFromITRA(loadBalancerBasePartNumber)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to retrieve load balancer base price")
+	}
+
+	bandwidth, err := i.GetCloudInfoFromITRA(loadBalancerBandwidthPartNumber)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to retrieve load balancer bandwidth price")
+	}
+
+	return []types.LoadBalancerPrice{{
+		Type:                 "flexible",
+		PricePerHour:         base.GetPrice("PAY_AS_YOU_GO"),
+		PricePerCapacityUnit: bandwidth.GetPrice("PAY_AS_YOU_GO"),
+	}}, nil
+}
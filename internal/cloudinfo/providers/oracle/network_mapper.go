@@ -26,7 +26,7 @@ var (
 		types.NtwLow:    {"0.6 Gbps", "0.7 Gbps"},
 		types.NtwMedium: {"1 Gbps", "1.2 Gbps", "1.4 Gbps", "2 Gbps", "2.4 Gbps"},
 		types.NtwHight:  {"4.1 Gbps", "4.8 Gbps", "8.2 Gbps"},
-		types.NtwExtra:  {"16.4 Gbps", "24.6 Gbps"},
+		types.NtwExtra:  {"16.4 Gbps", "24.6 Gbps", "2x25 Gbps"},
 	}
 )
 
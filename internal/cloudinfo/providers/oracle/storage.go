@@ -0,0 +1,68 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oracle
+
+import (
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+const (
+	// blockVolumeStoragePartNumber the part number of the block volume capacity, priced per GB per month
+	blockVolumeStoragePartNumber = "B91961"
+	// blockVolumePerformancePartNumber the part number of the block volume performance units, priced per VPU per GB per month
+	blockVolumePerformancePartNumber = "B91962"
+)
+
+// volumePerformanceLevels the volume performance units per GB of the block volume performance levels
+var volumePerformanceLevels = []struct {
+	name string
+	vpus float64
+}{
+	{name: "balanced", vpus: 10},
+	{name: "higher-performance", vpus: 20},
+	{name: "lower-cost", vpus: 0},
+	{name: "ultra-high-performance", vpus: 30},
+}
+
+// GetStoragePrices retrieves the prices of the block volume performance levels, the prices are the same in all regions
+func (i *Infoer) GetStoragePrices(region string) ([]types.StoragePrice, error) {
+	storage, err := i.GetCloudInfoFromITRA(blockVolumeStoragePartNumber)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to retrieve block volume storage price")
+	}
+
+	performance, err := i.GetCloudInfoFromITRA(blockVolumePerformancePartNumber)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to retrieve block volume performance price")
+	}
+
+	return blockVolumePrices(storage.GetPrice("PAY_AS_YOU_GO"), performance.GetPrice("PAY_AS_YOU_GO")), nil
+}
+
+// blockVolumePrices returns the prices of the block volume performance levels
+// the volume performance units are provisioned per GB, so they are included in the capacity price
+func blockVolumePrices(storagePrice, performanceUnitPrice float64) []types.StoragePrice {
+	prices := make([]types.StoragePrice, 0, len(volumePerformanceLevels))
+	for _, level := range volumePerformanceLevels {
+		prices = append(prices, types.StoragePrice{
+			Type:       level.name,
+			PricePerGb: storagePrice + level.vpus*performanceUnitPrice,
+		})
+	}
+
+	return prices
+}
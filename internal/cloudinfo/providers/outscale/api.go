@@ -0,0 +1,108 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package outscale
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/restapi"
+)
+
+const (
+	// apiEndpoint is the endpoint of the Outscale API (OAPI) of a region
+	apiEndpoint = "https://api.%s.outscale.com/api/v1"
+)
+
+// vmType is an Outscale VM type
+type vmType struct {
+	VmTypeName    string  `json:"VmTypeName"`
+	VcoreCount    int     `json:"VcoreCount"`
+	MemorySize    float64 `json:"MemorySize"`
+	BsuOptimized  bool    `json:"BsuOptimized"`
+	MaxPrivateIps int     `json:"MaxPrivateIps"`
+}
+
+// catalogEntry is a priced item of the public catalog of a region
+type catalogEntry struct {
+	Category      string  `json:"Category"`
+	Operation     string  `json:"Operation"`
+	Service       string  `json:"Service"`
+	SubregionName string  `json:"SubregionName"`
+	Type          string  `json:"Type"`
+	UnitPrice     float64 `json:"UnitPrice"`
+}
+
+// apiClient calls the public (unauthenticated) operations of the Outscale API
+type apiClient struct {
+	api         *restapi.Client
+	apiEndpoint string
+}
+
+func newAPIClient(userAgent string) *apiClient {
+	return &apiClient{
+		api:         restapi.NewClient("outscale", userAgent),
+		apiEndpoint: apiEndpoint,
+	}
+}
+
+// call invokes the operation of the Outscale API in the region and decodes the response into v
+func (c *apiClient) call(ctx context.Context, region, operation string, v interface{}) error {
+	target := fmt.Sprintf(c.apiEndpoint, region) + "/" + operation
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target, bytes.NewBufferString("{}"))
+	if err != nil {
+		return errors.WrapIfWithDetails(err, "failed to create request", "operation", operation)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	if err := c.api.Do(req, v); err != nil {
+		return errors.WithDetails(err, "operation", operation, "region", region)
+	}
+
+	return nil
+}
+
+// readVmTypes retrieves the VM types of the region
+func (c *apiClient) readVmTypes(ctx context.Context, region string) ([]vmType, error) {
+	var resp struct {
+		VmTypes []vmType `json:"VmTypes"`
+	}
+
+	if err := c.call(ctx, region, "ReadVmTypes", &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.VmTypes, nil
+}
+
+// readPublicCatalog retrieves the price list of the region
+func (c *apiClient) readPublicCatalog(ctx context.Context, region string) ([]catalogEntry, error) {
+	var resp struct {
+		Catalog struct {
+			Entries []catalogEntry `json:"Entries"`
+		} `json:"Catalog"`
+	}
+
+	if err := c.call(ctx, region, "ReadPublicCatalog", &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Catalog.Entries, nil
+}
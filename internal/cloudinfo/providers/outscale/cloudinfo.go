@@ -0,0 +1,338 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package outscale
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"emperror.dev/errors"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+	"github.com/banzaicloud/cloudinfo/internal/platform/log"
+)
+
+const (
+	svcCompute = "compute"
+
+	// fcuEndpoint is the endpoint of the EC2 compatible Outscale API (FCU) of a region
+	fcuEndpoint = "https://fcu.%s.outscale.com"
+)
+
+// regionNames are the descriptions of the Outscale regions
+var regionNames = map[string]string{
+	"eu-west-2":           "Europe (Paris)",
+	"cloudgouv-eu-west-1": "Europe (Paris, SecNumCloud)",
+	"us-east-2":           "US East (New Jersey)",
+	"us-west-1":           "US West (California)",
+	"ap-northeast-1":      "Asia Pacific (Tokyo)",
+}
+
+// regionCurrencies are the currencies of the public catalog of the Outscale regions, the prices are in EUR elsewhere
+var regionCurrencies = map[string]string{
+	"us-east-2":      "USD",
+	"us-west-1":      "USD",
+	"ap-northeast-1": "JPY",
+}
+
+// tinaTypeRe matches the custom VM types, eg.: tinav5.c4r8p1 (generation 5, 4 cores, 8 GiB memory, performance 1)
+var tinaTypeRe = regexp.MustCompile(`^tinav(\d+)\.c(\d+)r(\d+)p(\d+)$`)
+
+// OutscaleInfoer encapsulates the data and operations needed to access external Outscale resources.
+type OutscaleInfoer struct {
+	ec2Describer func(region string) Ec2Describer
+	api          *apiClient
+	region       string
+
+	logger cloudinfo.Logger
+}
+
+// Ec2Describer is the subset of the EC2 compatible operations used to describe the Outscale regions
+type Ec2Describer interface {
+	DescribeRegions(input *ec2.DescribeRegionsInput) (*ec2.DescribeRegionsOutput, error)
+	DescribeAvailabilityZones(input *ec2.DescribeAvailabilityZonesInput) (*ec2.DescribeAvailabilityZonesOutput, error)
+}
+
+// NewOutscaleInfoer creates a new instance of the Outscale infoer.
+func NewOutscaleInfoer(config Config, logger cloudinfo.Logger) (*OutscaleInfoer, error) {
+	if config.AccessKey == "" || config.SecretKey == "" {
+		return nil, errors.New("outscale access key and secret key are required")
+	}
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials(config.AccessKey, config.SecretKey, ""),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "creating outscale session")
+	}
+
+	if config.UserAgent != "" {
+		sess.Handlers.Build.PushBack(request.MakeAddToUserAgentFreeFormHandler(config.UserAgent))
+	}
+
+	return &OutscaleInfoer{
+		ec2Describer: func(region string) Ec2Describer {
+			return ec2.New(sess, aws.NewConfig().WithRegion(region).WithEndpoint(fmt.Sprintf(fcuEndpoint, region)))
+		},
+		api:    newAPIClient(config.UserAgent),
+		region: config.Region,
+		logger: logger,
+	}, nil
+}
+
+// Initialize retrieves the prices of the VM types in all regions
+func (i *OutscaleInfoer) Initialize() (map[string]map[string]types.Price, error) {
+	i.logger.Debug("initializing price info")
+
+	regions, err := i.GetRegions(svcCompute)
+	if err != nil {
+		return nil, err
+	}
+
+	allPrices := make(map[string]map[string]types.Price, len(regions))
+	for region := range regions {
+		vms, err := i.GetVirtualMachines(region)
+		if err != nil {
+			return nil, err
+		}
+
+		allPrices[region] = make(map[string]types.Price, len(vms))
+		for _, vm := range vms {
+			allPrices[region][vm.Type] = types.Price{
+				OnDemandPrice: vm.OnDemandPrice,
+			}
+		}
+	}
+
+	i.logger.Debug("finished initializing price info")
+	return allPrices, nil
+}
+
+// GetVirtualMachines retrieves the VM types of the region priced from its public catalog
+func (i *OutscaleInfoer) GetVirtualMachines(region string) ([]types.VMInfo, error) {
+	logger := log.WithFields(i.logger, map[string]interface{}{"region": region})
+	logger.Debug("getting product info")
+
+	ctx := context.Background()
+
+	vmTypes, err := i.api.readVmTypes(ctx, region)
+	if err != nil {
+		return nil, errors.WrapIfWithDetails(err, "failed to read vm types", "region", region)
+	}
+
+	entries, err := i.api.readPublicCatalog(ctx, region)
+	if err != nil {
+		return nil, errors.WrapIfWithDetails(err, "failed to read public catalog", "region", region)
+	}
+
+	prices := make(map[string]float64, len(entries))
+	for _, entry := range entries {
+		if entry.Category == "compute" {
+			prices[entry.Type] = entry.UnitPrice
+		}
+	}
+
+	zones, err := i.GetZones(region)
+	if err != nil {
+		return nil, err
+	}
+
+	currency, ok := regionCurrencies[region]
+	if !ok {
+		currency = "EUR"
+	}
+
+	var virtualMachines []types.VMInfo
+	for _, vmType := range vmTypes {
+		price, ok := vmPrice(vmType.VmTypeName, prices)
+		if !ok {
+			logger.Debug("no price found for vm type", map[string]interface{}{"type": vmType.VmTypeName})
+			continue
+		}
+
+		cpus := float64(vmType.VcoreCount)
+		category := getCategory(vmType.VmTypeName, cpus, vmType.MemorySize)
+
+		virtualMachines = append(virtualMachines, types.VMInfo{
+			Category:      category,
+			Type:          vmType.VmTypeName,
+			OnDemandPrice: price,
+			Currency:      currency,
+			Cpus:          cpus,
+			Mem:           vmType.MemorySize,
+			NtwPerf:       "N/A",
+			NtwPerfCat:    types.NtwMedium,
+			Zones:         zones,
+			Burst:         strings.HasPrefix(vmType.VmTypeName, "t2."),
+			Attributes:    cloudinfo.Attributes(fmt.Sprint(cpus), fmt.Sprint(vmType.MemorySize), types.NtwMedium, category),
+		})
+	}
+
+	logger.Debug("found vm types", map[string]interface{}{"numberOfTypes": len(virtualMachines)})
+	return virtualMachines, nil
+}
+
+// GetProducts retrieves the available VM types of the service in the region
+func (i *OutscaleInfoer) GetProducts(vms []types.VMInfo, service, regionId string) ([]types.VMInfo, error) {
+	switch service {
+	case svcCompute:
+		if len(vms) > 0 {
+			return vms, nil
+		}
+
+		return i.GetVirtualMachines(regionId)
+	default:
+		return nil, errors.NewWithDetails("invalid service", "service", service)
+	}
+}
+
+// GetZones returns the available subregions of the region
+func (i *OutscaleInfoer) GetZones(region string) ([]string, error) {
+	logger := log.WithFields(i.logger, map[string]interface{}{"region": region})
+	logger.Debug("getting zones")
+
+	azs, err := i.ec2Describer(region).DescribeAvailabilityZones(&ec2.DescribeAvailabilityZonesInput{})
+	if err != nil {
+		return nil, errors.WrapIfWithDetails(err, "failed to describe availability zones", "region", region)
+	}
+
+	var zones []string
+	for _, az := range azs.AvailabilityZones {
+		if aws.StringValue(az.State) == ec2.AvailabilityZoneStateAvailable {
+			zones = append(zones, aws.StringValue(az.ZoneName))
+		}
+	}
+
+	logger.Debug("found zones", map[string]interface{}{"numberOfZones": len(zones)})
+	return zones, nil
+}
+
+// GetRegions returns the regions available to the account
+func (i *OutscaleInfoer) GetRegions(service string) (map[string]string, error) {
+	if service != svcCompute {
+		return nil, errors.NewWithDetails("invalid service", "service", service)
+	}
+
+	output, err := i.ec2Describer(i.region).DescribeRegions(&ec2.DescribeRegionsInput{})
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to describe regions")
+	}
+
+	regions := make(map[string]string, len(output.Regions))
+	for _, region := range output.Regions {
+		id := aws.StringValue(region.RegionName)
+		name, ok := regionNames[id]
+		if !ok {
+			name = id
+		}
+		regions[id] = name
+	}
+
+	return regions, nil
+}
+
+// HasShortLivedPriceInfo signals that the Outscale prices don't change frequently
+func (*OutscaleInfoer) HasShortLivedPriceInfo() bool {
+	return false
+}
+
+// GetCurrentPrices is not supported, Outscale has no spot instances
+func (*OutscaleInfoer) GetCurrentPrices(region string) (map[string]types.Price, error) {
+	return nil, errors.New("GetCurrentPrices - not yet implemented")
+}
+
+// HasImages signals that images are not scraped for Outscale
+func (*OutscaleInfoer) HasImages() bool {
+	return false
+}
+
+// GetServiceImages is not supported for Outscale
+func (*OutscaleInfoer) GetServiceImages(service, region string) ([]types.Image, error) {
+	return nil, errors.New("GetServiceImages - not yet implemented")
+}
+
+// GetVersions returns no versions, Outscale has no managed kubernetes service scraped
+func (*OutscaleInfoer) GetVersions(service, region string) ([]types.LocationVersion, error) {
+	return []types.LocationVersion{}, nil
+}
+
+// GetServiceProducts is not supported for Outscale
+func (*OutscaleInfoer) GetServiceProducts(region, service string) ([]types.ProductDetails, error) {
+	return nil, errors.New("GetServiceProducts - not yet implemented")
+}
+
+// vmPrice returns the hourly price of the VM type from the catalog prices, the custom (tina) types
+// are priced per core of their generation and performance and per GiB of memory
+func vmPrice(name string, prices map[string]float64) (float64, bool) {
+	if price, ok := prices["BoxUsage:"+name]; ok {
+		return price, true
+	}
+
+	match := tinaTypeRe.FindStringSubmatch(name)
+	if match == nil {
+		return 0, false
+	}
+
+	corePrice, ok := prices[fmt.Sprintf("CustomCore:v%s-p%s", match[1], match[4])]
+	if !ok {
+		return 0, false
+	}
+
+	ramPrice, ok := prices["CustomRam"]
+	if !ok {
+		return 0, false
+	}
+
+	cores, _ := strconv.ParseFloat(match[2], 64)
+	ram, _ := strconv.ParseFloat(match[3], 64)
+
+	return cores*corePrice + ram*ramPrice, true
+}
+
+// getCategory maps the VM type to a category, by family for the EC2 compatible types and by memory per core for the tina types
+func getCategory(name string, cpus, mem float64) string {
+	if strings.HasPrefix(name, "tina") {
+		switch ratio := mem / cpus; {
+		case ratio <= 2:
+			return types.CategoryCompute
+		case ratio >= 8:
+			return types.CategoryMemory
+		default:
+			return types.CategoryGeneral
+		}
+	}
+
+	switch {
+	case strings.HasPrefix(name, "c"):
+		return types.CategoryCompute
+	case strings.HasPrefix(name, "r"), strings.HasPrefix(name, "x"):
+		return types.CategoryMemory
+	case strings.HasPrefix(name, "i"), strings.HasPrefix(name, "d"):
+		return types.CategoryStorage
+	case strings.HasPrefix(name, "p"), strings.HasPrefix(name, "g"):
+		return types.CategoryGpu
+	default:
+		return types.CategoryGeneral
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package outscale
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/cloudinfoadapter"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+type testDescriber struct{}
+
+func (testDescriber) DescribeRegions(*ec2.DescribeRegionsInput) (*ec2.DescribeRegionsOutput, error) {
+	return &ec2.DescribeRegionsOutput{
+		Regions: []*ec2.Region{
+			{RegionName: aws.String("eu-west-2")},
+			{RegionName: aws.String("us-east-2")},
+		},
+	}, nil
+}
+
+func (testDescriber) DescribeAvailabilityZones(*ec2.DescribeAvailabilityZonesInput) (*ec2.DescribeAvailabilityZonesOutput, error) {
+	return &ec2.DescribeAvailabilityZonesOutput{
+		AvailabilityZones: []*ec2.AvailabilityZone{
+			{ZoneName: aws.String("eu-west-2a"), State: aws.String(ec2.AvailabilityZoneStateAvailable)},
+			{ZoneName: aws.String("eu-west-2b"), State: aws.String(ec2.AvailabilityZoneStateUnavailable)},
+		},
+	}, nil
+}
+
+func newTestServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+
+		switch r.URL.Path {
+		case "/eu-west-2/ReadVmTypes":
+			fmt.Fprint(w, `{"VmTypes": [
+				{"VmTypeName": "c4.large", "VcoreCount": 2, "MemorySize": 3.75},
+				{"VmTypeName": "tinav5.c4r32p1", "VcoreCount": 4, "MemorySize": 32},
+				{"VmTypeName": "unknown.large", "VcoreCount": 2, "MemorySize": 8}]}`)
+		case "/eu-west-2/ReadPublicCatalog":
+			fmt.Fprint(w, `{"Catalog": {"Entries": [
+				{"Category": "compute", "Type": "BoxUsage:c4.large", "UnitPrice": 0.12},
+				{"Category": "compute", "Type": "CustomCore:v5-p1", "UnitPrice": 0.25},
+				{"Category": "compute", "Type": "CustomRam", "UnitPrice": 0.0625},
+				{"Category": "storage", "Type": "BSU:VolumeUsage:gp2", "UnitPrice": 0.11}]}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func newTestInfoer(t *testing.T, server *httptest.Server) *OutscaleInfoer {
+	infoer, err := NewOutscaleInfoer(Config{AccessKey: "ak", SecretKey: "sk", Region: "eu-west-2"}, cloudinfoadapter.NewNoopLogger())
+	assert.NoError(t, err)
+	infoer.ec2Describer = func(string) Ec2Describer { return testDescriber{} }
+	infoer.api.apiEndpoint = server.URL + "/%s"
+
+	return infoer
+}
+
+func TestNewOutscaleInfoer(t *testing.T) {
+	_, err := NewOutscaleInfoer(Config{AccessKey: "ak"}, cloudinfoadapter.NewNoopLogger())
+	assert.Error(t, err)
+}
+
+func TestOutscaleInfoer_GetRegions(t *testing.T) {
+	server := newTestServer(t)
+	defer server.Close()
+
+	regions, err := newTestInfoer(t, server).GetRegions("compute")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"eu-west-2": "Europe (Paris)", "us-east-2": "US East (New Jersey)"}, regions)
+}
+
+func TestOutscaleInfoer_GetVirtualMachines(t *testing.T) {
+	server := newTestServer(t)
+	defer server.Close()
+
+	vms, err := newTestInfoer(t, server).GetVirtualMachines("eu-west-2")
+	assert.NoError(t, err)
+	assert.Equal(t, []types.VMInfo{
+		{
+			Category:      types.CategoryCompute,
+			Type:          "c4.large",
+			OnDemandPrice: 0.12,
+			Currency:      "EUR",
+			Cpus:          2,
+			Mem:           3.75,
+			NtwPerf:       "N/A",
+			NtwPerfCat:    types.NtwMedium,
+			Zones:         []string{"eu-west-2a"},
+			Attributes:    map[string]string{"cpu": "2", "memory": "3.75", "networkPerfCategory": types.NtwMedium, "instanceTypeCategory": types.CategoryCompute},
+		},
+		{
+			Category:      types.CategoryMemory,
+			Type:          "tinav5.c4r32p1",
+			OnDemandPrice: 4*0.25 + 32*0.0625,
+			Currency:      "EUR",
+			Cpus:          4,
+			Mem:           32,
+			NtwPerf:       "N/A",
+			NtwPerfCat:    types.NtwMedium,
+			Zones:         []string{"eu-west-2a"},
+			Attributes:    map[string]string{"cpu": "4", "memory": "32", "networkPerfCategory": types.NtwMedium, "instanceTypeCategory": types.CategoryMemory},
+		},
+	}, vms)
+}
@@ -0,0 +1,197 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ovh
+
+import (
+	"context"
+	"crypto/sha1" // nolint: gosec
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/restapi"
+)
+
+// flavor is an instance flavor of a public cloud project
+type flavor struct {
+	ID                string    `json:"id"`
+	Name              string    `json:"name"`
+	Region            string    `json:"region"`
+	RAM               int       `json:"ram"`
+	Disk              int       `json:"disk"`
+	VCPUs             int       `json:"vcpus"`
+	Type              string    `json:"type"`
+	OSType            string    `json:"osType"`
+	OutboundBandwidth int       `json:"outboundBandwidth"`
+	Available         bool      `json:"available"`
+	PlanCodes         planCodes `json:"planCodes"`
+}
+
+// planCodes are the catalog plan codes of a flavor
+type planCodes struct {
+	Hourly  string `json:"hourly"`
+	Monthly string `json:"monthly"`
+}
+
+// catalog is the (partial) public cloud catalog
+type catalog struct {
+	Locale struct {
+		CurrencyCode string `json:"currencyCode"`
+	} `json:"locale"`
+	Addons []struct {
+		PlanCode string `json:"planCode"`
+		Pricings []struct {
+			Capacities   []string `json:"capacities"`
+			IntervalUnit string   `json:"intervalUnit"`
+			Price        int64    `json:"price"`
+		} `json:"pricings"`
+		Blobs struct {
+			Technical struct {
+				GPU struct {
+					Number int `json:"number"`
+				} `json:"gpu"`
+			} `json:"technical"`
+		} `json:"blobs"`
+	} `json:"addons"`
+}
+
+// catalogPrice is the price and the technical details of a catalog plan
+type catalogPrice struct {
+	price float64
+	gpus  int
+}
+
+// catalogPrices are the hourly prices of the catalog plans by plan code
+type catalogPrices struct {
+	currency string
+	plans    map[string]catalogPrice
+}
+
+const (
+	// catalogPriceUnit is the unit of the catalog prices (10^-8 of the currency)
+	catalogPriceUnit = 1e8
+)
+
+// client is a minimal client of the OVH API
+type client struct {
+	api    *restapi.Client
+	config Config
+
+	// timeDelta is the difference of the api and the local time, signatures are checked against the api time
+	timeDelta   *time.Duration
+	timeDeltaMu sync.Mutex
+}
+
+func newClient(config Config) *client {
+	return &client{
+		api:    restapi.NewClient("ovh", config.UserAgent),
+		config: config,
+	}
+}
+
+// get retrieves a resource of the api and decodes it into the given value, authenticated requests are signed
+func (c *client) get(ctx context.Context, path string, query url.Values, authenticated bool, v interface{}) error {
+	target := fmt.Sprintf("%s%s", c.config.Endpoint, path)
+	if len(query) > 0 {
+		target = fmt.Sprintf("%s?%s", target, query.Encode())
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return errors.WrapIf(err, "failed to create request")
+	}
+
+	if authenticated {
+		if err := c.sign(ctx, req, target); err != nil {
+			return err
+		}
+	}
+
+	return c.api.Do(req, v)
+}
+
+// sign adds the authentication headers to the request
+func (c *client) sign(ctx context.Context, req *http.Request, target string) error {
+	c.timeDeltaMu.Lock()
+	defer c.timeDeltaMu.Unlock()
+
+	if c.timeDelta == nil {
+		var serverTime int64
+		if err := c.get(ctx, "/auth/time", nil, false, &serverTime); err != nil {
+			return errors.WrapIf(err, "failed to retrieve the ovh api time")
+		}
+
+		delta := time.Until(time.Unix(serverTime, 0))
+		c.timeDelta = &delta
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Add(*c.timeDelta).Unix(), 10)
+	signature := sha1.Sum([]byte(fmt.Sprintf("%s+%s+%s+%s++%s", // nolint: gosec
+		c.config.ApplicationSecret, c.config.ConsumerKey, req.Method, target, timestamp)))
+
+	req.Header.Set("X-Ovh-Application", c.config.ApplicationKey)
+	req.Header.Set("X-Ovh-Consumer", c.config.ConsumerKey)
+	req.Header.Set("X-Ovh-Timestamp", timestamp)
+	req.Header.Set("X-Ovh-Signature", fmt.Sprintf("$1$%x", signature))
+
+	return nil
+}
+
+// listRegions lists the regions of the public cloud project
+func (c *client) listRegions(ctx context.Context) ([]string, error) {
+	var regions []string
+	err := c.get(ctx, fmt.Sprintf("/cloud/project/%s/region", url.PathEscape(c.config.ProjectID)), nil, true, &regions)
+
+	return regions, err
+}
+
+// listFlavors lists the flavors of the public cloud project in the region
+func (c *client) listFlavors(ctx context.Context, region string) ([]flavor, error) {
+	var flavors []flavor
+	err := c.get(ctx, fmt.Sprintf("/cloud/project/%s/flavor", url.PathEscape(c.config.ProjectID)),
+		url.Values{"region": {region}}, true, &flavors)
+
+	return flavors, err
+}
+
+// getCatalogPrices retrieves the hourly prices of the public cloud catalog by plan code
+func (c *client) getCatalogPrices(ctx context.Context) (catalogPrices, error) {
+	var cat catalog
+	if err := c.get(ctx, "/order/catalog/public/cloud", url.Values{"ovhSubsidiary": {c.config.Subsidiary}}, false, &cat); err != nil {
+		return catalogPrices{}, err
+	}
+
+	prices := make(map[string]catalogPrice, len(cat.Addons))
+	for _, addon := range cat.Addons {
+		for _, pricing := range addon.Pricings {
+			if pricing.IntervalUnit != "hour" {
+				continue
+			}
+
+			prices[addon.PlanCode] = catalogPrice{
+				price: float64(pricing.Price) / catalogPriceUnit,
+				gpus:  addon.Blobs.Technical.GPU.Number,
+			}
+			break
+		}
+	}
+
+	return catalogPrices{currency: cat.Locale.CurrencyCode, plans: prices}, nil
+}
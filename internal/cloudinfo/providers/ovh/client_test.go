@@ -0,0 +1,166 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ovh
+
+import (
+	"context"
+	"crypto/sha1" // nolint: gosec
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/cloudinfoadapter"
+)
+
+const testCatalog = `{"locale": {"currencyCode": "EUR", "subsidiary": "FR"}, "addons": [
+	{"planCode": "b2-7.consumption", "pricings": [
+		{"capacities": ["consumption"], "intervalUnit": "hour", "price": 6810000}]},
+	{"planCode": "t1-45.consumption", "pricings": [
+		{"capacities": ["consumption"], "intervalUnit": "month", "price": 100000000000},
+		{"capacities": ["consumption"], "intervalUnit": "hour", "price": 170000000}],
+		"blobs": {"technical": {"gpu": {"number": 1}}}},
+	{"planCode": "b2-7.monthly", "pricings": [
+		{"capacities": ["renew"], "intervalUnit": "month", "price": 2200000000}]}]}`
+
+const testFlavors = `[
+	{"name": "b2-7", "region": "GRA11", "ram": 7000, "vcpus": 2, "osType": "linux", "available": true,
+		"outboundBandwidth": 250, "planCodes": {"hourly": "b2-7.consumption"}},
+	{"name": "t1-45", "region": "GRA11", "ram": 45000, "vcpus": 8, "osType": "linux", "available": true,
+		"outboundBandwidth": 2000, "planCodes": {"hourly": "t1-45.consumption"}},
+	{"name": "win-b2-7", "region": "GRA11", "ram": 7000, "vcpus": 2, "osType": "windows", "available": true,
+		"planCodes": {"hourly": "win-b2-7.consumption"}}]`
+
+func TestClient_getCatalogPrices(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/order/catalog/public/cloud", r.URL.Path)
+		assert.Equal(t, "FR", r.URL.Query().Get("ovhSubsidiary"))
+		assert.Empty(t, r.Header.Get("X-Ovh-Signature"), "the catalog is public, the request should not be signed")
+
+		fmt.Fprint(w, testCatalog)
+	}))
+	defer server.Close()
+
+	c := newClient(Config{Endpoint: server.URL, Subsidiary: "FR"})
+
+	prices, err := c.getCatalogPrices(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "EUR", prices.currency)
+	assert.Equal(t, map[string]catalogPrice{
+		"b2-7.consumption":  {price: 0.0681},
+		"t1-45.consumption": {price: 1.7, gpus: 1},
+	}, prices.plans, "only the hourly prices should be retained")
+}
+
+func TestClient_sign(t *testing.T) {
+	serverTime := time.Now().Add(-time.Hour).Unix()
+
+	var signed *http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/auth/time":
+			fmt.Fprint(w, serverTime)
+		case "/cloud/project/project-id/region":
+			signed = r
+			fmt.Fprint(w, `["GRA11", "BHS5"]`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c := newClient(Config{
+		Endpoint:          server.URL,
+		ApplicationKey:    "app-key",
+		ApplicationSecret: "app-secret",
+		ConsumerKey:       "consumer-key",
+		ProjectID:         "project-id",
+	})
+
+	regions, err := c.listRegions(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"GRA11", "BHS5"}, regions)
+
+	if assert.NotNil(t, signed, "the region listing should be requested") {
+		timestamp := signed.Header.Get("X-Ovh-Timestamp")
+		signature := sha1.Sum([]byte(fmt.Sprintf("app-secret+consumer-key+GET+%s/cloud/project/project-id/region++%s", // nolint: gosec
+			server.URL, timestamp)))
+
+		assert.Equal(t, "app-key", signed.Header.Get("X-Ovh-Application"))
+		assert.Equal(t, "consumer-key", signed.Header.Get("X-Ovh-Consumer"))
+		assert.Equal(t, fmt.Sprintf("$1$%x", signature), signed.Header.Get("X-Ovh-Signature"))
+		assert.InDelta(t, serverTime, parseTimestamp(t, timestamp), 5, "the timestamp should be adjusted to the api time")
+	}
+}
+
+func parseTimestamp(t *testing.T, timestamp string) float64 {
+	var value int64
+	_, err := fmt.Sscan(timestamp, &value)
+	assert.NoError(t, err)
+
+	return float64(value)
+}
+
+func TestOVHInfoer_GetProducts(t *testing.T) {
+	catalogRequests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/auth/time":
+			fmt.Fprint(w, time.Now().Unix())
+		case "/order/catalog/public/cloud":
+			catalogRequests++
+			fmt.Fprint(w, testCatalog)
+		case "/cloud/project/project-id/region":
+			fmt.Fprint(w, `["GRA11"]`)
+		case "/cloud/project/project-id/flavor":
+			fmt.Fprint(w, testFlavors)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	infoer, err := NewOVHInfoer(Config{
+		Endpoint:          server.URL,
+		ApplicationKey:    "app-key",
+		ApplicationSecret: "app-secret",
+		ConsumerKey:       "consumer-key",
+		ProjectID:         "project-id",
+		Subsidiary:        "FR",
+	}, cloudinfoadapter.NewNoopLogger())
+	assert.NoError(t, err)
+
+	prices, err := infoer.Initialize()
+	assert.NoError(t, err)
+	assert.InDelta(t, 0.0681, prices["GRA11"]["b2-7"].OnDemandPrice, 1e-9)
+
+	vms, err := infoer.GetProducts(nil, svcCompute, "GRA11")
+	assert.NoError(t, err)
+	if assert.Len(t, vms, 2, "windows flavors should be skipped") {
+		assert.Equal(t, "b2-7", vms[0].Type)
+		assert.Equal(t, "EUR", vms[0].Currency)
+		assert.Equal(t, "t1-45", vms[1].Type)
+		assert.Equal(t, 1.0, vms[1].Gpus)
+	}
+
+	assert.Equal(t, 1, catalogRequests, "the catalog should be retrieved once per scrape cycle")
+
+	_, err = infoer.Initialize()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, catalogRequests, "the catalog should be retrieved again in the next scrape cycle")
+}
@@ -0,0 +1,257 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+	"github.com/banzaicloud/cloudinfo/internal/platform/log"
+)
+
+const svcCompute = "compute"
+
+// OVHInfoer encapsulates the data and operations needed to access external OVHcloud resources.
+type OVHInfoer struct {
+	client *client
+
+	// prices are the catalog prices of the current scrape cycle, retrieved again on initialization
+	prices   *catalogPrices
+	pricesMu sync.Mutex
+
+	logger cloudinfo.Logger
+}
+
+// NewOVHInfoer creates a new instance of the OVHcloud infoer.
+func NewOVHInfoer(config Config, logger cloudinfo.Logger) (*OVHInfoer, error) {
+	if config.ApplicationKey == "" || config.ApplicationSecret == "" || config.ConsumerKey == "" {
+		return nil, errors.New("ovh application key, application secret and consumer key are required")
+	}
+
+	if config.ProjectID == "" {
+		return nil, errors.New("ovh public cloud project id is required")
+	}
+
+	return &OVHInfoer{
+		client: newClient(config),
+		logger: logger,
+	}, nil
+}
+
+// Initialize retrieves the prices of the flavors in all regions
+func (i *OVHInfoer) Initialize() (map[string]map[string]types.Price, error) {
+	i.logger.Debug("initializing price info")
+
+	if _, err := i.catalogPrices(context.Background(), true); err != nil {
+		return nil, err
+	}
+
+	regions, err := i.client.listRegions(context.Background())
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to list regions")
+	}
+
+	allPrices := make(map[string]map[string]types.Price, len(regions))
+	for _, region := range regions {
+		vms, err := i.GetVirtualMachines(region)
+		if err != nil {
+			return nil, err
+		}
+
+		allPrices[region] = make(map[string]types.Price, len(vms))
+		for _, vm := range vms {
+			allPrices[region][vm.Type] = types.Price{
+				OnDemandPrice: vm.OnDemandPrice,
+			}
+		}
+	}
+
+	i.logger.Debug("finished initializing price info")
+	return allPrices, nil
+}
+
+// catalogPrices returns the catalog prices of the current scrape cycle
+// the catalog is only retrieved if it is not cached yet or a refresh is requested
+func (i *OVHInfoer) catalogPrices(ctx context.Context, refresh bool) (catalogPrices, error) {
+	i.pricesMu.Lock()
+	defer i.pricesMu.Unlock()
+
+	if i.prices != nil && !refresh {
+		return *i.prices, nil
+	}
+
+	prices, err := i.client.getCatalogPrices(ctx)
+	if err != nil {
+		return catalogPrices{}, errors.WrapIf(err, "failed to retrieve catalog prices")
+	}
+	i.prices = &prices
+
+	return prices, nil
+}
+
+// GetVirtualMachines retrieves the linux flavors available in the region
+func (i *OVHInfoer) GetVirtualMachines(region string) ([]types.VMInfo, error) {
+	logger := log.WithFields(i.logger, map[string]interface{}{"region": region})
+	logger.Debug("getting product info")
+
+	prices, err := i.catalogPrices(context.Background(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	flavors, err := i.client.listFlavors(context.Background(), region)
+	if err != nil {
+		return nil, errors.WrapIfWithDetails(err, "failed to list flavors", "region", region)
+	}
+
+	virtualMachines := make([]types.VMInfo, 0, len(flavors))
+	for _, f := range flavors {
+		if !f.Available || f.OSType != "linux" {
+			continue
+		}
+
+		price, ok := prices.plans[f.PlanCodes.Hourly]
+		if !ok {
+			logger.Debug("no price found for flavor", map[string]interface{}{"flavor": f.Name})
+			continue
+		}
+
+		category := getCategory(f.Name)
+		ntwPerf, ntwPerfCat := networkPerformance(f.OutboundBandwidth)
+		mem := float64(f.RAM) / 1024
+
+		virtualMachines = append(virtualMachines, types.VMInfo{
+			Category:      category,
+			Type:          f.Name,
+			OnDemandPrice: price.price,
+			Currency:      prices.currency,
+			Cpus:          float64(f.VCPUs),
+			Mem:           mem,
+			Gpus:          float64(price.gpus),
+			NtwPerf:       ntwPerf,
+			NtwPerfCat:    ntwPerfCat,
+			Zones:         []string{},
+			Burst:         strings.HasPrefix(f.Name, "d2-") || strings.HasPrefix(f.Name, "s1-"),
+			Attributes:    cloudinfo.Attributes(fmt.Sprint(f.VCPUs), fmt.Sprint(mem), ntwPerfCat, category),
+		})
+	}
+
+	logger.Debug("found flavors", map[string]interface{}{"numberOfTypes": len(virtualMachines)})
+	return virtualMachines, nil
+}
+
+// GetProducts retrieves the available virtual machines of the service in the region
+func (i *OVHInfoer) GetProducts(vms []types.VMInfo, service, regionId string) ([]types.VMInfo, error) {
+	switch service {
+	case svcCompute:
+		return i.GetVirtualMachines(regionId)
+	default:
+		return nil, errors.NewWithDetails("invalid service", "service", service)
+	}
+}
+
+// GetZones returns the availability zones of the region, OVHcloud regions have no zones
+func (*OVHInfoer) GetZones(region string) ([]string, error) {
+	return []string{}, nil
+}
+
+// GetRegions retrieves the regions of the public cloud project
+func (i *OVHInfoer) GetRegions(service string) (map[string]string, error) {
+	if service != svcCompute {
+		return nil, errors.NewWithDetails("invalid service", "service", service)
+	}
+
+	regions, err := i.client.listRegions(context.Background())
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to list regions")
+	}
+
+	regionMap := make(map[string]string, len(regions))
+	for _, region := range regions {
+		regionMap[region] = region
+	}
+
+	return regionMap, nil
+}
+
+// HasShortLivedPriceInfo signals that the OVHcloud prices don't change frequently
+func (*OVHInfoer) HasShortLivedPriceInfo() bool {
+	return false
+}
+
+// GetCurrentPrices is not supported, OVHcloud has no short lived prices
+func (*OVHInfoer) GetCurrentPrices(region string) (map[string]types.Price, error) {
+	return nil, errors.New("GetCurrentPrices - not yet implemented")
+}
+
+// HasImages signals that images are not scraped for OVHcloud
+func (*OVHInfoer) HasImages() bool {
+	return false
+}
+
+// GetServiceImages is not supported for OVHcloud
+func (*OVHInfoer) GetServiceImages(service, region string) ([]types.Image, error) {
+	return nil, errors.New("GetServiceImages - not yet implemented")
+}
+
+// GetVersions returns no versions for the supported services
+func (*OVHInfoer) GetVersions(service, region string) ([]types.LocationVersion, error) {
+	return []types.LocationVersion{}, nil
+}
+
+// GetServiceProducts is not supported for OVHcloud
+func (*OVHInfoer) GetServiceProducts(region, service string) ([]types.ProductDetails, error) {
+	return nil, errors.New("GetServiceProducts - not yet implemented")
+}
+
+// getCategory maps the flavor family (the prefix of the flavor name) to an instance type category
+func getCategory(name string) string {
+	family := strings.SplitN(name, "-", 2)[0]
+
+	switch {
+	case strings.HasPrefix(family, "c"):
+		return types.CategoryCompute
+	case strings.HasPrefix(family, "r"):
+		return types.CategoryMemory
+	case strings.HasPrefix(family, "i"):
+		return types.CategoryStorage
+	case strings.HasPrefix(family, "t"), strings.HasPrefix(family, "l4"),
+		strings.HasPrefix(family, "a10"), strings.HasPrefix(family, "h100"):
+		return types.CategoryGpu
+	default:
+		return types.CategoryGeneral
+	}
+}
+
+// networkPerformance maps the outbound bandwidth (Mbps) to the network performance and its category
+func networkPerformance(mbps int) (string, string) {
+	ntwPerf := fmt.Sprintf("%d Mbit/s", mbps)
+	switch {
+	case mbps < 1000:
+		return ntwPerf, types.NtwLow
+	case mbps < 5000:
+		return ntwPerf, types.NtwMedium
+	case mbps < 20000:
+		return ntwPerf, types.NtwHight
+	default:
+		return ntwPerf, types.NtwExtra
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ovh
+
+// Config holds the configuration of the OVHcloud provider
+type Config struct {
+	// Endpoint is the OVH API endpoint (eg.: https://eu.api.ovh.com/1.0)
+	Endpoint string
+
+	ApplicationKey    string
+	ApplicationSecret string
+	ConsumerKey       string
+
+	// ProjectID is the id of the public cloud project the flavors are listed in
+	ProjectID string
+
+	// Subsidiary is the OVH subsidiary the prices are retrieved for (eg.: FR, DE, GB)
+	Subsidiary string
+
+	UserAgent string
+}
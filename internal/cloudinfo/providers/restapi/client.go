@@ -0,0 +1,79 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package restapi holds the HTTP client shared by the providers calling the REST APIs of the clouds directly:
+// every call has a timeout, so a hanging api doesn't block the scrape, carries the configured user agent
+// and has its JSON response decoded.
+package restapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"emperror.dev/errors"
+)
+
+// RequestTimeout is the timeout of a single api call
+const RequestTimeout = 30 * time.Second
+
+// Client calls the JSON api of a provider
+type Client struct {
+	api        string
+	userAgent  string
+	httpClient *http.Client
+}
+
+// NewClient creates a client of the named api (eg.: hetzner), sending the user agent if set
+func NewClient(api, userAgent string) *Client {
+	return &Client{
+		api:        api,
+		userAgent:  userAgent,
+		httpClient: &http.Client{Timeout: RequestTimeout},
+	}
+}
+
+// Do sends the request and decodes the JSON response into the given value, the responses other than 200 OK fail
+func (c *Client) Do(req *http.Request, v interface{}) error {
+	_, err := c.DoStatus(req, http.StatusOK, v)
+
+	return err
+}
+
+// DoStatus sends the request and decodes the JSON response into the given value, the responses with other than
+// the expected status fail; the headers of the response are returned
+func (c *Client) DoStatus(req *http.Request, status int, v interface{}) (http.Header, error) {
+	req.Header.Set("Accept", "application/json")
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.WrapIfWithDetails(err, fmt.Sprintf("failed to call the %s api", c.api), "url", req.URL.Path)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != status {
+		return nil, errors.NewWithDetails(fmt.Sprintf("unexpected response from the %s api", c.api),
+			"url", req.URL.Path, "status", resp.StatusCode)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return nil, errors.WrapIfWithDetails(err, fmt.Sprintf("failed to decode %s api response", c.api), "url", req.URL.Path)
+	}
+
+	return resp.Header, nil
+}
@@ -0,0 +1,100 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package restapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_DoStatus(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		body      string
+		expected  int
+		userAgent string
+		checker   func(t *testing.T, header http.Header, value map[string]string, err error)
+	}{
+		{
+			name:      "the response is decoded",
+			status:    http.StatusOK,
+			body:      `{"name":"cx11"}`,
+			expected:  http.StatusOK,
+			userAgent: "cloudinfo",
+			checker: func(t *testing.T, header http.Header, value map[string]string, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, map[string]string{"name": "cx11"}, value)
+				assert.Equal(t, "1", header.Get("X-Total-Count"))
+			},
+		},
+		{
+			name:     "the expected status is accepted",
+			status:   http.StatusCreated,
+			body:     `{"name":"token"}`,
+			expected: http.StatusCreated,
+			checker: func(t *testing.T, header http.Header, value map[string]string, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, "token", value["name"])
+			},
+		},
+		{
+			name:     "an unexpected status fails",
+			status:   http.StatusUnauthorized,
+			body:     `{"error":"unauthorized"}`,
+			expected: http.StatusOK,
+			checker: func(t *testing.T, header http.Header, value map[string]string, err error) {
+				assert.EqualError(t, err, "unexpected response from the test api")
+			},
+		},
+		{
+			name:     "an invalid response fails",
+			status:   http.StatusOK,
+			body:     `[`,
+			expected: http.StatusOK,
+			checker: func(t *testing.T, header http.Header, value map[string]string, err error) {
+				assert.Error(t, err)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var userAgent string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "application/json", r.Header.Get("Accept"))
+				userAgent = r.Header.Get("User-Agent")
+				w.Header().Set("X-Total-Count", "1")
+				w.WriteHeader(test.status)
+				_, _ = w.Write([]byte(test.body))
+			}))
+			defer server.Close()
+
+			req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL+"/servers", nil)
+			require.NoError(t, err)
+
+			var value map[string]string
+			header, err := NewClient("test", test.userAgent).DoStatus(req, test.expected, &value)
+			test.checker(t, header, value, err)
+			if test.userAgent != "" {
+				assert.Equal(t, test.userAgent, userAgent)
+			}
+		})
+	}
+}
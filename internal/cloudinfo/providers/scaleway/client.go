@@ -0,0 +1,122 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scaleway
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/restapi"
+)
+
+const (
+	apiURL = "https://api.scaleway.com"
+)
+
+// serverType is an instance type of the Scaleway Instance API
+type serverType struct {
+	HourlyPrice  float64 `json:"hourly_price"`
+	MonthlyPrice float64 `json:"monthly_price"`
+	NCPUs        int     `json:"ncpus"`
+	RAM          int64   `json:"ram"`
+	Arch         string  `json:"arch"`
+	Baremetal    bool    `json:"baremetal"`
+	GPU          *int    `json:"gpu"`
+	EndOfService bool    `json:"end_of_service"`
+	Network      struct {
+		SumInternetBandwidth int64 `json:"sum_internet_bandwidth"`
+	} `json:"network"`
+}
+
+// kubernetesVersion is a Kubernetes version supported by Kapsule
+type kubernetesVersion struct {
+	Name string `json:"name"`
+}
+
+// client is a minimal client of the Scaleway API
+type client struct {
+	api       *restapi.Client
+	baseURL   string
+	secretKey string
+}
+
+func newClient(config Config) *client {
+	return &client{
+		api:       restapi.NewClient("scaleway", config.UserAgent),
+		baseURL:   apiURL,
+		secretKey: config.SecretKey,
+	}
+}
+
+// get retrieves a resource of the api and decodes it into the given value, returning the total count of the listing
+func (c *client) get(ctx context.Context, path string, query url.Values, v interface{}) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s%s?%s", c.baseURL, path, query.Encode()), nil)
+	if err != nil {
+		return 0, errors.WrapIf(err, "failed to create request")
+	}
+
+	req.Header.Set("X-Auth-Token", c.secretKey)
+
+	header, err := c.api.DoStatus(req, http.StatusOK, v)
+	if err != nil {
+		return 0, err
+	}
+
+	total, _ := strconv.Atoi(header.Get("X-Total-Count"))
+
+	return total, nil
+}
+
+// listServerTypes lists the instance types of the zone
+func (c *client) listServerTypes(ctx context.Context, zone string) (map[string]serverType, error) {
+	serverTypes := make(map[string]serverType)
+	for page := 1; ; page++ {
+		var resp struct {
+			Servers map[string]serverType `json:"servers"`
+		}
+
+		query := url.Values{"page": {strconv.Itoa(page)}, "per_page": {"100"}}
+		total, err := c.get(ctx, fmt.Sprintf("/instance/v1/zones/%s/products/servers", zone), query, &resp)
+		if err != nil {
+			return nil, err
+		}
+
+		for name, serverType := range resp.Servers {
+			serverTypes[name] = serverType
+		}
+
+		if len(resp.Servers) == 0 || len(serverTypes) >= total {
+			return serverTypes, nil
+		}
+	}
+}
+
+// listKubernetesVersions lists the Kubernetes versions supported by Kapsule in the region
+func (c *client) listKubernetesVersions(ctx context.Context, region string) ([]kubernetesVersion, error) {
+	var resp struct {
+		Versions []kubernetesVersion `json:"versions"`
+	}
+
+	if _, err := c.get(ctx, fmt.Sprintf("/k8s/v1/regions/%s/versions", region), url.Values{}, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Versions, nil
+}
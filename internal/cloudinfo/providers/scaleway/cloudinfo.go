@@ -0,0 +1,306 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scaleway
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+	"github.com/banzaicloud/cloudinfo/internal/platform/log"
+)
+
+const (
+	svcCompute = "compute"
+	svcKapsule = "kapsule"
+
+	// currency is the currency of the Scaleway prices
+	currency = "EUR"
+)
+
+// DefaultZones are the availability zones scraped if none are configured
+// DefaultZones are the zones scraped when no zones are configured
+var DefaultZones = []string{"fr-par-1", "fr-par-2", "fr-par-3", "nl-ams-1", "nl-ams-2", "nl-ams-3", "pl-waw-1", "pl-waw-2", "pl-waw-3"}
+
+// regionNames holds the names of the known regions
+var regionNames = map[string]string{
+	"fr-par": "Paris",
+	"nl-ams": "Amsterdam",
+	"pl-waw": "Warsaw",
+}
+
+// ScalewayInfoer encapsulates the data and operations needed to access external Scaleway resources.
+type ScalewayInfoer struct {
+	client *client
+	zones  []string
+
+	logger cloudinfo.Logger
+}
+
+// NewScalewayInfoer creates a new instance of the Scaleway infoer.
+func NewScalewayInfoer(config Config, logger cloudinfo.Logger) (*ScalewayInfoer, error) {
+	if config.SecretKey == "" {
+		return nil, errors.New("scaleway secret key is required")
+	}
+
+	zones := config.Zones
+	if len(zones) == 0 {
+		zones = DefaultZones
+	}
+
+	return &ScalewayInfoer{
+		client: newClient(config),
+		zones:  zones,
+		logger: logger,
+	}, nil
+}
+
+// regionOf returns the region of the zone (eg.: fr-par for fr-par-1)
+func regionOf(zone string) string {
+	if idx := strings.LastIndex(zone, "-"); idx > 0 {
+		return zone[:idx]
+	}
+
+	return zone
+}
+
+// Initialize retrieves the prices of the instance types in all regions
+func (i *ScalewayInfoer) Initialize() (map[string]map[string]types.Price, error) {
+	i.logger.Debug("initializing price info")
+
+	allPrices := make(map[string]map[string]types.Price)
+	for region := range i.regions() {
+		vms, err := i.GetVirtualMachines(region)
+		if err != nil {
+			return nil, err
+		}
+
+		allPrices[region] = make(map[string]types.Price, len(vms))
+		for _, vm := range vms {
+			allPrices[region][vm.Type] = types.Price{
+				OnDemandPrice: vm.OnDemandPrice,
+			}
+		}
+	}
+
+	i.logger.Debug("finished initializing price info")
+	return allPrices, nil
+}
+
+// regions returns the regions of the configured zones
+func (i *ScalewayInfoer) regions() map[string]string {
+	regions := make(map[string]string)
+	for _, zone := range i.zones {
+		region := regionOf(zone)
+
+		name, ok := regionNames[region]
+		if !ok {
+			name = region
+		}
+		regions[region] = name
+	}
+
+	return regions
+}
+
+// GetVirtualMachines retrieves the instance types available in the zones of the region
+func (i *ScalewayInfoer) GetVirtualMachines(region string) ([]types.VMInfo, error) {
+	logger := log.WithFields(i.logger, map[string]interface{}{"region": region})
+	logger.Debug("getting product info")
+
+	zones, _ := i.GetZones(region)
+
+	vmsByType := make(map[string]*types.VMInfo)
+	for _, zone := range zones {
+		serverTypes, err := i.client.listServerTypes(context.Background(), zone)
+		if err != nil {
+			return nil, errors.WrapIfWithDetails(err, "failed to list instance types", "zone", zone)
+		}
+
+		for name, t := range serverTypes {
+			if t.EndOfService {
+				continue
+			}
+
+			if vm, ok := vmsByType[name]; ok {
+				vm.Zones = append(vm.Zones, zone)
+				continue
+			}
+
+			var gpus int
+			if t.GPU != nil {
+				gpus = *t.GPU
+			}
+
+			category := getCategory(name, gpus)
+			ntwPerf, ntwPerfCat := networkPerformance(t.Network.SumInternetBandwidth)
+			mem := float64(t.RAM) / (1 << 30)
+
+			vmsByType[name] = &types.VMInfo{
+				Category:      category,
+				Type:          name,
+				OnDemandPrice: t.HourlyPrice,
+				MonthlyPrice:  t.MonthlyPrice,
+				Currency:      currency,
+				Cpus:          float64(t.NCPUs),
+				Mem:           mem,
+				Gpus:          float64(gpus),
+				NtwPerf:       ntwPerf,
+				NtwPerfCat:    ntwPerfCat,
+				Zones:         []string{zone},
+				Burst:         isShared(name),
+				Architecture:  cloudinfo.Architecture(t.Arch),
+				BareMetal:     t.Baremetal,
+				Attributes:    cloudinfo.Attributes(fmt.Sprint(t.NCPUs), fmt.Sprint(mem), ntwPerfCat, category),
+			}
+		}
+	}
+
+	virtualMachines := make([]types.VMInfo, 0, len(vmsByType))
+	for _, vm := range vmsByType {
+		sort.Strings(vm.Zones)
+		virtualMachines = append(virtualMachines, *vm)
+	}
+
+	logger.Debug("found instance types", map[string]interface{}{"numberOfTypes": len(virtualMachines)})
+	return virtualMachines, nil
+}
+
+// GetProducts retrieves the available virtual machines of the service in the region
+// the instance types are only listed if the virtual machines of the region are not known yet
+func (i *ScalewayInfoer) GetProducts(vms []types.VMInfo, service, regionId string) ([]types.VMInfo, error) {
+	switch service {
+	case svcCompute, svcKapsule:
+		if len(vms) > 0 {
+			return vms, nil
+		}
+
+		return i.GetVirtualMachines(regionId)
+	default:
+		return nil, errors.NewWithDetails("invalid service", "service", service)
+	}
+}
+
+// GetZones returns the configured zones of the region
+func (i *ScalewayInfoer) GetZones(region string) ([]string, error) {
+	var zones []string
+	for _, zone := range i.zones {
+		if regionOf(zone) == region {
+			zones = append(zones, zone)
+		}
+	}
+
+	return zones, nil
+}
+
+// GetRegions returns the regions of the configured zones
+func (i *ScalewayInfoer) GetRegions(service string) (map[string]string, error) {
+	if service != svcCompute && service != svcKapsule {
+		return nil, errors.NewWithDetails("invalid service", "service", service)
+	}
+
+	return i.regions(), nil
+}
+
+// HasShortLivedPriceInfo signals that the Scaleway prices don't change frequently
+func (*ScalewayInfoer) HasShortLivedPriceInfo() bool {
+	return false
+}
+
+// GetCurrentPrices is not supported, Scaleway has no short lived prices
+func (*ScalewayInfoer) GetCurrentPrices(region string) (map[string]types.Price, error) {
+	return nil, errors.New("GetCurrentPrices - not yet implemented")
+}
+
+// HasImages signals that images are not scraped for Scaleway
+func (*ScalewayInfoer) HasImages() bool {
+	return false
+}
+
+// GetServiceImages is not supported for Scaleway
+func (*ScalewayInfoer) GetServiceImages(service, region string) ([]types.Image, error) {
+	return nil, errors.New("GetServiceImages - not yet implemented")
+}
+
+// GetVersions retrieves the Kubernetes versions supported by Kapsule in the region
+func (i *ScalewayInfoer) GetVersions(service, region string) ([]types.LocationVersion, error) {
+	switch service {
+	case svcKapsule:
+		kubernetesVersions, err := i.client.listKubernetesVersions(context.Background(), region)
+		if err != nil {
+			return nil, errors.WrapIf(err, "failed to list kubernetes versions")
+		}
+
+		versions := make([]string, 0, len(kubernetesVersions))
+		for _, v := range kubernetesVersions {
+			versions = append(versions, v.Name)
+		}
+
+		return []types.LocationVersion{types.NewLocationVersion(region, versions, "")}, nil
+	default:
+		return []types.LocationVersion{}, nil
+	}
+}
+
+// GetServiceProducts is not supported for Scaleway
+func (*ScalewayInfoer) GetServiceProducts(region, service string) ([]types.ProductDetails, error) {
+	return nil, errors.New("GetServiceProducts - not yet implemented")
+}
+
+// getCategory maps the instance type to an instance type category
+func getCategory(name string, gpus int) string {
+	switch {
+	case gpus > 0:
+		return types.CategoryGpu
+	case strings.HasPrefix(name, "POP2-HC"):
+		return types.CategoryCompute
+	case strings.HasPrefix(name, "POP2-HM"):
+		return types.CategoryMemory
+	default:
+		return types.CategoryGeneral
+	}
+}
+
+// isShared signals whether the instance type has shared (burstable) cpus
+func isShared(name string) bool {
+	for _, prefix := range []string{"DEV1-", "PLAY2-", "STARDUST1-"} {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// networkPerformance maps the internet bandwidth (bit/s) to the network performance and its category
+func networkPerformance(bps int64) (string, string) {
+	mbps := bps / 1000000
+	ntwPerf := fmt.Sprintf("%d Mbit/s", mbps)
+	switch {
+	case mbps < 1000:
+		return ntwPerf, types.NtwLow
+	case mbps < 5000:
+		return ntwPerf, types.NtwMedium
+	case mbps < 20000:
+		return ntwPerf, types.NtwHight
+	default:
+		return ntwPerf, types.NtwExtra
+	}
+}
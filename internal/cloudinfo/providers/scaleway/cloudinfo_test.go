@@ -0,0 +1,125 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scaleway
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/cloudinfoadapter"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestClient_listServerTypes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/instance/v1/zones/fr-par-1/products/servers", r.URL.Path)
+		assert.Equal(t, "secret", r.Header.Get("X-Auth-Token"))
+
+		w.Header().Set("X-Total-Count", "3")
+		switch r.URL.Query().Get("page") {
+		case "1":
+			fmt.Fprint(w, `{"servers": {"DEV1-S": {"ncpus": 2}, "GP1-XS": {"ncpus": 4}}}`)
+		case "2":
+			fmt.Fprint(w, `{"servers": {"GPU-3070-S": {"ncpus": 8, "gpu": 1}}}`)
+		default:
+			fmt.Fprint(w, `{"servers": {}}`)
+		}
+	}))
+	defer server.Close()
+
+	c := newClient(Config{SecretKey: "secret"})
+	c.baseURL = server.URL
+
+	serverTypes, err := c.listServerTypes(context.Background(), "fr-par-1")
+	assert.NoError(t, err)
+	assert.Len(t, serverTypes, 3, "all the pages should be listed")
+	if assert.NotNil(t, serverTypes["GPU-3070-S"].GPU) {
+		assert.Equal(t, 1, *serverTypes["GPU-3070-S"].GPU)
+	}
+}
+
+func TestScalewayInfoer_GetProducts(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("X-Total-Count", "3")
+		switch r.URL.Path {
+		case "/instance/v1/zones/fr-par-1/products/servers":
+			fmt.Fprint(w, `{"servers": {
+				"DEV1-S": {"hourly_price": 0.0088, "monthly_price": 6.42, "ncpus": 2, "ram": 2147483648,
+					"network": {"sum_internet_bandwidth": 200000000}},
+				"POP2-HC-2C-4G": {"hourly_price": 0.0532, "ncpus": 2, "ram": 4294967296},
+				"START1-XS": {"ncpus": 1, "end_of_service": true}}}`)
+		case "/instance/v1/zones/fr-par-2/products/servers":
+			w.Header().Set("X-Total-Count", "1")
+			fmt.Fprint(w, `{"servers": {"DEV1-S": {"hourly_price": 0.0088, "monthly_price": 6.42, "ncpus": 2, "ram": 2147483648}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	infoer, err := NewScalewayInfoer(Config{SecretKey: "secret", Zones: []string{"fr-par-1", "fr-par-2", "nl-ams-1"}},
+		cloudinfoadapter.NewNoopLogger())
+	assert.NoError(t, err)
+	infoer.client.baseURL = server.URL
+
+	vms, err := infoer.GetProducts(nil, svcCompute, "fr-par")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, requests, "only the zones of the region should be listed")
+
+	vmsByType := make(map[string]types.VMInfo, len(vms))
+	for _, vm := range vms {
+		vmsByType[vm.Type] = vm
+	}
+	assert.Len(t, vmsByType, 2, "the end of service instance types should be skipped")
+
+	dev := vmsByType["DEV1-S"]
+	assert.Equal(t, []string{"fr-par-1", "fr-par-2"}, dev.Zones, "the zones of the instance type should be merged")
+	assert.Equal(t, 0.0088, dev.OnDemandPrice)
+	assert.Equal(t, 6.42, dev.MonthlyPrice)
+	assert.Equal(t, "EUR", dev.Currency)
+	assert.Equal(t, 2.0, dev.Mem)
+	assert.True(t, dev.Burst)
+	assert.Equal(t, types.NtwLow, dev.NtwPerfCat)
+
+	assert.Equal(t, types.CategoryCompute, vmsByType["POP2-HC-2C-4G"].Category)
+
+	reused, err := infoer.GetProducts(vms, svcKapsule, "fr-par")
+	assert.NoError(t, err)
+	assert.Equal(t, vms, reused, "the known virtual machines should be reused")
+	assert.Equal(t, 2, requests, "the instance types should not be listed again")
+
+	_, err = infoer.GetProducts(nil, "unknown", "fr-par")
+	assert.Error(t, err)
+}
+
+func TestNewScalewayInfoer(t *testing.T) {
+	_, err := NewScalewayInfoer(Config{}, cloudinfoadapter.NewNoopLogger())
+	assert.Error(t, err, "the secret key should be required")
+
+	infoer, err := NewScalewayInfoer(Config{SecretKey: "secret"}, cloudinfoadapter.NewNoopLogger())
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultZones, infoer.zones, "the default zones should be scraped without configured zones")
+
+	regions, err := infoer.GetRegions(svcCompute)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"fr-par": "Paris", "nl-ams": "Amsterdam", "pl-waw": "Warsaw"}, regions)
+}
@@ -0,0 +1,277 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tencent
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/restapi"
+)
+
+const (
+	cvmVersion = "2017-03-12"
+	tkeVersion = "2018-05-25"
+
+	chargeTypeOnDemand = "POSTPAID_BY_HOUR"
+	chargeTypeSpot     = "SPOTPAID"
+
+	stateAvailable = "AVAILABLE"
+	statusSell     = "SELL"
+)
+
+// cvmRegion is a region of the Tencent Cloud API
+type cvmRegion struct {
+	Region      string `json:"Region"`
+	RegionName  string `json:"RegionName"`
+	RegionState string `json:"RegionState"`
+}
+
+// cvmZone is an availability zone of the Tencent Cloud API
+type cvmZone struct {
+	Zone      string `json:"Zone"`
+	ZoneName  string `json:"ZoneName"`
+	ZoneState string `json:"ZoneState"`
+}
+
+// instanceTypeQuota is an instance type offered in a zone with the given charge type
+type instanceTypeQuota struct {
+	Zone               string  `json:"Zone"`
+	InstanceType       string  `json:"InstanceType"`
+	InstanceChargeType string  `json:"InstanceChargeType"`
+	InstanceFamily     string  `json:"InstanceFamily"`
+	Cpu                float64 `json:"Cpu"`
+	Memory             float64 `json:"Memory"`
+	GpuCount           float64 `json:"GpuCount"`
+	InstanceBandwidth  float64 `json:"InstanceBandwidth"`
+	Status             string  `json:"Status"`
+	Price              struct {
+		UnitPrice         float64 `json:"UnitPrice"`
+		UnitPriceDiscount float64 `json:"UnitPriceDiscount"`
+		ChargeUnit        string  `json:"ChargeUnit"`
+	} `json:"Price"`
+}
+
+// hourlyPrice returns the discounted hourly price of the instance type, falling back to the list price
+func (q instanceTypeQuota) hourlyPrice() float64 {
+	if q.Price.UnitPriceDiscount > 0 {
+		return q.Price.UnitPriceDiscount
+	}
+
+	return q.Price.UnitPrice
+}
+
+// apiError is the error returned in the response of the Tencent Cloud API
+type apiError struct {
+	Code    string `json:"Code"`
+	Message string `json:"Message"`
+}
+
+// client is a minimal client of the Tencent Cloud API (version 3.0, TC3-HMAC-SHA256 signature)
+type client struct {
+	api       *restapi.Client
+	endpoint  func(service string) string
+	secretID  string
+	secretKey string
+	language  string
+
+	now func() time.Time
+}
+
+func newClient(config Config) *client {
+	return &client{
+		api: restapi.NewClient("tencent cloud", config.UserAgent),
+		endpoint: func(service string) string {
+			return fmt.Sprintf("https://%s.tencentcloudapi.com", service)
+		},
+		secretID:  config.SecretID,
+		secretKey: config.SecretKey,
+		language:  config.Language,
+		now:       time.Now,
+	}
+}
+
+// call invokes the action of the service and decodes the response into the given value
+func (c *client) call(ctx context.Context, service, version, action, region string, params interface{}, v interface{}) error {
+	payload, err := json.Marshal(params)
+	if err != nil {
+		return errors.WrapIf(err, "failed to encode request parameters")
+	}
+
+	endpoint := c.endpoint(service)
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return errors.WrapIfWithDetails(err, "invalid endpoint", "endpoint", endpoint)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return errors.WrapIf(err, "failed to create request")
+	}
+
+	timestamp := c.now().Unix()
+
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	req.Header.Set("X-TC-Action", action)
+	req.Header.Set("X-TC-Version", version)
+	req.Header.Set("X-TC-Timestamp", strconv.FormatInt(timestamp, 10))
+	req.Header.Set("Authorization", c.authorization(service, u.Host, payload, timestamp))
+	if region != "" {
+		req.Header.Set("X-TC-Region", region)
+	}
+	if c.language != "" {
+		// region and zone names are returned in Chinese by default
+		req.Header.Set("X-TC-Language", c.language)
+	}
+
+	var body struct {
+		Response json.RawMessage `json:"Response"`
+	}
+	if err := c.api.Do(req, &body); err != nil {
+		return errors.WithDetails(err, "action", action)
+	}
+
+	// errors are reported with a 200 status code in the body of the response
+	var e struct {
+		Error *apiError `json:"Error"`
+	}
+	if err := json.Unmarshal(body.Response, &e); err != nil {
+		return errors.WrapIfWithDetails(err, "failed to decode tencent cloud api response", "action", action)
+	}
+	if e.Error != nil {
+		return errors.NewWithDetails(e.Error.Message, "action", action, "code", e.Error.Code)
+	}
+
+	if err := json.Unmarshal(body.Response, v); err != nil {
+		return errors.WrapIfWithDetails(err, "failed to decode tencent cloud api response", "action", action)
+	}
+
+	return nil
+}
+
+// authorization creates the TC3-HMAC-SHA256 signature of the request
+func (c *client) authorization(service, host string, payload []byte, timestamp int64) string {
+	const signedHeaders = "content-type;host"
+
+	canonicalRequest := fmt.Sprintf("POST\n/\n\ncontent-type:application/json; charset=utf-8\nhost:%s\n\n%s\n%s",
+		host, signedHeaders, sha256hex(payload))
+
+	date := time.Unix(timestamp, 0).UTC().Format("2006-01-02")
+	credentialScope := fmt.Sprintf("%s/%s/tc3_request", date, service)
+	stringToSign := fmt.Sprintf("TC3-HMAC-SHA256\n%d\n%s\n%s", timestamp, credentialScope, sha256hex([]byte(canonicalRequest)))
+
+	secretDate := hmacSHA256([]byte("TC3"+c.secretKey), date)
+	secretService := hmacSHA256(secretDate, service)
+	secretSigning := hmacSHA256(secretService, "tc3_request")
+	signature := hex.EncodeToString(hmacSHA256(secretSigning, stringToSign))
+
+	return fmt.Sprintf("TC3-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		c.secretID, credentialScope, signedHeaders, signature)
+}
+
+func sha256hex(b []byte) string {
+	h := sha256.Sum256(b)
+	return hex.EncodeToString(h[:])
+}
+
+func hmacSHA256(key []byte, msg string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(msg))
+	return mac.Sum(nil)
+}
+
+// listRegions lists the CVM regions
+func (c *client) listRegions(ctx context.Context, region string) ([]cvmRegion, error) {
+	var resp struct {
+		RegionSet []cvmRegion `json:"RegionSet"`
+	}
+
+	err := c.call(ctx, "cvm", cvmVersion, "DescribeRegions", region, struct{}{}, &resp)
+
+	return resp.RegionSet, err
+}
+
+// listZones lists the availability zones of the region
+func (c *client) listZones(ctx context.Context, region string) ([]cvmZone, error) {
+	var resp struct {
+		ZoneSet []cvmZone `json:"ZoneSet"`
+	}
+
+	err := c.call(ctx, "cvm", cvmVersion, "DescribeZones", region, struct{}{}, &resp)
+
+	return resp.ZoneSet, err
+}
+
+// listInstanceTypeQuotas lists the instance types sold in the zones of the region with the given charge type
+func (c *client) listInstanceTypeQuotas(ctx context.Context, region, chargeType string) ([]instanceTypeQuota, error) {
+	type filter struct {
+		Name   string   `json:"Name"`
+		Values []string `json:"Values"`
+	}
+
+	params := struct {
+		Filters []filter `json:"Filters"`
+	}{
+		Filters: []filter{{Name: "instance-charge-type", Values: []string{chargeType}}},
+	}
+
+	var resp struct {
+		InstanceTypeQuotaSet []instanceTypeQuota `json:"InstanceTypeQuotaSet"`
+	}
+
+	if err := c.call(ctx, "cvm", cvmVersion, "DescribeZoneInstanceConfigInfos", region, params, &resp); err != nil {
+		return nil, err
+	}
+
+	quotas := make([]instanceTypeQuota, 0, len(resp.InstanceTypeQuotaSet))
+	for _, q := range resp.InstanceTypeQuotaSet {
+		if q.Status == statusSell {
+			quotas = append(quotas, q)
+		}
+	}
+
+	return quotas, nil
+}
+
+// listKubernetesVersions lists the Kubernetes versions supported by TKE in the region
+func (c *client) listKubernetesVersions(ctx context.Context, region string) ([]string, error) {
+	var resp struct {
+		VersionInstanceSet []struct {
+			Version string `json:"Version"`
+		} `json:"VersionInstanceSet"`
+	}
+
+	if err := c.call(ctx, "tke", tkeVersion, "DescribeVersions", region, struct{}{}, &resp); err != nil {
+		return nil, err
+	}
+
+	versions := make([]string, 0, len(resp.VersionInstanceSet))
+	for _, v := range resp.VersionInstanceSet {
+		versions = append(versions, v.Version)
+	}
+
+	return versions, nil
+}
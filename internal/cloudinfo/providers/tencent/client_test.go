@@ -0,0 +1,91 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tencent
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_listInstanceTypeQuotas(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DescribeZoneInstanceConfigInfos", r.Header.Get("X-TC-Action"))
+		assert.Equal(t, "ap-guangzhou", r.Header.Get("X-TC-Region"))
+		assert.Equal(t, "en-US", r.Header.Get("X-TC-Language"))
+		assert.Equal(t, "1609459200", r.Header.Get("X-TC-Timestamp"))
+		assert.True(t, strings.HasPrefix(r.Header.Get("Authorization"),
+			"TC3-HMAC-SHA256 Credential=id/2021-01-01/cvm/tc3_request, SignedHeaders=content-type;host, Signature="))
+
+		fmt.Fprint(w, `{"Response": {"InstanceTypeQuotaSet": [
+			{"Zone": "ap-guangzhou-3", "InstanceType": "S5.MEDIUM4", "InstanceFamily": "S5", "Cpu": 2, "Memory": 4,
+				"Status": "SELL", "Price": {"UnitPrice": 0.5, "UnitPriceDiscount": 0.4, "ChargeUnit": "HOUR"}},
+			{"Zone": "ap-guangzhou-4", "InstanceType": "S5.MEDIUM4", "InstanceFamily": "S5", "Cpu": 2, "Memory": 4,
+				"Status": "SOLD_OUT", "Price": {"UnitPrice": 0.5, "ChargeUnit": "HOUR"}}
+		], "RequestId": "1"}}`)
+	}))
+	defer server.Close()
+
+	c := newClient(Config{SecretID: "id", SecretKey: "key", Language: "en-US"})
+	c.endpoint = func(string) string { return server.URL }
+	c.now = func() time.Time { return time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC) }
+
+	quotas, err := c.listInstanceTypeQuotas(context.Background(), "ap-guangzhou", chargeTypeOnDemand)
+	assert.NoError(t, err)
+	if assert.Len(t, quotas, 1, "sold out instance types should be skipped") {
+		assert.Equal(t, 0.4, quotas[0].hourlyPrice(), "the discounted price should be used")
+	}
+}
+
+func TestClient_callError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"Response": {"Error": {"Code": "AuthFailure.SignatureFailure", "Message": "invalid signature"}, "RequestId": "1"}}`)
+	}))
+	defer server.Close()
+
+	c := newClient(Config{SecretID: "id", SecretKey: "key"})
+	c.endpoint = func(string) string { return server.URL }
+
+	_, err := c.listRegions(context.Background(), "ap-guangzhou")
+	assert.EqualError(t, err, "invalid signature")
+}
+
+func TestGetCategory(t *testing.T) {
+	tests := []struct {
+		family   string
+		category string
+	}{
+		{family: "S5", category: "General purpose"},
+		{family: "SA2", category: "General purpose"},
+		{family: "C6", category: "Compute optimized"},
+		{family: "M5", category: "Memory optimized"},
+		{family: "IT5", category: "Storage optimized"},
+		{family: "GN7", category: "GPU instance"},
+		{family: "GI3X", category: "GPU instance"},
+		{family: "UNKNOWN1", category: "General purpose"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.family, func(t *testing.T) {
+			assert.Equal(t, test.category, getCategory(test.family))
+		})
+	}
+}
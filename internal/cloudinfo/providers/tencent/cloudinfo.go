@@ -0,0 +1,258 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tencent
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/metrics"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+	"github.com/banzaicloud/cloudinfo/internal/platform/log"
+)
+
+const (
+	svcCompute = "compute"
+	svcTke     = "tke"
+)
+
+var (
+	categoryMap = map[string][]string{
+		types.CategoryGeneral: {"S", "SA", "SN", "SK", "SR", "SW", "BF", "BS"},
+		types.CategoryCompute: {"C", "CN", "ITC"},
+		types.CategoryMemory:  {"M", "MA", "BMA"},
+		types.CategoryStorage: {"IT", "ITA", "D", "BMD"},
+		types.CategoryGpu:     {"GN", "GNV", "GT", "GI", "GC", "PNV", "BMG"},
+	}
+)
+
+// TencentInfoer encapsulates the data and operations needed to access external Tencent Cloud resources.
+type TencentInfoer struct {
+	client *client
+	region string
+
+	logger cloudinfo.Logger
+}
+
+// NewTencentInfoer creates a new instance of the Tencent Cloud infoer.
+func NewTencentInfoer(config Config, logger cloudinfo.Logger) (*TencentInfoer, error) {
+	if config.SecretID == "" || config.SecretKey == "" {
+		return nil, errors.New("tencent cloud secret id and secret key are required")
+	}
+
+	return &TencentInfoer{
+		client: newClient(config),
+		region: config.Region,
+		logger: logger,
+	}, nil
+}
+
+// Initialize is not needed on Tencent Cloud, the on demand prices are retrieved together with the instance types
+func (i *TencentInfoer) Initialize() (map[string]map[string]types.Price, error) {
+	return nil, nil
+}
+
+// GetVirtualMachines retrieves the instance types sold pay-as-you-go in the region
+func (i *TencentInfoer) GetVirtualMachines(region string) ([]types.VMInfo, error) {
+	logger := log.WithFields(i.logger, map[string]interface{}{"region": region})
+	logger.Debug("getting product info")
+
+	quotas, err := i.client.listInstanceTypeQuotas(context.Background(), region, chargeTypeOnDemand)
+	if err != nil {
+		return nil, errors.WrapIfWithDetails(err, "failed to list instance types", "region", region)
+	}
+
+	// the same instance type is listed once per zone
+	vmsByType := make(map[string]*types.VMInfo)
+	var instanceTypes []string
+	for _, q := range quotas {
+		if vm, ok := vmsByType[q.InstanceType]; ok {
+			vm.Zones = append(vm.Zones, q.Zone)
+			if price := q.hourlyPrice(); price < vm.OnDemandPrice {
+				vm.OnDemandPrice = price
+			}
+			continue
+		}
+
+		category := getCategory(q.InstanceFamily)
+		ntwPerf, ntwPerfCat := networkPerformance(q.InstanceBandwidth)
+
+		vmsByType[q.InstanceType] = &types.VMInfo{
+			Category:      category,
+			Type:          q.InstanceType,
+			OnDemandPrice: q.hourlyPrice(),
+			Cpus:          q.Cpu,
+			Mem:           q.Memory,
+			Gpus:          q.GpuCount,
+			NtwPerf:       ntwPerf,
+			NtwPerfCat:    ntwPerfCat,
+			Zones:         []string{q.Zone},
+			Attributes:    cloudinfo.Attributes(fmt.Sprint(q.Cpu), fmt.Sprint(q.Memory), ntwPerfCat, category),
+		}
+		instanceTypes = append(instanceTypes, q.InstanceType)
+	}
+
+	virtualMachines := make([]types.VMInfo, 0, len(instanceTypes))
+	for _, instanceType := range instanceTypes {
+		virtualMachines = append(virtualMachines, *vmsByType[instanceType])
+	}
+
+	logger.Debug("found instance types", map[string]interface{}{"numberOfTypes": len(virtualMachines)})
+	return virtualMachines, nil
+}
+
+// GetProducts retrieves the available virtual machines of the service in the region
+func (i *TencentInfoer) GetProducts(vms []types.VMInfo, service, regionId string) ([]types.VMInfo, error) {
+	switch service {
+	case svcCompute, svcTke:
+		return i.GetVirtualMachines(regionId)
+	default:
+		return nil, errors.NewWithDetails("invalid service", "service", service)
+	}
+}
+
+// GetZones retrieves the available zones of the region
+func (i *TencentInfoer) GetZones(region string) ([]string, error) {
+	zones, err := i.client.listZones(context.Background(), region)
+	if err != nil {
+		return nil, errors.WrapIfWithDetails(err, "failed to list zones", "region", region)
+	}
+
+	zoneNames := make([]string, 0, len(zones))
+	for _, z := range zones {
+		if z.ZoneState == stateAvailable {
+			zoneNames = append(zoneNames, z.Zone)
+		}
+	}
+
+	return zoneNames, nil
+}
+
+// GetRegions retrieves the available regions
+func (i *TencentInfoer) GetRegions(service string) (map[string]string, error) {
+	if service != svcCompute && service != svcTke {
+		return nil, errors.NewWithDetails("invalid service", "service", service)
+	}
+
+	regions, err := i.client.listRegions(context.Background(), i.region)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to list regions")
+	}
+
+	regionMap := make(map[string]string, len(regions))
+	for _, r := range regions {
+		if r.RegionState == stateAvailable {
+			regionMap[r.Region] = r.RegionName
+		}
+	}
+
+	return regionMap, nil
+}
+
+// HasShortLivedPriceInfo - Spot Prices are changing continuously on Tencent Cloud
+func (*TencentInfoer) HasShortLivedPriceInfo() bool {
+	return true
+}
+
+// GetCurrentPrices returns the current spot prices of every instance type in every availability zone in a given region
+func (i *TencentInfoer) GetCurrentPrices(region string) (map[string]types.Price, error) {
+	quotas, err := i.client.listInstanceTypeQuotas(context.Background(), region, chargeTypeSpot)
+	if err != nil {
+		return nil, errors.WrapIfWithDetails(err, "failed to list spot instance types", "region", region)
+	}
+
+	prices := make(map[string]types.Price)
+	for _, q := range quotas {
+		price, ok := prices[q.InstanceType]
+		if !ok {
+			price = types.Price{
+				SpotPrice:     make(types.SpotPriceInfo),
+				OnDemandPrice: -1,
+			}
+			prices[q.InstanceType] = price
+		}
+
+		price.SpotPrice[q.Zone] = q.hourlyPrice()
+		metrics.ReportTencentSpotPrice(region, q.Zone, q.InstanceType, q.hourlyPrice())
+	}
+
+	return prices, nil
+}
+
+// HasImages signals that images are not scraped for Tencent Cloud
+func (*TencentInfoer) HasImages() bool {
+	return false
+}
+
+// GetServiceImages is not supported for Tencent Cloud
+func (*TencentInfoer) GetServiceImages(service, region string) ([]types.Image, error) {
+	return nil, errors.New("GetServiceImages - not yet implemented")
+}
+
+// GetVersions retrieves the Kubernetes versions supported by TKE in the region
+func (i *TencentInfoer) GetVersions(service, region string) ([]types.LocationVersion, error) {
+	switch service {
+	case svcTke:
+		versions, err := i.client.listKubernetesVersions(context.Background(), region)
+		if err != nil {
+			return nil, errors.WrapIfWithDetails(err, "failed to list kubernetes versions", "region", region)
+		}
+
+		return []types.LocationVersion{types.NewLocationVersion(region, versions, "")}, nil
+	default:
+		return []types.LocationVersion{}, nil
+	}
+}
+
+// GetServiceProducts is not supported for Tencent Cloud
+func (*TencentInfoer) GetServiceProducts(region, service string) ([]types.ProductDetails, error) {
+	return nil, errors.New("GetServiceProducts - not yet implemented")
+}
+
+// getCategory maps the instance family (eg. S5, SA2, GN7) to an instance type category
+func getCategory(family string) string {
+	prefix := strings.ToUpper(family)
+	if idx := strings.IndexFunc(prefix, func(r rune) bool { return !unicode.IsLetter(r) }); idx >= 0 {
+		prefix = prefix[:idx]
+	}
+
+	for category, families := range categoryMap {
+		if cloudinfo.Contains(families, prefix) {
+			return category
+		}
+	}
+
+	return types.CategoryGeneral
+}
+
+// networkPerformance maps the internal bandwidth (Gbps) to the network performance and its category
+func networkPerformance(gbps float64) (string, string) {
+	ntwPerf := fmt.Sprintf("%.1f Gbit/s", gbps)
+	switch {
+	case gbps < 2:
+		return ntwPerf, types.NtwLow
+	case gbps < 10:
+		return ntwPerf, types.NtwMedium
+	case gbps < 25:
+		return ntwPerf, types.NtwHight
+	default:
+		return ntwPerf, types.NtwExtra
+	}
+}
@@ -0,0 +1,29 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tencent
+
+// Config holds the configuration of the Tencent Cloud provider
+type Config struct {
+	SecretID  string
+	SecretKey string
+
+	// Region is the region used to list the available regions
+	Region string
+
+	// Language is the language of the region and zone names (zh-CN or en-US)
+	Language string
+
+	UserAgent string
+}
@@ -0,0 +1,154 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upcloud
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/restapi"
+)
+
+const (
+	apiURL = "https://api.upcloud.com/1.3"
+
+	// planPricePrefix is the prefix of the server plan items of the zone price lists
+	planPricePrefix = "server_plan_"
+)
+
+// plan is a server plan of the UpCloud API
+type plan struct {
+	Name         string `json:"name"`
+	CoreNumber   int    `json:"core_number"`
+	MemoryAmount int    `json:"memory_amount"`
+	GPUAmount    int    `json:"gpu_amount"`
+	StorageSize  int    `json:"storage_size"`
+}
+
+// zone is a zone of the UpCloud API
+type zone struct {
+	ID          string `json:"id"`
+	Description string `json:"description"`
+	Public      string `json:"public"`
+}
+
+// client is a minimal client of the UpCloud API
+type client struct {
+	api      *restapi.Client
+	baseURL  string
+	username string
+	password string
+}
+
+func newClient(config Config) *client {
+	return &client{
+		api:      restapi.NewClient("upcloud", config.UserAgent),
+		baseURL:  apiURL,
+		username: config.Username,
+		password: config.Password,
+	}
+}
+
+// get retrieves a resource of the api and decodes it into the given value
+func (c *client) get(ctx context.Context, path string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+path, nil)
+	if err != nil {
+		return errors.WrapIf(err, "failed to create request")
+	}
+
+	req.SetBasicAuth(c.username, c.password)
+
+	return c.api.Do(req, v)
+}
+
+// listPlans lists the server plans
+func (c *client) listPlans(ctx context.Context) ([]plan, error) {
+	var resp struct {
+		Plans struct {
+			Plan []plan `json:"plan"`
+		} `json:"plans"`
+	}
+
+	if err := c.get(ctx, "/plan", &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Plans.Plan, nil
+}
+
+// listZones lists the public zones
+func (c *client) listZones(ctx context.Context) ([]zone, error) {
+	var resp struct {
+		Zones struct {
+			Zone []zone `json:"zone"`
+		} `json:"zones"`
+	}
+
+	if err := c.get(ctx, "/zone", &resp); err != nil {
+		return nil, err
+	}
+
+	var zones []zone
+	for _, z := range resp.Zones.Zone {
+		if z.Public == "yes" {
+			zones = append(zones, z)
+		}
+	}
+
+	return zones, nil
+}
+
+// listPlanPrices lists the hourly prices (in cents) of the server plans by zone and plan
+func (c *client) listPlanPrices(ctx context.Context) (map[string]map[string]float64, error) {
+	var resp struct {
+		Prices struct {
+			Zone []map[string]json.RawMessage `json:"zone"`
+		} `json:"prices"`
+	}
+
+	if err := c.get(ctx, "/price", &resp); err != nil {
+		return nil, err
+	}
+
+	prices := make(map[string]map[string]float64, len(resp.Prices.Zone))
+	for _, items := range resp.Prices.Zone {
+		var zoneName string
+		if err := json.Unmarshal(items["name"], &zoneName); err != nil {
+			return nil, errors.WrapIf(err, "failed to decode upcloud zone prices")
+		}
+
+		prices[zoneName] = make(map[string]float64)
+		for key, raw := range items {
+			if !strings.HasPrefix(key, planPricePrefix) {
+				continue
+			}
+
+			var item struct {
+				Price float64 `json:"price"`
+			}
+			if err := json.Unmarshal(raw, &item); err != nil {
+				return nil, errors.WrapIfWithDetails(err, "failed to decode upcloud plan price", "zone", zoneName, "item", key)
+			}
+
+			prices[zoneName][strings.TrimPrefix(key, planPricePrefix)] = item.Price
+		}
+	}
+
+	return prices, nil
+}
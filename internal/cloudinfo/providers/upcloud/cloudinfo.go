@@ -0,0 +1,201 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upcloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+	"github.com/banzaicloud/cloudinfo/internal/platform/log"
+)
+
+const svcCompute = "compute"
+
+// UpCloudInfoer encapsulates the data and operations needed to access external UpCloud resources.
+type UpCloudInfoer struct {
+	client *client
+
+	logger cloudinfo.Logger
+}
+
+// NewUpCloudInfoer creates a new instance of the UpCloud infoer.
+func NewUpCloudInfoer(config Config, logger cloudinfo.Logger) (*UpCloudInfoer, error) {
+	if config.Username == "" || config.Password == "" {
+		return nil, errors.New("upcloud username and password are required")
+	}
+
+	return &UpCloudInfoer{
+		client: newClient(config),
+		logger: logger,
+	}, nil
+}
+
+// Initialize retrieves the prices of the plans in all zones
+func (i *UpCloudInfoer) Initialize() (map[string]map[string]types.Price, error) {
+	i.logger.Debug("initializing price info")
+
+	prices, err := i.client.listPlanPrices(context.Background())
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to list plan prices")
+	}
+
+	allPrices := make(map[string]map[string]types.Price, len(prices))
+	for zone, planPrices := range prices {
+		allPrices[zone] = make(map[string]types.Price, len(planPrices))
+		for plan, price := range planPrices {
+			allPrices[zone][plan] = types.Price{
+				OnDemandPrice: price / 100,
+			}
+		}
+	}
+
+	i.logger.Debug("finished initializing price info")
+	return allPrices, nil
+}
+
+// GetVirtualMachines retrieves the plans priced in the zone
+func (i *UpCloudInfoer) GetVirtualMachines(region string) ([]types.VMInfo, error) {
+	logger := log.WithFields(i.logger, map[string]interface{}{"region": region})
+	logger.Debug("getting product info")
+
+	ctx := context.Background()
+
+	plans, err := i.client.listPlans(ctx)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to list plans")
+	}
+
+	prices, err := i.client.listPlanPrices(ctx)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to list plan prices")
+	}
+
+	var virtualMachines []types.VMInfo
+	for _, p := range plans {
+		// the prices are in cents
+		price, ok := prices[region][p.Name]
+		if !ok {
+			continue
+		}
+
+		cpus := float64(p.CoreNumber)
+		mem := float64(p.MemoryAmount) / 1024
+		category := getCategory(p.Name, p.GPUAmount)
+
+		virtualMachines = append(virtualMachines, types.VMInfo{
+			Category:      category,
+			Type:          p.Name,
+			OnDemandPrice: price / 100,
+			Currency:      "EUR",
+			Cpus:          cpus,
+			Mem:           mem,
+			Gpus:          float64(p.GPUAmount),
+			NtwPerf:       "N/A",
+			NtwPerfCat:    types.NtwMedium,
+			Zones:         []string{region},
+			Attributes:    cloudinfo.Attributes(fmt.Sprint(cpus), fmt.Sprint(mem), types.NtwMedium, category),
+		})
+	}
+
+	logger.Debug("found plans", map[string]interface{}{"numberOfTypes": len(virtualMachines)})
+	return virtualMachines, nil
+}
+
+// GetProducts retrieves the available plans of the service in the zone
+func (i *UpCloudInfoer) GetProducts(vms []types.VMInfo, service, regionId string) ([]types.VMInfo, error) {
+	switch service {
+	case svcCompute:
+		if len(vms) > 0 {
+			return vms, nil
+		}
+
+		return i.GetVirtualMachines(regionId)
+	default:
+		return nil, errors.NewWithDetails("invalid service", "service", service)
+	}
+}
+
+// GetZones returns the zone itself, UpCloud zones are single datacenters exposed as regions
+func (*UpCloudInfoer) GetZones(region string) ([]string, error) {
+	return []string{region}, nil
+}
+
+// GetRegions retrieves the public zones
+func (i *UpCloudInfoer) GetRegions(service string) (map[string]string, error) {
+	if service != svcCompute {
+		return nil, errors.NewWithDetails("invalid service", "service", service)
+	}
+
+	zones, err := i.client.listZones(context.Background())
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to list zones")
+	}
+
+	regions := make(map[string]string, len(zones))
+	for _, z := range zones {
+		regions[z.ID] = z.Description
+	}
+
+	return regions, nil
+}
+
+// HasShortLivedPriceInfo signals that the UpCloud prices don't change frequently
+func (*UpCloudInfoer) HasShortLivedPriceInfo() bool {
+	return false
+}
+
+// GetCurrentPrices is not supported, UpCloud has no spot instances
+func (*UpCloudInfoer) GetCurrentPrices(region string) (map[string]types.Price, error) {
+	return nil, errors.New("GetCurrentPrices - not yet implemented")
+}
+
+// HasImages signals that images are not scraped for UpCloud
+func (*UpCloudInfoer) HasImages() bool {
+	return false
+}
+
+// GetServiceImages is not supported for UpCloud
+func (*UpCloudInfoer) GetServiceImages(service, region string) ([]types.Image, error) {
+	return nil, errors.New("GetServiceImages - not yet implemented")
+}
+
+// GetVersions returns no versions, the UpCloud Kubernetes Service versions are not scraped
+func (*UpCloudInfoer) GetVersions(service, region string) ([]types.LocationVersion, error) {
+	return []types.LocationVersion{}, nil
+}
+
+// GetServiceProducts is not supported for UpCloud
+func (*UpCloudInfoer) GetServiceProducts(region, service string) ([]types.ProductDetails, error) {
+	return nil, errors.New("GetServiceProducts - not yet implemented")
+}
+
+// getCategory maps the plan to a category by its prefix, eg.: HICPU-8xCPU-12GB or HIMEM-2xCPU-8GB
+func getCategory(name string, gpus int) string {
+	switch {
+	case gpus > 0, strings.HasPrefix(name, "GPU-"):
+		return types.CategoryGpu
+	case strings.HasPrefix(name, "HICPU-"):
+		return types.CategoryCompute
+	case strings.HasPrefix(name, "HIMEM-"):
+		return types.CategoryMemory
+	default:
+		return types.CategoryGeneral
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upcloud
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/cloudinfoadapter"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func newTestServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "user", username)
+		assert.Equal(t, "secret", password)
+
+		switch r.URL.Path {
+		case "/plan":
+			fmt.Fprint(w, `{"plans": {"plan": [
+				{"name": "1xCPU-1GB", "core_number": 1, "memory_amount": 1024, "storage_size": 25},
+				{"name": "HIMEM-2xCPU-8GB", "core_number": 2, "memory_amount": 8192, "storage_size": 100}]}}`)
+		case "/zone":
+			fmt.Fprint(w, `{"zones": {"zone": [
+				{"id": "de-fra1", "description": "Frankfurt #1", "public": "yes"},
+				{"id": "fi-hel2", "description": "Helsinki #2", "public": "yes"},
+				{"id": "fi-dev1", "description": "Private", "public": "no"}]}}`)
+		case "/price":
+			fmt.Fprint(w, `{"prices": {"zone": [
+				{"name": "de-fra1", "server_plan_1xCPU-1GB": {"amount": 1, "price": 0.75}, "server_plan_HIMEM-2xCPU-8GB": {"amount": 1, "price": 5}, "storage_maxiops": {"amount": 1, "price": 0.03}},
+				{"name": "fi-hel2", "server_plan_1xCPU-1GB": {"amount": 1, "price": 0.5}}]}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func newTestInfoer(t *testing.T, server *httptest.Server) *UpCloudInfoer {
+	infoer, err := NewUpCloudInfoer(Config{Username: "user", Password: "secret"}, cloudinfoadapter.NewNoopLogger())
+	assert.NoError(t, err)
+	infoer.client.baseURL = server.URL
+
+	return infoer
+}
+
+func TestUpCloudInfoer_Initialize(t *testing.T) {
+	server := newTestServer(t)
+	defer server.Close()
+
+	prices, err := newTestInfoer(t, server).Initialize()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]map[string]types.Price{
+		"de-fra1": {
+			"1xCPU-1GB":       {OnDemandPrice: 0.0075},
+			"HIMEM-2xCPU-8GB": {OnDemandPrice: 0.05},
+		},
+		"fi-hel2": {
+			"1xCPU-1GB": {OnDemandPrice: 0.005},
+		},
+	}, prices)
+}
+
+func TestUpCloudInfoer_GetRegions(t *testing.T) {
+	server := newTestServer(t)
+	defer server.Close()
+
+	regions, err := newTestInfoer(t, server).GetRegions("compute")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"de-fra1": "Frankfurt #1", "fi-hel2": "Helsinki #2"}, regions)
+}
+
+func TestUpCloudInfoer_GetVirtualMachines(t *testing.T) {
+	server := newTestServer(t)
+	defer server.Close()
+
+	vms, err := newTestInfoer(t, server).GetVirtualMachines("fi-hel2")
+	assert.NoError(t, err)
+	assert.Equal(t, []types.VMInfo{
+		{
+			Category:      types.CategoryGeneral,
+			Type:          "1xCPU-1GB",
+			OnDemandPrice: 0.005,
+			Currency:      "EUR",
+			Cpus:          1,
+			Mem:           1,
+			NtwPerf:       "N/A",
+			NtwPerfCat:    types.NtwMedium,
+			Zones:         []string{"fi-hel2"},
+			Attributes:    map[string]string{"cpu": "1", "memory": "1", "networkPerfCategory": types.NtwMedium, "instanceTypeCategory": types.CategoryGeneral},
+		},
+	}, vms)
+}
@@ -0,0 +1,147 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vultr
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/restapi"
+)
+
+const (
+	apiURL = "https://api.vultr.com/v2"
+
+	// billedHours is the number of hours of a month the instances are billed for at most
+	billedHours = 672
+)
+
+// plan is an instance plan of the Vultr API
+type plan struct {
+	ID          string   `json:"id"`
+	VCPUCount   int      `json:"vcpu_count"`
+	RAM         int      `json:"ram"`
+	Disk        int      `json:"disk"`
+	MonthlyCost float64  `json:"monthly_cost"`
+	HourlyCost  float64  `json:"hourly_cost"`
+	Type        string   `json:"type"`
+	Locations   []string `json:"locations"`
+	GPUType     string   `json:"gpu_type"`
+}
+
+// region is a region of the Vultr API
+type region struct {
+	ID      string `json:"id"`
+	City    string `json:"city"`
+	Country string `json:"country"`
+}
+
+// meta is the pagination metadata of the Vultr API listings
+type meta struct {
+	Links struct {
+		Next string `json:"next"`
+	} `json:"links"`
+}
+
+// client is a minimal client of the Vultr API
+type client struct {
+	api     *restapi.Client
+	baseURL string
+	apiKey  string
+}
+
+func newClient(config Config) *client {
+	return &client{
+		api:     restapi.NewClient("vultr", config.UserAgent),
+		baseURL: apiURL,
+		apiKey:  config.APIKey,
+	}
+}
+
+// get retrieves a resource of the api and decodes it into the given value
+func (c *client) get(ctx context.Context, path string, query url.Values, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s%s?%s", c.baseURL, path, query.Encode()), nil)
+	if err != nil {
+		return errors.WrapIf(err, "failed to create request")
+	}
+
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+c.apiKey)
+	}
+
+	return c.api.Do(req, v)
+}
+
+// listPlans lists the instance plans
+func (c *client) listPlans(ctx context.Context) ([]plan, error) {
+	var plans []plan
+	query := url.Values{"per_page": {"500"}}
+	for {
+		var resp struct {
+			Plans []plan `json:"plans"`
+			Meta  meta   `json:"meta"`
+		}
+
+		if err := c.get(ctx, "/plans", query, &resp); err != nil {
+			return nil, err
+		}
+
+		plans = append(plans, resp.Plans...)
+
+		if resp.Meta.Links.Next == "" {
+			return plans, nil
+		}
+		query.Set("cursor", resp.Meta.Links.Next)
+	}
+}
+
+// listRegions lists the regions
+func (c *client) listRegions(ctx context.Context) ([]region, error) {
+	var resp struct {
+		Regions []region `json:"regions"`
+	}
+
+	if err := c.get(ctx, "/regions", url.Values{"per_page": {"500"}}, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Regions, nil
+}
+
+// listKubernetesVersions lists the Kubernetes versions supported by VKE
+func (c *client) listKubernetesVersions(ctx context.Context) ([]string, error) {
+	var resp struct {
+		Versions []string `json:"versions"`
+	}
+
+	if err := c.get(ctx, "/kubernetes/versions", url.Values{}, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Versions, nil
+}
+
+// hourlyPrice returns the hourly price of the plan, derived from the monthly price if not available
+func (p plan) hourlyPrice() float64 {
+	if p.HourlyCost > 0 {
+		return p.HourlyCost
+	}
+
+	return p.MonthlyCost / billedHours
+}
@@ -0,0 +1,206 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vultr
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+	"github.com/banzaicloud/cloudinfo/internal/platform/log"
+)
+
+const (
+	svcCompute = "compute"
+	svcVke     = "vke"
+)
+
+// VultrInfoer encapsulates the data and operations needed to access external Vultr resources.
+type VultrInfoer struct {
+	client *client
+
+	logger cloudinfo.Logger
+}
+
+// NewVultrInfoer creates a new instance of the Vultr infoer.
+func NewVultrInfoer(config Config, logger cloudinfo.Logger) (*VultrInfoer, error) {
+	return &VultrInfoer{
+		client: newClient(config),
+		logger: logger,
+	}, nil
+}
+
+// Initialize retrieves the prices of the plans in all regions
+func (i *VultrInfoer) Initialize() (map[string]map[string]types.Price, error) {
+	i.logger.Debug("initializing price info")
+
+	plans, err := i.client.listPlans(context.Background())
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to list plans")
+	}
+
+	allPrices := make(map[string]map[string]types.Price)
+	for _, p := range plans {
+		for _, location := range p.Locations {
+			if allPrices[location] == nil {
+				allPrices[location] = make(map[string]types.Price)
+			}
+
+			allPrices[location][p.ID] = types.Price{
+				OnDemandPrice: p.hourlyPrice(),
+			}
+		}
+	}
+
+	i.logger.Debug("finished initializing price info")
+	return allPrices, nil
+}
+
+// GetVirtualMachines retrieves the plans available in the region
+func (i *VultrInfoer) GetVirtualMachines(region string) ([]types.VMInfo, error) {
+	logger := log.WithFields(i.logger, map[string]interface{}{"region": region})
+	logger.Debug("getting product info")
+
+	plans, err := i.client.listPlans(context.Background())
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to list plans")
+	}
+
+	var virtualMachines []types.VMInfo
+	for _, p := range plans {
+		if !cloudinfo.Contains(p.Locations, region) {
+			continue
+		}
+
+		category := getCategory(p.ID, p.GPUType)
+		mem := float64(p.RAM) / 1024
+
+		virtualMachines = append(virtualMachines, types.VMInfo{
+			Category:      category,
+			Type:          p.ID,
+			OnDemandPrice: p.hourlyPrice(),
+			MonthlyPrice:  p.MonthlyCost,
+			Cpus:          float64(p.VCPUCount),
+			Mem:           mem,
+			NtwPerf:       "N/A",
+			NtwPerfCat:    types.NtwMedium,
+			Zones:         []string{},
+			Burst:         isShared(p.Type),
+			Attributes:    cloudinfo.Attributes(fmt.Sprint(p.VCPUCount), fmt.Sprint(mem), types.NtwMedium, category),
+		})
+	}
+
+	logger.Debug("found plans", map[string]interface{}{"numberOfTypes": len(virtualMachines)})
+	return virtualMachines, nil
+}
+
+// GetProducts retrieves the available virtual machines of the service in the region
+func (i *VultrInfoer) GetProducts(vms []types.VMInfo, service, regionId string) ([]types.VMInfo, error) {
+	switch service {
+	case svcCompute, svcVke:
+		return i.GetVirtualMachines(regionId)
+	default:
+		return nil, errors.NewWithDetails("invalid service", "service", service)
+	}
+}
+
+// GetZones returns the availability zones of the region, Vultr has no zones
+func (*VultrInfoer) GetZones(region string) ([]string, error) {
+	return []string{}, nil
+}
+
+// GetRegions retrieves the regions of the service
+func (i *VultrInfoer) GetRegions(service string) (map[string]string, error) {
+	if service != svcCompute && service != svcVke {
+		return nil, errors.NewWithDetails("invalid service", "service", service)
+	}
+
+	regions, err := i.client.listRegions(context.Background())
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to list regions")
+	}
+
+	regionMap := make(map[string]string, len(regions))
+	for _, r := range regions {
+		regionMap[r.ID] = r.City
+	}
+
+	return regionMap, nil
+}
+
+// HasShortLivedPriceInfo signals that the Vultr prices don't change frequently
+func (*VultrInfoer) HasShortLivedPriceInfo() bool {
+	return false
+}
+
+// GetCurrentPrices is not supported, Vultr has no short lived prices
+func (*VultrInfoer) GetCurrentPrices(region string) (map[string]types.Price, error) {
+	return nil, errors.New("GetCurrentPrices - not yet implemented")
+}
+
+// HasImages signals that images are not scraped for Vultr
+func (*VultrInfoer) HasImages() bool {
+	return false
+}
+
+// GetServiceImages is not supported for Vultr
+func (*VultrInfoer) GetServiceImages(service, region string) ([]types.Image, error) {
+	return nil, errors.New("GetServiceImages - not yet implemented")
+}
+
+// GetVersions retrieves the Kubernetes versions supported by VKE
+func (i *VultrInfoer) GetVersions(service, region string) ([]types.LocationVersion, error) {
+	switch service {
+	case svcVke:
+		versions, err := i.client.listKubernetesVersions(context.Background())
+		if err != nil {
+			return nil, errors.WrapIf(err, "failed to list kubernetes versions")
+		}
+
+		return []types.LocationVersion{types.NewLocationVersion(region, versions, "")}, nil
+	default:
+		return []types.LocationVersion{}, nil
+	}
+}
+
+// GetServiceProducts is not supported for Vultr
+func (*VultrInfoer) GetServiceProducts(region, service string) ([]types.ProductDetails, error) {
+	return nil, errors.New("GetServiceProducts - not yet implemented")
+}
+
+// getCategory maps the plan to an instance type category
+func getCategory(id, gpuType string) string {
+	switch {
+	case gpuType != "", strings.HasPrefix(id, "vcg-"):
+		return types.CategoryGpu
+	case strings.HasPrefix(id, "voc-c-"):
+		return types.CategoryCompute
+	case strings.HasPrefix(id, "voc-m-"):
+		return types.CategoryMemory
+	case strings.HasPrefix(id, "voc-s-"):
+		return types.CategoryStorage
+	default:
+		return types.CategoryGeneral
+	}
+}
+
+// isShared signals whether the plan type has shared (burstable) cpus
+func isShared(planType string) bool {
+	return planType == "vc2" || planType == "vhf" || planType == "vhp"
+}
@@ -0,0 +1,150 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vultr
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/cloudinfoadapter"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func newTestServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+
+		switch r.URL.Path {
+		case "/plans":
+			switch r.URL.Query().Get("cursor") {
+			case "":
+				fmt.Fprint(w, `{"plans": [
+					{"id": "vc2-1c-1gb", "vcpu_count": 1, "ram": 1024, "monthly_cost": 5, "type": "vc2", "locations": ["ewr", "ams"]},
+					{"id": "voc-c-2c-4gb-50s", "vcpu_count": 2, "ram": 4096, "monthly_cost": 40, "hourly_cost": 0.06, "type": "voc", "locations": ["ams"]}],
+					"meta": {"links": {"next": "page2"}}}`)
+			case "page2":
+				fmt.Fprint(w, `{"plans": [
+					{"id": "vcg-a100-1c-6g-4vram", "vcpu_count": 1, "ram": 6144, "monthly_cost": 90, "type": "vcg", "locations": ["ewr"], "gpu_type": "NVIDIA_A100"}],
+					"meta": {"links": {"next": ""}}}`)
+			default:
+				w.WriteHeader(http.StatusBadRequest)
+			}
+		case "/regions":
+			fmt.Fprint(w, `{"regions": [{"id": "ewr", "city": "New Jersey", "country": "US"}, {"id": "ams", "city": "Amsterdam", "country": "NL"}]}`)
+		case "/kubernetes/versions":
+			fmt.Fprint(w, `{"versions": ["v1.21.3+1", "v1.20.9+1"]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func newTestInfoer(t *testing.T, server *httptest.Server) *VultrInfoer {
+	infoer, err := NewVultrInfoer(Config{APIKey: "secret"}, cloudinfoadapter.NewNoopLogger())
+	assert.NoError(t, err)
+	infoer.client.baseURL = server.URL
+
+	return infoer
+}
+
+func TestVultrInfoer_Initialize(t *testing.T) {
+	server := newTestServer(t)
+	defer server.Close()
+
+	prices, err := newTestInfoer(t, server).Initialize()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]map[string]types.Price{
+		"ewr": {
+			"vc2-1c-1gb":           {OnDemandPrice: 5.0 / billedHours},
+			"vcg-a100-1c-6g-4vram": {OnDemandPrice: 90.0 / billedHours},
+		},
+		"ams": {
+			"vc2-1c-1gb":       {OnDemandPrice: 5.0 / billedHours},
+			"voc-c-2c-4gb-50s": {OnDemandPrice: 0.06},
+		},
+	}, prices)
+}
+
+func TestVultrInfoer_GetVirtualMachines(t *testing.T) {
+	server := newTestServer(t)
+	defer server.Close()
+
+	vms, err := newTestInfoer(t, server).GetVirtualMachines("ams")
+	assert.NoError(t, err)
+	if assert.Len(t, vms, 2, "only the plans of the region should be returned") {
+		assert.Equal(t, "vc2-1c-1gb", vms[0].Type)
+		assert.Equal(t, types.CategoryGeneral, vms[0].Category)
+		assert.Equal(t, 1.0, vms[0].Mem)
+		assert.Equal(t, 5.0, vms[0].MonthlyPrice)
+		assert.True(t, vms[0].Burst)
+
+		assert.Equal(t, "voc-c-2c-4gb-50s", vms[1].Type)
+		assert.Equal(t, types.CategoryCompute, vms[1].Category)
+		assert.Equal(t, 0.06, vms[1].OnDemandPrice)
+		assert.False(t, vms[1].Burst)
+	}
+
+	vms, err = newTestInfoer(t, server).GetVirtualMachines("ewr")
+	assert.NoError(t, err)
+	if assert.Len(t, vms, 2) {
+		assert.Equal(t, types.CategoryGpu, vms[1].Category)
+	}
+}
+
+func TestVultrInfoer_GetRegions(t *testing.T) {
+	server := newTestServer(t)
+	defer server.Close()
+
+	infoer := newTestInfoer(t, server)
+
+	regions, err := infoer.GetRegions(svcVke)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"ewr": "New Jersey", "ams": "Amsterdam"}, regions)
+
+	_, err = infoer.GetRegions("unknown")
+	assert.Error(t, err)
+}
+
+func TestVultrInfoer_GetVersions(t *testing.T) {
+	server := newTestServer(t)
+	defer server.Close()
+
+	infoer := newTestInfoer(t, server)
+
+	versions, err := infoer.GetVersions(svcVke, "ams")
+	assert.NoError(t, err)
+	assert.Equal(t, []types.LocationVersion{types.NewLocationVersion("ams", []string{"v1.21.3+1", "v1.20.9+1"}, "")}, versions)
+
+	versions, err = infoer.GetVersions(svcCompute, "ams")
+	assert.NoError(t, err)
+	assert.Empty(t, versions)
+}
+
+func TestClient_get(t *testing.T) {
+	server := newTestServer(t)
+	defer server.Close()
+
+	c := newClient(Config{APIKey: "secret"})
+	c.baseURL = server.URL
+
+	var v struct{}
+	assert.Error(t, c.get(context.Background(), "/unknown", url.Values{}, &v), "an unexpected status should fail")
+}
@@ -0,0 +1,218 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yandex
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/providers/restapi"
+)
+
+const (
+	iamURL     = "https://iam.api.cloud.yandex.net/iam/v1/tokens"
+	computeURL = "https://compute.api.cloud.yandex.net/compute/v1"
+	billingURL = "https://billing.api.cloud.yandex.net/billing/v1"
+	mk8sURL    = "https://mks.api.cloud.yandex.net/managed-kubernetes/v1"
+)
+
+// zone is an availability zone of the Yandex Cloud compute API
+type zone struct {
+	ID       string `json:"id"`
+	RegionID string `json:"regionId"`
+	Status   string `json:"status"`
+}
+
+// sku is a billable unit of the Yandex Cloud billing API
+type sku struct {
+	ID              string `json:"id"`
+	Name            string `json:"name"`
+	PricingUnit     string `json:"pricingUnit"`
+	PricingVersions []struct {
+		Type               string    `json:"type"`
+		EffectiveTime      time.Time `json:"effectiveTime"`
+		PricingExpressions []struct {
+			Rates []struct {
+				StartPricingQuantity string `json:"startPricingQuantity"`
+				UnitPrice            string `json:"unitPrice"`
+				Currency             string `json:"currency"`
+			} `json:"rates"`
+		} `json:"pricingExpressions"`
+	} `json:"pricingVersions"`
+}
+
+// unitPrice returns the price of the first rate of the latest effective street price version of the sku
+func (s sku) unitPrice(now time.Time) (float64, bool) {
+	var (
+		price     float64
+		found     bool
+		effective time.Time
+	)
+	for _, version := range s.PricingVersions {
+		if version.Type != "STREET_PRICE" || version.EffectiveTime.After(now) || version.EffectiveTime.Before(effective) {
+			continue
+		}
+
+		for _, expression := range version.PricingExpressions {
+			if len(expression.Rates) == 0 {
+				continue
+			}
+
+			value, err := strconv.ParseFloat(expression.Rates[0].UnitPrice, 64)
+			if err != nil {
+				continue
+			}
+
+			price, found, effective = value, true, version.EffectiveTime
+			break
+		}
+	}
+
+	return price, found
+}
+
+// client is a minimal client of the Yandex Cloud APIs
+type client struct {
+	api        *restapi.Client
+	iamURL     string
+	computeURL string
+	billingURL string
+	mk8sURL    string
+	oauthToken string
+
+	token       string
+	tokenExpiry time.Time
+	tokenMu     sync.Mutex
+}
+
+func newClient(config Config) *client {
+	return &client{
+		api:        restapi.NewClient("yandex cloud", config.UserAgent),
+		iamURL:     iamURL,
+		computeURL: computeURL,
+		billingURL: billingURL,
+		mk8sURL:    mk8sURL,
+		oauthToken: config.OAuthToken,
+	}
+}
+
+// iamToken exchanges the OAuth token for an IAM token, reused until it's about to expire
+func (c *client) iamToken(ctx context.Context) (string, error) {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	if c.token != "" && time.Now().Before(c.tokenExpiry) {
+		return c.token, nil
+	}
+
+	body, err := json.Marshal(map[string]string{"yandexPassportOauthToken": c.oauthToken})
+	if err != nil {
+		return "", errors.WrapIf(err, "failed to encode token request")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.iamURL, bytes.NewReader(body))
+	if err != nil {
+		return "", errors.WrapIf(err, "failed to create request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	var resp struct {
+		IAMToken  string    `json:"iamToken"`
+		ExpiresAt time.Time `json:"expiresAt"`
+	}
+	if err := c.api.Do(req, &resp); err != nil {
+		return "", errors.WrapIf(err, "failed to retrieve iam token")
+	}
+
+	c.token = resp.IAMToken
+	// renew the token a minute before it expires
+	c.tokenExpiry = resp.ExpiresAt.Add(-time.Minute)
+
+	return c.token, nil
+}
+
+// get retrieves a resource of an api and decodes it into the given value
+func (c *client) get(ctx context.Context, baseURL, path string, query url.Values, v interface{}) error {
+	token, err := c.iamToken(ctx)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s%s?%s", baseURL, path, query.Encode()), nil)
+	if err != nil {
+		return errors.WrapIf(err, "failed to create request")
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	return c.api.Do(req, v)
+}
+
+// listZones lists the availability zones
+func (c *client) listZones(ctx context.Context) ([]zone, error) {
+	var resp struct {
+		Zones []zone `json:"zones"`
+	}
+
+	if err := c.get(ctx, c.computeURL, "/zones", url.Values{}, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Zones, nil
+}
+
+// listSKUs lists the skus priced in the currency, following the page tokens
+func (c *client) listSKUs(ctx context.Context, currency string) ([]sku, error) {
+	var skus []sku
+	query := url.Values{"currency": {currency}, "pageSize": {"1000"}}
+	for {
+		var resp struct {
+			SKUs          []sku  `json:"skus"`
+			NextPageToken string `json:"nextPageToken"`
+		}
+
+		if err := c.get(ctx, c.billingURL, "/skus", query, &resp); err != nil {
+			return nil, err
+		}
+
+		skus = append(skus, resp.SKUs...)
+
+		if resp.NextPageToken == "" {
+			return skus, nil
+		}
+		query.Set("pageToken", resp.NextPageToken)
+	}
+}
+
+// listKubernetesVersions lists the Kubernetes versions supported by Managed Service for Kubernetes
+func (c *client) listKubernetesVersions(ctx context.Context) ([]string, error) {
+	var resp struct {
+		AvailableVersions []string `json:"availableVersions"`
+	}
+
+	if err := c.get(ctx, c.mk8sURL, "/versions", url.Values{}, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.AvailableVersions, nil
+}
@@ -0,0 +1,261 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+	"github.com/banzaicloud/cloudinfo/internal/platform/log"
+)
+
+const (
+	svcCompute = "compute"
+	svcMk8s    = "mk8s"
+)
+
+var regionNames = map[string]string{
+	"ru-central1": "Russia Central",
+}
+
+// YandexInfoer encapsulates the data and operations needed to access external Yandex Cloud resources.
+type YandexInfoer struct {
+	client   *client
+	currency string
+
+	logger cloudinfo.Logger
+}
+
+// NewYandexInfoer creates a new instance of the Yandex Cloud infoer.
+func NewYandexInfoer(config Config, logger cloudinfo.Logger) (*YandexInfoer, error) {
+	if config.OAuthToken == "" {
+		return nil, errors.New("yandex cloud oauth token is required")
+	}
+
+	currency := strings.ToUpper(config.Currency)
+	if currency == "" {
+		currency = "RUB"
+	}
+
+	return &YandexInfoer{
+		client:   newClient(config),
+		currency: currency,
+		logger:   logger,
+	}, nil
+}
+
+// Initialize retrieves the prices of the instance types in all regions
+func (i *YandexInfoer) Initialize() (map[string]map[string]types.Price, error) {
+	i.logger.Debug("initializing price info")
+
+	regions, err := i.GetRegions(svcCompute)
+	if err != nil {
+		return nil, err
+	}
+
+	allPrices := make(map[string]map[string]types.Price, len(regions))
+	for region := range regions {
+		vms, err := i.GetVirtualMachines(region)
+		if err != nil {
+			return nil, err
+		}
+
+		allPrices[region] = make(map[string]types.Price, len(vms))
+		for _, vm := range vms {
+			allPrices[region][vm.Type] = types.Price{
+				OnDemandPrice: vm.OnDemandPrice,
+			}
+		}
+	}
+
+	i.logger.Debug("finished initializing price info")
+	return allPrices, nil
+}
+
+// GetVirtualMachines prices the shapes of the platforms, the prices are the same in all regions
+func (i *YandexInfoer) GetVirtualMachines(region string) ([]types.VMInfo, error) {
+	logger := log.WithFields(i.logger, map[string]interface{}{"region": region})
+	logger.Debug("getting product info")
+
+	skus, err := i.client.listSKUs(context.Background(), i.currency)
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to list skus")
+	}
+
+	zones, err := i.GetZones(region)
+	if err != nil {
+		return nil, err
+	}
+
+	prices := getPlatformPrices(skus, time.Now())
+
+	var virtualMachines []types.VMInfo
+	for _, p := range platforms {
+		pp := prices[p.ID]
+		for _, s := range p.shapes() {
+			corePrice, ok := pp.Cores[s.Fraction]
+			if !ok || pp.Memory == 0 {
+				logger.Debug("no price found for instance type", map[string]interface{}{"instanceType": s.name()})
+				continue
+			}
+
+			category := getCategory(s)
+			cpus, mem := float64(s.Cores), float64(s.Memory)
+
+			virtualMachines = append(virtualMachines, types.VMInfo{
+				Category:      category,
+				Type:          s.name(),
+				OnDemandPrice: cpus*corePrice + mem*pp.Memory,
+				Currency:      i.currency,
+				Cpus:          cpus,
+				Mem:           mem,
+				NtwPerf:       "N/A",
+				NtwPerfCat:    types.NtwMedium,
+				Zones:         zones,
+				Burst:         s.Fraction < 100,
+				BaselineCPU:   baselineCPU(s),
+				Attributes:    cloudinfo.Attributes(fmt.Sprint(cpus), fmt.Sprint(mem), types.NtwMedium, category),
+			})
+		}
+	}
+
+	logger.Debug("found instance types", map[string]interface{}{"numberOfTypes": len(virtualMachines)})
+	return virtualMachines, nil
+}
+
+// GetProducts retrieves the available instance types of the service in the region
+func (i *YandexInfoer) GetProducts(vms []types.VMInfo, service, regionId string) ([]types.VMInfo, error) {
+	switch service {
+	case svcCompute, svcMk8s:
+		if len(vms) > 0 {
+			return vms, nil
+		}
+
+		return i.GetVirtualMachines(regionId)
+	default:
+		return nil, errors.NewWithDetails("invalid service", "service", service)
+	}
+}
+
+// GetZones retrieves the availability zones of the region that are up
+func (i *YandexInfoer) GetZones(region string) ([]string, error) {
+	zones, err := i.client.listZones(context.Background())
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to list zones")
+	}
+
+	zoneIDs := make([]string, 0, len(zones))
+	for _, z := range zones {
+		if z.RegionID == region && z.Status == "UP" {
+			zoneIDs = append(zoneIDs, z.ID)
+		}
+	}
+	sort.Strings(zoneIDs)
+
+	return zoneIDs, nil
+}
+
+// GetRegions retrieves the regions of the availability zones
+func (i *YandexInfoer) GetRegions(service string) (map[string]string, error) {
+	if service != svcCompute && service != svcMk8s {
+		return nil, errors.NewWithDetails("invalid service", "service", service)
+	}
+
+	zones, err := i.client.listZones(context.Background())
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to list zones")
+	}
+
+	regions := make(map[string]string)
+	for _, z := range zones {
+		name, ok := regionNames[z.RegionID]
+		if !ok {
+			name = z.RegionID
+		}
+		regions[z.RegionID] = name
+	}
+
+	return regions, nil
+}
+
+// HasShortLivedPriceInfo signals that the Yandex Cloud prices don't change frequently
+func (*YandexInfoer) HasShortLivedPriceInfo() bool {
+	return false
+}
+
+// GetCurrentPrices is not supported, the preemptible prices are not scraped
+func (*YandexInfoer) GetCurrentPrices(region string) (map[string]types.Price, error) {
+	return nil, errors.New("GetCurrentPrices - not yet implemented")
+}
+
+// HasImages signals that images are not scraped for Yandex Cloud
+func (*YandexInfoer) HasImages() bool {
+	return false
+}
+
+// GetServiceImages is not supported for Yandex Cloud
+func (*YandexInfoer) GetServiceImages(service, region string) ([]types.Image, error) {
+	return nil, errors.New("GetServiceImages - not yet implemented")
+}
+
+// GetVersions retrieves the Kubernetes versions supported by Managed Service for Kubernetes
+func (i *YandexInfoer) GetVersions(service, region string) ([]types.LocationVersion, error) {
+	switch service {
+	case svcMk8s:
+		versions, err := i.client.listKubernetesVersions(context.Background())
+		if err != nil {
+			return nil, errors.WrapIf(err, "failed to list kubernetes versions")
+		}
+
+		return []types.LocationVersion{types.NewLocationVersion(region, versions, "")}, nil
+	default:
+		return []types.LocationVersion{}, nil
+	}
+}
+
+// GetServiceProducts is not supported for Yandex Cloud
+func (*YandexInfoer) GetServiceProducts(region, service string) ([]types.ProductDetails, error) {
+	return nil, errors.New("GetServiceProducts - not yet implemented")
+}
+
+// getCategory categorizes a shape by its memory per core, the burstable shapes are general purpose
+func getCategory(s shape) string {
+	switch memoryPerCore := s.Memory / s.Cores; {
+	case s.Fraction < 100:
+		return types.CategoryGeneral
+	case memoryPerCore >= 8:
+		return types.CategoryMemory
+	case memoryPerCore <= 1:
+		return types.CategoryCompute
+	default:
+		return types.CategoryGeneral
+	}
+}
+
+// baselineCPU returns the guaranteed cpu performance of a burstable shape in percent of a vCPU
+func baselineCPU(s shape) float64 {
+	if s.Fraction < 100 {
+		return float64(s.Fraction)
+	}
+
+	return 0
+}
@@ -0,0 +1,126 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yandex
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/cloudinfoadapter"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// testSKU returns a sku with a single street price version
+func testSKU(name, price string, effective time.Time) string {
+	return fmt.Sprintf(`{"name": %q, "pricingVersions": [{"type": "STREET_PRICE", "effectiveTime": %q,
+		"pricingExpressions": [{"rates": [{"startPricingQuantity": "0", "unitPrice": %q, "currency": "RUB"}]}]}]}`,
+		name, effective.Format(time.RFC3339), price)
+}
+
+func TestYandexInfoer_GetVirtualMachines(t *testing.T) {
+	past := time.Now().Add(-24 * time.Hour)
+
+	var tokenRequests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/iam" {
+			tokenRequests++
+
+			var body map[string]string
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "oauth", body["yandexPassportOauthToken"])
+
+			fmt.Fprintf(w, `{"iamToken": "iam", "expiresAt": %q}`, time.Now().Add(time.Hour).Format(time.RFC3339))
+			return
+		}
+
+		assert.Equal(t, "Bearer iam", r.Header.Get("Authorization"))
+
+		switch r.URL.Path {
+		case "/compute/zones":
+			fmt.Fprint(w, `{"zones": [
+				{"id": "ru-central1-b", "regionId": "ru-central1", "status": "UP"},
+				{"id": "ru-central1-a", "regionId": "ru-central1", "status": "UP"},
+				{"id": "ru-central1-c", "regionId": "ru-central1", "status": "DOWN"}]}`)
+		case "/billing/skus":
+			assert.Equal(t, "RUB", r.URL.Query().Get("currency"))
+
+			if r.URL.Query().Get("pageToken") == "" {
+				fmt.Fprintf(w, `{"skus": [%s, %s], "nextPageToken": "next"}`,
+					testSKU("Intel Ice Lake. 100% vCPU", "1.00", past),
+					testSKU("Intel Ice Lake. 100% vCPU. Preemptible instance", "0.30", past))
+				return
+			}
+
+			fmt.Fprintf(w, `{"skus": [%s, %s, %s]}`,
+				testSKU("Intel Ice Lake. RAM", "0.25", past),
+				testSKU("Intel Ice Lake. 20% vCPU", "0.40", past),
+				testSKU("Intel Ice Lake. 50% vCPU", "0.70", time.Now().Add(24*time.Hour)))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	infoer, err := NewYandexInfoer(Config{OAuthToken: "oauth"}, cloudinfoadapter.NewNoopLogger())
+	require.NoError(t, err)
+	infoer.client.iamURL = server.URL + "/iam"
+	infoer.client.computeURL = server.URL + "/compute"
+	infoer.client.billingURL = server.URL + "/billing"
+
+	vms, err := infoer.GetVirtualMachines("ru-central1")
+	require.NoError(t, err)
+	assert.Equal(t, 1, tokenRequests, "the iam token should be reused")
+
+	vmsByType := make(map[string]types.VMInfo, len(vms))
+	for _, vm := range vms {
+		vmsByType[vm.Type] = vm
+	}
+	assert.Len(t, vmsByType, 20+3, "the full core and the priced burstable shapes of standard-v3 should be listed")
+
+	vm := vmsByType["standard-v3-c4-m16"]
+	assert.Equal(t, 4*1.00+16*0.25, vm.OnDemandPrice, "the on demand (not preemptible) prices should be used")
+	assert.Equal(t, "RUB", vm.Currency)
+	assert.Equal(t, []string{"ru-central1-a", "ru-central1-b"}, vm.Zones)
+	assert.Equal(t, types.CategoryGeneral, vm.Category)
+	assert.False(t, vm.Burst)
+
+	vm = vmsByType["standard-v3-c2-m2-cf20"]
+	assert.Equal(t, 2*0.40+2*0.25, vm.OnDemandPrice)
+	assert.True(t, vm.Burst)
+	assert.Equal(t, 20.0, vm.BaselineCPU)
+
+	assert.NotContains(t, vmsByType, "standard-v3-c2-m2-cf50", "prices effective in the future should be ignored")
+	assert.Equal(t, types.CategoryMemory, vmsByType["standard-v3-c2-m16"].Category)
+
+	regions, err := infoer.GetRegions(svcMk8s)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"ru-central1": "Russia Central"}, regions)
+}
+
+func TestPlatform_shapes(t *testing.T) {
+	shapes := platform{ID: "standard-v2", Fractions: []int{5, 100}}.shapes()
+
+	assert.Len(t, shapes, 3+20)
+	assert.Equal(t, "standard-v2-c2-m1-cf5", shapes[0].name())
+	assert.Equal(t, "standard-v2-c2-m2", shapes[3].name())
+	assert.Equal(t, "standard-v2-c32-m256", shapes[len(shapes)-1].name())
+}
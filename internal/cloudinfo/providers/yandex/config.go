@@ -0,0 +1,26 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yandex
+
+// Config holds the configuration of the Yandex Cloud provider
+type Config struct {
+	// OAuthToken is a Yandex account OAuth token, exchanged for IAM tokens
+	OAuthToken string
+
+	// Currency is the currency of the prices (RUB, USD or KZT)
+	Currency string
+
+	UserAgent string
+}
@@ -0,0 +1,116 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yandex
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// platform is a compute platform, the cores and memory of the instances are freely configurable on it
+type platform struct {
+	ID string
+	// CPU is the processor of the platform as named in the skus (eg.: Intel Ice Lake. 100% vCPU)
+	CPU string
+	// Fractions are the guaranteed vCPU shares (%) the platform supports, 100 is a full core
+	Fractions []int
+}
+
+var platforms = []platform{
+	{ID: "standard-v1", CPU: "Intel Broadwell", Fractions: []int{5, 20, 100}},
+	{ID: "standard-v2", CPU: "Intel Cascade Lake", Fractions: []int{5, 20, 50, 100}},
+	{ID: "standard-v3", CPU: "Intel Ice Lake", Fractions: []int{20, 50, 100}},
+}
+
+// shape is an instance configuration of a platform, exposed as an instance type
+type shape struct {
+	Platform string
+	Cores    int
+	Memory   int
+	Fraction int
+}
+
+// name returns the instance type name of the shape (eg.: standard-v3-c4-m16, standard-v3-c2-m2-cf20)
+func (s shape) name() string {
+	name := fmt.Sprintf("%s-c%d-m%d", s.Platform, s.Cores, s.Memory)
+	if s.Fraction < 100 {
+		name += fmt.Sprintf("-cf%d", s.Fraction)
+	}
+
+	return name
+}
+
+// shapes returns the instance configurations exposed for the platform: full cores with 1-8 GiB memory per core,
+// and two burstable cores with 1-4 GiB memory for each partial core fraction
+func (p platform) shapes() []shape {
+	var shapes []shape
+	for _, fraction := range p.Fractions {
+		if fraction < 100 {
+			for _, memory := range []int{1, 2, 4} {
+				shapes = append(shapes, shape{Platform: p.ID, Cores: 2, Memory: memory, Fraction: fraction})
+			}
+			continue
+		}
+
+		for _, cores := range []int{2, 4, 8, 16, 32} {
+			for _, memoryPerCore := range []int{1, 2, 4, 8} {
+				shapes = append(shapes, shape{Platform: p.ID, Cores: cores, Memory: cores * memoryPerCore, Fraction: fraction})
+			}
+		}
+	}
+
+	return shapes
+}
+
+// platformPrices are the hourly prices of a core by fraction and of a GiB of memory of a platform
+type platformPrices struct {
+	Cores  map[int]float64
+	Memory float64
+}
+
+// getPlatformPrices matches the on demand skus to the platforms by their names, the preemptible skus are skipped
+func getPlatformPrices(skus []sku, now time.Time) map[string]platformPrices {
+	prices := make(map[string]platformPrices, len(platforms))
+	for _, p := range platforms {
+		pp := platformPrices{Cores: make(map[int]float64)}
+		for _, s := range skus {
+			if !strings.HasPrefix(s.Name, p.CPU+".") || strings.Contains(strings.ToLower(s.Name), "preemptible") {
+				continue
+			}
+
+			price, ok := s.unitPrice(now)
+			if !ok {
+				continue
+			}
+
+			resource := strings.TrimSpace(strings.TrimPrefix(s.Name, p.CPU+"."))
+			if resource == "RAM" {
+				pp.Memory = price
+				continue
+			}
+
+			for _, fraction := range p.Fractions {
+				if resource == fmt.Sprintf("%d%% vCPU", fraction) {
+					pp.Cores[fraction] = price
+				}
+			}
+		}
+
+		prices[p.ID] = pp
+	}
+
+	return prices
+}
@@ -0,0 +1,95 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+)
+
+// redacted replaces the values of the sensitive fields in the raw provider payloads
+const redacted = "[REDACTED]"
+
+// sensitiveKeys holds the (lowercase) fragments of the field names redacted from the raw provider payloads
+var sensitiveKeys = []string{"password", "secret", "token", "credential", "accesskey", "privatekey", "account", "owner", "project"}
+
+// sensitiveValues holds the patterns of the identifiers embedded in the values (eg.: resource urls, ARNs) of the raw provider payloads
+// the first group of the pattern is retained, the rest of the match is redacted
+var sensitiveValues = []*regexp.Regexp{
+	// google resource urls, eg.: https://www.googleapis.com/compute/v1/projects/<project>/zones/us-central1-a
+	regexp.MustCompile(`(/projects/)[^/]+`),
+	// azure resource ids, eg.: /subscriptions/<subscription>/providers/Microsoft.Compute
+	regexp.MustCompile(`(?i)(/subscriptions/)[^/]+`),
+	// amazon ARNs, eg.: arn:aws:iam::<account>:role/name
+	regexp.MustCompile(`(arn:[^:]*:[^:]*:[^:]*:)\d{12}`),
+}
+
+// RawPayload serializes the raw provider response of a product for debugging purposes, the sensitive fields are redacted
+// nil is returned if the payload can't be serialized
+func RawPayload(payload interface{}) json.RawMessage {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil
+	}
+
+	var generic interface{}
+	if err := json.Unmarshal(data, &generic); err != nil {
+		return nil
+	}
+
+	data, err = json.Marshal(redact(generic))
+	if err != nil {
+		return nil
+	}
+
+	return data
+}
+
+// redact walks the generic json value and replaces the values of the sensitive fields
+func redact(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, val := range v {
+			if isSensitive(key) {
+				v[key] = redacted
+				continue
+			}
+			v[key] = redact(val)
+		}
+	case []interface{}:
+		for i, val := range v {
+			v[i] = redact(val)
+		}
+	case string:
+		for _, pattern := range sensitiveValues {
+			v = pattern.ReplaceAllString(v, "${1}"+redacted)
+		}
+		return v
+	}
+
+	return value
+}
+
+func isSensitive(key string) bool {
+	key = strings.ToLower(key)
+	for _, sensitive := range sensitiveKeys {
+		if strings.Contains(key, sensitive) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,74 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRawPayload(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload interface{}
+		check   func(raw string)
+	}{
+		{
+			name: "sensitive fields are redacted",
+			payload: map[string]interface{}{
+				"instanceType": "m5.large",
+				"ownerId":      "123456789012",
+				"nested": []interface{}{
+					map[string]interface{}{"secretKey": "secret", "vcpu": "2"},
+				},
+			},
+			check: func(raw string) {
+				assert.JSONEq(t, `{"instanceType":"m5.large","ownerId":"[REDACTED]","nested":[{"secretKey":"[REDACTED]","vcpu":"2"}]}`, raw)
+			},
+		},
+		{
+			name: "identifiers in values are redacted",
+			payload: map[string]interface{}{
+				"selfLink": "https://www.googleapis.com/compute/v1/projects/my-project/zones/us-central1-a/machineTypes/n1-standard-1",
+				"zone":     "us-central1-a",
+				"id":       "/subscriptions/0000-1111/providers/Microsoft.Compute/skus/Standard_D2_v3",
+				"arn":      "arn:aws:iam::123456789012:role/pricing",
+			},
+			check: func(raw string) {
+				assert.JSONEq(t, `{
+					"selfLink":"https://www.googleapis.com/compute/v1/projects/[REDACTED]/zones/us-central1-a/machineTypes/n1-standard-1",
+					"zone":"us-central1-a",
+					"id":"/subscriptions/[REDACTED]/providers/Microsoft.Compute/skus/Standard_D2_v3",
+					"arn":"arn:aws:iam::[REDACTED]:role/pricing"
+				}`, raw)
+			},
+		},
+		{
+			name: "structs are serialized",
+			payload: struct {
+				Name string
+			}{Name: "n1-standard-1"},
+			check: func(raw string) {
+				assert.JSONEq(t, `{"Name":"n1-standard-1"}`, raw)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.check(string(RawPayload(test.payload)))
+		})
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"strings"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// regionLocation the country (ISO 3166-1 alpha-2 code), the city or area and the approximate coordinates of a region
+type regionLocation struct {
+	country   string
+	name      string
+	latitude  float64
+	longitude float64
+}
+
+// regionLocations the maintained locations of the regions by provider and region; the azure coordinates are the ones
+// published with the locations of the subscriptions
+var regionLocations = map[string]map[string]regionLocation{
+	"amazon": {
+		"af-south-1":     {"ZA", "Cape Town", -33.92, 18.42},
+		"ap-east-1":      {"HK", "Hong Kong", 22.32, 114.17},
+		"ap-northeast-1": {"JP", "Tokyo", 35.68, 139.69},
+		"ap-northeast-2": {"KR", "Seoul", 37.57, 126.98},
+		"ap-northeast-3": {"JP", "Osaka", 34.69, 135.50},
+		"ap-south-1":     {"IN", "Mumbai", 19.08, 72.88},
+		"ap-south-2":     {"IN", "Hyderabad", 17.39, 78.49},
+		"ap-southeast-1": {"SG", "Singapore", 1.35, 103.82},
+		"ap-southeast-2": {"AU", "Sydney", -33.87, 151.21},
+		"ap-southeast-3": {"ID", "Jakarta", -6.21, 106.85},
+		"ap-southeast-4": {"AU", "Melbourne", -37.81, 144.96},
+		"ca-central-1":   {"CA", "Montreal", 45.50, -73.57},
+		"cn-north-1":     {"CN", "Beijing", 39.90, 116.41},
+		"cn-northwest-1": {"CN", "Ningxia", 38.47, 106.26},
+		"eu-central-1":   {"DE", "Frankfurt", 50.11, 8.68},
+		"eu-central-2":   {"CH", "Zurich", 47.38, 8.54},
+		"eu-north-1":     {"SE", "Stockholm", 59.33, 18.07},
+		"eu-south-1":     {"IT", "Milan", 45.46, 9.19},
+		"eu-south-2":     {"ES", "Aragon", 41.60, -0.88},
+		"eu-west-1":      {"IE", "Dublin", 53.35, -6.26},
+		"eu-west-2":      {"GB", "London", 51.51, -0.13},
+		"eu-west-3":      {"FR", "Paris", 48.86, 2.35},
+		"me-central-1":   {"AE", "UAE", 24.45, 54.38},
+		"me-south-1":     {"BH", "Bahrain", 26.07, 50.56},
+		"sa-east-1":      {"BR", "Sao Paulo", -23.55, -46.63},
+		"us-east-1":      {"US", "N. Virginia", 38.95, -77.45},
+		"us-east-2":      {"US", "Ohio", 39.96, -83.00},
+		"us-west-1":      {"US", "N. California", 37.34, -121.89},
+		"us-west-2":      {"US", "Oregon", 45.84, -119.70},
+	},
+	"google": {
+		"asia-east1":              {"TW", "Changhua County", 24.05, 120.52},
+		"asia-east2":              {"HK", "Hong Kong", 22.32, 114.17},
+		"asia-northeast1":         {"JP", "Tokyo", 35.68, 139.69},
+		"asia-northeast2":         {"JP", "Osaka", 34.69, 135.50},
+		"asia-northeast3":         {"KR", "Seoul", 37.57, 126.98},
+		"asia-south1":             {"IN", "Mumbai", 19.08, 72.88},
+		"asia-south2":             {"IN", "Delhi", 28.70, 77.10},
+		"asia-southeast1":         {"SG", "Jurong West", 1.34, 103.71},
+		"asia-southeast2":         {"ID", "Jakarta", -6.21, 106.85},
+		"australia-southeast1":    {"AU", "Sydney", -33.87, 151.21},
+		"australia-southeast2":    {"AU", "Melbourne", -37.81, 144.96},
+		"europe-central2":         {"PL", "Warsaw", 52.23, 21.01},
+		"europe-north1":           {"FI", "Hamina", 60.57, 27.20},
+		"europe-southwest1":       {"ES", "Madrid", 40.42, -3.70},
+		"europe-west1":            {"BE", "St. Ghislain", 50.45, 3.82},
+		"europe-west2":            {"GB", "London", 51.51, -0.13},
+		"europe-west3":            {"DE", "Frankfurt", 50.11, 8.68},
+		"europe-west4":            {"NL", "Eemshaven", 53.44, 6.83},
+		"europe-west6":            {"CH", "Zurich", 47.38, 8.54},
+		"europe-west8":            {"IT", "Milan", 45.46, 9.19},
+		"europe-west9":            {"FR", "Paris", 48.86, 2.35},
+		"me-west1":                {"IL", "Tel Aviv", 32.09, 34.78},
+		"northamerica-northeast1": {"CA", "Montreal", 45.50, -73.57},
+		"northamerica-northeast2": {"CA", "Toronto", 43.65, -79.38},
+		"southamerica-east1":      {"BR", "Sao Paulo", -23.55, -46.63},
+		"southamerica-west1":      {"CL", "Santiago", -33.45, -70.67},
+		"us-central1":             {"US", "Council Bluffs, Iowa", 41.26, -95.86},
+		"us-east1":                {"US", "Moncks Corner, South Carolina", 33.20, -80.01},
+		"us-east4":                {"US", "Ashburn, Virginia", 39.04, -77.49},
+		"us-east5":                {"US", "Columbus, Ohio", 39.96, -83.00},
+		"us-south1":               {"US", "Dallas, Texas", 32.78, -96.80},
+		"us-west1":                {"US", "The Dalles, Oregon", 45.59, -121.18},
+		"us-west2":                {"US", "Los Angeles, California", 34.05, -118.24},
+		"us-west3":                {"US", "Salt Lake City, Utah", 40.76, -111.89},
+		"us-west4":                {"US", "Las Vegas, Nevada", 36.17, -115.14},
+	},
+	"azure": {
+		"australiaeast":      {"AU", "New South Wales", -33.86, 151.21},
+		"australiasoutheast": {"AU", "Victoria", -37.81, 144.96},
+		"brazilsouth":        {"BR", "Sao Paulo State", -23.55, -46.63},
+		"canadacentral":      {"CA", "Toronto", 43.65, -79.38},
+		"canadaeast":         {"CA", "Quebec", 46.82, -71.22},
+		"centralindia":       {"IN", "Pune", 18.58, 73.92},
+		"centralus":          {"US", "Iowa", 41.59, -93.62},
+		"eastasia":           {"HK", "Hong Kong", 22.27, 114.19},
+		"eastus":             {"US", "Virginia", 37.37, -79.82},
+		"eastus2":            {"US", "Virginia", 36.67, -78.39},
+		"francecentral":      {"FR", "Paris", 46.38, 2.37},
+		"germanywestcentral": {"DE", "Frankfurt", 50.11, 8.68},
+		"italynorth":         {"IT", "Milan", 45.47, 9.18},
+		"japaneast":          {"JP", "Tokyo, Saitama", 35.68, 139.77},
+		"japanwest":          {"JP", "Osaka", 34.69, 135.50},
+		"koreacentral":       {"KR", "Seoul", 37.57, 126.98},
+		"northcentralus":     {"US", "Illinois", 41.88, -87.63},
+		"northeurope":        {"IE", "Ireland", 53.35, -6.26},
+		"norwayeast":         {"NO", "Norway", 59.91, 10.75},
+		"polandcentral":      {"PL", "Warsaw", 52.23, 21.02},
+		"qatarcentral":       {"QA", "Doha", 25.55, 51.44},
+		"southafricanorth":   {"ZA", "Johannesburg", -25.73, 28.22},
+		"southcentralus":     {"US", "Texas", 29.42, -98.50},
+		"southeastasia":      {"SG", "Singapore", 1.28, 103.83},
+		"southindia":         {"IN", "Chennai", 12.98, 80.16},
+		"swedencentral":      {"SE", "Gavle", 60.67, 17.14},
+		"switzerlandnorth":   {"CH", "Zurich", 47.45, 8.56},
+		"uaenorth":           {"AE", "Dubai", 25.27, 55.32},
+		"uksouth":            {"GB", "London", 50.94, -0.80},
+		"ukwest":             {"GB", "Cardiff", 53.43, -3.08},
+		"westcentralus":      {"US", "Wyoming", 40.89, -110.23},
+		"westeurope":         {"NL", "Netherlands", 52.37, 4.90},
+		"westindia":          {"IN", "Mumbai", 19.09, 72.87},
+		"westus":             {"US", "California", 37.78, -122.42},
+		"westus2":            {"US", "Washington", 47.23, -119.85},
+		"westus3":            {"US", "Phoenix", 33.45, -112.07},
+	},
+}
+
+// SetRegionGeo sets the continent of a region, and the country, the display name and the coordinates of the regions
+// of the maintained mapping; the display name defaults to the name of the region published by the provider
+func SetRegionGeo(provider string, region *types.Region) {
+	if continent := getContinent(region.ID); continent != "unknown" {
+		region.Continent = continent
+	}
+
+	region.DisplayName = region.Name
+	if location, ok := regionLocations[provider][region.ID]; ok {
+		region.Country = location.country
+		region.DisplayName = location.name
+		region.Latitude = location.latitude
+		region.Longitude = location.longitude
+	}
+}
+
+// MatchContinent checks whether the continent matches the given name case insensitively, ignoring the spaces, dashes
+// and underscores (eg.: north-america, NorthAmerica)
+func MatchContinent(continent, name string) bool {
+	normalize := strings.NewReplacer(" ", "", "-", "", "_", "")
+
+	return strings.EqualFold(normalize.Replace(continent), normalize.Replace(name))
+}
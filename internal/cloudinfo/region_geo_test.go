@@ -0,0 +1,67 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestSetRegionGeo(t *testing.T) {
+	tests := []struct {
+		name     string
+		provider string
+		region   types.Region
+		expected types.Region
+	}{
+		{
+			name:     "maintained location",
+			provider: "amazon",
+			region:   types.Region{ID: "eu-central-1", Name: "EU (Frankfurt)"},
+			expected: types.Region{ID: "eu-central-1", Name: "EU (Frankfurt)", DisplayName: "Frankfurt", Continent: types.ContinentEurope,
+				Country: "DE", Latitude: 50.11, Longitude: 8.68},
+		},
+		{
+			name:     "unknown location",
+			provider: "vultr",
+			region:   types.Region{ID: "fra", Name: "Frankfurt"},
+			expected: types.Region{ID: "fra", Name: "Frankfurt", DisplayName: "Frankfurt", Continent: types.ContinentEurope},
+		},
+		{
+			name:     "unknown continent",
+			provider: "custom",
+			region:   types.Region{ID: "dc1", Name: "Datacenter 1"},
+			expected: types.Region{ID: "dc1", Name: "Datacenter 1", DisplayName: "Datacenter 1"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			region := test.region
+			SetRegionGeo(test.provider, &region)
+			assert.Equal(t, test.expected, region)
+		})
+	}
+}
+
+func TestMatchContinent(t *testing.T) {
+	assert.True(t, MatchContinent(types.ContinentEurope, "europe"))
+	assert.True(t, MatchContinent(types.ContinentNorthAmerica, "north-america"))
+	assert.True(t, MatchContinent(types.ContinentNorthAmerica, "NorthAmerica"))
+	assert.False(t, MatchContinent(types.ContinentSouthAmerica, "north_america"))
+	assert.False(t, MatchContinent("", "europe"))
+}
@@ -0,0 +1,46 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// HoursPerMonth the number of hours the hourly prices are converted to monthly prices with
+const HoursPerMonth = 730
+
+// reservationTermHours the length of the reservation terms in hours
+var reservationTermHours = map[string]float64{
+	"1mo": HoursPerMonth,
+	"1yr": 8760,
+	"3yr": 3 * 8760,
+}
+
+// NewReservedPrice assembles the price of a reservation from its upfront and recurring hourly prices,
+// the upfront price is spread over the hours of the term in the effective hourly price
+func NewReservedPrice(term, paymentOption string, upfront, hourly float64) types.ReservedPrice {
+	price := types.ReservedPrice{
+		Term:                 term,
+		PaymentOption:        paymentOption,
+		UpfrontPrice:         upfront,
+		MonthlyPrice:         hourly * HoursPerMonth,
+		EffectiveHourlyPrice: hourly,
+	}
+	if hours, ok := reservationTermHours[term]; ok {
+		price.EffectiveHourlyPrice += upfront / hours
+	}
+
+	return price
+}
@@ -0,0 +1,40 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestNewReservedPrice(t *testing.T) {
+	assert.Equal(t, types.ReservedPrice{
+		Term:                 "1yr",
+		PaymentOption:        types.PaymentPartialUpfront,
+		UpfrontPrice:         438,
+		MonthlyPrice:         36.5,
+		EffectiveHourlyPrice: 0.1,
+	}, NewReservedPrice("1yr", types.PaymentPartialUpfront, 438, 0.05), "the upfront price should be spread over the term")
+
+	assert.Equal(t, types.ReservedPrice{
+		Term:                 "3yr",
+		PaymentOption:        types.PaymentAllUpfront,
+		UpfrontPrice:         2628,
+		EffectiveHourlyPrice: 0.1,
+	}, NewReservedPrice("3yr", types.PaymentAllUpfront, 2628, 0))
+}
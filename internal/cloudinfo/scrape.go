@@ -16,7 +16,11 @@ package cloudinfo
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"sort"
 	"strconv"
 	"sync"
 	"time"
@@ -30,6 +34,55 @@ import (
 	"github.com/banzaicloud/cloudinfo/internal/platform/log"
 )
 
+const (
+	// ServerlessService the service of the serverless containers, its regions are the ones the serverless container
+	// prices are known in
+	ServerlessService = "serverless"
+
+	// regionScrapeAttempts the number of attempts to scrape a region in a scraping cycle
+	regionScrapeAttempts = 3
+	// regionRetryBackoff the delay before retrying to scrape a region, multiplied by the number of attempts
+	regionRetryBackoff = 5 * time.Second
+)
+
+// regionInfo holds the scraped information of a service region, committed to the store at once
+type regionInfo struct {
+	zones    []string
+	vms      []types.VMInfo
+	images   []types.Image
+	versions []types.LocationVersion
+}
+
+// contentHash returns the SHA-256 hash of the scraped information of the region, changed whenever any of it changes
+func (info regionInfo) contentHash() (string, error) {
+	content, err := json.Marshal(struct {
+		Zones    []string                `json:"zones"`
+		Vms      []types.VMInfo          `json:"vms"`
+		Images   []types.Image           `json:"images"`
+		Versions []types.LocationVersion `json:"versions"`
+	}{info.zones, info.vms, info.images, info.versions})
+	if err != nil {
+		return "", errors.WrapIf(err, "failed to marshal region information")
+	}
+
+	sum := sha256.Sum256(content)
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// priceHash returns the SHA-256 hash of the prices of a region, changed whenever any of them changes
+func priceHash(prices map[string]types.Price) (string, error) {
+	// the keys of the maps are marshaled in order
+	content, err := json.Marshal(prices)
+	if err != nil {
+		return "", errors.WrapIf(err, "failed to marshal prices")
+	}
+
+	sum := sha256.Sum256(content)
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
 // scrapingManager manages data renewal for a given provider
 // retrieves data from the cloud provider and stores it in the store
 type scrapingManager struct {
@@ -41,6 +94,12 @@ type scrapingManager struct {
 	log          Logger
 	eventBus     messaging.EventBus
 	errorHandler ErrorHandler
+	// workloads classifies the workload fit of the products
+	workloads *WorkloadClassifier
+	// lifecycles overrides the lifecycle stage of the products
+	lifecycles *LifecycleOverrides
+	// regionRetryBackoff the delay before retrying to scrape a region
+	regionRetryBackoff time.Duration
 }
 
 func (sm *scrapingManager) initialize(ctx context.Context) {
@@ -55,16 +114,18 @@ func (sm *scrapingManager) initialize(ctx context.Context) {
 		return
 	}
 
+	now := time.Now()
 	for region, ap := range prices {
 		for instType, p := range ap {
-			sm.store.StorePrice(sm.provider, region, instType, p)
+			sm.storePrice(region, instType, p, now)
 			metrics.OnDemandPriceGauge.WithLabelValues(sm.provider, region, instType).Set(p.OnDemandPrice)
 		}
+		sm.storePriceHash(region, ap)
 	}
 	sm.log.Info("finished initializing cloud product information")
 }
 
-func (sm *scrapingManager) scrapeServiceRegionProducts(ctx context.Context, service string, regionId string) error {
+func (sm *scrapingManager) scrapeServiceRegionProducts(ctx context.Context, service string, regionId string) ([]types.VMInfo, error) {
 	logger := log.WithFields(sm.log, map[string]interface{}{"service": service, "region": regionId})
 
 	logger.Debug("retrieving regional product information")
@@ -75,23 +136,35 @@ func (sm *scrapingManager) scrapeServiceRegionProducts(ctx context.Context, serv
 
 	values, err := sm.infoer.GetProducts(vms, service, regionId)
 	if err != nil {
-		return errors.Wrap(err, "failed to retrieve products for region")
+		return nil, errors.Wrap(err, "failed to retrieve products for region")
+	}
+
+	if len(values) == 0 && len(vms) > 0 {
+		return nil, errors.New("no products retrieved for region")
 	}
 
-	for _, vm := range values {
+	for i, vm := range values {
 		if vm.OnDemandPrice > 0 {
 			metrics.OnDemandPriceGauge.WithLabelValues(sm.provider, regionId, vm.Type).Set(vm.OnDemandPrice)
 		}
-	}
-
-	sm.store.StoreVm(sm.provider, service, regionId, values)
 
-	err = sm.updateVirtualMachines(service, regionId)
-	if err != nil {
-		return err
+		values[i].Workloads = sm.workloads.Classify(vm)
+		values[i].Lifecycle = sm.lifecycles.Lifecycle(vm)
+		values[i].MaxPods = MaxPods(sm.provider, service, vm)
+		// the instance types of the providers not publishing their architecture are amd64
+		if vm.Architecture == "" {
+			values[i].Architecture = types.ArchitectureAMD64
+		}
+		if vm.NtwPerfGbps == 0 {
+			values[i].NtwPerfGbps = NetworkPerfGbps(vm.NtwPerf)
+		}
 	}
+	MarkFreeTier(sm.provider, regionId, values)
 
-	return nil
+	values = sm.updateVirtualMachines(regionId, values)
+	sm.recordOnDemandPrices(regionId, values, time.Now())
+
+	return values, nil
 }
 
 func (sm *scrapingManager) scrapeServiceRegionImages(ctx context.Context, service string, regionId string) error {
@@ -108,28 +181,87 @@ func (sm *scrapingManager) scrapeServiceRegionImages(ctx context.Context, servic
 	return nil
 }
 
-func (sm *scrapingManager) scrapeServiceRegionVersions(ctx context.Context, service string, regionId string) error {
-	versions, err := sm.infoer.GetVersions(service, regionId)
-	if err != nil {
-		return errors.WrapIf(err, "failed to retrieve service versions for region")
+// scrapeServiceRegion retrieves the information of a service region without storing it
+func (sm *scrapingManager) scrapeServiceRegion(ctx context.Context, service, regionId string) (info regionInfo, err error) {
+	if info.zones, err = sm.infoer.GetZones(regionId); err != nil {
+		return info, errors.WrapIf(err, "failed to retrieve zones for region")
 	}
 
-	sm.store.DeleteVersion(sm.provider, service, regionId)
-	sm.store.StoreVersion(sm.provider, service, regionId, versions)
+	if info.vms, err = sm.scrapeServiceRegionProducts(ctx, service, regionId); err != nil {
+		return info, err
+	}
 
-	return nil
+	if sm.infoer.HasImages() {
+		sm.log.Debug("retrieving regional image information", map[string]interface{}{"service": service, "region": regionId})
+		if info.images, err = sm.infoer.GetServiceImages(service, regionId); err != nil {
+			return info, errors.WrapIf(err, "failed to retrieve service images for region")
+		}
+	}
+
+	if info.versions, err = sm.infoer.GetVersions(service, regionId); err != nil {
+		return info, errors.WrapIf(err, "failed to retrieve service versions for region")
+	}
+
+	return info, nil
 }
 
-func (sm *scrapingManager) scrapeServiceRegionZones(ctx context.Context, service, region string) error {
-	zones, err := sm.infoer.GetZones(region)
-	if err != nil {
-		return errors.WrapIf(err, "failed to retrieve zones for region")
+// scrapeServiceRegionWithRetry retries scraping a service region a bounded number of times
+func (sm *scrapingManager) scrapeServiceRegionWithRetry(ctx context.Context, service, regionId string) (regionInfo, error) {
+	var err error
+	for attempt := 1; attempt <= regionScrapeAttempts; attempt++ {
+		var info regionInfo
+		if info, err = sm.scrapeServiceRegion(ctx, service, regionId); err == nil {
+			return info, nil
+		}
+
+		if attempt == regionScrapeAttempts {
+			break
+		}
+
+		sm.log.Warn("failed to scrape region, retrying", map[string]interface{}{
+			"service": service, "region": regionId, "attempt": attempt, "error": err.Error()})
+
+		select {
+		case <-ctx.Done():
+			return regionInfo{}, errors.WrapIf(ctx.Err(), "scraping region cancelled")
+		case <-time.After(time.Duration(attempt) * sm.regionRetryBackoff):
+		}
 	}
 
-	sm.store.DeleteZones(sm.provider, service, region)
-	sm.store.StoreZones(sm.provider, service, region, zones)
+	return regionInfo{}, err
+}
+
+// storeServiceRegion commits the scraped information of a service region to the store
+func (sm *scrapingManager) storeServiceRegion(service, regionId string, info regionInfo) {
+	sm.store.DeleteZones(sm.provider, service, regionId)
+	sm.store.StoreZones(sm.provider, service, regionId, info.zones)
 
-	return nil
+	previous, _ := sm.store.GetVm(sm.provider, service, regionId)
+	stampProductChanges(previous, info.vms, time.Now())
+	change := diffProducts(previous, info.vms)
+
+	sm.store.DeleteVm(sm.provider, service, regionId)
+	sm.store.StoreVm(sm.provider, service, regionId, info.vms)
+
+	if sm.infoer.HasImages() {
+		sm.store.DeleteImage(sm.provider, service, regionId)
+		sm.store.StoreImage(sm.provider, service, regionId, info.images)
+	}
+
+	sm.store.DeleteVersion(sm.provider, service, regionId)
+	sm.store.StoreVersion(sm.provider, service, regionId, info.versions)
+
+	// the hash identifies the stored content for the conditional requests of the clients
+	if hash, err := info.contentHash(); err == nil {
+		sm.store.StoreRegionHash(sm.provider, service, regionId, hash)
+	} else {
+		sm.log.Warn("failed to hash region information", map[string]interface{}{"service": service, "region": regionId, "error": err.Error()})
+	}
+
+	// the first scrape of a region has nothing to compare to
+	if len(previous) > 0 && !change.Empty() {
+		sm.eventBus.PublishProductsChanged(sm.provider, service, regionId, change)
+	}
 }
 
 func (sm *scrapingManager) scrapeServiceRegionInfo(ctx context.Context, services []types.Service) error {
@@ -150,6 +282,14 @@ func (sm *scrapingManager) scrapeServiceRegionInfo(ctx context.Context, services
 			continue
 		}
 
+		if service.ServiceName() == ServerlessService {
+			if err := sm.scrapeServerlessPrices(ctx); err != nil {
+				sm.metrics.ReportScrapeFailure(sm.provider, service.ServiceName(), "N/A")
+				lastScrapeError = err
+			}
+			continue
+		}
+
 		regions, err := sm.infoer.GetRegions(service.ServiceName())
 		if err != nil {
 			sm.metrics.ReportScrapeFailure(sm.provider, service.ServiceName(), "N/A")
@@ -159,37 +299,33 @@ func (sm *scrapingManager) scrapeServiceRegionInfo(ctx context.Context, services
 		sm.store.DeleteRegions(sm.provider, service.ServiceName())
 		sm.store.StoreRegions(sm.provider, service.ServiceName(), regions)
 
+		var failedRegions []string
 		for regionId := range regions {
 			start := time.Now()
-			if err = sm.scrapeServiceRegionZones(ctx, service.ServiceName(), regionId); err != nil {
-				lastScrapeError = errors.WithDetails(err, "provider", sm.provider, "service", service.ServiceName(), "region", regionId)
-				sm.log.WithFields(map[string]interface{}{"error": lastScrapeError, "region": regionId}).
-					Error("failed to scrape zones for region")
-				continue
-			}
-			if err = sm.scrapeServiceRegionProducts(ctx, service.ServiceName(), regionId); err != nil {
-				sm.metrics.ReportScrapeFailure(sm.provider, service.ServiceName(), regionId)
-				lastScrapeError = errors.WithDetails(err, "provider", sm.provider, "service", service.ServiceName(), "region", regionId)
-				sm.log.WithFields(map[string]interface{}{"error": lastScrapeError, "region": regionId}).
-					Error("failed to scrape products for region")
-				continue
-			}
-			if err = sm.scrapeServiceRegionImages(ctx, service.ServiceName(), regionId); err != nil {
-				sm.metrics.ReportScrapeFailure(sm.provider, service.ServiceName(), regionId)
-				lastScrapeError = errors.WithDetails(err, "provider", sm.provider, "service", service.ServiceName(), "region", regionId)
-				sm.log.WithFields(map[string]interface{}{"error": lastScrapeError, "region": regionId}).
-					Error("failed to scrape images for region")
-				continue
-			}
-			if err = sm.scrapeServiceRegionVersions(ctx, service.ServiceName(), regionId); err != nil {
+
+			// the region is only committed to the store if all of its information is retrieved,
+			// the previously stored information is retained otherwise
+			info, err := sm.scrapeServiceRegionWithRetry(ctx, service.ServiceName(), regionId)
+			if err != nil {
 				sm.metrics.ReportScrapeFailure(sm.provider, service.ServiceName(), regionId)
+				sm.store.StoreRegionError(sm.provider, service.ServiceName(), regionId, types.ScrapeError{Time: time.Now(), Message: err.Error()})
 				lastScrapeError = errors.WithDetails(err, "provider", sm.provider, "service", service.ServiceName(), "region", regionId)
 				sm.log.WithFields(map[string]interface{}{"error": lastScrapeError, "region": regionId}).
-					Error("failed to scrape images for region")
+					Error("failed to scrape region, retaining previous region information")
+				failedRegions = append(failedRegions, regionId)
 				continue
 			}
+
+			sm.storeServiceRegion(service.ServiceName(), regionId, info)
+			sm.updateRegionStatus(service.ServiceName(), regionId)
+			sm.eventBus.PublishRegionRefreshed(sm.provider, service.ServiceName(), regionId)
 			sm.metrics.ReportScrapeRegionCompleted(sm.provider, service.ServiceName(), regionId, start)
 		}
+
+		if len(failedRegions) > 0 {
+			sort.Strings(failedRegions)
+			sm.log.Error("failed to scrape regions", map[string]interface{}{"service": service.ServiceName(), "regions": failedRegions})
+		}
 	}
 	return lastScrapeError
 }
@@ -200,6 +336,13 @@ func (sm *scrapingManager) updateStatus(ctx context.Context) {
 	sm.store.StoreStatus(sm.provider, values)
 }
 
+// updateRegionStatus records the time of the last successful scrape of a region
+func (sm *scrapingManager) updateRegionStatus(service, region string) {
+	values := strconv.Itoa(int(time.Now().UnixNano() / 1e6))
+	sm.log.Debug("updating status for region", map[string]interface{}{"service": service, "region": region})
+	sm.store.StoreRegionStatus(sm.provider, service, region, values)
+}
+
 // scrapeServiceInformation scrapes service and region dependant cloud information and stores its
 func (sm *scrapingManager) scrapeServiceInformation(ctx context.Context) {
 	ctx, _ = sm.tracer.StartWithTags(ctx, "scrape-service-info", map[string]interface{}{"provider": sm.provider})
@@ -234,8 +377,18 @@ func (sm *scrapingManager) scrapePricesInRegion(ctx context.Context, region stri
 		sm.errorHandler.Handle(err)
 	}
 
+	changed := make(messaging.SpotPrices)
 	for instType, price := range prices {
-		sm.store.StorePrice(sm.provider, region, instType, price)
+		if sm.storePrice(region, instType, price, start) {
+			changed[instType] = price.SpotPrice
+		}
+	}
+	if err == nil {
+		sm.storePriceHash(region, prices)
+	}
+
+	if len(changed) > 0 {
+		sm.eventBus.PublishSpotPricesChanged(sm.provider, region, changed)
 	}
 
 	sm.metrics.ReportScrapeRegionShortLivedCompleted(sm.provider, region, start)
@@ -264,13 +417,300 @@ func (sm *scrapingManager) scrapePricesInAllRegions(ctx context.Context) {
 	sm.metrics.ReportScrapeProviderShortLivedCompleted(sm.provider, start)
 }
 
-func (sm *scrapingManager) updateVirtualMachines(service, region string) error {
-	vms, ok := sm.store.GetVm(sm.provider, service, region)
+// scrapeStoragePrices retrieves and stores the block and object storage prices in all the regions of the provider
+func (sm *scrapingManager) scrapeStoragePrices(ctx context.Context) {
+	blockPricer, hasBlock := sm.infoer.(StoragePricer)
+	objectPricer, hasObject := sm.infoer.(ObjectStoragePricer)
+	if !hasBlock && !hasObject {
+		return
+	}
+
+	ctx, _ = sm.tracer.StartWithTags(ctx, "scrape-storage-prices", map[string]interface{}{"provider": sm.provider})
+	defer sm.tracer.EndSpan(ctx)
+	sm.log.Info("start scraping storage prices")
+
+	regions, err := sm.infoer.GetRegions("compute")
+	if err != nil {
+		sm.log.Error("failed to retrieve regions")
+		sm.errorHandler.Handle(err)
+		return
+	}
+
+	for regionId := range regions {
+		if hasBlock {
+			prices, err := blockPricer.GetStoragePrices(regionId)
+			if err != nil {
+				sm.log.Error("failed to scrape storage prices in region", map[string]interface{}{"region": regionId})
+				sm.errorHandler.Handle(errors.WithDetails(err, "provider", sm.provider, "region", regionId))
+			} else {
+				sm.store.StoreStoragePrices(sm.provider, regionId, prices)
+			}
+		}
+
+		if hasObject {
+			prices, err := objectPricer.GetObjectStoragePrices(regionId)
+			if err != nil {
+				sm.log.Error("failed to scrape object storage prices in region", map[string]interface{}{"region": regionId})
+				sm.errorHandler.Handle(errors.WithDetails(err, "provider", sm.provider, "region", regionId))
+			} else {
+				sm.store.StoreObjectStoragePrices(sm.provider, regionId, prices)
+			}
+		}
+	}
+	sm.log.Info("finished scraping storage prices")
+}
+
+// scrapeNetworkPrices retrieves and stores the network traffic, load balancer, NAT gateway and public IP prices
+// in all the regions of the provider
+func (sm *scrapingManager) scrapeNetworkPrices(ctx context.Context) {
+	trafficPricer, hasTraffic := sm.infoer.(NetworkPricer)
+	loadBalancerPricer, hasLoadBalancer := sm.infoer.(LoadBalancerPricer)
+	natGatewayPricer, hasNatGateway := sm.infoer.(NatGatewayPricer)
+	publicIpPricer, hasPublicIp := sm.infoer.(PublicIpPricer)
+	if !hasTraffic && !hasLoadBalancer && !hasNatGateway && !hasPublicIp {
+		return
+	}
+
+	ctx, _ = sm.tracer.StartWithTags(ctx, "scrape-network-prices", map[string]interface{}{"provider": sm.provider})
+	defer sm.tracer.EndSpan(ctx)
+	sm.log.Info("start scraping network prices")
+
+	regions, err := sm.infoer.GetRegions("compute")
+	if err != nil {
+		sm.log.Error("failed to retrieve regions")
+		sm.errorHandler.Handle(err)
+		return
+	}
+
+	for regionId := range regions {
+		if hasTraffic {
+			prices, err := trafficPricer.GetNetworkPrices(regionId)
+			if err != nil {
+				sm.log.Error("failed to scrape network prices in region", map[string]interface{}{"region": regionId})
+				sm.errorHandler.Handle(errors.WithDetails(err, "provider", sm.provider, "region", regionId))
+			} else {
+				sm.store.StoreNetworkPrices(sm.provider, regionId, prices)
+			}
+		}
+
+		if hasLoadBalancer {
+			prices, err := loadBalancerPricer.GetLoadBalancerPrices(regionId)
+			if err != nil {
+				sm.log.Error("failed to scrape load balancer prices in region", map[string]interface{}{"region": regionId})
+				sm.errorHandler.Handle(errors.WithDetails(err, "provider", sm.provider, "region", regionId))
+			} else {
+				sm.store.StoreLoadBalancerPrices(sm.provider, regionId, prices)
+			}
+		}
+
+		if hasNatGateway {
+			prices, err := natGatewayPricer.GetNatGatewayPrices(regionId)
+			if err != nil {
+				sm.log.Error("failed to scrape NAT gateway prices in region", map[string]interface{}{"region": regionId})
+				sm.errorHandler.Handle(errors.WithDetails(err, "provider", sm.provider, "region", regionId))
+			} else {
+				sm.store.StoreNatGatewayPrices(sm.provider, regionId, prices)
+			}
+		}
+
+		if hasPublicIp {
+			prices, err := publicIpPricer.GetPublicIpPrices(regionId)
+			if err != nil {
+				sm.log.Error("failed to scrape public IP prices in region", map[string]interface{}{"region": regionId})
+				sm.errorHandler.Handle(errors.WithDetails(err, "provider", sm.provider, "region", regionId))
+			} else {
+				sm.store.StorePublicIpPrices(sm.provider, regionId, prices)
+			}
+		}
+	}
+	sm.log.Info("finished scraping network prices")
+}
+
+// scrapeDatabases scrapes the managed database instance classes and their prices in all the regions of the provider
+func (sm *scrapingManager) scrapeDatabases(ctx context.Context) {
+	databasePricer, ok := sm.infoer.(DatabasePricer)
 	if !ok {
-		sm.log.Debug("VMs not yet cached, update suspended")
-		return errors.NewWithDetails("VMs not yet cached", "provider", sm.provider, "service", service, "region", region)
+		return
 	}
 
+	ctx, _ = sm.tracer.StartWithTags(ctx, "scrape-databases", map[string]interface{}{"provider": sm.provider})
+	defer sm.tracer.EndSpan(ctx)
+	sm.log.Info("start scraping managed databases")
+
+	regions, err := sm.infoer.GetRegions("compute")
+	if err != nil {
+		sm.log.Error("failed to retrieve regions")
+		sm.errorHandler.Handle(err)
+		return
+	}
+
+	for regionId := range regions {
+		databases, err := databasePricer.GetDatabases(regionId)
+		if err != nil {
+			sm.log.Error("failed to scrape managed databases in region", map[string]interface{}{"region": regionId})
+			sm.errorHandler.Handle(errors.WithDetails(err, "provider", sm.provider, "region", regionId))
+			continue
+		}
+
+		sm.store.StoreDatabases(sm.provider, regionId, databases)
+	}
+	sm.log.Info("finished scraping managed databases")
+}
+
+func (sm *scrapingManager) scrapeZoneInfo(ctx context.Context) {
+	zoneDescriber, ok := sm.infoer.(ZoneDescriber)
+	if !ok {
+		return
+	}
+
+	ctx, _ = sm.tracer.StartWithTags(ctx, "scrape-zone-info", map[string]interface{}{"provider": sm.provider})
+	defer sm.tracer.EndSpan(ctx)
+	sm.log.Info("start scraping availability zones")
+
+	regions, err := sm.infoer.GetRegions("compute")
+	if err != nil {
+		sm.log.Error("failed to retrieve regions")
+		sm.errorHandler.Handle(err)
+		return
+	}
+
+	for regionId := range regions {
+		zones, err := zoneDescriber.GetZoneInfo(regionId)
+		if err != nil {
+			sm.log.Error("failed to scrape availability zones in region", map[string]interface{}{"region": regionId})
+			sm.errorHandler.Handle(errors.WithDetails(err, "provider", sm.provider, "region", regionId))
+			continue
+		}
+
+		sm.store.StoreZoneInfo(sm.provider, regionId, zones)
+	}
+	sm.log.Info("finished scraping availability zones")
+}
+
+// scrapeDedicatedHosts scrapes the dedicated host types and their prices in all the regions of the provider
+func (sm *scrapingManager) scrapeDedicatedHosts(ctx context.Context) {
+	dedicatedHostPricer, ok := sm.infoer.(DedicatedHostPricer)
+	if !ok {
+		return
+	}
+
+	ctx, _ = sm.tracer.StartWithTags(ctx, "scrape-dedicated-hosts", map[string]interface{}{"provider": sm.provider})
+	defer sm.tracer.EndSpan(ctx)
+	sm.log.Info("start scraping dedicated hosts")
+
+	regions, err := sm.infoer.GetRegions("compute")
+	if err != nil {
+		sm.log.Error("failed to retrieve regions")
+		sm.errorHandler.Handle(err)
+		return
+	}
+
+	for regionId := range regions {
+		hosts, err := dedicatedHostPricer.GetDedicatedHosts(regionId)
+		if err != nil {
+			sm.log.Error("failed to scrape dedicated hosts in region", map[string]interface{}{"region": regionId})
+			sm.errorHandler.Handle(errors.WithDetails(err, "provider", sm.provider, "region", regionId))
+			continue
+		}
+
+		sm.store.StoreDedicatedHosts(sm.provider, regionId, hosts)
+	}
+	sm.log.Info("finished scraping dedicated hosts")
+}
+
+// scrapeServerlessPrices scrapes the serverless container prices in all the regions of the provider, the regions of
+// the serverless service are the ones the prices are found in
+func (sm *scrapingManager) scrapeServerlessPrices(ctx context.Context) error {
+	serverlessPricer, ok := sm.infoer.(ServerlessPricer)
+	if !ok {
+		sm.log.Warn("serverless container prices are not supported by the provider")
+		return nil
+	}
+
+	ctx, _ = sm.tracer.StartWithTags(ctx, "scrape-serverless-prices", map[string]interface{}{"provider": sm.provider})
+	defer sm.tracer.EndSpan(ctx)
+	sm.log.Info("start scraping serverless container prices")
+
+	regions, err := sm.infoer.GetRegions("compute")
+	if err != nil {
+		return errors.WithDetails(err, "failed to retrieve regions", "service", ServerlessService)
+	}
+
+	serverlessRegions := make(map[string]string)
+	for regionId, name := range regions {
+		prices, err := serverlessPricer.GetServerlessPrices(regionId)
+		if err != nil {
+			sm.log.Error("failed to scrape serverless container prices in region", map[string]interface{}{"region": regionId})
+			sm.errorHandler.Handle(errors.WithDetails(err, "provider", sm.provider, "region", regionId))
+			sm.store.StoreRegionError(sm.provider, ServerlessService, regionId, types.ScrapeError{Time: time.Now(), Message: err.Error()})
+			// the region is retained with its previous prices
+			if _, ok := sm.store.GetServerlessPrices(sm.provider, regionId); ok {
+				serverlessRegions[regionId] = name
+			}
+			continue
+		}
+
+		if len(prices) == 0 {
+			continue
+		}
+
+		sm.store.StoreServerlessPrices(sm.provider, regionId, prices)
+		sm.store.DeleteZones(sm.provider, ServerlessService, regionId)
+		sm.store.StoreZones(sm.provider, ServerlessService, regionId, []string{})
+		sm.updateRegionStatus(ServerlessService, regionId)
+		serverlessRegions[regionId] = name
+	}
+
+	sm.store.DeleteRegions(sm.provider, ServerlessService)
+	sm.store.StoreRegions(sm.provider, ServerlessService, serverlessRegions)
+
+	sm.log.Info("finished scraping serverless container prices", map[string]interface{}{"numberOfRegions": len(serverlessRegions)})
+	return nil
+}
+
+// storePrice stores the price of an instance type and records its spot prices in the spot price history,
+// returns whether the spot prices have changed
+func (sm *scrapingManager) storePrice(region, instanceType string, price types.Price, timestamp time.Time) bool {
+	sm.store.StorePrice(sm.provider, region, instanceType, price)
+
+	if len(price.SpotPrice) == 0 {
+		return false
+	}
+
+	history, _ := sm.store.GetSpotPriceHistory(sm.provider, region, instanceType)
+	history, changed := appendSpotPriceRecord(history, newSpotPriceRecord(timestamp, price.SpotPrice), spotPriceHistoryRetention)
+	if changed {
+		sm.store.StoreSpotPriceHistory(sm.provider, region, instanceType, history)
+	}
+
+	return changed
+}
+
+// storePriceHash stores the hash of the prices of a region, so the entity tags of the region change with its prices
+func (sm *scrapingManager) storePriceHash(region string, prices map[string]types.Price) {
+	hash, err := priceHash(prices)
+	if err != nil {
+		sm.log.Warn("failed to hash prices", map[string]interface{}{"region": region, "error": err.Error()})
+		return
+	}
+
+	sm.store.StoreRegionPriceHash(sm.provider, region, hash)
+}
+
+// recordOnDemandPrices records the on demand prices of the virtual machines in the on demand price history
+func (sm *scrapingManager) recordOnDemandPrices(region string, vms []types.VMInfo, timestamp time.Time) {
+	for _, vm := range vms {
+		record := types.OnDemandPriceRecord{Timestamp: timestamp, OnDemandPrice: vm.OnDemandPrice, Currency: vm.Currency}
+
+		history, _ := sm.store.GetOnDemandPriceHistory(sm.provider, region, vm.Type)
+		if history, changed := appendOnDemandPriceRecord(history, record, onDemandPriceHistoryRetention); changed {
+			sm.store.StoreOnDemandPriceHistory(sm.provider, region, vm.Type, history)
+		}
+	}
+}
+
+// updateVirtualMachines applies the stored on demand, reserved, effective monthly and operating system prices to the virtual machines and drops the ones without on demand price
+func (sm *scrapingManager) updateVirtualMachines(region string, vms []types.VMInfo) []types.VMInfo {
 	virtualMachines := make([]types.VMInfo, 0, len(vms))
 	for _, vm := range vms {
 		prices, found := sm.store.GetPrice(sm.provider, region, vm.Type)
@@ -279,6 +719,15 @@ func (sm *scrapingManager) updateVirtualMachines(service, region string) error {
 			if prices.OnDemandPrice > 0 {
 				vm.OnDemandPrice = prices.OnDemandPrice
 			}
+			if len(prices.ReservedPrices) > 0 {
+				vm.ReservedPrices = prices.ReservedPrices
+			}
+			if prices.EffectiveMonthlyPrice > 0 {
+				vm.EffectiveMonthlyPrice = prices.EffectiveMonthlyPrice
+			}
+			if len(prices.OsPrices) > 0 {
+				vm.OsPrices = prices.OsPrices
+			}
 		}
 
 		if vm.OnDemandPrice != 0 {
@@ -286,10 +735,7 @@ func (sm *scrapingManager) updateVirtualMachines(service, region string) error {
 		}
 	}
 
-	sm.store.DeleteVm(sm.provider, service, region)
-	sm.store.StoreVm(sm.provider, service, region, virtualMachines)
-
-	return nil
+	return virtualMachines
 }
 
 // scrape implements the scraping logic for a provider
@@ -304,6 +750,12 @@ func (sm *scrapingManager) scrape(ctx context.Context) {
 
 	sm.scrapeServiceInformation(ctx)
 
+	sm.scrapeDatabases(ctx)
+
+	sm.scrapeDedicatedHosts(ctx)
+
+	sm.scrapeZoneInfo(ctx)
+
 	// emit a scraping complete event to notify potential subscribers
 	sm.eventBus.PublishScrapingComplete(sm.provider)
 
@@ -331,30 +783,43 @@ func (sm *scrapingManager) scrapePKEImages(ctx context.Context, service types.Se
 }
 
 func NewScrapingManager(provider string, infoer CloudInfoer, store CloudInfoStore, log Logger,
-	metrics metrics.Reporter, tracer tracing.Tracer, eventBus messaging.EventBus, errorHandler ErrorHandler) *scrapingManager {
+	metrics metrics.Reporter, tracer tracing.Tracer, eventBus messaging.EventBus, errorHandler ErrorHandler,
+	workloads *WorkloadClassifier, lifecycles *LifecycleOverrides) *scrapingManager {
 	return &scrapingManager{
-		provider:     provider,
-		infoer:       infoer,
-		store:        store,
-		log:          log.WithFields(map[string]interface{}{"component": "scraping-manager", "provider": provider}),
-		metrics:      metrics,
-		tracer:       tracer,
-		eventBus:     eventBus,
-		errorHandler: errorHandler,
+		provider:           provider,
+		infoer:             infoer,
+		store:              store,
+		log:                log.WithFields(map[string]interface{}{"component": "scraping-manager", "provider": provider}),
+		metrics:            metrics,
+		tracer:             tracer,
+		eventBus:           eventBus,
+		errorHandler:       errorHandler,
+		workloads:          workloads,
+		lifecycles:         lifecycles,
+		regionRetryBackoff: regionRetryBackoff,
 	}
 }
 
 type ScrapingDriver struct {
 	scrapingManagers []*scrapingManager
 	renewalInterval  time.Duration
-	errorHandler     ErrorHandler
-	log              Logger
+	// storageInterval the interval of renewing the block and object storage prices, zero disables the scraping
+	storageInterval time.Duration
+	// networkInterval the interval of renewing the network traffic, load balancer, NAT gateway and public IP prices,
+	// zero disables the scraping
+	networkInterval time.Duration
+
+	// priority holds the providers to be scraped first (in the given order) on startup
+	priority      []string
+	initialScrape sync.Once
+	errorHandler  ErrorHandler
+	log           Logger
 }
 
 func (sd *ScrapingDriver) StartScraping() error {
 	ctx := context.Background()
 
-	if err := NewPeriodicExecutor(sd.renewalInterval, sd.log).Execute(ctx, sd.renewAll); err != nil {
+	if err := NewPeriodicExecutor(sd.renewalInterval, sd.log).Execute(ctx, sd.renew); err != nil {
 		return errors.WrapIf(err, "failed to scrape cloud information")
 	}
 
@@ -363,9 +828,71 @@ func (sd *ScrapingDriver) StartScraping() error {
 		return errors.WrapIf(err, "failed to scrape spot price info")
 	}
 
+	if sd.storageInterval > 0 {
+		if err := NewPeriodicExecutor(sd.storageInterval, sd.log).Execute(ctx, sd.renewStorage); err != nil {
+			return errors.WrapIf(err, "failed to scrape storage prices")
+		}
+	}
+
+	if sd.networkInterval > 0 {
+		if err := NewPeriodicExecutor(sd.networkInterval, sd.log).Execute(ctx, sd.renewNetwork); err != nil {
+			return errors.WrapIf(err, "failed to scrape network prices")
+		}
+	}
+
 	return nil
 }
 
+// renew scrapes the providers; the first scrape is performed in priority order, subsequent ones concurrently
+func (sd *ScrapingDriver) renew(ctx context.Context) {
+	initial := false
+	sd.initialScrape.Do(func() {
+		initial = true
+		sd.renewAllByPriority(ctx)
+	})
+
+	if !initial {
+		sd.renewAll(ctx)
+	}
+}
+
+// renewAllByPriority scrapes the prioritized providers one after the other, then the rest of them concurrently
+func (sd *ScrapingDriver) renewAllByPriority(ctx context.Context) {
+	prioritized, rest := sd.prioritizedManagers()
+
+	for _, manager := range prioritized {
+		sd.log.Info("scraping prioritized provider", map[string]interface{}{"provider": manager.provider})
+		manager.scrape(ctx)
+	}
+
+	for _, manager := range rest {
+		go manager.scrape(ctx)
+	}
+}
+
+// prioritizedManagers splits the scraping managers into the prioritized ones (in priority order) and the rest
+func (sd *ScrapingDriver) prioritizedManagers() ([]*scrapingManager, []*scrapingManager) {
+	var prioritized, rest []*scrapingManager
+
+	seen := make(map[string]bool, len(sd.priority))
+	for _, provider := range sd.priority {
+		for _, manager := range sd.scrapingManagers {
+			if manager.provider == provider && !seen[provider] {
+				prioritized = append(prioritized, manager)
+				seen[provider] = true
+			}
+		}
+	}
+
+	for _, manager := range sd.scrapingManagers {
+		if !seen[manager.provider] {
+			rest = append(rest, manager)
+		}
+	}
+
+	return prioritized, rest
+}
+
 func (sd *ScrapingDriver) renewAll(ctx context.Context) {
 	for _, manager := range sd.scrapingManagers {
 		go manager.scrape(ctx)
@@ -383,6 +910,20 @@ func (sd *ScrapingDriver) renewShortLived(ctx context.Context) {
 	}
 }
 
+// renewStorage scrapes the block and object storage prices of the providers
+func (sd *ScrapingDriver) renewStorage(ctx context.Context) {
+	for _, manager := range sd.scrapingManagers {
+		go manager.scrapeStoragePrices(ctx)
+	}
+}
+
+// renewNetwork scrapes the network traffic, load balancer, NAT gateway and public IP prices of the providers
+func (sd *ScrapingDriver) renewNetwork(ctx context.Context) {
+	for _, manager := range sd.scrapingManagers {
+		go manager.scrapeNetworkPrices(ctx)
+	}
+}
+
 func (sd *ScrapingDriver) RefreshProvider(ctx context.Context, provider string) {
 	for _, manager := range sd.scrapingManagers {
 		if manager.provider == provider {
@@ -391,7 +932,25 @@ func (sd *ScrapingDriver) RefreshProvider(ctx context.Context, provider string)
 	}
 }
 
-func NewScrapingDriver(renewalInterval time.Duration,
+// ScrapingDriverConfig holds the settings of the scraping driver
+type ScrapingDriverConfig struct {
+	// RenewalInterval the interval of renewing the products and the prices of the providers
+	RenewalInterval time.Duration
+	// StorageInterval the interval of renewing the block and object storage prices, zero disables the scraping
+	StorageInterval time.Duration
+	// NetworkInterval the interval of renewing the network traffic, load balancer, NAT gateway and public IP prices,
+	// zero disables the scraping
+	NetworkInterval time.Duration
+
+	// Priority the providers to be scraped first (in the given order) on startup
+	Priority []string
+	// Workloads the workload fit of the instance types by instance type prefix, overriding the classification rules
+	Workloads map[string][]string
+	// Lifecycles the lifecycle stages of the instance types by provider and instance type prefix
+	Lifecycles map[string]map[string]string
+}
+
+func NewScrapingDriver(config ScrapingDriverConfig,
 	infoers map[string]CloudInfoer,
 	store CloudInfoStore,
 	eventBus messaging.EventBus,
@@ -400,15 +959,27 @@ func NewScrapingDriver(renewalInterval time.Duration,
 	errorHandler ErrorHandler,
 	log Logger) *ScrapingDriver {
 	managers := make([]*scrapingManager, 0, len(infoers))
+	classifier := NewWorkloadClassifier(config.Workloads)
 
 	for provider, infoer := range infoers {
-		managers = append(managers, NewScrapingManager(provider, infoer, store, log, metrics, tracer, eventBus, errorHandler))
+		managers = append(managers, NewScrapingManager(provider, infoer, store, log, metrics, tracer, eventBus, errorHandler, classifier,
+			NewLifecycleOverrides(config.Lifecycles[provider])))
+	}
+
+	driverLog := log.WithFields(map[string]interface{}{"component": "scraping-driver"})
+	for _, provider := range config.Priority {
+		if _, ok := infoers[provider]; !ok {
+			driverLog.Warn("ignoring unknown or disabled provider in scrape priority", map[string]interface{}{"provider": provider})
+		}
 	}
 
 	return &ScrapingDriver{
 		scrapingManagers: managers,
-		renewalInterval:  renewalInterval,
+		renewalInterval:  config.RenewalInterval,
+		storageInterval:  config.StorageInterval,
+		networkInterval:  config.NetworkInterval,
+		priority:         config.Priority,
 		errorHandler:     errorHandler,
-		log:              log.WithFields(map[string]interface{}{"component": "scraping-driver"}),
+		log:              driverLog,
 	}
 }
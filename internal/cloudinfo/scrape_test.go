@@ -0,0 +1,518 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"context"
+	"testing"
+
+	"emperror.dev/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/banzaicloud/cloudinfo/internal/app/cloudinfo/messaging"
+	"github.com/banzaicloud/cloudinfo/internal/app/cloudinfo/tracing"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/metrics"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// flakyInfoer fails to retrieve the products of a region the configured number of times
+type flakyInfoer struct {
+	failures map[string]int
+	// implement the interface
+	CloudInfoer
+}
+
+func (fi *flakyInfoer) GetRegions(service string) (map[string]string, error) {
+	return map[string]string{"region-1": "Region 1", "region-2": "Region 2"}, nil
+}
+
+func (fi *flakyInfoer) GetZones(region string) ([]string, error) {
+	return []string{region + "a"}, nil
+}
+
+func (fi *flakyInfoer) GetProducts(vms []types.VMInfo, service, regionId string) ([]types.VMInfo, error) {
+	if fi.failures[regionId] > 0 {
+		fi.failures[regionId]--
+		return nil, errors.New("transient error")
+	}
+	return []types.VMInfo{{Type: "scraped", OnDemandPrice: 1}}, nil
+}
+
+func (fi *flakyInfoer) HasImages() bool {
+	return false
+}
+
+func (fi *flakyInfoer) GetVersions(service, region string) ([]types.LocationVersion, error) {
+	return nil, nil
+}
+
+// regionStore stores the virtual machines of the regions in memory
+type regionStore struct {
+	vms     map[string][]types.VMInfo
+	history map[string][]types.OnDemandPriceRecord
+	errors  map[string]types.ScrapeError
+	// implement the interface
+	CloudInfoStore
+}
+
+func (rs *regionStore) StoreRegions(provider, service string, val map[string]string)               {}
+func (rs *regionStore) DeleteRegions(provider, service string)                                     {}
+func (rs *regionStore) StoreZones(provider, service, region string, val []string)                  {}
+func (rs *regionStore) DeleteZones(provider, service, region string)                               {}
+func (rs *regionStore) StoreVersion(provider, service, region string, val []types.LocationVersion) {}
+func (rs *regionStore) DeleteVersion(provider, service, region string)                             {}
+func (rs *regionStore) StoreRegionStatus(provider, service, region string, val string)             {}
+func (rs *regionStore) StoreRegionHash(provider, service, region string, val string)               {}
+
+func (rs *regionStore) StoreRegionError(provider, service, region string, val types.ScrapeError) {
+	rs.errors[region] = val
+}
+
+func (rs *regionStore) GetPrice(provider, region, instanceType string) (types.Price, bool) {
+	return types.Price{}, false
+}
+
+func (rs *regionStore) StoreVm(provider, service, region string, val []types.VMInfo) {
+	rs.vms[region] = val
+}
+
+func (rs *regionStore) GetVm(provider, service, region string) ([]types.VMInfo, bool) {
+	vms, ok := rs.vms[region]
+	return vms, ok
+}
+
+func (rs *regionStore) DeleteVm(provider, service, region string) {
+	delete(rs.vms, region)
+}
+
+func (rs *regionStore) StoreOnDemandPriceHistory(provider, region, instanceType string, val []types.OnDemandPriceRecord) {
+	rs.history[region+"/"+instanceType] = val
+}
+
+func (rs *regionStore) GetOnDemandPriceHistory(provider, region, instanceType string) ([]types.OnDemandPriceRecord, bool) {
+	history, ok := rs.history[region+"/"+instanceType]
+	return history, ok
+}
+
+func TestScrapingManager_scrapeServiceRegionInfo(t *testing.T) {
+	previous := []types.VMInfo{{Type: "previous", OnDemandPrice: 1}}
+	store := &regionStore{vms: map[string][]types.VMInfo{"region-1": previous, "region-2": previous},
+		history: make(map[string][]types.OnDemandPriceRecord), errors: make(map[string]types.ScrapeError)}
+	infoer := &flakyInfoer{failures: map[string]int{"region-1": regionScrapeAttempts - 1, "region-2": regionScrapeAttempts}}
+
+	sm := NewScrapingManager("dummy", infoer, store, cloudinfoLogger, metrics.NewNoOpMetricsReporter(),
+		tracing.NewNoOpTracer(), messaging.NewDefaultEventBus(nil), nil, NewWorkloadClassifier(nil), NewLifecycleOverrides(nil))
+	sm.regionRetryBackoff = 0
+
+	err := sm.scrapeServiceRegionInfo(context.Background(), []types.Service{{Service: "compute"}})
+	assert.Error(t, err, "the failed region should be reported")
+
+	assert.Equal(t, "scraped", store.vms["region-1"][0].Type, "the region should be committed after retrying")
+	assert.Equal(t, previous, store.vms["region-2"], "the previous information of the failed region should be retained")
+	assert.Len(t, store.history["region-1/scraped"], 1, "the on demand price should be recorded")
+	assert.Empty(t, store.history["region-2/scraped"], "the price of the failed region should not be recorded")
+	assert.NotContains(t, store.errors, "region-1", "the retried region should not record an error")
+	assert.Contains(t, store.errors["region-2"].Message, "transient error", "the error of the failed region should be recorded")
+}
+
+func TestScrapingDriver_prioritizedManagers(t *testing.T) {
+	infoers := map[string]CloudInfoer{"amazon": nil, "google": nil, "azure": nil, "alibaba": nil}
+	config := ScrapingDriverConfig{Priority: []string{"google", "unknown", "amazon", "google"}}
+	sd := NewScrapingDriver(config, infoers, nil, messaging.NewDefaultEventBus(nil), metrics.NewNoOpMetricsReporter(),
+		tracing.NewNoOpTracer(), nil, cloudinfoLogger)
+
+	prioritized, rest := sd.prioritizedManagers()
+
+	var prioritizedProviders, restProviders []string
+	for _, manager := range prioritized {
+		prioritizedProviders = append(prioritizedProviders, manager.provider)
+	}
+	for _, manager := range rest {
+		restProviders = append(restProviders, manager.provider)
+	}
+
+	assert.Equal(t, []string{"google", "amazon"}, prioritizedProviders, "the providers should be scraped in priority order")
+	assert.ElementsMatch(t, []string{"azure", "alibaba"}, restProviders, "the rest of the providers should be scraped afterwards")
+}
+
+// storageInfoer prices the block storage of the regions, except the failing ones
+type storageInfoer struct {
+	flakyInfoer
+	failing map[string]bool
+}
+
+func (si *storageInfoer) GetStoragePrices(region string) ([]types.StoragePrice, error) {
+	if si.failing[region] {
+		return nil, errors.New("transient error")
+	}
+	return []types.StoragePrice{{Type: "ssd", PricePerGb: 0.1}}, nil
+}
+
+func (si *storageInfoer) GetObjectStoragePrices(region string) ([]types.ObjectStoragePrice, error) {
+	if si.failing[region] {
+		return nil, errors.New("transient error")
+	}
+	return []types.ObjectStoragePrice{{Class: "standard", PricePerGb: 0.02}}, nil
+}
+
+// storagePriceStore stores the storage prices of the regions in memory
+type storagePriceStore struct {
+	prices       map[string][]types.StoragePrice
+	objectPrices map[string][]types.ObjectStoragePrice
+	// implement the interface
+	CloudInfoStore
+}
+
+func (ss *storagePriceStore) StoreStoragePrices(provider, region string, val []types.StoragePrice) {
+	ss.prices[region] = val
+}
+
+func (ss *storagePriceStore) StoreObjectStoragePrices(provider, region string, val []types.ObjectStoragePrice) {
+	ss.objectPrices[region] = val
+}
+
+// collectingErrorHandler collects the handled errors
+type collectingErrorHandler struct {
+	errs []error
+}
+
+func (eh *collectingErrorHandler) Handle(err error) {
+	eh.errs = append(eh.errs, err)
+}
+
+func TestScrapingManager_scrapeStoragePrices(t *testing.T) {
+	store := &storagePriceStore{
+		prices:       make(map[string][]types.StoragePrice),
+		objectPrices: make(map[string][]types.ObjectStoragePrice),
+	}
+	errorHandler := &collectingErrorHandler{}
+	infoer := &storageInfoer{failing: map[string]bool{"region-2": true}}
+
+	sm := NewScrapingManager("dummy", infoer, store, cloudinfoLogger, metrics.NewNoOpMetricsReporter(),
+		tracing.NewNoOpTracer(), messaging.NewDefaultEventBus(nil), errorHandler, NewWorkloadClassifier(nil), NewLifecycleOverrides(nil))
+
+	sm.scrapeStoragePrices(context.Background())
+
+	assert.Equal(t, map[string][]types.StoragePrice{"region-1": {{Type: "ssd", PricePerGb: 0.1}}}, store.prices,
+		"the prices of the failed region should not be stored")
+	assert.Equal(t, map[string][]types.ObjectStoragePrice{"region-1": {{Class: "standard", PricePerGb: 0.02}}}, store.objectPrices,
+		"the object storage prices of the failed region should not be stored")
+	assert.Len(t, errorHandler.errs, 2, "the failures should be handled")
+
+	// the providers without storage prices are skipped
+	sm = NewScrapingManager("dummy", &flakyInfoer{}, store, cloudinfoLogger, metrics.NewNoOpMetricsReporter(),
+		tracing.NewNoOpTracer(), messaging.NewDefaultEventBus(nil), errorHandler, NewWorkloadClassifier(nil), NewLifecycleOverrides(nil))
+	sm.scrapeStoragePrices(context.Background())
+
+	assert.Len(t, store.prices, 1)
+}
+
+// networkInfoer prices the network traffic of the regions, except the failing ones
+type networkInfoer struct {
+	flakyInfoer
+	failing map[string]bool
+}
+
+func (ni *networkInfoer) GetNetworkPrices(region string) ([]types.NetworkPrice, error) {
+	if ni.failing[region] {
+		return nil, errors.New("transient error")
+	}
+	return []types.NetworkPrice{{Type: types.TrafficInternetEgress, PricePerGb: 0.09}}, nil
+}
+
+func (ni *networkInfoer) GetLoadBalancerPrices(region string) ([]types.LoadBalancerPrice, error) {
+	if ni.failing[region] {
+		return nil, errors.New("transient error")
+	}
+	return []types.LoadBalancerPrice{{Type: "application", PricePerHour: 0.025}}, nil
+}
+
+func (ni *networkInfoer) GetNatGatewayPrices(region string) ([]types.NatGatewayPrice, error) {
+	if ni.failing[region] {
+		return nil, errors.New("transient error")
+	}
+	return []types.NatGatewayPrice{{Type: "nat-gateway", PricePerHour: 0.048, PricePerGb: 0.048}}, nil
+}
+
+// networkPriceStore stores the network traffic, load balancer and NAT gateway prices of the regions in memory
+type networkPriceStore struct {
+	prices             map[string][]types.NetworkPrice
+	loadBalancerPrices map[string][]types.LoadBalancerPrice
+	natGatewayPrices   map[string][]types.NatGatewayPrice
+	// implement the interface
+	CloudInfoStore
+}
+
+func (ns *networkPriceStore) StoreNetworkPrices(provider, region string, val []types.NetworkPrice) {
+	ns.prices[region] = val
+}
+
+func (ns *networkPriceStore) StoreLoadBalancerPrices(provider, region string, val []types.LoadBalancerPrice) {
+	ns.loadBalancerPrices[region] = val
+}
+
+func (ns *networkPriceStore) StoreNatGatewayPrices(provider, region string, val []types.NatGatewayPrice) {
+	ns.natGatewayPrices[region] = val
+}
+
+func TestScrapingManager_scrapeNetworkPrices(t *testing.T) {
+	store := &networkPriceStore{
+		prices:             make(map[string][]types.NetworkPrice),
+		loadBalancerPrices: make(map[string][]types.LoadBalancerPrice),
+		natGatewayPrices:   make(map[string][]types.NatGatewayPrice),
+	}
+	errorHandler := &collectingErrorHandler{}
+	infoer := &networkInfoer{failing: map[string]bool{"region-2": true}}
+
+	sm := NewScrapingManager("dummy", infoer, store, cloudinfoLogger, metrics.NewNoOpMetricsReporter(),
+		tracing.NewNoOpTracer(), messaging.NewDefaultEventBus(nil), errorHandler, NewWorkloadClassifier(nil), NewLifecycleOverrides(nil))
+
+	sm.scrapeNetworkPrices(context.Background())
+
+	assert.Equal(t, map[string][]types.NetworkPrice{"region-1": {{Type: types.TrafficInternetEgress, PricePerGb: 0.09}}}, store.prices,
+		"the prices of the failed region should not be stored")
+	assert.Equal(t, map[string][]types.LoadBalancerPrice{"region-1": {{Type: "application", PricePerHour: 0.025}}}, store.loadBalancerPrices,
+		"the load balancer prices of the failed region should not be stored")
+	assert.Len(t, store.natGatewayPrices, 1, "the NAT gateway prices of the failed region should not be stored")
+	assert.Len(t, errorHandler.errs, 3, "the failures should be handled")
+}
+
+// databaseInfoer knows the managed databases of the regions, except the failing ones
+type databaseInfoer struct {
+	flakyInfoer
+	failing map[string]bool
+}
+
+func (di *databaseInfoer) GetDatabases(region string) ([]types.DatabaseInfo, error) {
+	if di.failing[region] {
+		return nil, errors.New("transient error")
+	}
+	return []types.DatabaseInfo{{InstanceClass: "db.m5.large", Engine: types.DatabaseMySQL, OnDemandPrice: 0.171}}, nil
+}
+
+// databaseStore stores the managed databases of the regions in memory
+type databaseStore struct {
+	databases map[string][]types.DatabaseInfo
+	// implement the interface
+	CloudInfoStore
+}
+
+func (ds *databaseStore) StoreDatabases(provider, region string, val []types.DatabaseInfo) {
+	ds.databases[region] = val
+}
+
+func TestScrapingManager_scrapeDatabases(t *testing.T) {
+	store := &databaseStore{databases: make(map[string][]types.DatabaseInfo)}
+	errorHandler := &collectingErrorHandler{}
+	infoer := &databaseInfoer{failing: map[string]bool{"region-2": true}}
+
+	sm := NewScrapingManager("dummy", infoer, store, cloudinfoLogger, metrics.NewNoOpMetricsReporter(),
+		tracing.NewNoOpTracer(), messaging.NewDefaultEventBus(nil), errorHandler, NewWorkloadClassifier(nil), NewLifecycleOverrides(nil))
+
+	sm.scrapeDatabases(context.Background())
+
+	assert.Equal(t, map[string][]types.DatabaseInfo{"region-1": {{InstanceClass: "db.m5.large", Engine: types.DatabaseMySQL, OnDemandPrice: 0.171}}},
+		store.databases, "the databases of the failed region should not be stored")
+	assert.Len(t, errorHandler.errs, 1, "the failure should be handled")
+}
+
+// dedicatedHostInfoer knows the dedicated hosts of the regions, except the failing ones
+type dedicatedHostInfoer struct {
+	flakyInfoer
+	failing map[string]bool
+}
+
+func (di *dedicatedHostInfoer) GetDedicatedHosts(region string) ([]types.DedicatedHostInfo, error) {
+	if di.failing[region] {
+		return nil, errors.New("transient error")
+	}
+	return []types.DedicatedHostInfo{{Type: "m5", Family: "m5", Cores: 48, Cpus: 96, Mem: 384, OnDemandPrice: 5.069}}, nil
+}
+
+// dedicatedHostStore stores the dedicated hosts of the regions in memory
+type dedicatedHostStore struct {
+	hosts map[string][]types.DedicatedHostInfo
+	// implement the interface
+	CloudInfoStore
+}
+
+func (ds *dedicatedHostStore) StoreDedicatedHosts(provider, region string, val []types.DedicatedHostInfo) {
+	ds.hosts[region] = val
+}
+
+func TestScrapingManager_scrapeDedicatedHosts(t *testing.T) {
+	store := &dedicatedHostStore{hosts: make(map[string][]types.DedicatedHostInfo)}
+	errorHandler := &collectingErrorHandler{}
+	infoer := &dedicatedHostInfoer{failing: map[string]bool{"region-2": true}}
+
+	sm := NewScrapingManager("dummy", infoer, store, cloudinfoLogger, metrics.NewNoOpMetricsReporter(),
+		tracing.NewNoOpTracer(), messaging.NewDefaultEventBus(nil), errorHandler, NewWorkloadClassifier(nil), NewLifecycleOverrides(nil))
+
+	sm.scrapeDedicatedHosts(context.Background())
+
+	assert.Equal(t, map[string][]types.DedicatedHostInfo{"region-1": {{Type: "m5", Family: "m5", Cores: 48, Cpus: 96, Mem: 384, OnDemandPrice: 5.069}}},
+		store.hosts, "the dedicated hosts of the failed region should not be stored")
+	assert.Len(t, errorHandler.errs, 1, "the failure should be handled")
+}
+
+// serverlessInfoer knows the serverless container prices of the regions, except the failing ones
+type serverlessInfoer struct {
+	flakyInfoer
+	failing map[string]bool
+}
+
+func (si *serverlessInfoer) GetServerlessPrices(region string) ([]types.ServerlessPrice, error) {
+	if si.failing[region] {
+		return nil, errors.New("transient error")
+	}
+	return []types.ServerlessPrice{{Type: "fargate", Os: types.OsLinux, Architecture: types.ArchitectureAMD64,
+		PricePerVcpuSecond: 0.0000112, PricePerGbSecond: 0.0000012}}, nil
+}
+
+// serverlessStore stores the serverless container prices and the regions of the serverless service in memory
+type serverlessStore struct {
+	prices  map[string][]types.ServerlessPrice
+	regions map[string]string
+	// implement the interface
+	CloudInfoStore
+}
+
+func (ss *serverlessStore) StoreServerlessPrices(provider, region string, val []types.ServerlessPrice) {
+	ss.prices[region] = val
+}
+
+func (ss *serverlessStore) GetServerlessPrices(provider, region string) ([]types.ServerlessPrice, bool) {
+	prices, ok := ss.prices[region]
+	return prices, ok
+}
+
+func (ss *serverlessStore) StoreRegions(provider, service string, val map[string]string) {
+	ss.regions = val
+}
+
+func (ss *serverlessStore) DeleteRegions(provider, service string) {}
+
+func (ss *serverlessStore) StoreZones(provider, service, region string, val []string) {}
+
+func (ss *serverlessStore) DeleteZones(provider, service, region string) {}
+
+func (ss *serverlessStore) StoreRegionStatus(provider, service, region string, val string) {}
+
+func (ss *serverlessStore) StoreRegionError(provider, service, region string, val types.ScrapeError) {
+}
+
+func TestScrapingManager_scrapeServerlessPrices(t *testing.T) {
+	store := &serverlessStore{prices: make(map[string][]types.ServerlessPrice)}
+	errorHandler := &collectingErrorHandler{}
+	infoer := &serverlessInfoer{failing: map[string]bool{"region-2": true}}
+
+	sm := NewScrapingManager("dummy", infoer, store, cloudinfoLogger, metrics.NewNoOpMetricsReporter(),
+		tracing.NewNoOpTracer(), messaging.NewDefaultEventBus(nil), errorHandler, NewWorkloadClassifier(nil), NewLifecycleOverrides(nil))
+
+	err := sm.scrapeServerlessPrices(context.Background())
+	assert.NoError(t, err)
+
+	assert.Len(t, store.prices, 1, "the prices of the failed region should not be stored")
+	assert.Equal(t, map[string][]types.ServerlessPrice{"region-1": {{Type: "fargate", Os: types.OsLinux, Architecture: types.ArchitectureAMD64,
+		PricePerVcpuSecond: 0.0000112, PricePerGbSecond: 0.0000012}}}, store.prices)
+	assert.Equal(t, map[string]string{"region-1": "Region 1"}, store.regions, "the failed region without prices should not be a serverless region")
+	assert.Len(t, errorHandler.errs, 1, "the failure should be handled")
+}
+
+func TestRegionInfo_contentHash(t *testing.T) {
+	info := regionInfo{
+		zones: []string{"eu-west-1a"},
+		vms:   []types.VMInfo{{Type: "m5.large", OnDemandPrice: 0.107}},
+	}
+
+	hash, err := info.contentHash()
+	require.NoError(t, err)
+	assert.Len(t, hash, 64)
+
+	same, err := regionInfo{zones: []string{"eu-west-1a"}, vms: []types.VMInfo{{Type: "m5.large", OnDemandPrice: 0.107}}}.contentHash()
+	require.NoError(t, err)
+	assert.Equal(t, hash, same, "the same content should have the same hash")
+
+	repriced, err := regionInfo{zones: []string{"eu-west-1a"}, vms: []types.VMInfo{{Type: "m5.large", OnDemandPrice: 0.096}}}.contentHash()
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, repriced, "a changed price should change the hash")
+}
+
+// spotPriceInfoer returns the current spot prices of the regions
+type spotPriceInfoer struct {
+	prices map[string]types.Price
+	// implement the interface
+	CloudInfoer
+}
+
+func (si *spotPriceInfoer) GetCurrentPrices(region string) (map[string]types.Price, error) {
+	return si.prices, nil
+}
+
+// priceStore stores the prices and the hashes of the regions in memory
+type priceStore struct {
+	priceHashes map[string]string
+	// implement the interface
+	CloudInfoStore
+}
+
+func (ps *priceStore) StorePrice(provider, region, instanceType string, val types.Price) {}
+
+func (ps *priceStore) GetSpotPriceHistory(provider, region, instanceType string) ([]types.SpotPriceRecord, bool) {
+	return nil, false
+}
+
+func (ps *priceStore) StoreSpotPriceHistory(provider, region, instanceType string, val []types.SpotPriceRecord) {
+}
+
+func (ps *priceStore) GetRegionHash(provider, service, region string) (string, bool) {
+	return "content", true
+}
+
+func (ps *priceStore) StoreRegionPriceHash(provider, region string, val string) {
+	ps.priceHashes[region] = val
+}
+
+func (ps *priceStore) GetRegionPriceHash(provider, region string) (string, bool) {
+	hash, ok := ps.priceHashes[region]
+	return hash, ok
+}
+
+func TestScrapingManager_scrapePricesInRegion_regionHash(t *testing.T) {
+	store := &priceStore{priceHashes: make(map[string]string)}
+	infoer := &spotPriceInfoer{prices: map[string]types.Price{
+		"m5.large": {SpotPrice: types.SpotPriceInfo{"eu-west-1a": 0.03}},
+	}}
+	ci := &cloudInfo{cloudInfoStore: store}
+
+	sm := NewScrapingManager("dummy", infoer, store, cloudinfoLogger, metrics.NewNoOpMetricsReporter(),
+		tracing.NewNoOpTracer(), messaging.NewDefaultEventBus(nil), nil, NewWorkloadClassifier(nil), NewLifecycleOverrides(nil))
+
+	sm.scrapePricesInRegion(context.Background(), "eu-west-1", nil)
+	hash, err := ci.GetRegionHash("dummy", "compute", "eu-west-1")
+	require.NoError(t, err)
+
+	sm.scrapePricesInRegion(context.Background(), "eu-west-1", nil)
+	same, err := ci.GetRegionHash("dummy", "compute", "eu-west-1")
+	require.NoError(t, err)
+	assert.Equal(t, hash, same, "the unchanged spot prices should keep the hash")
+
+	infoer.prices = map[string]types.Price{"m5.large": {SpotPrice: types.SpotPriceInfo{"eu-west-1a": 0.04}}}
+	sm.scrapePricesInRegion(context.Background(), "eu-west-1", nil)
+	repriced, err := ci.GetRegionHash("dummy", "compute", "eu-west-1")
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, repriced, "a changed spot price should change the hash")
+}
@@ -0,0 +1,251 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+const (
+	// SearchHitInstanceType is a hit on the name of an instance type.
+	SearchHitInstanceType = "instanceType"
+	// SearchHitFamily is a hit on the family of an instance type.
+	SearchHitFamily = "family"
+	// SearchHitAttribute is a hit on an attribute value of an instance type.
+	SearchHitAttribute = "attribute"
+
+	defaultSearchService = "compute"
+	defaultSearchLimit   = 50
+	maxSearchLimit       = 500
+	minSearchQueryLength = 2
+)
+
+// SearchStore retrieves the products to search in.
+type SearchStore interface {
+	// GetProviders returns the supported providers.
+	GetProviders() ([]types.Provider, error)
+
+	// GetRegions returns the regions of a provider service.
+	GetRegions(provider string, service string) (map[string]string, error)
+
+	// GetProductDetails retrieves product details from the given provider and region.
+	GetProductDetails(provider string, service string, region string) ([]types.ProductDetails, error)
+}
+
+// SearchService searches the instance types of every provider and region.
+type SearchService struct {
+	store SearchStore
+}
+
+// NewSearchService returns a new SearchService.
+func NewSearchService(store SearchStore) *SearchService {
+	return &SearchService{
+		store: store,
+	}
+}
+
+// SearchHit is an instance type matching a search query.
+type SearchHit struct {
+	// Kind tells what matched the query: the name, the family or an attribute of the instance type.
+	Kind         string
+	Provider     string
+	Service      string
+	Region       string
+	InstanceType string
+	// Field is the name of the matching field (the family or the attribute name).
+	Field string
+	// Value is the matching value.
+	Value string
+	// Link is the path of the products of the region, relative to the API root.
+	Link string
+
+	rank int
+}
+
+// SearchValidationError is returned if a search query is invalid.
+type SearchValidationError struct {
+	Message string
+}
+
+// Error implements the error interface.
+func (e SearchValidationError) Error() string {
+	return e.Message
+}
+
+// IsBusinessError tells the transport layer whether this error should be translated into the transport format
+// or an internal error should be returned instead.
+func (SearchValidationError) IsBusinessError() bool {
+	return true
+}
+
+// Search returns the instance types whose name or attribute values contain the query
+// or whose family is the query, the closest matches first.
+// The compute service is searched unless another service is given.
+func (s *SearchService) Search(ctx context.Context, query string, service *string, limit *int) ([]SearchHit, error) {
+	query = strings.ToLower(strings.TrimSpace(query))
+	if len(query) < minSearchQueryLength {
+		return nil, errors.WithStack(SearchValidationError{
+			Message: fmt.Sprintf("the query must be at least %d characters long", minSearchQueryLength),
+		})
+	}
+
+	size := defaultSearchLimit
+	if limit != nil {
+		if *limit < 1 || *limit > maxSearchLimit {
+			return nil, errors.WithStack(SearchValidationError{
+				Message: fmt.Sprintf("the limit must be between 1 and %d", maxSearchLimit),
+			})
+		}
+		size = *limit
+	}
+
+	svc := defaultSearchService
+	if service != nil && *service != "" {
+		svc = *service
+	}
+
+	providers, err := s.store.GetProviders()
+	if err != nil {
+		return nil, errors.WrapIf(err, "failed to list providers")
+	}
+
+	hits := make([]SearchHit, 0)
+	for _, provider := range providers {
+		// providers or regions without data are skipped, the search covers whatever is available
+		regions, err := s.store.GetRegions(provider.Provider, svc)
+		if err != nil {
+			continue
+		}
+
+		for region := range regions {
+			products, err := s.store.GetProductDetails(provider.Provider, svc, region)
+			if err != nil {
+				continue
+			}
+
+			for _, product := range products {
+				hit, ok := matchProduct(query, product)
+				if !ok {
+					continue
+				}
+
+				hit.Provider = provider.Provider
+				hit.Service = svc
+				hit.Region = region
+				hit.Link = fmt.Sprintf("/providers/%s/services/%s/regions/%s/products", provider.Provider, svc, region)
+				hits = append(hits, hit)
+			}
+		}
+	}
+
+	sort.Slice(hits, func(i, j int) bool {
+		a, b := hits[i], hits[j]
+		if a.rank != b.rank {
+			return a.rank < b.rank
+		}
+		if a.Provider != b.Provider {
+			return a.Provider < b.Provider
+		}
+		if a.Region != b.Region {
+			return a.Region < b.Region
+		}
+		return a.InstanceType < b.InstanceType
+	})
+
+	if len(hits) > size {
+		hits = hits[:size]
+	}
+
+	return hits, nil
+}
+
+// matchProduct returns the best hit of a product on a lower case query:
+// an exact name, then an exact family, then a partial name and finally an attribute value.
+func matchProduct(query string, product types.ProductDetails) (SearchHit, bool) {
+	name := strings.ToLower(product.Type)
+	hit := SearchHit{
+		InstanceType: product.Type,
+	}
+
+	family := instanceTypeFamily(product.Type)
+
+	switch {
+	case name == query:
+		hit.Kind, hit.rank = SearchHitInstanceType, 0
+	case family != "" && strings.ToLower(family) == query:
+		hit.Kind, hit.rank = SearchHitFamily, 1
+		hit.Field = "family"
+		hit.Value = family
+
+		return hit, true
+	case strings.HasPrefix(name, query):
+		hit.Kind, hit.rank = SearchHitInstanceType, 2
+	case strings.Contains(name, query):
+		hit.Kind, hit.rank = SearchHitInstanceType, 3
+	}
+	if hit.Kind != "" {
+		hit.Field = "name"
+		hit.Value = product.Type
+
+		return hit, true
+	}
+
+	attributes := map[string]string{
+		"category": product.Category,
+		"gpuModel": product.GpuModel,
+	}
+	for key, value := range product.Attributes {
+		attributes[key] = value
+	}
+
+	keys := make([]string, 0, len(attributes))
+	for key := range attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if value := attributes[key]; value != "" && strings.Contains(strings.ToLower(value), query) {
+			hit.Kind, hit.rank = SearchHitAttribute, 4
+			hit.Field = key
+			hit.Value = value
+
+			return hit, true
+		}
+	}
+
+	return SearchHit{}, false
+}
+
+// instanceTypeFamily returns the family of an instance type, the part of its name before the size,
+// eg. m5 for m5.2xlarge or n1-standard for n1-standard-8.
+func instanceTypeFamily(name string) string {
+	if i := strings.Index(name, "."); i > 0 {
+		return name[:i]
+	}
+
+	if i := strings.LastIndexAny(name, "-_"); i > 0 {
+		return name[:i]
+	}
+
+	return ""
+}
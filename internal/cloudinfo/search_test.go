@@ -0,0 +1,178 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+type searchStoreStub struct {
+	*InMemoryProviderStore
+	*InMemoryRegionStore
+	*InMemoryInstanceTypeStore
+}
+
+func TestSearchService_Search(t *testing.T) {
+	providerStore := NewInMemoryProviderStore()
+	providerStore.providers = []types.Provider{{Provider: "amazon"}, {Provider: "google"}}
+
+	regionStore := NewInMemoryRegionStore()
+	regionStore.regions = map[string]map[string]map[string]string{
+		"amazon": {"compute": {"eu-west-1": "EU (Ireland)"}},
+		"google": {"compute": {"europe-west1": "Belgium"}},
+	}
+
+	instanceTypeStore := NewInMemoryInstanceTypeStore()
+	instanceTypeStore.products = map[string]map[string]map[string][]types.ProductDetails{
+		"amazon": {
+			"compute": {
+				"eu-west-1": []types.ProductDetails{
+					{VMInfo: types.VMInfo{Type: "m5.2xlarge", Category: types.CategoryGeneral}},
+					{VMInfo: types.VMInfo{Type: "m5.large", Category: types.CategoryGeneral}},
+					{VMInfo: types.VMInfo{Type: "am5.xlarge", Category: types.CategoryGeneral}},
+					{VMInfo: types.VMInfo{Type: "p3.2xlarge", Category: types.CategoryGpu, Attributes: map[string]string{"gpuModel": "Tesla V100"}}},
+				},
+			},
+		},
+		"google": {
+			"compute": {
+				"europe-west1": []types.ProductDetails{
+					{VMInfo: types.VMInfo{Type: "n1-standard-8", Category: types.CategoryGeneral}},
+				},
+			},
+		},
+	}
+
+	searchService := NewSearchService(searchStoreStub{providerStore, regionStore, instanceTypeStore})
+
+	one := 1
+	zero := 0
+
+	tests := []struct {
+		name  string
+		query string
+		limit *int
+		check func(t *testing.T, hits []SearchHit, err error)
+	}{
+		{
+			name:  "instance types ranked by closeness",
+			query: "M5.",
+			check: func(t *testing.T, hits []SearchHit, err error) {
+				require.NoError(t, err)
+				require.Len(t, hits, 3)
+				assert.Equal(t, "m5.2xlarge", hits[0].InstanceType)
+				assert.Equal(t, "m5.large", hits[1].InstanceType)
+				assert.Equal(t, "am5.xlarge", hits[2].InstanceType)
+				assert.Equal(t, SearchHitInstanceType, hits[0].Kind)
+				assert.Equal(t, "/providers/amazon/services/compute/regions/eu-west-1/products", hits[0].Link)
+			},
+		},
+		{
+			name:  "exact name first",
+			query: "m5.large",
+			check: func(t *testing.T, hits []SearchHit, err error) {
+				require.NoError(t, err)
+				require.Len(t, hits, 1)
+				assert.Equal(t, "m5.large", hits[0].InstanceType)
+			},
+		},
+		{
+			name:  "family",
+			query: "N1-Standard",
+			check: func(t *testing.T, hits []SearchHit, err error) {
+				require.NoError(t, err)
+				require.Len(t, hits, 1)
+				assert.Equal(t, SearchHit{
+					Kind:         SearchHitFamily,
+					Provider:     "google",
+					Service:      "compute",
+					Region:       "europe-west1",
+					InstanceType: "n1-standard-8",
+					Field:        "family",
+					Value:        "n1-standard",
+					Link:         "/providers/google/services/compute/regions/europe-west1/products",
+					rank:         1,
+				}, hits[0])
+			},
+		},
+		{
+			name:  "attribute",
+			query: "v100",
+			check: func(t *testing.T, hits []SearchHit, err error) {
+				require.NoError(t, err)
+				require.Len(t, hits, 1)
+				assert.Equal(t, SearchHitAttribute, hits[0].Kind)
+				assert.Equal(t, "gpuModel", hits[0].Field)
+				assert.Equal(t, "Tesla V100", hits[0].Value)
+			},
+		},
+		{
+			name:  "family before partial names",
+			query: "m5",
+			check: func(t *testing.T, hits []SearchHit, err error) {
+				require.NoError(t, err)
+				require.Len(t, hits, 3)
+				assert.Equal(t, SearchHitFamily, hits[0].Kind)
+				assert.Equal(t, SearchHitFamily, hits[1].Kind)
+				assert.Equal(t, SearchHitInstanceType, hits[2].Kind)
+				assert.Equal(t, "am5.xlarge", hits[2].InstanceType)
+			},
+		},
+		{
+			name:  "limit",
+			query: "m5",
+			limit: &one,
+			check: func(t *testing.T, hits []SearchHit, err error) {
+				require.NoError(t, err)
+				require.Len(t, hits, 1)
+			},
+		},
+		{
+			name:  "query too short",
+			query: "m",
+			check: func(t *testing.T, hits []SearchHit, err error) {
+				assert.Error(t, err)
+			},
+		},
+		{
+			name:  "invalid limit",
+			query: "m5",
+			limit: &zero,
+			check: func(t *testing.T, hits []SearchHit, err error) {
+				assert.Error(t, err)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			hits, err := searchService.Search(context.Background(), test.query, nil, test.limit)
+
+			test.check(t, hits, err)
+		})
+	}
+}
+
+func TestInstanceTypeFamily(t *testing.T) {
+	assert.Equal(t, "m5", instanceTypeFamily("m5.2xlarge"))
+	assert.Equal(t, "n1-standard", instanceTypeFamily("n1-standard-8"))
+	assert.Equal(t, "", instanceTypeFamily("small"))
+}
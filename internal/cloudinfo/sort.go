@@ -0,0 +1,82 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"sort"
+	"strings"
+
+	"emperror.dev/errors"
+)
+
+// Sort orders of the listings
+const (
+	SortOrderAsc  = "asc"
+	SortOrderDesc = "desc"
+)
+
+// ParseSortOrder returns true if the sort order (asc or desc) is descending
+func ParseSortOrder(order string) (bool, error) {
+	switch strings.ToLower(order) {
+	case SortOrderAsc:
+		return false, nil
+	case SortOrderDesc:
+		return true, nil
+	default:
+		return false, errors.NewWithDetails("unsupported sort order", "order", order)
+	}
+}
+
+// instanceTypeComparators holds the "less" functions of the fields the instance types can be sorted by
+var instanceTypeComparators = map[string]func(a, b InstanceType) bool{
+	"onDemandPrice": func(a, b InstanceType) bool { return a.Price < b.Price },
+	"cpu":           func(a, b InstanceType) bool { return a.CPU < b.CPU },
+	"memory":        func(a, b InstanceType) bool { return a.Memory < b.Memory },
+}
+
+// SortInstanceTypes sorts the instance types by the field (onDemandPrice, cpu or memory) in the order (asc if empty),
+// the instance types with equal values are ordered by their name to keep the pages of the listings stable
+func SortInstanceTypes(instanceTypes []InstanceType, field string, order string) error {
+	less, ok := instanceTypeComparators[field]
+	if !ok {
+		return errors.NewWithDetails("unsupported sort field", "field", field)
+	}
+
+	var desc bool
+	if order != "" {
+		var err error
+		if desc, err = ParseSortOrder(order); err != nil {
+			return err
+		}
+	}
+
+	sort.SliceStable(instanceTypes, func(i, j int) bool {
+		a, b := instanceTypes[i], instanceTypes[j]
+		if desc {
+			a, b = b, a
+		}
+
+		if less(a, b) {
+			return true
+		}
+		if less(b, a) {
+			return false
+		}
+
+		return instanceTypes[i].Name < instanceTypes[j].Name
+	})
+
+	return nil
+}
@@ -0,0 +1,89 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortInstanceTypes(t *testing.T) {
+	instanceTypes := func() []InstanceType {
+		return []InstanceType{
+			{Name: "m5.large", Price: 0.096, CPU: 2, Memory: 8},
+			{Name: "c5.xlarge", Price: 0.17, CPU: 4, Memory: 8},
+			{Name: "t3.large", Price: 0.0832, CPU: 2, Memory: 8},
+			{Name: "a1.large", Price: 0.096, CPU: 2, Memory: 4},
+		}
+	}
+	namesOf := func(instanceTypes []InstanceType) []string {
+		var names []string
+		for _, instanceType := range instanceTypes {
+			names = append(names, instanceType.Name)
+		}
+		return names
+	}
+
+	tests := []struct {
+		name     string
+		field    string
+		order    string
+		expected []string
+		err      string
+	}{
+		{
+			name:     "cheapest first",
+			field:    "onDemandPrice",
+			expected: []string{"t3.large", "a1.large", "m5.large", "c5.xlarge"},
+		},
+		{
+			name:     "most cpus first",
+			field:    "cpu",
+			order:    "desc",
+			expected: []string{"c5.xlarge", "a1.large", "m5.large", "t3.large"},
+		},
+		{
+			name:     "least memory first",
+			field:    "memory",
+			order:    "ASC",
+			expected: []string{"a1.large", "c5.xlarge", "m5.large", "t3.large"},
+		},
+		{
+			name:  "unsupported sort field",
+			field: "name",
+			err:   "unsupported sort field",
+		},
+		{
+			name:  "unsupported sort order",
+			field: "cpu",
+			order: "random",
+			err:   "unsupported sort order",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sorted := instanceTypes()
+			err := SortInstanceTypes(sorted, test.field, test.order)
+			if test.err != "" {
+				assert.EqualError(t, err, test.err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, namesOf(sorted))
+		})
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// spotPriceHistoryRetention the time the spot price records are kept for after they are superseded
+const spotPriceHistoryRetention = 30 * 24 * time.Hour
+
+// newSpotPriceRecord creates a spot price record with the zone prices ordered by zone
+func newSpotPriceRecord(timestamp time.Time, spotPrice types.SpotPriceInfo) types.SpotPriceRecord {
+	record := types.SpotPriceRecord{
+		Timestamp: timestamp,
+		SpotPrice: make([]types.ZonePrice, 0, len(spotPrice)),
+	}
+
+	for zone, price := range spotPrice {
+		record.SpotPrice = append(record.SpotPrice, *types.NewZonePrice(zone, price))
+	}
+	sort.Slice(record.SpotPrice, func(i, j int) bool {
+		return record.SpotPrice[i].Zone < record.SpotPrice[j].Zone
+	})
+
+	return record
+}
+
+// appendSpotPriceRecord appends the record to the history if the prices changed since the last record
+// and drops the records superseded before the retention period; the passed in history is left untouched.
+// The returned flag signals whether the history changed.
+func appendSpotPriceRecord(history []types.SpotPriceRecord, record types.SpotPriceRecord, retention time.Duration) ([]types.SpotPriceRecord, bool) {
+	if n := len(history); n > 0 && reflect.DeepEqual(history[n-1].SpotPrice, record.SpotPrice) {
+		return history, false
+	}
+
+	updated := make([]types.SpotPriceRecord, 0, len(history)+1)
+	updated = append(updated, history...)
+	updated = append(updated, record)
+
+	// a record is dropped once its successor is older than the retention period
+	cutoff := record.Timestamp.Add(-retention)
+	first := 0
+	for first+1 < len(updated) && !updated[first+1].Timestamp.After(cutoff) {
+		first++
+	}
+
+	return updated[first:], true
+}
+
+// filterSpotPriceHistory returns the records in effect between from and to, zero times leave the range open
+func filterSpotPriceHistory(history []types.SpotPriceRecord, from, to time.Time) []types.SpotPriceRecord {
+	filtered := make([]types.SpotPriceRecord, 0, len(history))
+	for i, record := range history {
+		if !to.IsZero() && record.Timestamp.After(to) {
+			break
+		}
+
+		// the record is superseded before the start of the range
+		if !from.IsZero() && i+1 < len(history) && !history[i+1].Timestamp.After(from) {
+			continue
+		}
+
+		filtered = append(filtered, record)
+	}
+
+	return filtered
+}
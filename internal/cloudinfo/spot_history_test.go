@@ -0,0 +1,133 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestNewSpotPriceRecord(t *testing.T) {
+	now := time.Now()
+	record := newSpotPriceRecord(now, types.SpotPriceInfo{"zone-b": 0.2, "zone-a": 0.1})
+
+	assert.Equal(t, types.SpotPriceRecord{
+		Timestamp: now,
+		SpotPrice: []types.ZonePrice{{Zone: "zone-a", Price: 0.1}, {Zone: "zone-b", Price: 0.2}},
+	}, record)
+}
+
+func TestAppendSpotPriceRecord(t *testing.T) {
+	start := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	record := func(hours int, price float64) types.SpotPriceRecord {
+		return newSpotPriceRecord(start.Add(time.Duration(hours)*time.Hour), types.SpotPriceInfo{"zone-a": price})
+	}
+
+	tests := []struct {
+		name    string
+		history []types.SpotPriceRecord
+		record  types.SpotPriceRecord
+		want    []types.SpotPriceRecord
+		changed bool
+	}{
+		{
+			name:    "first record",
+			record:  record(0, 0.1),
+			want:    []types.SpotPriceRecord{record(0, 0.1)},
+			changed: true,
+		},
+		{
+			name:    "unchanged prices",
+			history: []types.SpotPriceRecord{record(0, 0.1)},
+			record:  record(1, 0.1),
+			want:    []types.SpotPriceRecord{record(0, 0.1)},
+		},
+		{
+			name:    "changed prices",
+			history: []types.SpotPriceRecord{record(0, 0.1)},
+			record:  record(1, 0.2),
+			want:    []types.SpotPriceRecord{record(0, 0.1), record(1, 0.2)},
+			changed: true,
+		},
+		{
+			name:    "superseded before the retention period",
+			history: []types.SpotPriceRecord{record(0, 0.1), record(1, 0.2), record(5, 0.3)},
+			record:  record(12, 0.4),
+			want:    []types.SpotPriceRecord{record(1, 0.2), record(5, 0.3), record(12, 0.4)},
+			changed: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			history, changed := appendSpotPriceRecord(test.history, test.record, 10*time.Hour)
+
+			assert.Equal(t, test.want, history)
+			assert.Equal(t, test.changed, changed)
+		})
+	}
+}
+
+func TestFilterSpotPriceHistory(t *testing.T) {
+	start := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	at := func(hours int) time.Time {
+		return start.Add(time.Duration(hours) * time.Hour)
+	}
+	history := []types.SpotPriceRecord{
+		newSpotPriceRecord(at(0), types.SpotPriceInfo{"zone-a": 0.1}),
+		newSpotPriceRecord(at(2), types.SpotPriceInfo{"zone-a": 0.2}),
+		newSpotPriceRecord(at(4), types.SpotPriceInfo{"zone-a": 0.3}),
+	}
+
+	tests := []struct {
+		name     string
+		from, to time.Time
+		want     []types.SpotPriceRecord
+	}{
+		{
+			name: "open range",
+			want: history,
+		},
+		{
+			name: "record in effect at the start of the range",
+			from: at(3),
+			want: history[1:],
+		},
+		{
+			name: "record starting at the start of the range",
+			from: at(2),
+			want: history[1:],
+		},
+		{
+			name: "closed range",
+			from: at(1),
+			to:   at(3),
+			want: history[:2],
+		},
+		{
+			name: "range before the history",
+			to:   start.Add(-time.Hour),
+			want: []types.SpotPriceRecord{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, filterSpotPriceHistory(history, test.from, test.to))
+		})
+	}
+}
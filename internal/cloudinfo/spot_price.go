@@ -0,0 +1,85 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"context"
+
+	"emperror.dev/emperror"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// SpotPriceStore retrieves the products holding the spot prices.
+type SpotPriceStore interface {
+	// GetProductDetails retrieves product details from the given provider and region.
+	GetProductDetails(provider string, service string, region string) ([]types.ProductDetails, error)
+}
+
+// SpotPriceService provides access to the current spot prices of the instance types.
+type SpotPriceService struct {
+	store SpotPriceStore
+}
+
+// NewSpotPriceService returns a new SpotPriceService.
+func NewSpotPriceService(store SpotPriceStore) *SpotPriceService {
+	return &SpotPriceService{
+		store: store,
+	}
+}
+
+// SpotPrice is the current spot price of an instance type in a zone.
+type SpotPrice struct {
+	InstanceType string
+	Zone         string
+	Price        float64
+}
+
+// ListSpotPrices returns a list of the current spot prices in a region,
+// optionally restricted to a zone and an instance type.
+func (s *SpotPriceService) ListSpotPrices(ctx context.Context, provider string, service string, region string, zone *string, instanceType *string) ([]SpotPrice, error) {
+	products, err := s.store.GetProductDetails(provider, service, region)
+	if err != nil {
+		return nil, emperror.WrapWith(
+			err,
+			"failed to list spot prices",
+			"provider", provider,
+			"service", service,
+			"region", region,
+		)
+	}
+
+	var spotPrices []SpotPrice
+
+	for _, product := range products {
+		if instanceType != nil && product.Type != *instanceType {
+			continue
+		}
+
+		for _, price := range product.SpotPrice {
+			if zone != nil && price.Zone != *zone {
+				continue
+			}
+
+			spotPrices = append(spotPrices, SpotPrice{
+				InstanceType: product.Type,
+				Zone:         price.Zone,
+				Price:        price.Price,
+			})
+		}
+	}
+
+	return spotPrices, nil
+}
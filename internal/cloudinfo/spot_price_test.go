@@ -0,0 +1,100 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestSpotPriceService_ListSpotPrices(t *testing.T) {
+	store := NewInMemoryInstanceTypeStore()
+	store.products = map[string]map[string]map[string][]types.ProductDetails{
+		"amazon": {
+			"compute": {
+				"eu-west-1": []types.ProductDetails{
+					{
+						VMInfo: types.VMInfo{
+							Type: "t2.small",
+							SpotPrice: []types.ZonePrice{
+								{Zone: "eu-west-1a", Price: 0.01},
+								{Zone: "eu-west-1b", Price: 0.02},
+							},
+						},
+					},
+					{
+						VMInfo: types.VMInfo{
+							Type: "t2.medium",
+							SpotPrice: []types.ZonePrice{
+								{Zone: "eu-west-1a", Price: 0.03},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	spotPriceService := NewSpotPriceService(store)
+
+	zone := "eu-west-1a"
+	instanceType := "t2.small"
+
+	tests := []struct {
+		name         string
+		zone         *string
+		instanceType *string
+		spotPrices   []SpotPrice
+	}{
+		{
+			name: "all",
+			spotPrices: []SpotPrice{
+				{InstanceType: "t2.small", Zone: "eu-west-1a", Price: 0.01},
+				{InstanceType: "t2.small", Zone: "eu-west-1b", Price: 0.02},
+				{InstanceType: "t2.medium", Zone: "eu-west-1a", Price: 0.03},
+			},
+		},
+		{
+			name: "zone",
+			zone: &zone,
+			spotPrices: []SpotPrice{
+				{InstanceType: "t2.small", Zone: "eu-west-1a", Price: 0.01},
+				{InstanceType: "t2.medium", Zone: "eu-west-1a", Price: 0.03},
+			},
+		},
+		{
+			name:         "instance type",
+			instanceType: &instanceType,
+			spotPrices: []SpotPrice{
+				{InstanceType: "t2.small", Zone: "eu-west-1a", Price: 0.01},
+				{InstanceType: "t2.small", Zone: "eu-west-1b", Price: 0.02},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			spotPrices, err := spotPriceService.ListSpotPrices(context.Background(), "amazon", "compute", "eu-west-1", test.zone, test.instanceType)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.spotPrices, spotPrices)
+		})
+	}
+}
@@ -15,6 +15,7 @@
 package cloudinfo
 
 import (
+	"context"
 	"io"
 
 	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
@@ -27,6 +28,42 @@ const (
 	// priceKeyTemplate format for generating price cache keys
 	PriceKeyTemplate = "/banzaicloud.com/cloudinfo/providers/%s/regions/%s/prices/%s"
 
+	// spotPriceHistoryKeyTemplate format for generating spot price history cache keys
+	SpotPriceHistoryKeyTemplate = "/banzaicloud.com/cloudinfo/providers/%s/regions/%s/spot-price-history/%s"
+
+	// onDemandPriceHistoryKeyTemplate format for generating on demand price history cache keys
+	OnDemandPriceHistoryKeyTemplate = "/banzaicloud.com/cloudinfo/providers/%s/regions/%s/price-history/%s"
+
+	// storagePriceKeyTemplate format for generating block storage price cache keys
+	StoragePriceKeyTemplate = "/banzaicloud.com/cloudinfo/providers/%s/regions/%s/storage"
+
+	// objectStoragePriceKeyTemplate format for generating object storage price cache keys
+	ObjectStoragePriceKeyTemplate = "/banzaicloud.com/cloudinfo/providers/%s/regions/%s/object-storage"
+
+	// networkPriceKeyTemplate format for generating network traffic price cache keys
+	NetworkPriceKeyTemplate = "/banzaicloud.com/cloudinfo/providers/%s/regions/%s/network"
+
+	// loadBalancerPriceKeyTemplate format for generating load balancer price cache keys
+	LoadBalancerPriceKeyTemplate = "/banzaicloud.com/cloudinfo/providers/%s/regions/%s/loadbalancer"
+
+	// natGatewayPriceKeyTemplate format for generating NAT gateway price cache keys
+	NatGatewayPriceKeyTemplate = "/banzaicloud.com/cloudinfo/providers/%s/regions/%s/nat-gateway"
+
+	// publicIpPriceKeyTemplate format for generating public IP price cache keys
+	PublicIpPriceKeyTemplate = "/banzaicloud.com/cloudinfo/providers/%s/regions/%s/public-ip"
+
+	// databaseKeyTemplate format for generating database instance classes cache keys
+	DatabaseKeyTemplate = "/banzaicloud.com/cloudinfo/providers/%s/regions/%s/databases"
+
+	// zoneInfoKeyTemplate format for generating availability zones cache keys
+	ZoneInfoKeyTemplate = "/banzaicloud.com/cloudinfo/providers/%s/regions/%s/zones"
+
+	// dedicatedHostKeyTemplate format for generating dedicated hosts cache keys
+	DedicatedHostKeyTemplate = "/banzaicloud.com/cloudinfo/providers/%s/regions/%s/dedicated-hosts"
+
+	// serverlessPriceKeyTemplate format for generating serverless container prices cache keys
+	ServerlessPriceKeyTemplate = "/banzaicloud.com/cloudinfo/providers/%s/regions/%s/serverless"
+
 	// zoneKeyTemplate format for generating zone cache keys
 	ZoneKeyTemplate = "/banzaicloud.com/cloudinfo/providers/%s/services/%s/regions/%s/zones/"
 
@@ -36,6 +73,18 @@ const (
 	// statusKeyTemplate format for generating status cache keys
 	StatusKeyTemplate = "/banzaicloud.com/cloudinfo/providers/%s/status/"
 
+	// regionStatusKeyTemplate format for generating region status cache keys
+	RegionStatusKeyTemplate = "/banzaicloud.com/cloudinfo/providers/%s/services/%s/regions/%s/status/"
+
+	// regionHashKeyTemplate format for generating region content hash cache keys
+	RegionHashKeyTemplate = "/banzaicloud.com/cloudinfo/providers/%s/services/%s/regions/%s/hash/"
+
+	// regionPriceHashKeyTemplate format for generating region price hash cache keys
+	RegionPriceHashKeyTemplate = "/banzaicloud.com/cloudinfo/providers/%s/regions/%s/pricehash/"
+
+	// regionErrorKeyTemplate format for generating region scrape error cache keys
+	RegionErrorKeyTemplate = "/banzaicloud.com/cloudinfo/providers/%s/services/%s/regions/%s/error/"
+
 	// imageKeyTemplate format for generating image cache keys
 	ImageKeyTemplate = "/banzaicloud.com/cloudinfo/providers/%s/services/%s/regions/%s/images"
 
@@ -50,6 +99,9 @@ const (
 type CloudInfoStore interface {
 	Ready() bool
 
+	// Ping checks the connectivity of the store backend
+	Ping(ctx context.Context) error
+
 	StoreRegions(provider, service string, val map[string]string)
 	GetRegions(provider, service string) (map[string]string, bool)
 	DeleteRegions(provider, service string)
@@ -61,6 +113,42 @@ type CloudInfoStore interface {
 	StorePrice(provider, region, instanceType string, val types.Price)
 	GetPrice(provider, region, instanceType string) (types.Price, bool)
 
+	StoreSpotPriceHistory(provider, region, instanceType string, val []types.SpotPriceRecord)
+	GetSpotPriceHistory(provider, region, instanceType string) ([]types.SpotPriceRecord, bool)
+
+	StoreOnDemandPriceHistory(provider, region, instanceType string, val []types.OnDemandPriceRecord)
+	GetOnDemandPriceHistory(provider, region, instanceType string) ([]types.OnDemandPriceRecord, bool)
+
+	StoreStoragePrices(provider, region string, val []types.StoragePrice)
+	GetStoragePrices(provider, region string) ([]types.StoragePrice, bool)
+
+	StoreObjectStoragePrices(provider, region string, val []types.ObjectStoragePrice)
+	GetObjectStoragePrices(provider, region string) ([]types.ObjectStoragePrice, bool)
+
+	StoreNetworkPrices(provider, region string, val []types.NetworkPrice)
+	GetNetworkPrices(provider, region string) ([]types.NetworkPrice, bool)
+
+	StoreLoadBalancerPrices(provider, region string, val []types.LoadBalancerPrice)
+	GetLoadBalancerPrices(provider, region string) ([]types.LoadBalancerPrice, bool)
+
+	StoreNatGatewayPrices(provider, region string, val []types.NatGatewayPrice)
+	GetNatGatewayPrices(provider, region string) ([]types.NatGatewayPrice, bool)
+
+	StorePublicIpPrices(provider, region string, val []types.PublicIpPrice)
+	GetPublicIpPrices(provider, region string) ([]types.PublicIpPrice, bool)
+
+	StoreDatabases(provider, region string, val []types.DatabaseInfo)
+	GetDatabases(provider, region string) ([]types.DatabaseInfo, bool)
+
+	StoreZoneInfo(provider, region string, val []types.ZoneInfo)
+	GetZoneInfo(provider, region string) ([]types.ZoneInfo, bool)
+
+	StoreDedicatedHosts(provider, region string, val []types.DedicatedHostInfo)
+	GetDedicatedHosts(provider, region string) ([]types.DedicatedHostInfo, bool)
+
+	StoreServerlessPrices(provider, region string, val []types.ServerlessPrice)
+	GetServerlessPrices(provider, region string) ([]types.ServerlessPrice, bool)
+
 	StoreVm(provider, service, region string, val []types.VMInfo)
 	GetVm(provider, service, region string) ([]types.VMInfo, bool)
 	DeleteVm(provider, service, region string)
@@ -76,6 +164,18 @@ type CloudInfoStore interface {
 	StoreStatus(provider string, val string)
 	GetStatus(provider string) (string, bool)
 
+	StoreRegionStatus(provider, service, region string, val string)
+	GetRegionStatus(provider, service, region string) (string, bool)
+
+	StoreRegionHash(provider, service, region string, val string)
+	GetRegionHash(provider, service, region string) (string, bool)
+
+	StoreRegionPriceHash(provider, region string, val string)
+	GetRegionPriceHash(provider, region string) (string, bool)
+
+	StoreRegionError(provider, service, region string, val types.ScrapeError)
+	GetRegionError(provider, service, region string) (types.ScrapeError, bool)
+
 	StoreServices(provider string, services []types.Service)
 	GetServices(provider string) ([]types.Service, bool)
 
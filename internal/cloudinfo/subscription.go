@@ -0,0 +1,43 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+// Kinds of the product updates
+const (
+	// ProductAdded the product is newly offered in the region
+	ProductAdded = "added"
+	// ProductRemoved the product is not offered anymore in the region
+	ProductRemoved = "removed"
+	// ProductRepriced the on demand price of the product has changed
+	ProductRepriced = "repriced"
+)
+
+// SpotPriceChange is the changed spot price of an instance type in a zone.
+type SpotPriceChange struct {
+	Provider     string
+	Region       string
+	Zone         string
+	InstanceType string
+	Price        float64
+}
+
+// ProductUpdate is a change of a product of a region detected by the scraping.
+type ProductUpdate struct {
+	Provider     string
+	Service      string
+	Region       string
+	InstanceType string
+	Change       string
+}
@@ -15,7 +15,7 @@
 package types
 
 import (
-	"strings"
+	"encoding/json"
 	"time"
 )
 
@@ -46,12 +46,60 @@ type CloudInfo interface {
 
 	GetStatus(provider string) (string, error)
 
+	// GetRegionStatus returns the time of the last successful scrape of a region
+	GetRegionStatus(provider, service, region string) (string, error)
+
+	// GetRegionHash returns the hash of the content of a region stored by its last successful scrape and of its prices
+	GetRegionHash(provider, service, region string) (string, error)
+
+	// GetRegionError returns the error of the last failed scrape of a region
+	GetRegionError(provider, service, region string) (ScrapeError, error)
+
 	GetProductDetails(provider, service, region string) ([]ProductDetails, error)
 
 	GetServiceImages(provider, service, region string) ([]Image, error)
 
 	GetVersions(provider, service, region string) ([]LocationVersion, error)
 
+	// GetSpotPriceHistory returns the spot price records of an instance type in effect between from and to
+	GetSpotPriceHistory(provider, region, instanceType string, from, to time.Time) ([]SpotPriceRecord, error)
+
+	// GetOnDemandPriceHistory returns the on demand price records of an instance type in effect between from and to
+	GetOnDemandPriceHistory(provider, region, instanceType string, from, to time.Time) ([]OnDemandPriceRecord, error)
+
+	// GetProductChanges returns the products changed after the given time per provider, service and region
+	GetProductChanges(provider string, since time.Time) ([]ProductChanges, error)
+
+	// GetStoragePrices returns the block storage prices in a region
+	GetStoragePrices(provider, region string) ([]StoragePrice, error)
+
+	// GetObjectStoragePrices returns the object storage prices in a region
+	GetObjectStoragePrices(provider, region string) ([]ObjectStoragePrice, error)
+
+	// GetNetworkPrices returns the network traffic prices in a region
+	GetNetworkPrices(provider, region string) ([]NetworkPrice, error)
+
+	// GetLoadBalancerPrices returns the load balancer prices in a region
+	GetLoadBalancerPrices(provider, region string) ([]LoadBalancerPrice, error)
+
+	// GetNatGatewayPrices returns the NAT gateway prices in a region
+	GetNatGatewayPrices(provider, region string) ([]NatGatewayPrice, error)
+
+	// GetPublicIpPrices returns the public IP prices in a region
+	GetPublicIpPrices(provider, region string) ([]PublicIpPrice, error)
+
+	// GetDatabases returns the database instance classes in a region
+	GetDatabases(provider, region string) ([]DatabaseInfo, error)
+
+	// GetZoneInfo returns the availability zones in a region
+	GetZoneInfo(provider, region string) ([]ZoneInfo, error)
+
+	// GetDedicatedHosts returns the dedicated hosts in a region
+	GetDedicatedHosts(provider, region string) ([]DedicatedHostInfo, error)
+
+	// GetServerlessPrices returns the serverless container prices in a region
+	GetServerlessPrices(provider, region string) ([]ServerlessPrice, error)
+
 	GetContinentsData(provider, service string) (map[string][]Region, error)
 
 	GetContinents() []string
@@ -76,12 +124,78 @@ const (
 	CategoryGpu     = "GPU instance"
 	CategoryStorage = "Storage optimized"
 
+	// Workload fit tags of virtual machines
+	WorkloadGeneral = "general"
+	WorkloadCompute = "compute"
+	WorkloadMemory  = "memory"
+	WorkloadGpuML   = "gpu-ml"
+	WorkloadStorage = "storage"
+
+	// GPU vendors
+	GpuVendorNvidia = "nvidia"
+	GpuVendorAmd    = "amd"
+
+	// network traffic types
+	TrafficInternetEgress = "internet-egress"
+	TrafficInterZone      = "inter-zone"
+	TrafficInterRegion    = "inter-region"
+
+	// reservation payment options
+	PaymentNoUpfront      = "No Upfront"
+	PaymentPartialUpfront = "Partial Upfront"
+	PaymentAllUpfront     = "All Upfront"
+
+	// managed database deployments
+	DatabaseSingleZone       = "single-zone"
+	DatabaseHighAvailability = "high-availability"
+
+	// managed database engines
+	DatabaseMySQL      = "mysql"
+	DatabasePostgreSQL = "postgresql"
+	DatabaseMariaDB    = "mariadb"
+
+	// local disk types
+	LocalDiskNVMe = "nvme"
+	LocalDiskSSD  = "ssd"
+	LocalDiskHDD  = "hdd"
+
+	// instance type lifecycle stages
+	LifecyclePreview            = "preview"
+	LifecycleGA                 = "ga"
+	LifecyclePreviousGeneration = "previous-generation"
+	LifecycleRetiring           = "retiring"
+
+	// cpu architectures
+	ArchitectureAMD64 = "amd64"
+	ArchitectureARM64 = "arm64"
+
+	// operating systems (licenses) of the on demand prices
+	OsLinux   = "linux"
+	OsWindows = "windows"
+	OsRHEL    = "rhel"
+	OsSUSE    = "suse"
+
+	// non-GPU accelerator types
+	AcceleratorTPU        = "tpu"
+	AcceleratorInferentia = "inferentia"
+	AcceleratorTrainium   = "trainium"
+	AcceleratorFPGA       = "fpga"
+
 	ContinentNorthAmerica = "North America"
 	ContinentSouthAmerica = "South America"
 	ContinentEurope       = "Europe"
 	ContinentAfrica       = "Africa"
 	ContinentAsia         = "Asia"
 	ContinentAustralia    = "Australia"
+
+	// PlacementCluster the placement strategy packing instances close together (AWS cluster placement group, GCP compact placement,
+	// Azure proximity placement group)
+	PlacementCluster = "cluster"
+	// PlacementSpread the placement strategy spreading instances across distinct hardware (AWS spread placement group,
+	// GCP spread placement)
+	PlacementSpread = "spread"
+	// PlacementPartition the placement strategy spreading groups of instances across distinct racks (AWS partition placement group)
+	PlacementPartition = "partition"
 )
 
 // NetworkPerfMapper operations related  to mapping between virtual machines to network performance categories
@@ -90,6 +204,24 @@ type NetworkPerfMapper interface {
 	MapNetworkPerf(ntwPerf string) (string, error)
 }
 
+// ZoneInfo describes an availability zone of a region
+type ZoneInfo struct {
+	// Name the name of the zone, eg.: eu-west-1a
+	Name string `json:"name"`
+	// Id the provider native id of the zone, eg.: the AWS AZ ID (euw1-az1) identifying the same location across accounts
+	Id string `json:"id,omitempty"`
+	// InstanceTypes the instance types offered in the zone, ordered by name. Only applies for providers exposing them
+	InstanceTypes []string `json:"instanceTypes,omitempty"`
+}
+
+// ScrapeError describes a failed scrape
+type ScrapeError struct {
+	// Time the time the scrape failed at
+	Time time.Time `json:"time"`
+	// Message the error message
+	Message string `json:"message"`
+}
+
 // ZonePrice struct for displaying price information per zone
 type ZonePrice struct {
 	Zone  string  `json:"zone"`
@@ -128,8 +260,14 @@ type ProductDetails struct {
 	// Embedded struct!
 	VMInfo
 
-	// Burst this is derived for now
-	Burst bool `json:"burst,omitempty"`
+	// Derived the values computed over the result set, only set on request
+	Derived *DerivedDetails `json:"derived,omitempty"`
+}
+
+// DerivedDetails holds the values of a product computed in comparison to the other products of the result set
+type DerivedDetails struct {
+	// PricePremium the on demand price premium (%) over the cheapest product in the same category, null if there is no baseline
+	PricePremium *float64 `json:"pricePremium"`
 }
 
 // ProductDetailSource product details related set of operations
@@ -138,11 +276,18 @@ type ProductDetailSource interface {
 	GetProductDetails(provider string, region string) ([]ProductDetails, error)
 }
 
+// ProductChanges holds the products of a region changed after a point in time
+type ProductChanges struct {
+	Provider string           `json:"provider"`
+	Service  string           `json:"service"`
+	Region   string           `json:"region"`
+	Products []ProductDetails `json:"products"`
+}
+
 // NewProductDetails creates a new ProductDetails struct and returns a pointer to it
 func NewProductDetails(vm VMInfo) *ProductDetails {
 	pd := ProductDetails{}
 	pd.VMInfo = vm
-	pd.Burst = vm.IsBurst()
 	return &pd
 }
 
@@ -158,6 +303,8 @@ type ServiceDescriber interface {
 type Service struct {
 	Service  string `json:"service"`
 	IsStatic bool   `json:"isStatic"`
+	// ControlPlaneFee the hourly fee of a managed Kubernetes cluster control plane in USD, omitted for the free ones
+	ControlPlaneFee float64 `json:"controlPlaneFee,omitempty"`
 }
 
 // ServiceName returns the service name
@@ -221,6 +368,30 @@ func (v Version) VersionName() string {
 type Region struct {
 	ID   string `json:"id"`
 	Name string `json:"name"`
+	// DisplayName the name of the location of the region, eg.: Frankfurt
+	DisplayName string `json:"displayName,omitempty"`
+	// Continent the continent of the region, if known
+	Continent string `json:"continent,omitempty"`
+	// Country the ISO 3166-1 alpha-2 code of the country of the region, if known
+	Country string `json:"country,omitempty"`
+	// Latitude the approximate latitude of the region, if known
+	Latitude float64 `json:"latitude,omitempty"`
+	// Longitude the approximate longitude of the region, if known
+	Longitude float64 `json:"longitude,omitempty"`
+	// Carbon the carbon intensity of the electricity of the region, if known
+	Carbon *RegionCarbon `json:"carbon,omitempty"`
+	// Compliance the compliance scopes of the region, eg.: hipaa, fedramp-moderate, c5
+	Compliance []string `json:"compliance,omitempty"`
+}
+
+// RegionCarbon describes the carbon intensity of the electricity consumed in a region
+type RegionCarbon struct {
+	// Intensity the average carbon intensity of the grid electricity in gCO2eq/kWh
+	Intensity float64 `json:"intensity" yaml:"intensity"`
+	// CarbonFreeEnergy the share of the carbon-free energy consumed in the region in percent, if known
+	CarbonFreeEnergy float64 `json:"carbonFreeEnergy,omitempty" yaml:"carbonFreeEnergy"`
+	// Source the publication the data comes from, eg.: google 2021
+	Source string `json:"source,omitempty" yaml:"source"`
 }
 
 // SpotPriceInfo represents different prices per availability zones
@@ -230,6 +401,190 @@ type SpotPriceInfo map[string]float64
 type Price struct {
 	OnDemandPrice float64       `json:"onDemandPrice"`
 	SpotPrice     SpotPriceInfo `json:"spotPrice"`
+	// ReservedPrices the prices of the instance type committed to for a term, for providers pricing commitments
+	// along with the on demand prices
+	ReservedPrices []ReservedPrice `json:"reservedPrices,omitempty"`
+	// EffectiveMonthlyPrice the price of a whole month of usage, for providers discounting sustained usage
+	EffectiveMonthlyPrice float64 `json:"effectiveMonthlyPrice,omitempty"`
+	// OsPrices the on demand prices per operating system, for providers pricing the licenses along with the instances
+	OsPrices map[string]float64 `json:"osPrices,omitempty"`
+}
+
+// SpotPriceRecord describes the spot prices of an instance type per availability zone from a point in time,
+// until the timestamp of the next record
+type SpotPriceRecord struct {
+	// Timestamp the time the prices were first scraped at
+	Timestamp time.Time `json:"timestamp"`
+	// SpotPrice the spot prices per availability zone, ordered by zone
+	SpotPrice []ZonePrice `json:"spotPrice"`
+}
+
+// OnDemandPriceRecord describes the on demand price of an instance type from a point in time, until the timestamp of
+// the next record
+type OnDemandPriceRecord struct {
+	// Timestamp the time the price was first scraped at
+	Timestamp time.Time `json:"timestamp"`
+	// OnDemandPrice the hourly on demand price
+	OnDemandPrice float64 `json:"onDemandPrice"`
+	// Currency the ISO 4217 code of the currency of the price, empty for USD
+	Currency string `json:"currency,omitempty"`
+}
+
+// ReservedPrice describes the price of an instance type reserved for a term
+type ReservedPrice struct {
+	// Term the length of the reservation, eg.: 1mo, 1yr, 3yr
+	Term string `json:"term"`
+	// PaymentOption the way the reservation is paid for, eg.: No Upfront, Partial Upfront, All Upfront
+	PaymentOption string `json:"paymentOption,omitempty"`
+	// UpfrontPrice the price paid at the start of the term
+	UpfrontPrice float64 `json:"upfrontPrice,omitempty"`
+	// MonthlyPrice the monthly price of the reservation, besides the upfront price
+	MonthlyPrice float64 `json:"monthlyPrice"`
+	// EffectiveHourlyPrice the hourly price of the reservation with the upfront price spread over the term,
+	// comparable to the on demand price
+	EffectiveHourlyPrice float64 `json:"effectiveHourlyPrice"`
+}
+
+// SavingsPlanPrice describes the hourly rate of an instance type covered by a savings plan commitment
+type SavingsPlanPrice struct {
+	// PlanType the type of the savings plan, eg.: Compute, EC2Instance
+	PlanType string `json:"planType"`
+	// Term the length of the commitment, eg.: 1yr, 3yr
+	Term string `json:"term"`
+	// PaymentOption eg.: No Upfront, Partial Upfront, All Upfront
+	PaymentOption string  `json:"paymentOption"`
+	Rate          float64 `json:"rate"`
+}
+
+// StoragePrice describes the monthly prices of a block storage volume type
+type StoragePrice struct {
+	// Type the volume type, eg.: gp3, pd-ssd, UltraSSD_LRS
+	Type string `json:"type"`
+	// PricePerGb the monthly price of a GiB of provisioned capacity
+	PricePerGb float64 `json:"pricePerGbMonth"`
+	// PricePerIops the monthly price of a provisioned IOPS, if the IOPS are provisioned separately
+	PricePerIops float64 `json:"pricePerIopsMonth,omitempty"`
+	// PricePerThroughput the monthly price of a provisioned MiB/s of throughput, if the throughput is provisioned separately
+	PricePerThroughput float64 `json:"pricePerThroughputMonth,omitempty"`
+	// PricePerSnapshotGb the monthly price of a GiB of the snapshots of the volumes, if known
+	PricePerSnapshotGb float64 `json:"pricePerSnapshotGbMonth,omitempty"`
+	// Currency the ISO 4217 code of the currency of the prices, empty for USD
+	Currency string `json:"currency,omitempty"`
+}
+
+// ObjectStoragePrice describes the prices of an object storage class
+type ObjectStoragePrice struct {
+	// Class the storage class, eg.: STANDARD_IA, NEARLINE, Cool
+	Class string `json:"class"`
+	// PricePerGb the monthly price of a GiB stored (the first pricing tier)
+	PricePerGb float64 `json:"pricePerGbMonth"`
+	// PricePerThousandWrites the price of a thousand write (PUT, COPY, POST, LIST) requests
+	PricePerThousandWrites float64 `json:"pricePerThousandWrites"`
+	// PricePerThousandReads the price of a thousand read (GET) requests
+	PricePerThousandReads float64 `json:"pricePerThousandReads"`
+	// Currency the ISO 4217 code of the currency of the prices, empty for USD
+	Currency string `json:"currency,omitempty"`
+}
+
+// NetworkPrice describes the price of a type of network traffic leaving a region or an availability zone
+type NetworkPrice struct {
+	// Type the type of the traffic: internet-egress, inter-zone or inter-region
+	Type string `json:"type"`
+	// PricePerGb the price of a GB transferred (the first paid pricing tier)
+	PricePerGb float64 `json:"pricePerGb"`
+	// Currency the ISO 4217 code of the currency of the prices, empty for USD
+	Currency string `json:"currency,omitempty"`
+}
+
+// LoadBalancerPrice describes the prices of a managed load balancer type
+type LoadBalancerPrice struct {
+	// Type the load balancer type, eg.: application, network, forwarding-rule, application-gateway-v2, flexible
+	Type string `json:"type"`
+	// PricePerHour the hourly price of a load balancer
+	PricePerHour float64 `json:"pricePerHour"`
+	// PricePerCapacityUnit the hourly price of a capacity unit (eg.: LCU, Mbps of bandwidth), if the capacity is priced separately
+	PricePerCapacityUnit float64 `json:"pricePerCapacityUnitHour,omitempty"`
+	// PricePerGb the price of a GB of processed data, if the processed data is priced separately
+	PricePerGb float64 `json:"pricePerGbProcessed,omitempty"`
+	// Currency the ISO 4217 code of the currency of the prices, empty for USD
+	Currency string `json:"currency,omitempty"`
+}
+
+// NatGatewayPrice describes the prices of a managed NAT gateway
+type NatGatewayPrice struct {
+	// Type the NAT gateway type, eg.: nat-gateway, cloud-nat
+	Type string `json:"type"`
+	// PricePerHour the hourly price of a NAT gateway
+	PricePerHour float64 `json:"pricePerHour"`
+	// PricePerGb the price of a GB of processed data
+	PricePerGb float64 `json:"pricePerGbProcessed"`
+	// Currency the ISO 4217 code of the currency of the prices, empty for USD
+	Currency string `json:"currency,omitempty"`
+}
+
+// PublicIpPrice describes the price of a public IPv4 address
+type PublicIpPrice struct {
+	// Type the kind of the address, eg.: in-use, idle (reserved, but not attached), standard
+	Type string `json:"type"`
+	// PricePerHour the hourly price of an address
+	PricePerHour float64 `json:"pricePerHour"`
+	// Currency the ISO 4217 code of the currency of the prices, empty for USD
+	Currency string `json:"currency,omitempty"`
+}
+
+// DatabaseInfo describes a managed database instance class of a database engine
+type DatabaseInfo struct {
+	// InstanceClass the name of the instance class, eg.: db.m5.large, db-custom-2-7680, Standard_D2ds_v4
+	InstanceClass string `json:"instanceClass"`
+	// Engine the database engine, eg.: mysql, postgresql, mariadb
+	Engine string `json:"engine"`
+	// Deployment the availability of the instance, single-zone or high-availability
+	Deployment string `json:"deployment"`
+	// Cpus the number of vCPUs of the instance class
+	Cpus float64 `json:"cpusPerVm"`
+	// Mem the memory of the instance class in GiB
+	Mem float64 `json:"memPerVm"`
+	// OnDemandPrice the hourly price of the instance, without the storage
+	OnDemandPrice float64 `json:"onDemandPrice"`
+	// Currency the ISO 4217 code of the currency of the prices, empty for USD
+	Currency string `json:"currency,omitempty"`
+}
+
+// DedicatedHostInfo describes a dedicated host type, a physical server running the instances of a single customer
+type DedicatedHostInfo struct {
+	// Type the host type, eg.: m5, DSv3-Type1
+	Type string `json:"type"`
+	// Family the instance family the host can run, eg.: m5, DSv3
+	Family string `json:"family"`
+	// Cores the number of physical cores of the host, if known
+	Cores float64 `json:"cores,omitempty"`
+	// Cpus the number of vCPUs of the host, if known
+	Cpus float64 `json:"cpus,omitempty"`
+	// Mem the memory of the host in GiB, if known
+	Mem float64 `json:"mem,omitempty"`
+	// OnDemandPrice the hourly price of the host, the instances running on it are not billed
+	OnDemandPrice float64 `json:"onDemandPrice"`
+	// Currency the ISO 4217 code of the currency of the prices, empty for USD
+	Currency string `json:"currency,omitempty"`
+}
+
+// ServerlessPrice describes the usage based prices of the serverless containers, the billed vCPU and memory are
+// the ones requested by the containers
+type ServerlessPrice struct {
+	// Type the serverless offering, eg.: fargate, fargate-spot, cloud-run, container-instances
+	Type string `json:"type"`
+	// Os the operating system of the containers, eg.: linux, windows
+	Os string `json:"os"`
+	// Architecture the CPU architecture of the containers, eg.: amd64, arm64
+	Architecture string `json:"architecture"`
+	// PricePerVcpuSecond the price of a vCPU-second
+	PricePerVcpuSecond float64 `json:"pricePerVcpuSecond"`
+	// PricePerGbSecond the price of a GB-second of memory
+	PricePerGbSecond float64 `json:"pricePerGbSecond"`
+	// PricePerMillionRequests the price of a million requests, if the requests are charged
+	PricePerMillionRequests float64 `json:"pricePerMillionRequests,omitempty"`
+	// Currency the ISO 4217 code of the currency of the prices, empty for USD
+	Currency string `json:"currency,omitempty"`
 }
 
 // VMInfo representation of a virtual machine
@@ -247,10 +602,115 @@ type VMInfo struct {
 	Attributes    map[string]string `json:"attributes"`
 	// CurrentGen signals whether the instance type generation is the current one. Only applies for amazon
 	CurrentGen bool `json:"currentGen"`
+	// Burst signals whether the instance type has burstable (shared or credit based) cpu performance
+	Burst bool `json:"burst"`
+	// BaselineCPU the sustained cpu performance of a burstable instance type in percent of a vCPU, if known
+	BaselineCPU float64 `json:"baselineCpu,omitempty"`
+	// CpuCredits the cpu credit model of a burstable instance type, if known
+	CpuCredits *CpuCredits `json:"cpuCredits,omitempty"`
+	// PlacementGroup the supported low-latency placement strategy of the instance type, empty if not supported
+	PlacementGroup string `json:"placementGroup,omitempty"`
+	// PlacementStrategies the placement strategies supported by the instance type (cluster, spread, partition), if known
+	PlacementStrategies []string `json:"placementStrategies,omitempty"`
+	// RawPayload the (redacted) provider response the instance type was mapped from, only retained in debug mode
+	RawPayload json.RawMessage `json:"rawPayload,omitempty"`
+	// SavingsPlans the savings plans rates of the instance type. Only applies for amazon
+	SavingsPlans []SavingsPlanPrice `json:"savingsPlans,omitempty"`
+	// MonthlyPrice the monthly price (cap) of the instance type. Only applies for providers billing monthly
+	MonthlyPrice float64 `json:"monthlyPrice,omitempty"`
+	// EffectiveMonthlyPrice the price of a whole month of usage with the sustained use discounts applied.
+	// Only applies for providers discounting sustained usage
+	EffectiveMonthlyPrice float64 `json:"effectiveMonthlyPrice,omitempty"`
+	// Currency the ISO 4217 code of the currency of the prices, empty for USD
+	Currency string `json:"currency,omitempty"`
+	// Workloads the workload fit tags of the instance type (general, compute, memory, gpu-ml, storage)
+	Workloads []string `json:"workloads,omitempty"`
+	// BareMetal signals a dedicated physical server without a hypervisor, eg.: i3.metal, BM.Standard2.52
+	BareMetal bool `json:"bareMetal,omitempty"`
+	// NICs the number of network interfaces of the instance type, if known
+	NICs int `json:"nics,omitempty"`
+	// ReservedPrices the prices of the instance type reserved for a term. Only applies for providers with reservations
+	ReservedPrices []ReservedPrice `json:"reservedPrices,omitempty"`
+	// OsPrices the on demand prices per operating system (linux, windows, rhel, suse) including the license fees, if known
+	OsPrices map[string]float64 `json:"osPrices,omitempty"`
+	// Partition the partition of the provider the instance type is offered in, eg.: aws-us-gov. Only applies for amazon
+	Partition string `json:"partition,omitempty"`
+	// GpuVendor the vendor of the GPUs of the instance type, eg.: nvidia, amd, if known
+	GpuVendor string `json:"gpuVendor,omitempty"`
+	// GpuModel the model of the GPUs of the instance type, eg.: Tesla V100, if known
+	GpuModel string `json:"gpuModel,omitempty"`
+	// GpuMem the memory of the GPUs of the instance type altogether in GiB, if known
+	GpuMem float64 `json:"gpuMemPerVm,omitempty"`
+	// LocalDisks the local (instance store) disks of the instance type, if known
+	LocalDisks []LocalDisk `json:"localDisks,omitempty"`
+	// Lifecycle the lifecycle stage of the instance type (preview, ga, previous-generation, retiring), if known
+	Lifecycle string `json:"lifecycle,omitempty"`
+	// Architecture the cpu architecture of the instance type (amd64 or arm64)
+	Architecture string `json:"architecture,omitempty"`
+	// NetworkLimits the network interface and private IP address limits of the instance type, if known
+	NetworkLimits *NetworkLimits `json:"networkLimits,omitempty"`
+	// AcceleratedNetworking signals the support of accelerated networking (ENA, SR-IOV) by the instance type, if known
+	AcceleratedNetworking bool `json:"acceleratedNetworking,omitempty"`
+	// IPv6 signals the support of IPv6 addresses by the instance type, if known
+	IPv6 bool `json:"ipv6,omitempty"`
+	// NtwPerfGbps the (maximum) network performance of the instance type in Gbps, the numeric form of NtwPerf, if known
+	NtwPerfGbps float64 `json:"networkPerfGbps,omitempty"`
+	// MaxPods the maximum number of pods of a node of the managed kubernetes service, if known
+	MaxPods int `json:"maxPods,omitempty"`
+	// FreeTier signals whether the instance type is eligible for the free tier (always free) offering of the provider
+	FreeTier bool `json:"freeTier,omitempty"`
+	// Accelerators the non-GPU accelerators (TPU, Inferentia, Trainium, FPGA) of the instance type, if any
+	Accelerators []Accelerator `json:"accelerators,omitempty"`
+	// UpdatedAt the time the price or the attributes of the instance type were last changed at
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// CpuCredits describes the cpu credit model of a burstable instance type, a credit is a vCPU running at 100% for a minute
+type CpuCredits struct {
+	// EarnedPerHour the number of credits earned per hour while running at the baseline performance
+	EarnedPerHour float64 `json:"earnedPerHour"`
+	// MaxAccrued the maximum number of credits that can be accrued
+	MaxAccrued float64 `json:"maxAccrued"`
+	// UnlimitedSurcharge the price of the surplus credits spent in unlimited mode per vCPU-hour, missing if not supported
+	UnlimitedSurcharge float64 `json:"unlimitedSurcharge,omitempty"`
+}
+
+// NetworkLimits describes the network interface and private IP address limits of an instance type
+type NetworkLimits struct {
+	// MaxInterfaces the maximum number of network interfaces
+	MaxInterfaces int `json:"maxInterfaces"`
+	// IPv4PerInterface the maximum number of private IPv4 addresses per network interface
+	IPv4PerInterface int `json:"ipv4PerInterface"`
+	// IPv6PerInterface the maximum number of IPv6 addresses per network interface, missing if unknown
+	IPv6PerInterface int `json:"ipv6PerInterface,omitempty"`
+}
+
+// Accelerator describes a group of identical non-GPU accelerators attached to an instance type
+type Accelerator struct {
+	// Type the type of the accelerators: tpu, inferentia, trainium or fpga
+	Type string `json:"type"`
+	// Vendor the vendor of the accelerators, eg.: aws, google, xilinx, if known
+	Vendor string `json:"vendor,omitempty"`
+	// Model the model of the accelerators, eg.: Inferentia2, tpu-v5-lite-podslice, Virtex UltraScale (VU9P), if known
+	Model string `json:"model,omitempty"`
+	// Count the number of the accelerators
+	Count int `json:"count"`
+	// Memory the memory of the accelerators altogether in GiB, if known
+	Memory float64 `json:"memory,omitempty"`
+}
+
+// LocalDisk describes a group of identical local disks attached to an instance type
+type LocalDisk struct {
+	// Count the number of the disks
+	Count int `json:"count"`
+	// Size the size of a disk in GB
+	Size float64 `json:"size"`
+	// Type the type of the disks: nvme, ssd or hdd
+	Type string `json:"type"`
 }
 
-// IsBurst returns true if the EC2 instance vCPU is burst type
-// the decision is made based on the instance type
+// IsBurst returns true if the instance type has burstable cpu performance
+// the flag is populated by the provider specific infoers
 func (vm VMInfo) IsBurst() bool {
-	return strings.HasPrefix(strings.ToUpper(vm.Type), "T")
+	return vm.Burst
 }
@@ -0,0 +1,74 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"context"
+
+	"emperror.dev/emperror"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// VersionStore retrieves versions.
+type VersionStore interface {
+	// GetVersions returns the versions of a service in a region.
+	GetVersions(provider, service, region string) ([]types.LocationVersion, error)
+}
+
+// VersionService provides access to the versions of a service, eg.: the Kubernetes versions of a managed service.
+type VersionService struct {
+	store VersionStore
+}
+
+// NewVersionService returns a new VersionService.
+func NewVersionService(store VersionStore) *VersionService {
+	return &VersionService{
+		store: store,
+	}
+}
+
+// LocationVersion is the list of the versions of a service available in a location.
+type LocationVersion struct {
+	Location string
+	Versions []string
+	Default  string
+}
+
+// ListVersions returns a list of versions of a service in a region.
+func (s *VersionService) ListVersions(ctx context.Context, provider string, service string, region string) ([]LocationVersion, error) {
+	cloudVersions, err := s.store.GetVersions(provider, service, region)
+	if err != nil {
+		return nil, emperror.WrapWith(
+			err,
+			"failed to list versions",
+			"provider", provider,
+			"service", service,
+			"region", region,
+		)
+	}
+
+	versions := make([]LocationVersion, len(cloudVersions))
+
+	for i, version := range cloudVersions {
+		versions[i] = LocationVersion{
+			Location: version.Location,
+			Versions: version.Versions,
+			Default:  version.Default,
+		}
+	}
+
+	return versions, nil
+}
@@ -0,0 +1,36 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+// InMemoryVersionStore keeps versions in the memory.
+// Use it in tests or for development/demo purposes.
+type InMemoryVersionStore struct {
+	versions map[string]map[string]map[string][]types.LocationVersion
+}
+
+// NewInMemoryVersionStore returns a new InMemoryVersionStore.
+func NewInMemoryVersionStore() *InMemoryVersionStore {
+	return &InMemoryVersionStore{
+		versions: make(map[string]map[string]map[string][]types.LocationVersion),
+	}
+}
+
+func (s *InMemoryVersionStore) GetVersions(provider, service, region string) ([]types.LocationVersion, error) {
+	return s.versions[provider][service][region], nil
+}
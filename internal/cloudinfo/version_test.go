@@ -0,0 +1,51 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestVersionService_ListVersions(t *testing.T) {
+	store := NewInMemoryVersionStore()
+	store.versions = map[string]map[string]map[string][]types.LocationVersion{
+		"amazon": {
+			"eks": {
+				"eu-west-1": []types.LocationVersion{
+					types.NewLocationVersion("eu-west-1", []string{"1.20", "1.21"}, "1.21"),
+				},
+			},
+		},
+	}
+
+	versionService := NewVersionService(store)
+
+	versions, err := versionService.ListVersions(context.Background(), "amazon", "eks", "eu-west-1")
+	require.NoError(t, err)
+
+	assert.Equal(
+		t,
+		[]LocationVersion{
+			{Location: "eu-west-1", Versions: []string{"1.20", "1.21"}, Default: "1.21"},
+		},
+		versions,
+	)
+}
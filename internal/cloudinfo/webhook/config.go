@@ -0,0 +1,99 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"net/url"
+	"time"
+
+	"emperror.dev/errors"
+)
+
+// Config holds the configuration of the webhooks notified of the product changes
+type Config struct {
+	// Endpoints the change notifications are posted to
+	Endpoints []EndpointConfig
+
+	// Attempts the number of attempts of delivering a notification to an endpoint
+	Attempts int
+
+	// RetryBackoff the wait before the retries, multiplied by the number of the failed attempts
+	RetryBackoff time.Duration
+
+	// Timeout the timeout of a delivery attempt
+	Timeout time.Duration
+}
+
+// EndpointConfig holds the configuration of a webhook endpoint
+type EndpointConfig struct {
+	// URL the notifications are posted to
+	URL string
+
+	// Secret the payloads are signed with (HMAC-SHA256), the payloads are not signed if empty
+	Secret string
+
+	// Providers the notifications are sent for, all of them if empty
+	Providers []string
+
+	// Regions the notifications are sent for, all of them if empty
+	Regions []string
+}
+
+// Validate checks that the configuration is valid.
+func (c Config) Validate() error {
+	if len(c.Endpoints) == 0 {
+		return nil
+	}
+
+	if c.Attempts < 1 {
+		return errors.New("webhook attempts must be positive")
+	}
+
+	if c.RetryBackoff < 0 {
+		return errors.New("webhook retry backoff must not be negative")
+	}
+
+	if c.Timeout <= 0 {
+		return errors.New("webhook timeout must be positive")
+	}
+
+	for _, endpoint := range c.Endpoints {
+		u, err := url.Parse(endpoint.URL)
+		if err != nil {
+			return errors.WrapIfWithDetails(err, "invalid webhook url", "url", endpoint.URL)
+		}
+
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return errors.NewWithDetails("webhook url must be an http or https url", "url", endpoint.URL)
+		}
+	}
+
+	return nil
+}
+
+// matches checks whether the notifications of the provider and the region are sent to the endpoint
+func (e EndpointConfig) matches(provider, region string) bool {
+	return (len(e.Providers) == 0 || contains(e.Providers, provider)) && (len(e.Regions) == 0 || contains(e.Regions, region))
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,170 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/cloudinfo/internal/app/cloudinfo/messaging"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo"
+)
+
+const (
+	// EventProductsChanged the event of the notifications of the product changes
+	EventProductsChanged = "products.changed"
+
+	// SignatureHeader the header of the HMAC-SHA256 signature of the payload, in the sha256=<hex digest> form
+	SignatureHeader = "X-Cloudinfo-Signature"
+
+	// EventHeader the header of the event of the payload
+	EventHeader = "X-Cloudinfo-Event"
+)
+
+// Payload is the JSON body of the notifications
+type Payload struct {
+	Event     string    `json:"event"`
+	Timestamp time.Time `json:"timestamp"`
+	Provider  string    `json:"provider"`
+	Service   string    `json:"service"`
+	Region    string    `json:"region"`
+	Added     []string  `json:"added,omitempty"`
+	Removed   []string  `json:"removed,omitempty"`
+	Repriced  []string  `json:"repriced,omitempty"`
+}
+
+// Dispatcher posts the product changes detected by the scraping to the configured endpoints
+type Dispatcher struct {
+	endpoints    []EndpointConfig
+	attempts     int
+	retryBackoff time.Duration
+	httpClient   *http.Client
+	log          cloudinfo.Logger
+}
+
+// NewDispatcher creates a new webhook dispatcher
+func NewDispatcher(config Config, log cloudinfo.Logger) *Dispatcher {
+	return &Dispatcher{
+		endpoints:    config.Endpoints,
+		attempts:     config.Attempts,
+		retryBackoff: config.RetryBackoff,
+		httpClient:   &http.Client{Timeout: config.Timeout},
+		log:          log.WithFields(map[string]interface{}{"component": "webhook"}),
+	}
+}
+
+// Subscribe subscribes the dispatcher to the product changes of the event bus
+func (d *Dispatcher) Subscribe(eventBus messaging.EventBus) {
+	eventBus.SubscribeProductsChanged(func(provider, service, region string, change messaging.ProductsChange) {
+		d.Dispatch(context.Background(), Payload{
+			Event:     EventProductsChanged,
+			Timestamp: time.Now().UTC(),
+			Provider:  provider,
+			Service:   service,
+			Region:    region,
+			Added:     change.Added,
+			Removed:   change.Removed,
+			Repriced:  change.Repriced,
+		})
+	})
+}
+
+// Dispatch posts the payload to the endpoints interested in its provider and region, the failed deliveries are logged
+func (d *Dispatcher) Dispatch(ctx context.Context, payload Payload) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		d.log.Error("failed to marshal webhook payload", map[string]interface{}{"error": err.Error()})
+		return
+	}
+
+	for _, endpoint := range d.endpoints {
+		if !endpoint.matches(payload.Provider, payload.Region) {
+			continue
+		}
+
+		if err := d.deliver(ctx, endpoint, payload.Event, body); err != nil {
+			d.log.Warn("failed to deliver webhook", map[string]interface{}{"url": endpoint.URL, "provider": payload.Provider,
+				"service": payload.Service, "region": payload.Region, "error": err.Error()})
+			continue
+		}
+
+		d.log.Debug("webhook delivered", map[string]interface{}{"url": endpoint.URL, "provider": payload.Provider,
+			"service": payload.Service, "region": payload.Region})
+	}
+}
+
+// deliver posts the body to the endpoint, retrying the failed attempts
+func (d *Dispatcher) deliver(ctx context.Context, endpoint EndpointConfig, event string, body []byte) error {
+	var err error
+	for attempt := 1; attempt <= d.attempts; attempt++ {
+		if err = d.post(ctx, endpoint, event, body); err == nil {
+			return nil
+		}
+
+		if attempt == d.attempts {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.WrapIf(ctx.Err(), "webhook delivery cancelled")
+		case <-time.After(time.Duration(attempt) * d.retryBackoff):
+		}
+	}
+
+	return errors.WithDetails(err, "attempts", d.attempts)
+}
+
+// post posts the body to the endpoint once, the non 2xx responses are considered failures
+func (d *Dispatcher) post(ctx context.Context, endpoint EndpointConfig, event string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.URL, bytes.NewReader(body))
+	if err != nil {
+		return errors.WrapIf(err, "failed to create request")
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, event)
+	if endpoint.Secret != "" {
+		req.Header.Set(SignatureHeader, Sign(endpoint.Secret, body))
+	}
+
+	resp, err := d.httpClient.Do(req)
+	if err != nil {
+		return errors.WrapIfWithDetails(err, "failed to post webhook", "url", endpoint.URL)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return errors.NewWithDetails("unexpected response from the webhook", "url", endpoint.URL, "status", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// Sign returns the HMAC-SHA256 signature of the body with the secret in the sha256=<hex digest> form
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
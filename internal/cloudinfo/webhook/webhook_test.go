@@ -0,0 +1,163 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"logur.dev/logur"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/cloudinfoadapter"
+)
+
+// recorder records the requests received by a webhook endpoint, failing the first ones
+type recorder struct {
+	failures   int
+	requests   int
+	bodies     [][]byte
+	signatures []string
+	mu         sync.Mutex
+}
+
+func (r *recorder) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.requests++
+	if r.requests <= r.failures {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+
+	body, _ := ioutil.ReadAll(req.Body)
+	r.bodies = append(r.bodies, body)
+	r.signatures = append(r.signatures, req.Header.Get(SignatureHeader))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func TestDispatcher_Dispatch(t *testing.T) {
+	payload := Payload{Event: EventProductsChanged, Provider: "amazon", Service: "compute", Region: "eu-west-1",
+		Added: []string{"m6i.large"}, Repriced: []string{"m5.large"}}
+	body, _ := json.Marshal(payload)
+
+	tests := []struct {
+		name      string
+		endpoint  EndpointConfig
+		failures  int
+		requests  int
+		delivered bool
+		signature string
+	}{
+		{
+			name:      "signed payload delivered",
+			endpoint:  EndpointConfig{Secret: "secret"},
+			requests:  1,
+			delivered: true,
+			signature: Sign("secret", body),
+		},
+		{
+			name:      "unsigned payload delivered to matching provider and region",
+			endpoint:  EndpointConfig{Providers: []string{"amazon"}, Regions: []string{"eu-west-1", "us-east-1"}},
+			requests:  1,
+			delivered: true,
+		},
+		{
+			name:     "other provider filtered",
+			endpoint: EndpointConfig{Providers: []string{"google"}},
+		},
+		{
+			name:     "other region filtered",
+			endpoint: EndpointConfig{Regions: []string{"us-east-1"}},
+		},
+		{
+			name:      "delivered after retries",
+			endpoint:  EndpointConfig{},
+			failures:  2,
+			requests:  3,
+			delivered: true,
+		},
+		{
+			name:     "given up after the attempts",
+			endpoint: EndpointConfig{},
+			failures: 5,
+			requests: 3,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rec := &recorder{failures: test.failures}
+			server := httptest.NewServer(rec)
+			defer server.Close()
+
+			test.endpoint.URL = server.URL
+			dispatcher := NewDispatcher(Config{Endpoints: []EndpointConfig{test.endpoint}, Attempts: 3, Timeout: time.Second},
+				cloudinfoadapter.NewLogger(&logur.TestLogger{}))
+			dispatcher.Dispatch(context.Background(), payload)
+
+			assert.Equal(t, test.requests, rec.requests)
+			if test.delivered {
+				assert.Equal(t, [][]byte{body}, rec.bodies)
+				assert.Equal(t, []string{test.signature}, rec.signatures)
+			} else {
+				assert.Empty(t, rec.bodies)
+			}
+		})
+	}
+}
+
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		config Config
+		valid  bool
+	}{
+		{
+			name:   "no endpoints",
+			config: Config{},
+			valid:  true,
+		},
+		{
+			name:   "valid endpoints",
+			config: Config{Endpoints: []EndpointConfig{{URL: "https://example.com/hook"}}, Attempts: 3, Timeout: time.Second},
+			valid:  true,
+		},
+		{
+			name:   "invalid url",
+			config: Config{Endpoints: []EndpointConfig{{URL: "example.com/hook"}}, Attempts: 3, Timeout: time.Second},
+		},
+		{
+			name:   "no attempts",
+			config: Config{Endpoints: []EndpointConfig{{URL: "https://example.com/hook"}}, Timeout: time.Second},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.config.Validate()
+			if test.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"sort"
+	"strings"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+const (
+	// memory per vCPU (GiB) at or above which an instance type fits memory intensive workloads
+	memoryIntensiveRatio = 6.5
+	// memory per vCPU (GiB) at or below which an instance type fits compute intensive workloads
+	computeIntensiveRatio = 2.5
+)
+
+// workloadOrder the order of the workload tags in the classification
+var workloadOrder = map[string]int{
+	types.WorkloadGeneral: 0,
+	types.WorkloadCompute: 1,
+	types.WorkloadMemory:  2,
+	types.WorkloadGpuML:   3,
+	types.WorkloadStorage: 4,
+}
+
+// WorkloadClassifier suggests the workloads an instance type fits.
+//
+// The classification rules are (an instance type can fit several workloads):
+//   - gpu-ml: the instance type has GPUs or is in the GPU category
+//   - memory: the instance type is in the memory optimized category or has at least 6.5 GiB memory per vCPU
+//   - compute: the instance type is in the compute optimized category or has at most 2.5 GiB memory per vCPU
+//     (the memory per vCPU ratios are not applied to burstable instance types)
+//   - storage: the instance type is in the storage optimized category
+//   - general: the instance type is in the general purpose category or doesn't fit any of the above
+//
+// The rules can be overridden per instance type family with a mapping of instance type prefixes to workloads,
+// the longest matching prefix wins (case insensitive).
+type WorkloadClassifier struct {
+	mapping map[string][]string
+}
+
+// NewWorkloadClassifier creates a new workload classifier with the given instance type prefix - workloads mapping
+func NewWorkloadClassifier(mapping map[string][]string) *WorkloadClassifier {
+	return &WorkloadClassifier{
+		mapping: mapping,
+	}
+}
+
+// Classify returns the workloads the instance type fits
+func (wc *WorkloadClassifier) Classify(vm types.VMInfo) []string {
+	if workloads, ok := wc.lookup(vm.Type); ok {
+		return workloads
+	}
+
+	var workloads []string
+	if vm.Gpus > 0 || vm.Category == types.CategoryGpu {
+		workloads = append(workloads, types.WorkloadGpuML)
+	}
+
+	if vm.Category == types.CategoryStorage {
+		workloads = append(workloads, types.WorkloadStorage)
+	}
+
+	var ratio float64
+	if vm.Cpus > 0 && !vm.Burst {
+		ratio = vm.Mem / vm.Cpus
+	}
+
+	if vm.Category == types.CategoryMemory || ratio >= memoryIntensiveRatio {
+		workloads = append(workloads, types.WorkloadMemory)
+	}
+
+	if vm.Category == types.CategoryCompute || (ratio > 0 && ratio <= computeIntensiveRatio) {
+		workloads = append(workloads, types.WorkloadCompute)
+	}
+
+	if vm.Category == types.CategoryGeneral || len(workloads) == 0 {
+		workloads = append(workloads, types.WorkloadGeneral)
+	}
+
+	sort.Slice(workloads, func(i, j int) bool {
+		return workloadOrder[workloads[i]] < workloadOrder[workloads[j]]
+	})
+
+	return workloads
+}
+
+// IsWorkload checks whether the given value is a known workload tag
+func IsWorkload(workload string) bool {
+	_, ok := workloadOrder[workload]
+	return ok
+}
+
+// ValidateWorkloadMapping checks that the instance type prefix - workloads mapping only holds known workload tags
+func ValidateWorkloadMapping(mapping map[string][]string) error {
+	for prefix, workloads := range mapping {
+		for _, workload := range workloads {
+			if !IsWorkload(workload) {
+				return errors.NewWithDetails("unknown workload", "prefix", prefix, "workload", workload)
+			}
+		}
+	}
+
+	return nil
+}
+
+// lookup returns the configured workloads of the longest prefix matching the instance type
+func (wc *WorkloadClassifier) lookup(instanceType string) ([]string, bool) {
+	var (
+		match     string
+		workloads []string
+	)
+
+	for prefix, w := range wc.mapping {
+		if strings.HasPrefix(strings.ToLower(instanceType), strings.ToLower(prefix)) && len(prefix) > len(match) {
+			match, workloads = prefix, w
+		}
+	}
+
+	return workloads, match != ""
+}
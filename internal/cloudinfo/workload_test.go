@@ -0,0 +1,81 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudinfo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+func TestWorkloadClassifier_Classify(t *testing.T) {
+	tests := []struct {
+		name      string
+		mapping   map[string][]string
+		vm        types.VMInfo
+		workloads []string
+	}{
+		{
+			name:      "gpu instance type",
+			vm:        types.VMInfo{Type: "p3.2xlarge", Category: types.CategoryGpu, Cpus: 8, Mem: 61, Gpus: 1},
+			workloads: []string{types.WorkloadMemory, types.WorkloadGpuML},
+		},
+		{
+			name:      "gpu category without gpu count",
+			vm:        types.VMInfo{Type: "g4dn.xlarge", Category: types.CategoryGpu, Cpus: 4, Mem: 16},
+			workloads: []string{types.WorkloadGpuML},
+		},
+		{
+			name:      "compute optimized instance type",
+			vm:        types.VMInfo{Type: "c5.large", Category: types.CategoryCompute, Cpus: 2, Mem: 4},
+			workloads: []string{types.WorkloadCompute},
+		},
+		{
+			name:      "memory intensive instance type without category",
+			vm:        types.VMInfo{Type: "n2-highmem-2", Cpus: 2, Mem: 16},
+			workloads: []string{types.WorkloadMemory},
+		},
+		{
+			name:      "burstable instance type is not classified by its ratio",
+			vm:        types.VMInfo{Type: "t3.micro", Category: types.CategoryGeneral, Cpus: 2, Mem: 1, Burst: true},
+			workloads: []string{types.WorkloadGeneral},
+		},
+		{
+			name:      "instance type without a clear fit",
+			vm:        types.VMInfo{Type: "unknown", Cpus: 2, Mem: 8},
+			workloads: []string{types.WorkloadGeneral},
+		},
+		{
+			name:      "longest matching prefix of the mapping wins",
+			mapping:   map[string][]string{"i": {types.WorkloadGeneral}, "i3": {types.WorkloadStorage}},
+			vm:        types.VMInfo{Type: "i3.large", Category: types.CategoryStorage, Cpus: 2, Mem: 15.25},
+			workloads: []string{types.WorkloadStorage},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.workloads, NewWorkloadClassifier(test.mapping).Classify(test.vm))
+		})
+	}
+}
+
+func TestValidateWorkloadMapping(t *testing.T) {
+	assert.NoError(t, ValidateWorkloadMapping(nil))
+	assert.NoError(t, ValidateWorkloadMapping(map[string][]string{"p3": {types.WorkloadGpuML, types.WorkloadMemory}}))
+	assert.Error(t, ValidateWorkloadMapping(map[string][]string{"p3": {"gpu"}}), "unknown workloads should be rejected")
+}
@@ -0,0 +1,322 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"bufio"
+	"bytes"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"emperror.dev/errors"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo"
+)
+
+const (
+	// startTimeout is the time a plugin has to complete the handshake after it's launched
+	startTimeout = 10 * time.Second
+
+	// stopTimeout is the time a plugin has to exit after its connection is closed, before it's killed
+	stopTimeout = 5 * time.Second
+)
+
+// Config describes how to launch a plugin.
+type Config struct {
+	// Path of the plugin executable
+	Path string
+
+	// Args passed to the plugin executable
+	Args []string
+}
+
+// Client is a CloudInfoer backed by a plugin process.
+type Client struct {
+	name   string
+	cmd    *exec.Cmd
+	rpc    *rpc.Client
+	exited chan struct{}
+	log    cloudinfo.Logger
+}
+
+// Start launches the plugin executable and completes the handshake with it.
+// The plugin process is stopped by closing the returned client.
+func Start(name string, config Config, log cloudinfo.Logger) (*Client, error) {
+	if config.Path == "" {
+		return nil, errors.NewWithDetails("plugin path is required", "plugin", name)
+	}
+
+	// the pipes are created explicitly (instead of cmd.StdoutPipe) so waiting for the process doesn't close our ends
+	stdinR, stdinW, err := os.Pipe()
+	if err != nil {
+		return nil, errors.WrapIfWithDetails(err, "failed to create plugin pipe", "plugin", name)
+	}
+
+	stdoutR, stdoutW, err := os.Pipe()
+	if err != nil {
+		closeAll(stdinR, stdinW)
+		return nil, errors.WrapIfWithDetails(err, "failed to create plugin pipe", "plugin", name)
+	}
+
+	cmd := exec.Command(config.Path, config.Args...)
+	cmd.Env = append(os.Environ(), MagicCookieKey+"="+MagicCookieValue)
+	cmd.Stdin = stdinR
+	cmd.Stdout = stdoutW
+	cmd.Stderr = &logWriter{log: log}
+
+	if err := cmd.Start(); err != nil {
+		closeAll(stdinR, stdinW, stdoutR, stdoutW)
+		return nil, errors.WrapIfWithDetails(err, "failed to start plugin", "plugin", name, "path", config.Path)
+	}
+
+	// the child process holds its own copies of these ends
+	closeAll(stdinR, stdoutW)
+
+	c := &Client{
+		name:   name,
+		cmd:    cmd,
+		exited: make(chan struct{}),
+		log:    log,
+	}
+
+	go func() {
+		if err := cmd.Wait(); err != nil {
+			log.Warn("plugin exited", map[string]interface{}{"err": err.Error()})
+		} else {
+			log.Debug("plugin exited")
+		}
+		close(c.exited)
+	}()
+
+	reader := bufio.NewReader(stdoutR)
+
+	if err := c.handshake(reader); err != nil {
+		closeAll(stdinW, stdoutR)
+		_ = cmd.Process.Kill()
+		<-c.exited
+
+		return nil, errors.WithDetails(err, "plugin", name, "path", config.Path)
+	}
+
+	c.rpc = rpc.NewClientWithCodec(jsonrpc.NewClientCodec(readWriteCloser{Reader: reader, WriteCloser: stdinW, closer: stdoutR}))
+
+	return c, nil
+}
+
+// handshake waits for the handshake line of the plugin and checks the protocol version in it
+func (c *Client) handshake(reader *bufio.Reader) error {
+	lines := make(chan string, 1)
+	errs := make(chan error, 1)
+
+	go func() {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			errs <- err
+			return
+		}
+		lines <- line
+	}()
+
+	select {
+	case line := <-lines:
+		return parseHandshake(line)
+	case err := <-errs:
+		return errors.WrapIf(err, "failed to read plugin handshake")
+	case <-c.exited:
+		return errors.New("plugin exited before the handshake")
+	case <-time.After(startTimeout):
+		return errors.New("timed out waiting for the plugin handshake")
+	}
+}
+
+// parseHandshake checks the handshake line written by a plugin
+func parseHandshake(line string) error {
+	line = strings.TrimSpace(line)
+	if !strings.HasPrefix(line, handshakePrefix) {
+		return errors.NewWithDetails("unexpected plugin handshake", "handshake", line)
+	}
+
+	version, err := strconv.Atoi(strings.TrimPrefix(line, handshakePrefix))
+	if err != nil {
+		return errors.WrapIfWithDetails(err, "invalid plugin protocol version", "handshake", line)
+	}
+
+	if version != ProtocolVersion {
+		return errors.NewWithDetails("incompatible plugin protocol version", "version", version, "expected", ProtocolVersion)
+	}
+
+	return nil
+}
+
+// Close closes the connection to the plugin and waits for it to exit.
+func (c *Client) Close() error {
+	// the plugin exits once its standard input is closed
+	_ = c.rpc.Close()
+
+	c.stop()
+
+	return nil
+}
+
+// stop waits for the plugin process to exit, killing it when it doesn't in time
+func (c *Client) stop() {
+	select {
+	case <-c.exited:
+		return
+	case <-time.After(stopTimeout):
+	}
+
+	if err := c.cmd.Process.Kill(); err != nil {
+		c.log.Warn("failed to kill plugin", map[string]interface{}{"err": err.Error()})
+	}
+
+	<-c.exited
+}
+
+// call calls the given method of the plugin
+func (c *Client) call(method string, args interface{}, reply interface{}) error {
+	if err := c.rpc.Call(serviceName+"."+method, args, reply); err != nil {
+		return errors.WrapIfWithDetails(err, "plugin call failed", "plugin", c.name, "method", method)
+	}
+
+	return nil
+}
+
+// Initialize is called once per product info renewals so it can be used to download a large price descriptor
+func (c *Client) Initialize() (map[string]map[string]Price, error) {
+	var reply map[string]map[string]Price
+	err := c.call("Initialize", Empty{}, &reply)
+
+	return reply, err
+}
+
+// GetVirtualMachines retrieves the available virtual machines in a region
+func (c *Client) GetVirtualMachines(region string) ([]VMInfo, error) {
+	var reply []VMInfo
+	err := c.call("GetVirtualMachines", RegionArgs{Region: region}, &reply)
+
+	return reply, err
+}
+
+// GetProducts retrieves the available virtual machines based on the arguments provided
+func (c *Client) GetProducts(vms []VMInfo, service, regionId string) ([]VMInfo, error) {
+	var reply []VMInfo
+	err := c.call("GetProducts", ProductsArgs{VMs: vms, Service: service, Region: regionId}, &reply)
+
+	return reply, err
+}
+
+// GetZones returns the availability zones in a region
+func (c *Client) GetZones(region string) ([]string, error) {
+	var reply []string
+	err := c.call("GetZones", RegionArgs{Region: region}, &reply)
+
+	return reply, err
+}
+
+// GetRegions returns a map with available regions
+func (c *Client) GetRegions(service string) (map[string]string, error) {
+	var reply map[string]string
+	err := c.call("GetRegions", ServiceArgs{Service: service}, &reply)
+
+	return reply, err
+}
+
+// HasShortLivedPriceInfo signals if a product info provider has frequently changing price info
+func (c *Client) HasShortLivedPriceInfo() bool {
+	var reply bool
+	if err := c.call("HasShortLivedPriceInfo", Empty{}, &reply); err != nil {
+		c.log.Warn("failed to call plugin", map[string]interface{}{"err": err.Error()})
+	}
+
+	return reply
+}
+
+// GetCurrentPrices retrieves all the spot prices in a region
+func (c *Client) GetCurrentPrices(region string) (map[string]Price, error) {
+	var reply map[string]Price
+	err := c.call("GetCurrentPrices", RegionArgs{Region: region}, &reply)
+
+	return reply, err
+}
+
+// HasImages signals if a product info provider has image support
+func (c *Client) HasImages() bool {
+	var reply bool
+	if err := c.call("HasImages", Empty{}, &reply); err != nil {
+		c.log.Warn("failed to call plugin", map[string]interface{}{"err": err.Error()})
+	}
+
+	return reply
+}
+
+// GetServiceImages retrieves the images supported by the given service in the given region
+func (c *Client) GetServiceImages(service, region string) ([]Image, error) {
+	var reply []Image
+	err := c.call("GetServiceImages", ServiceRegionArgs{Service: service, Region: region}, &reply)
+
+	return reply, err
+}
+
+// GetVersions retrieves the versions supported by the given service in the given region
+func (c *Client) GetVersions(service, region string) ([]LocationVersion, error) {
+	var reply []LocationVersion
+	err := c.call("GetVersions", ServiceRegionArgs{Service: service, Region: region}, &reply)
+
+	return reply, err
+}
+
+// GetServiceProducts retrieves the products supported by the given service in the given region
+func (c *Client) GetServiceProducts(region, service string) ([]ProductDetails, error) {
+	var reply []ProductDetails
+	err := c.call("GetServiceProducts", ServiceRegionArgs{Service: service, Region: region}, &reply)
+
+	return reply, err
+}
+
+// logWriter logs the lines written by the plugin to its standard error
+type logWriter struct {
+	log cloudinfo.Logger
+	buf bytes.Buffer
+}
+
+func (w *logWriter) Write(p []byte) (int, error) {
+	w.buf.Write(p)
+
+	for {
+		i := bytes.IndexByte(w.buf.Bytes(), '\n')
+		if i < 0 {
+			break
+		}
+
+		if line := strings.TrimSpace(string(w.buf.Next(i + 1))); line != "" {
+			w.log.Info(line)
+		}
+	}
+
+	return len(p), nil
+}
+
+// closeAll closes the given files ignoring the errors
+func closeAll(files ...*os.File) {
+	for _, f := range files {
+		_ = f.Close()
+	}
+}
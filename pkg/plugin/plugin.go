@@ -0,0 +1,94 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package plugin lets third parties ship cloud info providers out-of-tree.
+//
+// A plugin is a standalone executable that implements CloudInfoer and calls Serve from its main function.
+// Cloudinfo launches the configured plugin executables on startup and talks to them over their
+// standard input and output using JSON-RPC, so plugins can be built independently of the cloudinfo binary.
+package plugin
+
+import (
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo"
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/types"
+)
+
+const (
+	// ProtocolVersion is the version of the wire contract between cloudinfo and its plugins.
+	// It is increased on every incompatible change of the RPC methods or their arguments.
+	ProtocolVersion = 1
+
+	// MagicCookieKey and MagicCookieValue are passed to the plugin in its environment,
+	// so a plugin executable refuses to run when it's not launched by cloudinfo.
+	MagicCookieKey   = "CLOUDINFO_PLUGIN_MAGIC_COOKIE"
+	MagicCookieValue = "c2a6dbe1-6a4f-4c3e-8b51-6f0c0c1f4b7e"
+
+	// handshakePrefix prefixes the first line written by the plugin, followed by its protocol version
+	handshakePrefix = "cloudinfo-plugin|"
+
+	// serviceName is the name the infoer is registered with on the RPC server
+	serviceName = "Plugin"
+)
+
+type (
+	// CloudInfoer is the interface plugins implement, it's the same as the one of the built-in providers.
+	CloudInfoer = cloudinfo.CloudInfoer
+
+	// VMInfo describes an instance type.
+	VMInfo = types.VMInfo
+
+	// ZonePrice describes the spot price of an instance type in an availability zone.
+	ZonePrice = types.ZonePrice
+
+	// Price describes the on demand and spot prices of an instance type.
+	Price = types.Price
+
+	// SpotPriceInfo contains the spot prices of an instance type per availability zone.
+	SpotPriceInfo = types.SpotPriceInfo
+
+	// Image describes an image of a service.
+	Image = types.Image
+
+	// LocationVersion describes the versions of a service available in a location.
+	LocationVersion = types.LocationVersion
+
+	// ProductDetails describes a product of a service.
+	ProductDetails = types.ProductDetails
+)
+
+// Empty is the argument of the RPC methods that don't take any.
+type Empty struct{}
+
+// RegionArgs is the argument of the RPC methods taking a region.
+type RegionArgs struct {
+	Region string
+}
+
+// ServiceArgs is the argument of the RPC methods taking a service.
+type ServiceArgs struct {
+	Service string
+}
+
+// ServiceRegionArgs is the argument of the RPC methods taking a service and a region.
+type ServiceRegionArgs struct {
+	Service string
+	Region  string
+}
+
+// ProductsArgs is the argument of the GetProducts RPC method.
+type ProductsArgs struct {
+	VMs     []VMInfo
+	Service string
+	Region  string
+}
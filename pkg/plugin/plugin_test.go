@@ -0,0 +1,154 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"emperror.dev/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/banzaicloud/cloudinfo/internal/cloudinfo/cloudinfoadapter"
+)
+
+// helperEnv makes the test binary act as a plugin when it's launched by the tests
+const helperEnv = "CLOUDINFO_PLUGIN_TEST_HELPER"
+
+func TestMain(m *testing.M) {
+	if os.Getenv(helperEnv) == "1" {
+		if err := Serve(testInfoer{}); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
+	os.Exit(m.Run())
+}
+
+// testInfoer is the infoer served by the helper plugin
+type testInfoer struct{}
+
+func (testInfoer) Initialize() (map[string]map[string]Price, error) {
+	return map[string]map[string]Price{"region-1": {"small": {OnDemandPrice: 0.5}}}, nil
+}
+
+func (testInfoer) GetVirtualMachines(region string) ([]VMInfo, error) {
+	return []VMInfo{{Type: "small", Cpus: 1, Mem: 2, Zones: []string{region}}}, nil
+}
+
+func (testInfoer) GetProducts(vms []VMInfo, service, regionId string) ([]VMInfo, error) {
+	return vms, nil
+}
+
+func (testInfoer) GetZones(region string) ([]string, error) {
+	return []string{region}, nil
+}
+
+func (testInfoer) GetRegions(service string) (map[string]string, error) {
+	return nil, errors.New("no regions for " + service)
+}
+
+func (testInfoer) HasShortLivedPriceInfo() bool {
+	return true
+}
+
+func (testInfoer) GetCurrentPrices(region string) (map[string]Price, error) {
+	return nil, errors.New("no spot prices")
+}
+
+func (testInfoer) HasImages() bool {
+	return false
+}
+
+func (testInfoer) GetServiceImages(service, region string) ([]Image, error) {
+	return nil, nil
+}
+
+func (testInfoer) GetVersions(service, region string) ([]LocationVersion, error) {
+	return []LocationVersion{{Location: region, Versions: []string{"1.21"}, Default: "1.21"}}, nil
+}
+
+func (testInfoer) GetServiceProducts(region, service string) ([]ProductDetails, error) {
+	return nil, nil
+}
+
+func TestClient(t *testing.T) {
+	require.NoError(t, os.Setenv(helperEnv, "1"))
+	defer os.Unsetenv(helperEnv)
+
+	client, err := Start("test", Config{Path: os.Args[0]}, cloudinfoadapter.NewNoopLogger())
+	require.NoError(t, err)
+	defer client.Close()
+
+	prices, err := client.Initialize()
+	require.NoError(t, err)
+	assert.Equal(t, 0.5, prices["region-1"]["small"].OnDemandPrice)
+
+	vms, err := client.GetVirtualMachines("region-1")
+	require.NoError(t, err)
+	assert.Equal(t, []VMInfo{{Type: "small", Cpus: 1, Mem: 2, Zones: []string{"region-1"}}}, vms)
+
+	products, err := client.GetProducts(vms, "compute", "region-1")
+	require.NoError(t, err)
+	assert.Equal(t, vms, products)
+
+	versions, err := client.GetVersions("kubernetes", "region-1")
+	require.NoError(t, err)
+	assert.Equal(t, []LocationVersion{{Location: "region-1", Versions: []string{"1.21"}, Default: "1.21"}}, versions)
+
+	assert.True(t, client.HasShortLivedPriceInfo())
+	assert.False(t, client.HasImages())
+
+	_, err = client.GetRegions("compute")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no regions for compute")
+}
+
+func TestStart_NotAPlugin(t *testing.T) {
+	// without the helper env the test binary runs the tests instead of completing the handshake
+	_, err := Start("test", Config{Path: os.Args[0], Args: []string{"-test.run=^$"}}, cloudinfoadapter.NewNoopLogger())
+	assert.Error(t, err)
+}
+
+func TestServe_NotLaunchedByCloudinfo(t *testing.T) {
+	assert.Error(t, Serve(testInfoer{}))
+}
+
+func TestParseHandshake(t *testing.T) {
+	tests := []struct {
+		name    string
+		line    string
+		wantErr bool
+	}{
+		{name: "compatible", line: fmt.Sprintf("cloudinfo-plugin|%d\n", ProtocolVersion)},
+		{name: "incompatible version", line: "cloudinfo-plugin|0\n", wantErr: true},
+		{name: "invalid version", line: "cloudinfo-plugin|x\n", wantErr: true},
+		{name: "not a handshake", line: "PASS\n", wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := parseHandshake(test.line)
+			if test.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
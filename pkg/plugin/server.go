@@ -0,0 +1,130 @@
+// Copyright © 2021 Banzai Cloud
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"fmt"
+	"io"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+	"os"
+
+	"emperror.dev/errors"
+)
+
+// Serve serves the infoer to cloudinfo over the standard input and output of the process.
+// It's meant to be called from the main function of the plugin and blocks until cloudinfo closes the connection.
+// The standard output is reserved for the RPC connection, so anything printed there afterwards is redirected to the standard error.
+func Serve(infoer CloudInfoer) error {
+	if os.Getenv(MagicCookieKey) != MagicCookieValue {
+		return errors.New("this executable is a cloudinfo plugin, it's not meant to be executed directly")
+	}
+
+	stdout := os.Stdout
+	os.Stdout = os.Stderr
+
+	return serve(infoer, os.Stdin, stdout)
+}
+
+// serve writes the handshake and serves the RPC requests read from r until it's closed
+func serve(infoer CloudInfoer, r io.ReadCloser, w io.WriteCloser) error {
+	server := rpc.NewServer()
+	if err := server.RegisterName(serviceName, &rpcServer{infoer: infoer}); err != nil {
+		return errors.WrapIf(err, "failed to register plugin rpc server")
+	}
+
+	if _, err := fmt.Fprintf(w, "%s%d\n", handshakePrefix, ProtocolVersion); err != nil {
+		return errors.WrapIf(err, "failed to write plugin handshake")
+	}
+
+	server.ServeCodec(jsonrpc.NewServerCodec(readWriteCloser{Reader: r, WriteCloser: w, closer: r}))
+
+	return nil
+}
+
+// rpcServer exposes the methods of an infoer as RPC methods
+type rpcServer struct {
+	infoer CloudInfoer
+}
+
+func (s *rpcServer) Initialize(_ Empty, reply *map[string]map[string]Price) (err error) {
+	*reply, err = s.infoer.Initialize()
+	return
+}
+
+func (s *rpcServer) GetVirtualMachines(args RegionArgs, reply *[]VMInfo) (err error) {
+	*reply, err = s.infoer.GetVirtualMachines(args.Region)
+	return
+}
+
+func (s *rpcServer) GetProducts(args ProductsArgs, reply *[]VMInfo) (err error) {
+	*reply, err = s.infoer.GetProducts(args.VMs, args.Service, args.Region)
+	return
+}
+
+func (s *rpcServer) GetZones(args RegionArgs, reply *[]string) (err error) {
+	*reply, err = s.infoer.GetZones(args.Region)
+	return
+}
+
+func (s *rpcServer) GetRegions(args ServiceArgs, reply *map[string]string) (err error) {
+	*reply, err = s.infoer.GetRegions(args.Service)
+	return
+}
+
+func (s *rpcServer) HasShortLivedPriceInfo(_ Empty, reply *bool) error {
+	*reply = s.infoer.HasShortLivedPriceInfo()
+	return nil
+}
+
+func (s *rpcServer) GetCurrentPrices(args RegionArgs, reply *map[string]Price) (err error) {
+	*reply, err = s.infoer.GetCurrentPrices(args.Region)
+	return
+}
+
+func (s *rpcServer) HasImages(_ Empty, reply *bool) error {
+	*reply = s.infoer.HasImages()
+	return nil
+}
+
+func (s *rpcServer) GetServiceImages(args ServiceRegionArgs, reply *[]Image) (err error) {
+	*reply, err = s.infoer.GetServiceImages(args.Service, args.Region)
+	return
+}
+
+func (s *rpcServer) GetVersions(args ServiceRegionArgs, reply *[]LocationVersion) (err error) {
+	*reply, err = s.infoer.GetVersions(args.Service, args.Region)
+	return
+}
+
+func (s *rpcServer) GetServiceProducts(args ServiceRegionArgs, reply *[]ProductDetails) (err error) {
+	*reply, err = s.infoer.GetServiceProducts(args.Region, args.Service)
+	return
+}
+
+// readWriteCloser joins the two directions of the plugin connection
+type readWriteCloser struct {
+	io.Reader
+	io.WriteCloser
+
+	closer io.Closer
+}
+
+func (c readWriteCloser) Close() error {
+	werr := c.WriteCloser.Close()
+	rerr := c.closer.Close()
+
+	return errors.Combine(werr, rerr)
+}